@@ -0,0 +1,15 @@
+// Package authentication provides the authentication fx module.
+package authentication
+
+import (
+	"go.uber.org/fx"
+)
+
+// Module provided to fxlog.
+var Module = fx.Module(
+	"authenticationfx",
+	fx.Provide(
+		ProvideConfig,
+		NewTokenValidator,
+	),
+)
@@ -0,0 +1,32 @@
+// Package authentication provides the authentication options.
+package authentication
+
+import (
+	"time"
+
+	"github.com/iancoleman/strcase"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/config"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/config/environment"
+	typeMapper "github.com/raphaeldiscky/go-food-micro/internal/pkg/reflection/typemapper"
+)
+
+// optionName is the name of the option for the authentication.
+var optionName = strcase.ToLowerCamel(typeMapper.GetGenericTypeNameByT[AuthenticationOptions]())
+
+// AuthenticationOptions is a struct that contains the options for validating the bearer tokens of the requests.
+type AuthenticationOptions struct {
+	// SigningKey is the HMAC key the HS256 tokens are signed with, the requests are anonymous without it.
+	SigningKey string `mapstructure:"signingKey" env:"SigningKey"`
+	// Issuer is the required `iss` claim, empty accepts any issuer.
+	Issuer string `mapstructure:"issuer"     env:"Issuer"`
+	// Audience is the required `aud` claim, empty accepts any audience.
+	Audience string `mapstructure:"audience"   env:"Audience"`
+	// ClockSkew is the tolerance of the `exp` and `nbf` claims.
+	ClockSkew time.Duration `mapstructure:"clockSkew"  default:"30s"`
+}
+
+// ProvideConfig provides the config for the authentication.
+func ProvideConfig(environment environment.Environment) (*AuthenticationOptions, error) {
+	return config.BindConfigKey[*AuthenticationOptions](optionName, environment)
+}
@@ -0,0 +1,66 @@
+// Package authentication provides the authenticated principal of a request.
+package authentication
+
+import (
+	"context"
+	"strings"
+)
+
+// RoleAdmin is the role of the operators that act on behalf of any customer.
+const RoleAdmin = "admin"
+
+// Principal is a struct that contains the authenticated caller of a request.
+type Principal struct {
+	Subject string
+	Email   string
+	Roles   []string
+}
+
+// Identity returns the subject of the principal, or its email when the token has no subject.
+func (p *Principal) Identity() string {
+	if p.Subject != "" {
+		return p.Subject
+	}
+
+	return p.Email
+}
+
+// HasRole returns true when the principal has the role.
+func (p *Principal) HasRole(role string) bool {
+	for _, r := range p.Roles {
+		if strings.EqualFold(r, role) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsAdmin returns true when the principal has the admin role.
+func (p *Principal) IsAdmin() bool {
+	return p.HasRole(RoleAdmin)
+}
+
+// IsAccount returns true when the email of the principal is the account email.
+func (p *Principal) IsAccount(email string) bool {
+	return p.Email != "" && strings.EqualFold(p.Email, strings.TrimSpace(email))
+}
+
+// principalKey is the context key of the principal.
+type principalKey struct{}
+
+// WithPrincipal returns a context with the authenticated principal.
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the authenticated principal of the context, false for an anonymous caller.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+
+	return principal, ok && principal != nil
+}
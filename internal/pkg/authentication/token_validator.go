@@ -0,0 +1,103 @@
+// Package authentication provides the validation of the bearer tokens.
+package authentication
+
+import (
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/golang-jwt/jwt"
+)
+
+// ErrAuthenticationDisabled is returned when a token is validated without a signing key.
+var ErrAuthenticationDisabled = errors.New("authentication is not configured")
+
+// claims is a struct that contains the claims of a bearer token.
+type claims struct {
+	jwt.StandardClaims
+	Email string   `json:"email,omitempty"`
+	Roles []string `json:"roles,omitempty"`
+}
+
+// TokenValidator validates the HS256 bearer tokens and returns their principal.
+type TokenValidator struct {
+	options *AuthenticationOptions
+	now     func() time.Time
+}
+
+// NewTokenValidator creates a new token validator.
+func NewTokenValidator(options *AuthenticationOptions) *TokenValidator {
+	return &TokenValidator{options: options, now: time.Now}
+}
+
+// Enabled returns true when the tokens can be validated.
+func (v *TokenValidator) Enabled() bool {
+	return v != nil && v.options != nil && v.options.SigningKey != ""
+}
+
+// Validate checks the signature, the expiry, the issuer and the audience of the token and returns its principal.
+func (v *TokenValidator) Validate(token string) (*Principal, error) {
+	if !v.Enabled() {
+		return nil, ErrAuthenticationDisabled
+	}
+
+	tokenClaims := &claims{}
+
+	// the claims are validated below with the clock skew, so the parser only checks the signature
+	parser := &jwt.Parser{ValidMethods: []string{jwt.SigningMethodHS256.Alg()}, SkipClaimsValidation: true}
+
+	_, err := parser.ParseWithClaims(token, tokenClaims, func(*jwt.Token) (interface{}, error) {
+		return []byte(v.options.SigningKey), nil
+	})
+	if err != nil {
+		return nil, errors.WrapIf(err, "invalid token")
+	}
+
+	now := v.now()
+	skew := int64(v.options.ClockSkew / time.Second)
+
+	switch {
+	case tokenClaims.ExpiresAt == 0 || !tokenClaims.VerifyExpiresAt(now.Unix()-skew, true):
+		return nil, errors.New("token is expired")
+	case !tokenClaims.VerifyNotBefore(now.Unix()+skew, false):
+		return nil, errors.New("token is not valid yet")
+	case v.options.Issuer != "" && !tokenClaims.VerifyIssuer(v.options.Issuer, true):
+		return nil, errors.New("token has an invalid issuer")
+	case v.options.Audience != "" && !tokenClaims.VerifyAudience(v.options.Audience, true):
+		return nil, errors.New("token has an invalid audience")
+	case tokenClaims.Subject == "":
+		return nil, errors.New("token has no subject")
+	}
+
+	return &Principal{
+		Subject: tokenClaims.Subject,
+		Email:   tokenClaims.Email,
+		Roles:   tokenClaims.Roles,
+	}, nil
+}
+
+// Issue signs a token of the principal valid for the ttl, it is used by the tests and the tools of the operators.
+func (v *TokenValidator) Issue(principal *Principal, ttl time.Duration) (string, error) {
+	if !v.Enabled() {
+		return "", ErrAuthenticationDisabled
+	}
+
+	now := v.now()
+	tokenClaims := &claims{
+		StandardClaims: jwt.StandardClaims{
+			Subject:   principal.Subject,
+			Issuer:    v.options.Issuer,
+			Audience:  v.options.Audience,
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(ttl).Unix(),
+		},
+		Email: principal.Email,
+		Roles: principal.Roles,
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, tokenClaims).SignedString([]byte(v.options.SigningKey))
+	if err != nil {
+		return "", errors.WrapIf(err, "error in signing the token")
+	}
+
+	return token, nil
+}
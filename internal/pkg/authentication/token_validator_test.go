@@ -0,0 +1,75 @@
+//go:build unit
+// +build unit
+
+// Package authentication provides the token validator tests.
+package authentication
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTokenValidator() *TokenValidator {
+	return NewTokenValidator(&AuthenticationOptions{
+		SigningKey: "test-signing-key",
+		Issuer:     "food-micro",
+		Audience:   "orderservice",
+		ClockSkew:  time.Second,
+	})
+}
+
+func Test_TokenValidator_Returns_The_Principal_Of_An_Issued_Token(t *testing.T) {
+	validator := newTestTokenValidator()
+
+	token, err := validator.Issue(
+		&Principal{Subject: "user-1", Email: "john@example.com", Roles: []string{RoleAdmin}},
+		time.Minute,
+	)
+	require.NoError(t, err)
+
+	principal, err := validator.Validate(token)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", principal.Subject)
+	assert.True(t, principal.IsAccount("John@Example.com"))
+	assert.True(t, principal.IsAdmin())
+}
+
+func Test_TokenValidator_Rejects_Invalid_Tokens(t *testing.T) {
+	validator := newTestTokenValidator()
+	principal := &Principal{Subject: "user-1", Email: "john@example.com"}
+
+	expired, err := validator.Issue(principal, -time.Minute)
+	require.NoError(t, err)
+
+	otherKey, err := NewTokenValidator(&AuthenticationOptions{SigningKey: "other-key"}).Issue(principal, time.Minute)
+	require.NoError(t, err)
+
+	otherAudience, err := NewTokenValidator(&AuthenticationOptions{
+		SigningKey: "test-signing-key",
+		Issuer:     "food-micro",
+		Audience:   "catalogs",
+	}).Issue(principal, time.Minute)
+	require.NoError(t, err)
+
+	for name, token := range map[string]string{
+		"expired":        expired,
+		"other key":      otherKey,
+		"other audience": otherAudience,
+		"malformed":      "not-a-token",
+	} {
+		_, err := validator.Validate(token)
+		assert.Error(t, err, name)
+	}
+}
+
+func Test_TokenValidator_Without_A_Signing_Key_Is_Disabled(t *testing.T) {
+	validator := NewTokenValidator(&AuthenticationOptions{})
+
+	assert.False(t, validator.Enabled())
+
+	_, err := validator.Validate("token")
+	assert.ErrorIs(t, err, ErrAuthenticationDisabled)
+}
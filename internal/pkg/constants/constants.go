@@ -33,6 +33,7 @@ const (
 const (
 	ErrBadRequestTitle          = "Bad Request"
 	ErrConflictTitle            = "Conflict Error"
+	ErrPreconditionFailedTitle  = "Precondition Failed"
 	ErrNotFoundTitle            = "Not Found"
 	ErrUnauthorizedTitle        = "Unauthorized"
 	ErrForbiddenTitle           = "Forbidden"
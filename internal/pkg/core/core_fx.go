@@ -4,7 +4,12 @@ package core
 import (
 	"go.uber.org/fx"
 
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/transform"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/avro"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/json"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/protobuf"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/schemaregistry"
 )
 
 // Module provided to fxlog.
@@ -14,7 +19,53 @@ var Module = fx.Module(
 	fx.Provide(
 		json.NewDefaultJsonSerializer,
 		json.NewDefaultEventJsonSerializer,
-		json.NewDefaultMessageJsonSerializer,
+		newMessageSerializer,
 		json.NewDefaultMetadataJsonSerializer,
+		newTransformPipeline,
 	),
 )
+
+// transformPipelineParams are the message transforms that are provided with transform.AsTransform.
+type transformPipelineParams struct {
+	fx.In
+
+	Transforms []transform.Transform `group:"messageTransforms"`
+}
+
+// newTransformPipeline provides the pipeline of the message transforms, the pipeline is run by the producers, the
+// consumers and the event store.
+func newTransformPipeline(params transformPipelineParams) *transform.Pipeline {
+	return transform.NewPipeline(params.Transforms...)
+}
+
+// messageSerializerParams are the dependencies of the message serializer, the schema registry is provided by the
+// schemaregistry module.
+type messageSerializerParams struct {
+	fx.In
+
+	Serializer            serializer.Serializer
+	SchemaRegistry        *schemaregistry.Registry              `optional:"true"`
+	SchemaRegistryOptions *schemaregistry.SchemaRegistryOptions `optional:"true"`
+}
+
+// newMessageSerializer provides the json message serializer that also deserializes the protobuf and avro messages
+// by their content type, the json payloads are validated with the schema registry when a validation is enabled.
+func newMessageSerializer(params messageSerializerParams) serializer.MessageSerializer {
+	var messageSerializer serializer.MessageSerializer = serializer.NewContentTypeMessageSerializer(
+		json.NewDefaultMessageJsonSerializer(params.Serializer),
+		protobuf.NewProtobufMessageSerializer(),
+		avro.NewAvroMessageSerializer(),
+	)
+
+	options := params.SchemaRegistryOptions
+	if params.SchemaRegistry == nil || options == nil || (!options.ValidateOnPublish && !options.ValidateOnConsume) {
+		return messageSerializer
+	}
+
+	return schemaregistry.NewValidatingMessageSerializer(
+		messageSerializer,
+		params.SchemaRegistry,
+		options.ValidateOnPublish,
+		options.ValidateOnConsume,
+	)
+}
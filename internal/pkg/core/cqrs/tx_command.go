@@ -0,0 +1,31 @@
+// Package cqrs provides a module for the cqrs.
+package cqrs
+
+// txCommand is a tx command.
+type txCommand struct {
+	Command
+}
+
+// TxCommand is a command that the mediator transaction pipeline runs in a transaction.
+type TxCommand interface {
+	Command
+	isTxRequest()
+}
+
+// NewTxCommandByT creates a new tx command by type.
+func NewTxCommandByT[T any]() TxCommand {
+	return &txCommand{Command: NewCommandByT[T]()}
+}
+
+// isTxRequest is a tx request.
+func (c *txCommand) isTxRequest() {
+}
+
+// IsTxCommand checks if the object is a tx command.
+func IsTxCommand(obj interface{}) bool {
+	if _, ok := obj.(TxCommand); ok {
+		return true
+	}
+
+	return false
+}
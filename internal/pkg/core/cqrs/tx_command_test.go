@@ -0,0 +1,42 @@
+//go:build unit
+// +build unit
+
+// Package cqrs provides the cqrs implementation.
+package cqrs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// TestTxCommand tests the tx command.
+func TestTxCommand(t *testing.T) {
+	t.Helper()
+
+	command := &ChangeProductPriceTest{
+		TxCommand: NewTxCommandByT[*ChangeProductPriceTest](),
+		ProductID: uuid.NewV4(),
+		NewPrice:  100,
+	}
+
+	var i interface{} = command
+	_, isTxRequest := i.(TxRequest)
+
+	assert.True(t, isTxRequest)
+	assert.True(t, IsTxCommand(command))
+	assert.True(t, IsCommand(command))
+	assert.True(t, IsRequest(command))
+	assert.False(t, IsTxCommand(&CreateProductTest{Command: NewCommandByT[*CreateProductTest]()}))
+	assert.Equal(t, command.ShortTypeName(), "*ChangeProductPriceTest")
+}
+
+// ChangeProductPriceTest is a struct that represents a change product price test.
+type ChangeProductPriceTest struct {
+	TxCommand
+
+	ProductID uuid.UUID
+	NewPrice  float64
+}
@@ -0,0 +1,97 @@
+// Package consumer provides the handling of the consumed messages with the consumer pipelines and the retries.
+package consumer
+
+import (
+	"context"
+	"time"
+
+	"emperror.dev/errors"
+
+	linq "github.com/ahmetb/go-linq/v3"
+	retry "github.com/avast/retry-go"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/pipeline"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+)
+
+const (
+	retryAttempts = 3
+	retryDelay    = 300 * time.Millisecond
+)
+
+var retryOptions = []retry.Option{
+	retry.Attempts(retryAttempts),
+	retry.Delay(retryDelay),
+	retry.DelayType(retry.BackOffDelay),
+}
+
+// HandleWithRetry runs a consumer handler behind the consumer pipelines and retries it with a backoff, it is shared
+// by the consumers of the transports.
+func HandleWithRetry(
+	ctx context.Context,
+	pipelines []pipeline.ConsumerPipeline,
+	handler ConsumerHandler,
+	messageConsumeContext types.MessageConsumeContext,
+) error {
+	err := retry.Do(func() error {
+		var lastHandler pipeline.ConsumerHandlerFunc
+
+		if len(pipelines) > 0 {
+			reversPipes := reversOrder(pipelines)
+			lastHandler = func(ctx context.Context) error {
+				return handler.Handle(ctx, messageConsumeContext)
+			}
+
+			aggregateResult := linq.From(reversPipes).
+				AggregateWithSeedT(lastHandler, func(next pipeline.ConsumerHandlerFunc, pipe pipeline.ConsumerPipeline) pipeline.ConsumerHandlerFunc {
+					pipeValue := pipe
+					nexValue := next
+
+					return func(ctx context.Context) error {
+						return pipeValue.Handle(
+							ctx,
+							messageConsumeContext,
+							nexValue,
+						)
+					}
+				})
+
+			v, ok := aggregateResult.(pipeline.ConsumerHandlerFunc)
+			if !ok {
+				return errors.New(
+					"failed to convert aggregateResult to pipeline.ConsumerHandlerFunc",
+				)
+			}
+			err := v(ctx)
+			if err != nil {
+				return errors.Wrap(
+					err,
+					"error handling consumer handlers pipeline",
+				)
+			}
+
+			return nil
+		}
+		err := handler.Handle(ctx, messageConsumeContext)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}, append(retryOptions, retry.Context(ctx))...)
+
+	return err
+}
+
+// reversOrder returns the pipelines in the reverse order.
+func reversOrder(
+	values []pipeline.ConsumerPipeline,
+) []pipeline.ConsumerPipeline {
+	var reverseValues []pipeline.ConsumerPipeline
+
+	for i := len(values) - 1; i >= 0; i-- {
+		reverseValues = append(reverseValues, values[i])
+	}
+
+	return reverseValues
+}
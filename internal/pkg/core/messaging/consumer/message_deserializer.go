@@ -0,0 +1,61 @@
+// Package consumer provides the deserialization of the consumed messages.
+package consumer
+
+import (
+	"emperror.dev/errors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/transform"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/versioning"
+)
+
+// DeserializeMessage decodes the payload of a consumed message, upcasts it to the current schema, deserializes it with the
+// serializer of its content type and reverts the message transforms of the producer.
+func DeserializeMessage(
+	messageSerializer serializer.MessageSerializer,
+	transforms *transform.Pipeline,
+	contentType string,
+	contentEncoding string,
+	eventType string,
+	schemaVersion int,
+	body []byte,
+) (types.IMessage, error) {
+	payload := &transform.Payload{Data: body, ContentEncoding: contentEncoding}
+	if err := transforms.DecodePayload(payload); err != nil {
+		return nil, errors.WrapIff(
+			err,
+			"error in decoding the payload of type '%s' with content encoding '%s'",
+			eventType,
+			contentEncoding,
+		)
+	}
+
+	// the messages published with an older schema are upcasted before the handlers see them
+	upcastedType, upcastedBody, err := versioning.Upcast[types.IMessage](
+		eventType,
+		schemaVersion,
+		payload.Data,
+	)
+	if err != nil {
+		return nil, errors.WrapIff(err, "error in upcasting of type '%s'", eventType)
+	}
+
+	// the message serializer negotiates the serializer of the content type, the unsupported ones fail here
+	message, err := messageSerializer.Deserialize(upcastedBody, upcastedType, contentType)
+	if err != nil {
+		return nil, errors.WrapIff(
+			err,
+			"error in deserilizng of type '%s' with content type '%s'",
+			upcastedType,
+			contentType,
+		)
+	}
+
+	// the message transforms of the producer are reverted, like the encryption of the pii fields
+	if err := transforms.DecodeMessage(message); err != nil {
+		return nil, errors.WrapIff(err, "error in decoding the message of type '%s'", upcastedType)
+	}
+
+	return message, nil
+}
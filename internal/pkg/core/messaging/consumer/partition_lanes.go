@@ -0,0 +1,87 @@
+// Package consumer provides the partition lanes of the consumers that order the messages by their partition key.
+package consumer
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+)
+
+// PartitionLanes handles the deliveries of the same partition key one after another on the same lane, while the
+// deliveries of the other partition keys are handled concurrently on the other lanes.
+type PartitionLanes[T any] struct {
+	lanes   []chan T
+	workers sync.WaitGroup
+}
+
+// NewPartitionLanes creates and starts the lanes, every lane handles its deliveries in their dispatch order until the
+// context is done or the lanes are closed.
+func NewPartitionLanes[T any](
+	ctx context.Context,
+	count int,
+	buffer int,
+	handle func(ctx context.Context, delivery T),
+) *PartitionLanes[T] {
+	l := &PartitionLanes[T]{lanes: make([]chan T, max(count, 1))}
+
+	for i := range l.lanes {
+		lane := make(chan T, max(buffer, 0))
+		l.lanes[i] = lane
+
+		l.workers.Add(1)
+		go func() {
+			defer l.workers.Done()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case delivery, ok := <-lane:
+					if !ok {
+						return
+					}
+					handle(ctx, delivery)
+				}
+			}
+		}()
+	}
+
+	return l
+}
+
+// Dispatch queues a delivery on the lane of its partition key, it waits while the lane is full and returns false
+// when the context is done before the delivery is queued.
+func (l *PartitionLanes[T]) Dispatch(ctx context.Context, partitionKey string, delivery T) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case l.lanes[LaneIndex(partitionKey, len(l.lanes))] <- delivery:
+		return true
+	}
+}
+
+// Close closes the lanes after the last dispatch and waits for their workers, it returns the queued deliveries that
+// weren't handled because the context was done.
+func (l *PartitionLanes[T]) Close() []T {
+	for _, lane := range l.lanes {
+		close(lane)
+	}
+	l.workers.Wait()
+
+	var unhandled []T
+	for _, lane := range l.lanes {
+		for delivery := range lane {
+			unhandled = append(unhandled, delivery)
+		}
+	}
+
+	return unhandled
+}
+
+// LaneIndex returns the lane of a partition key, a partition key always gets the same lane of the same lanes count.
+func LaneIndex(partitionKey string, count int) int {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(partitionKey))
+
+	return int(hash.Sum32() % uint32(count))
+}
@@ -0,0 +1,102 @@
+//go:build unit
+// +build unit
+
+// Package consumer provides the partition lanes tests.
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type keyedDelivery struct {
+	key      string
+	sequence int
+}
+
+func Test_PartitionLanes_Handle_The_Deliveries_Of_A_Key_In_Order(t *testing.T) {
+	var mu sync.Mutex
+	handled := map[string][]int{}
+	active := map[string]bool{}
+
+	lanes := NewPartitionLanes(context.Background(), 4, 2, func(_ context.Context, delivery keyedDelivery) {
+		mu.Lock()
+		assert.False(t, active[delivery.key], "deliveries of the key `%s` are handled concurrently", delivery.key)
+		active[delivery.key] = true
+		mu.Unlock()
+
+		time.Sleep(time.Millisecond)
+
+		mu.Lock()
+		active[delivery.key] = false
+		handled[delivery.key] = append(handled[delivery.key], delivery.sequence)
+		mu.Unlock()
+	})
+
+	const keys, deliveries = 8, 20
+	for sequence := 0; sequence < deliveries; sequence++ {
+		for key := 0; key < keys; key++ {
+			require.True(t, lanes.Dispatch(context.Background(), fmt.Sprintf("key-%d", key), keyedDelivery{
+				key:      fmt.Sprintf("key-%d", key),
+				sequence: sequence,
+			}))
+		}
+	}
+	assert.Empty(t, lanes.Close())
+
+	require.Len(t, handled, keys)
+	for key, sequences := range handled {
+		require.Len(t, sequences, deliveries, key)
+		for i, sequence := range sequences {
+			assert.Equal(t, i, sequence, key)
+		}
+	}
+}
+
+func Test_PartitionLanes_Return_The_Unhandled_Deliveries_After_The_Context_Is_Done(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	started := make(chan struct{}, 3)
+	block := make(chan struct{})
+
+	var mu sync.Mutex
+	var handled []int
+
+	lanes := NewPartitionLanes(ctx, 1, 2, func(_ context.Context, delivery keyedDelivery) {
+		started <- struct{}{}
+		<-block
+
+		mu.Lock()
+		handled = append(handled, delivery.sequence)
+		mu.Unlock()
+	})
+
+	require.True(t, lanes.Dispatch(ctx, "key", keyedDelivery{key: "key", sequence: 0}))
+	<-started
+
+	// the lane is full while its worker handles the first delivery
+	require.True(t, lanes.Dispatch(ctx, "key", keyedDelivery{key: "key", sequence: 1}))
+	require.True(t, lanes.Dispatch(ctx, "key", keyedDelivery{key: "key", sequence: 2}))
+
+	cancel()
+	assert.False(t, lanes.Dispatch(ctx, "key", keyedDelivery{key: "key", sequence: 3}))
+	close(block)
+
+	// every dispatched delivery is either handled or returned in its dispatch order
+	unhandled := lanes.Close()
+	sequences := handled
+	for _, delivery := range unhandled {
+		sequences = append(sequences, delivery.sequence)
+	}
+	assert.Equal(t, []int{0, 1, 2}, sequences)
+}
+
+func Test_LaneIndex_Is_Stable(t *testing.T) {
+	assert.Equal(t, LaneIndex("product-1", 8), LaneIndex("product-1", 8))
+	assert.Less(t, LaneIndex("product-1", 8), 8)
+}
@@ -7,4 +7,6 @@ const (
 	Type          string = "type"
 	ContentType   string = "content-type"
 	Created       string = "created"
+	ReplyTo       string = "reply-to"
+	PartitionKey  string = "partition-key"
 )
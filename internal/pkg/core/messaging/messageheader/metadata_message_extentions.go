@@ -38,8 +38,9 @@ func SetMessageType(m metadata.Metadata, val string) {
 	m.Set(Type, val)
 }
 
+// SetMessageContentType sets the `content-type` header, the `type` header keeps the message type.
 func SetMessageContentType(m metadata.Metadata, val string) {
-	m.Set(Type, val)
+	m.Set(ContentType, val)
 }
 
 func GetMessageContentType(m metadata.Metadata) string {
@@ -53,3 +54,19 @@ func GetMessageCreated(m metadata.Metadata) time.Time {
 func SetMessageCreated(m metadata.Metadata, val time.Time) {
 	m.Set(Created, val)
 }
+
+func GetReplyTo(m metadata.Metadata) string {
+	return m.GetString(ReplyTo)
+}
+
+func SetReplyTo(m metadata.Metadata, val string) {
+	m.Set(ReplyTo, val)
+}
+
+func GetPartitionKey(m metadata.Metadata) string {
+	return m.GetString(PartitionKey)
+}
+
+func SetPartitionKey(m metadata.Metadata, val string) {
+	m.Set(PartitionKey, val)
+}
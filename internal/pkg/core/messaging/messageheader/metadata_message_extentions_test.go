@@ -0,0 +1,23 @@
+//go:build unit
+// +build unit
+
+package messageHeader
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/metadata"
+)
+
+func Test_SetMessageContentType_Keeps_The_Message_Type(t *testing.T) {
+	meta := metadata.Metadata{}
+
+	SetMessageType(meta, "products.product-created")
+	SetMessageContentType(meta, "application/json")
+
+	assert.Equal(t, "products.product-created", GetMessageType(meta))
+	assert.Equal(t, "application/json", GetMessageContentType(meta))
+	assert.Equal(t, "application/json", meta.GetString(ContentType))
+}
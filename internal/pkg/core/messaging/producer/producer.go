@@ -19,3 +19,9 @@ type Producer interface {
 	) error
 	IsProduced(func(message types.IMessage))
 }
+
+// BatchProducer is a producer that publishes a batch of messages and waits for all of them together.
+type BatchProducer interface {
+	Producer
+	PublishMessages(ctx context.Context, messages []types.IMessage, meta metadata.Metadata) error
+}
@@ -0,0 +1,52 @@
+// Package producer provides the message headers of the published messages.
+package producer
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+
+	messageHeader "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/messageheader"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/utils"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/metadata"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/versioning"
+)
+
+// PublishingMetadata returns a copy of the metadata with the message headers of a publishing, it is shared by the
+// producers of the transports.
+func PublishingMetadata(
+	message types.IMessage,
+	meta metadata.Metadata,
+	messageSerializer serializer.MessageSerializer,
+) metadata.Metadata {
+	meta = metadata.FromMetadata(meta)
+
+	// the stable alias of the message type, or just message type name not full type name because in other side
+	// package name for type could be different
+	messageHeader.SetMessageType(meta, versioning.TypeName(message))
+	versioning.SetSchemaVersion(meta, versioning.SchemaVersion(message))
+	messageHeader.SetMessageContentType(meta, messageSerializer.ContentType())
+
+	if messageHeader.GetMessageId(meta) == "" {
+		messageHeader.SetMessageId(meta, message.GeMessageId())
+	}
+
+	if messageHeader.GetMessageCreated(meta).Equal(*new(time.Time)) {
+		messageHeader.SetMessageCreated(meta, message.GetCreated())
+	}
+
+	if messageHeader.GetCorrelationId(meta) == "" {
+		cid := uuid.NewV4().String()
+		messageHeader.SetCorrelationId(meta, cid)
+	}
+	messageHeader.SetMessageName(meta, utils.GetMessageName(message))
+
+	// the consumers that order the messages by their partition key read it without deserializing the messages
+	if partitionKey := utils.GetPartitionKey(message); partitionKey != "" {
+		messageHeader.SetPartitionKey(meta, partitionKey)
+	}
+
+	return meta
+}
@@ -0,0 +1,71 @@
+// Package requestreply provides the requests that wait for their replies.
+package requestreply
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// PendingRequests tracks the requests that wait for their replies by their correlation ids, it is shared by the
+// requesters of the transports.
+type PendingRequests[T any] struct {
+	mu       sync.Mutex
+	requests map[string]chan T
+}
+
+// NewPendingRequests creates a new pending requests.
+func NewPendingRequests[T any]() *PendingRequests[T] {
+	return &PendingRequests[T]{requests: make(map[string]chan T)}
+}
+
+// Add adds a request and returns the channel of its reply.
+func (p *PendingRequests[T]) Add(correlationId string) <-chan T {
+	replies := make(chan T, 1)
+
+	p.mu.Lock()
+	p.requests[correlationId] = replies
+	p.mu.Unlock()
+
+	return replies
+}
+
+// Remove removes a request that doesn't wait for its reply anymore.
+func (p *PendingRequests[T]) Remove(correlationId string) {
+	p.mu.Lock()
+	delete(p.requests, correlationId)
+	p.mu.Unlock()
+}
+
+// Resolve delivers the reply of a request, it returns false for a reply without a waiting request, like the late
+// reply of a timed out request.
+func (p *PendingRequests[T]) Resolve(correlationId string, reply T) bool {
+	p.mu.Lock()
+	replies, ok := p.requests[correlationId]
+	delete(p.requests, correlationId)
+	p.mu.Unlock()
+
+	if ok {
+		replies <- reply
+	}
+
+	return ok
+}
+
+// Len returns the number of the waiting requests.
+func (p *PendingRequests[T]) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return len(p.requests)
+}
+
+// WithRequestTimeout returns a context that is done after the request timeout, a sooner deadline of the context is
+// kept.
+func WithRequestTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
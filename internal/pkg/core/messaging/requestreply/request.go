@@ -0,0 +1,88 @@
+// Package requestreply provides the typed requests and request handlers.
+package requestreply
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/otel/tracing"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/metadata"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing/utils"
+)
+
+// Request sends a request and returns its reply of the reply type, the span of the request covers the whole round
+// trip and is the parent of the spans of the request and the reply.
+func Request[TReq types.IMessage, TRes types.IMessage](
+	ctx context.Context,
+	requester Requester,
+	request TReq,
+	meta metadata.Metadata,
+) (TRes, error) {
+	var empty TRes
+
+	ctx, span := tracing.MessagingTracer.Start(
+		ctx,
+		fmt.Sprintf("%s request", request.GetMessageTypeName()),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.MessageIDKey.String(request.GeMessageId()),
+			attribute.Key(tracing.MessageType).String(request.GetMessageTypeName()),
+		),
+	)
+	defer span.End()
+
+	reply, err := requester.SendRequest(ctx, request, meta)
+	if err != nil {
+		return empty, utils.TraceStatusFromSpan(span, err)
+	}
+
+	typedReply, ok := reply.(TRes)
+	if !ok {
+		return empty, utils.TraceStatusFromSpan(
+			span,
+			errors.WithMessagef(
+				ErrUnexpectedReply,
+				"reply `%s` of the request `%s`",
+				reply.GetMessageTypeName(),
+				request.GetMessageTypeName(),
+			),
+		)
+	}
+
+	span.SetAttributes(attribute.String("messaging.reply_type", reply.GetMessageTypeName()))
+	_ = utils.TraceStatusFromSpan(span, nil)
+
+	return typedReply, nil
+}
+
+// requestHandler is a request handler of the requests of a request type.
+type requestHandler[TReq types.IMessage, TRes types.IMessage] struct {
+	handle func(ctx context.Context, request TReq) (TRes, error)
+}
+
+// NewRequestHandler creates a new request handler from a function of the request type and the reply type.
+func NewRequestHandler[TReq types.IMessage, TRes types.IMessage](
+	handle func(ctx context.Context, request TReq) (TRes, error),
+) RequestHandler {
+	return &requestHandler[TReq, TRes]{handle: handle}
+}
+
+// Handle handles a request of the request type.
+func (h *requestHandler[TReq, TRes]) Handle(
+	ctx context.Context,
+	consumeContext types.MessageConsumeContext,
+) (types.IMessage, error) {
+	request, ok := consumeContext.Message().(TReq)
+	if !ok {
+		return nil, errors.Errorf("request of type `%s` can't be handled", consumeContext.MessageType())
+	}
+
+	return h.handle(ctx, request)
+}
@@ -0,0 +1,97 @@
+// Package requestreply provides the request/reply messaging on top of the message transports.
+package requestreply
+
+import (
+	"context"
+	"time"
+
+	"emperror.dev/errors"
+
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/metadata"
+	typeMapper "github.com/raphaeldiscky/go-food-micro/internal/pkg/reflection/typemapper"
+)
+
+// DefaultRequestTimeout is the request timeout of the transports without a configured request timeout.
+const DefaultRequestTimeout = 30 * time.Second
+
+var (
+	// ErrRequestsNotSupported is returned by a bus whose producer can't send the requests.
+	ErrRequestsNotSupported = errors.New("the producer of the bus doesn't support the requests")
+	// ErrUnexpectedReply is returned for a reply that isn't of the reply type of the request.
+	ErrUnexpectedReply = errors.New("unexpected reply type")
+)
+
+// Requester sends the requests and waits for their replies.
+type Requester interface {
+	// SendRequest publishes a request with a new correlation id and waits for the reply with the same correlation id,
+	// until the context is done or the request timeout of the transport is reached.
+	SendRequest(ctx context.Context, request types.IMessage, meta metadata.Metadata) (types.IMessage, error)
+}
+
+// Replier publishes the replies of the requests to their reply addresses.
+type Replier interface {
+	PublishReply(
+		ctx context.Context,
+		replyTo string,
+		correlationId string,
+		reply types.IMessage,
+		meta metadata.Metadata,
+	) error
+}
+
+// RequestHandler handles a request and returns its reply.
+type RequestHandler interface {
+	Handle(ctx context.Context, consumeContext types.MessageConsumeContext) (types.IMessage, error)
+}
+
+// Responder connects the request handlers of the request types, like ConnectConsumerHandler connects the consumer
+// handlers of the message types.
+type Responder interface {
+	ConnectResponder(requestType types.IMessage, handler RequestHandler) error
+}
+
+// ErrorReply is the reply of a request whose handler failed.
+type ErrorReply struct {
+	*types.Message
+	Error string `json:"error"`
+}
+
+// NewErrorReply creates a new error reply.
+func NewErrorReply(err error) *ErrorReply {
+	return &ErrorReply{
+		Message: types.NewMessage(uuid.NewV4().String()),
+		Error:   err.Error(),
+	}
+}
+
+// GetMessageTypeName returns the message type name.
+func (r *ErrorReply) GetMessageTypeName() string {
+	return typeMapper.GetTypeName(r)
+}
+
+// GetMessageFullTypeName returns the message full type name.
+func (r *ErrorReply) GetMessageFullTypeName() string {
+	return typeMapper.GetFullTypeName(r)
+}
+
+// ReplyError is the error of a request whose handler failed on the responder side.
+type ReplyError struct {
+	Message string
+}
+
+// Error returns the error message of the responder.
+func (e *ReplyError) Error() string {
+	return "request failed on the responder: " + e.Message
+}
+
+// ReplyResult returns the reply of a received reply message, an error reply is returned as a ReplyError.
+func ReplyResult(reply types.IMessage) (types.IMessage, error) {
+	if errorReply, ok := reply.(*ErrorReply); ok {
+		return nil, &ReplyError{Message: errorReply.Error}
+	}
+
+	return reply, nil
+}
@@ -0,0 +1,62 @@
+// Package requestreply provides the consumer handler of the responders.
+package requestreply
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/consumer"
+	messageHeader "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/messageheader"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+)
+
+// responderHandler is the consumer handler of a request handler, it publishes the reply of a request to the reply
+// address of the request.
+type responderHandler struct {
+	handler RequestHandler
+	replier Replier
+	logger  logger.Logger
+}
+
+// NewResponderHandler creates a new consumer handler that replies to the requests with a request handler.
+func NewResponderHandler(
+	handler RequestHandler,
+	replier Replier,
+	logger logger.Logger,
+) consumer.ConsumerHandler {
+	return &responderHandler{handler: handler, replier: replier, logger: logger}
+}
+
+// Handle handles a request and publishes its reply, the error of the request handler is sent back as an error
+// reply instead of redelivering the request.
+func (h *responderHandler) Handle(ctx context.Context, consumeContext types.MessageConsumeContext) error {
+	replyTo := messageHeader.GetReplyTo(consumeContext.Metadata())
+
+	reply, err := h.handler.Handle(ctx, consumeContext)
+
+	// a request that is published without a reply address is handled like the other messages
+	if replyTo == "" {
+		return err
+	}
+
+	if err == nil && reply == nil {
+		err = errors.New("request handler returned no reply")
+	}
+
+	if err != nil {
+		reply = NewErrorReply(err)
+	}
+
+	// the requester waits for a reply until its timeout, so a request isn't handled again for a lost reply
+	if err := h.replier.PublishReply(ctx, replyTo, consumeContext.CorrelationId(), reply, nil); err != nil {
+		h.logger.Errorf(
+			"[responderHandler.Handle] error in publishing the reply of the request `%s`: %v",
+			consumeContext.MessageId(),
+			err,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+// Package compression provides the gzip and zstd compression of the message payloads.
+package compression
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+
+	"emperror.dev/errors"
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/transform"
+)
+
+const (
+	// EncodingGzip is the content encoding of the gzip compressed payloads.
+	EncodingGzip = "gzip"
+	// EncodingZstd is the content encoding of the zstd compressed payloads.
+	EncodingZstd = "zstd"
+
+	// maxDecompressedSize is the max size of a decompressed payload, it protects the consumers from the
+	// decompression bombs.
+	maxDecompressedSize = 64 << 20
+)
+
+// ErrUnsupportedEncoding is returned for a compression encoding other than gzip and zstd.
+var ErrUnsupportedEncoding = errors.New("unsupported compression encoding")
+
+// CompressionTransform compresses the outgoing payloads with its encoding and decompresses the incoming gzip and
+// zstd payloads, whatever its encoding is.
+type CompressionTransform struct {
+	encoding    string
+	minSize     int
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+}
+
+// NewCompressionTransform creates a new compression transform, the payloads smaller than the min size are not
+// compressed and an empty encoding only decompresses the payloads.
+func NewCompressionTransform(encoding string, minSize int) (*CompressionTransform, error) {
+	if encoding != "" && encoding != EncodingGzip && encoding != EncodingZstd {
+		return nil, errors.WithMessagef(ErrUnsupportedEncoding, "`%s`", encoding)
+	}
+
+	zstdEncoder, err := zstd.NewWriter(nil)
+	if err != nil {
+		return nil, errors.WrapIf(err, "error in creating the zstd encoder")
+	}
+
+	zstdDecoder, err := zstd.NewReader(nil, zstd.WithDecoderMaxMemory(maxDecompressedSize))
+	if err != nil {
+		return nil, errors.WrapIf(err, "error in creating the zstd decoder")
+	}
+
+	return &CompressionTransform{
+		encoding:    encoding,
+		minSize:     minSize,
+		zstdEncoder: zstdEncoder,
+		zstdDecoder: zstdDecoder,
+	}, nil
+}
+
+// Name returns the name of the transform.
+func (c *CompressionTransform) Name() string {
+	return "compression"
+}
+
+// Encoding returns the compression encoding of the outgoing payloads.
+func (c *CompressionTransform) Encoding() string {
+	return c.encoding
+}
+
+// EncodePayload compresses an outgoing payload, the small and the already encoded payloads are not compressed.
+func (c *CompressionTransform) EncodePayload(payload *transform.Payload) error {
+	if c.encoding == "" || payload.ContentEncoding != "" || len(payload.Data) < c.minSize {
+		return nil
+	}
+
+	var data []byte
+	switch c.encoding {
+	case EncodingGzip:
+		var buffer bytes.Buffer
+		writer := gzip.NewWriter(&buffer)
+		if _, err := writer.Write(payload.Data); err != nil {
+			return errors.WrapIf(err, "error in gzip compression of the payload")
+		}
+
+		if err := writer.Close(); err != nil {
+			return errors.WrapIf(err, "error in gzip compression of the payload")
+		}
+		data = buffer.Bytes()
+	case EncodingZstd:
+		data = c.zstdEncoder.EncodeAll(payload.Data, make([]byte, 0, len(payload.Data)))
+	}
+
+	payload.Data = data
+	payload.ContentEncoding = c.encoding
+
+	return nil
+}
+
+// DecodePayload decompresses an incoming gzip or zstd payload.
+func (c *CompressionTransform) DecodePayload(payload *transform.Payload) error {
+	var data []byte
+
+	switch payload.ContentEncoding {
+	case EncodingGzip:
+		reader, err := gzip.NewReader(bytes.NewReader(payload.Data))
+		if err != nil {
+			return errors.WrapIf(err, "error in gzip decompression of the payload")
+		}
+		defer reader.Close()
+
+		data, err = io.ReadAll(io.LimitReader(reader, maxDecompressedSize+1))
+		if err != nil {
+			return errors.WrapIf(err, "error in gzip decompression of the payload")
+		}
+
+		if len(data) > maxDecompressedSize {
+			return errors.Errorf("decompressed payload is larger than %d bytes", maxDecompressedSize)
+		}
+	case EncodingZstd:
+		var err error
+		data, err = c.zstdDecoder.DecodeAll(payload.Data, nil)
+		if err != nil {
+			return errors.WrapIf(err, "error in zstd decompression of the payload")
+		}
+	default:
+		return nil
+	}
+
+	payload.Data = data
+	payload.ContentEncoding = ""
+
+	return nil
+}
@@ -0,0 +1,60 @@
+//go:build unit
+// +build unit
+
+// Package compression provides the compression transform tests.
+package compression
+
+import (
+	"bytes"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/transform"
+)
+
+func Test_CompressionTransform_Compresses_And_Decompresses_The_Payloads(t *testing.T) {
+	data := bytes.Repeat([]byte(`{"name":"product"}`), 100)
+
+	for _, encoding := range []string{EncodingGzip, EncodingZstd} {
+		t.Run(encoding, func(t *testing.T) {
+			compressionTransform, err := NewCompressionTransform(encoding, 1024)
+			require.NoError(t, err)
+
+			payload := &transform.Payload{Data: data}
+			require.NoError(t, compressionTransform.EncodePayload(payload))
+			assert.Equal(t, encoding, payload.ContentEncoding)
+			assert.Less(t, len(payload.Data), len(data))
+
+			// the consumers decompress all the encodings
+			decompressor, err := NewCompressionTransform("", 0)
+			require.NoError(t, err)
+			require.NoError(t, decompressor.DecodePayload(payload))
+			assert.Equal(t, "", payload.ContentEncoding)
+			assert.Equal(t, data, payload.Data)
+		})
+	}
+}
+
+func Test_CompressionTransform_Skips_The_Small_And_The_Encoded_Payloads(t *testing.T) {
+	compressionTransform, err := NewCompressionTransform(EncodingGzip, 1024)
+	require.NoError(t, err)
+
+	small := &transform.Payload{Data: []byte(`{"name":"product"}`)}
+	require.NoError(t, compressionTransform.EncodePayload(small))
+	assert.Equal(t, "", small.ContentEncoding)
+	assert.Equal(t, []byte(`{"name":"product"}`), small.Data)
+
+	encoded := &transform.Payload{Data: bytes.Repeat([]byte("a"), 2048), ContentEncoding: "identity"}
+	require.NoError(t, compressionTransform.EncodePayload(encoded))
+	assert.Equal(t, "identity", encoded.ContentEncoding)
+
+	// an unknown content encoding is not decoded
+	require.NoError(t, compressionTransform.DecodePayload(encoded))
+	assert.Equal(t, bytes.Repeat([]byte("a"), 2048), encoded.Data)
+
+	_, err = NewCompressionTransform("br", 0)
+	assert.True(t, errors.Is(err, ErrUnsupportedEncoding))
+}
@@ -0,0 +1,16 @@
+// Package transform provides the helpers of the message transforms.
+package transform
+
+import (
+	"go.uber.org/fx"
+)
+
+// AsTransform is a helper function that annotates a constructor with the Transform interface, the fx groups are
+// unordered so the transforms of the group should not depend on each other.
+func AsTransform(transform interface{}) interface{} {
+	return fx.Annotate(
+		transform,
+		fx.As(new(Transform)),
+		fx.ResultTags(`group:"messageTransforms"`),
+	)
+}
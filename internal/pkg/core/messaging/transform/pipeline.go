@@ -0,0 +1,103 @@
+// Package transform provides the pipeline of the message transforms.
+package transform
+
+import (
+	"emperror.dev/errors"
+)
+
+// Pipeline runs the transforms in their order on the outgoing messages and in the reverse order on the incoming
+// messages, a nil pipeline doesn't transform the messages.
+type Pipeline struct {
+	messageTransforms []MessageTransform
+	payloadTransforms []PayloadTransform
+}
+
+// NewPipeline creates a new pipeline of the transforms.
+func NewPipeline(transforms ...Transform) *Pipeline {
+	return (*Pipeline)(nil).With(transforms...)
+}
+
+// With returns a new pipeline with the transforms appended.
+func (p *Pipeline) With(transforms ...Transform) *Pipeline {
+	pipeline := &Pipeline{}
+	if p != nil {
+		pipeline.messageTransforms = append(pipeline.messageTransforms, p.messageTransforms...)
+		pipeline.payloadTransforms = append(pipeline.payloadTransforms, p.payloadTransforms...)
+	}
+
+	for _, transform := range transforms {
+		if messageTransform, ok := transform.(MessageTransform); ok {
+			pipeline.messageTransforms = append(pipeline.messageTransforms, messageTransform)
+		}
+
+		if payloadTransform, ok := transform.(PayloadTransform); ok {
+			pipeline.payloadTransforms = append(pipeline.payloadTransforms, payloadTransform)
+		}
+	}
+
+	return pipeline
+}
+
+// EncodeMessage runs the message transforms on an outgoing message.
+func (p *Pipeline) EncodeMessage(message interface{}) (interface{}, error) {
+	if p == nil {
+		return message, nil
+	}
+
+	for _, transform := range p.messageTransforms {
+		encoded, err := transform.EncodeMessage(message)
+		if err != nil {
+			return nil, errors.WrapIff(err, "error in the `%s` transform of the message", transform.Name())
+		}
+		message = encoded
+	}
+
+	return message, nil
+}
+
+// DecodeMessage runs the message transforms on an incoming message.
+func (p *Pipeline) DecodeMessage(message interface{}) error {
+	if p == nil {
+		return nil
+	}
+
+	for i := len(p.messageTransforms) - 1; i >= 0; i-- {
+		transform := p.messageTransforms[i]
+		if err := transform.DecodeMessage(message); err != nil {
+			return errors.WrapIff(err, "error in the `%s` transform of the message", transform.Name())
+		}
+	}
+
+	return nil
+}
+
+// EncodePayload runs the payload transforms on an outgoing payload.
+func (p *Pipeline) EncodePayload(payload *Payload) error {
+	if p == nil {
+		return nil
+	}
+
+	for _, transform := range p.payloadTransforms {
+		if err := transform.EncodePayload(payload); err != nil {
+			return errors.WrapIff(err, "error in the `%s` transform of the payload", transform.Name())
+		}
+	}
+
+	return nil
+}
+
+// DecodePayload runs the payload transforms on an incoming payload.
+func (p *Pipeline) DecodePayload(payload *Payload) error {
+	if p == nil {
+		return nil
+	}
+
+	for i := len(p.payloadTransforms) - 1; i >= 0; i-- {
+		transform := p.payloadTransforms[i]
+		if err := transform.DecodePayload(payload); err != nil {
+			return errors.WrapIff(err, "error in the `%s` transform of the payload", transform.Name())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+// Package transform provides the pluggable transforms of the messages in the producer and the consumer path.
+package transform
+
+// Transform is a transform of the outgoing and the incoming messages, it is a MessageTransform, a PayloadTransform
+// or both.
+type Transform interface {
+	Name() string
+}
+
+// MessageTransform transforms the messages before their serialization and after their deserialization, like the
+// field level encryption.
+type MessageTransform interface {
+	Transform
+	// EncodeMessage returns the transformed copy of an outgoing message, the original message is not changed.
+	EncodeMessage(message interface{}) (interface{}, error)
+	// DecodeMessage transforms a deserialized incoming message in place.
+	DecodeMessage(message interface{}) error
+}
+
+// PayloadTransform transforms the serialized payloads, like the compression, the applied encoding is carried by the
+// content encoding of the payload.
+type PayloadTransform interface {
+	Transform
+	// EncodePayload transforms an outgoing payload.
+	EncodePayload(payload *Payload) error
+	// DecodePayload transforms an incoming payload, the payloads with an unknown content encoding are not changed.
+	DecodePayload(payload *Payload) error
+}
+
+// Payload is a serialized message and its content encoding.
+type Payload struct {
+	Data            []byte
+	ContentEncoding string
+}
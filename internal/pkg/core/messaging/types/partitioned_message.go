@@ -0,0 +1,9 @@
+// Package types provides partitioned message.
+package types
+
+// IPartitionedMessage is a message with a partition key, the transports keep the messages with the same partition
+// key in order, like the messages of an aggregate.
+type IPartitionedMessage interface {
+	IMessage
+	GetPartitionKey() string
+}
@@ -0,0 +1,30 @@
+// Package utils provides the partition key of the messages.
+package utils
+
+import (
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+)
+
+// aggregateMessage is a message of an aggregate, like a domain event.
+type aggregateMessage interface {
+	GetAggregateID() uuid.UUID
+}
+
+// GetPartitionKey returns the partition key of a message, the key of a partitioned message or the id of the
+// aggregate of the message. It is empty for the messages without a partition key.
+func GetPartitionKey(message types.IMessage) string {
+	switch m := message.(type) {
+	case types.IPartitionedMessage:
+		return m.GetPartitionKey()
+	case aggregateMessage:
+		if m.GetAggregateID() == uuid.Nil {
+			return ""
+		}
+
+		return m.GetAggregateID().String()
+	default:
+		return ""
+	}
+}
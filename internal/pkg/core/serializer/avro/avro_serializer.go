@@ -0,0 +1,105 @@
+// Package avro provides an avro serializer.
+package avro
+
+import (
+	"reflect"
+	"sync"
+
+	"emperror.dev/errors"
+
+	hambaAvro "github.com/hamba/avro/v2"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/json"
+	typeMapper "github.com/raphaeldiscky/go-food-micro/internal/pkg/reflection/typemapper"
+)
+
+// ContentType is the content type of the avro binary payloads.
+const ContentType = "application/avro"
+
+var (
+	schemasMu sync.RWMutex
+	schemas   = make(map[reflect.Type]hambaAvro.Schema)
+)
+
+// RegisterSchema registers the avro schema of the type T. The record fields are matched by the `avro` struct tags,
+// or by the go field names of the fields without the tag, the embedded structs are flattened.
+func RegisterSchema[T any](schema string) error {
+	parsed, err := hambaAvro.Parse(schema)
+	if err != nil {
+		return errors.WrapIff(err, "error in parsing the avro schema of `%s`", typeMapper.GetGenericTypeNameByT[T]())
+	}
+
+	schemasMu.Lock()
+	defer schemasMu.Unlock()
+
+	schemas[pointerType(typeMapper.GetGenericTypeByT[T]())] = parsed
+
+	return nil
+}
+
+// avroSerializer is a struct that represents an avro serializer, the non binary operations use json.
+type avroSerializer struct {
+	serializer.Serializer
+}
+
+// NewAvroSerializer creates a new avro serializer.
+func NewAvroSerializer() serializer.Serializer {
+	return &avroSerializer{Serializer: json.NewDefaultJsonSerializer()}
+}
+
+// NewAvroMessageSerializer creates a new avro message serializer.
+func NewAvroMessageSerializer() serializer.MessageSerializer {
+	return serializer.NewBinaryMessageSerializer(NewAvroSerializer(), ContentType)
+}
+
+// NewAvroEventSerializer creates a new avro event serializer.
+func NewAvroEventSerializer() serializer.EventSerializer {
+	return serializer.NewBinaryEventSerializer(NewAvroSerializer(), ContentType)
+}
+
+// Marshal marshals a value of a type with a registered avro schema.
+func (s *avroSerializer) Marshal(v interface{}) ([]byte, error) {
+	schema, err := schemaOf(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return hambaAvro.Marshal(schema, v)
+}
+
+// Unmarshal unmarshals the data to a pointer of a type with a registered avro schema.
+func (s *avroSerializer) Unmarshal(data []byte, v interface{}) error {
+	schema, err := schemaOf(v)
+	if err != nil {
+		return err
+	}
+
+	return hambaAvro.Unmarshal(schema, data, v)
+}
+
+// schemaOf returns the registered avro schema of the type of a value.
+func schemaOf(v interface{}) (hambaAvro.Schema, error) {
+	if v == nil {
+		return nil, errors.New("can't find the avro schema of a nil value")
+	}
+
+	schemasMu.RLock()
+	defer schemasMu.RUnlock()
+
+	schema, ok := schemas[pointerType(reflect.TypeOf(v))]
+	if !ok {
+		return nil, errors.Errorf("avro schema of `%T` is not registered", v)
+	}
+
+	return schema, nil
+}
+
+// pointerType returns the pointer type of a struct type.
+func pointerType(typ reflect.Type) reflect.Type {
+	if typ.Kind() == reflect.Ptr {
+		return typ
+	}
+
+	return reflect.PointerTo(typ)
+}
@@ -0,0 +1,76 @@
+//go:build unit
+// +build unit
+
+// Package avro provides the avro message serializer tests.
+package avro
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	typeMapper "github.com/raphaeldiscky/go-food-micro/internal/pkg/reflection/typemapper"
+)
+
+type productCreatedAvroTest struct {
+	*types.Message
+	ProductID uuid.UUID `avro:"productId"`
+	Name      string    `avro:"name"`
+	Price     float64   `avro:"price"`
+}
+
+const productCreatedAvroTestSchema = `{
+	"type": "record",
+	"name": "ProductCreated",
+	"fields": [
+		{"name": "MessageId", "type": "string"},
+		{"name": "Created", "type": {"type": "long", "logicalType": "timestamp-micros"}},
+		{"name": "EventType", "type": "string"},
+		{"name": "productId", "type": {"type": "fixed", "name": "uuid", "size": 16}},
+		{"name": "name", "type": "string"},
+		{"name": "price", "type": "double"}
+	]
+}`
+
+func Test_Serialize_And_Deserialize_A_Registered_Schema(t *testing.T) {
+	require.NoError(t, RegisterSchema[*productCreatedAvroTest](productCreatedAvroTestSchema))
+
+	messageSerializer := NewAvroMessageSerializer()
+	message := &productCreatedAvroTest{
+		Message:   types.NewMessage(uuid.NewV4().String()),
+		ProductID: uuid.NewV4(),
+		Name:      "Book",
+		Price:     10,
+	}
+
+	result, err := messageSerializer.Serialize(message)
+	require.NoError(t, err)
+	assert.Equal(t, ContentType, result.ContentType)
+
+	deserialized, err := messageSerializer.Deserialize(
+		result.Data,
+		typeMapper.GetTypeName(message),
+		result.ContentType,
+	)
+	require.NoError(t, err)
+
+	product, ok := deserialized.(*productCreatedAvroTest)
+	require.True(t, ok)
+	assert.Equal(t, message.MessageId, product.MessageId)
+	assert.True(t, message.Created.Truncate(1000).Equal(product.Created))
+	assert.Equal(t, message.ProductID, product.ProductID)
+	assert.Equal(t, "Book", product.Name)
+}
+
+func Test_RegisterSchema_Rejects_An_Invalid_Schema(t *testing.T) {
+	assert.Error(t, RegisterSchema[*productCreatedAvroTest](`{"type": "record"}`))
+}
+
+func Test_Serializer_Rejects_The_Types_Without_Schema(t *testing.T) {
+	_, err := NewAvroSerializer().Marshal(&struct{ Name string }{Name: "Book"})
+	assert.Error(t, err)
+}
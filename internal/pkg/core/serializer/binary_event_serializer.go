@@ -0,0 +1,136 @@
+// Package serializer provides a binary event serializer.
+package serializer
+
+import (
+	"reflect"
+
+	"emperror.dev/errors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/domain"
+	typeMapper "github.com/raphaeldiscky/go-food-micro/internal/pkg/reflection/typemapper"
+)
+
+// binaryEventSerializer is a struct that represents an event serializer of a binary format, like avro or protobuf.
+type binaryEventSerializer struct {
+	serializer  Serializer
+	contentType string
+}
+
+// NewBinaryEventSerializer creates a new event serializer that serializes the events with the binary serializer
+// and tags them with its content type.
+func NewBinaryEventSerializer(serializer Serializer, contentType string) EventSerializer {
+	return &binaryEventSerializer{serializer: serializer, contentType: contentType}
+}
+
+// Serialize serializes an event.
+func (s *binaryEventSerializer) Serialize(
+	event domain.IDomainEvent,
+) (*EventSerializationResult, error) {
+	return s.SerializeObject(event)
+}
+
+// SerializeObject serializes an object.
+func (s *binaryEventSerializer) SerializeObject(
+	event interface{},
+) (*EventSerializationResult, error) {
+	if event == nil {
+		return &EventSerializationResult{Data: nil, ContentType: s.ContentType()}, nil
+	}
+
+	data, err := s.serializer.Marshal(event)
+	if err != nil {
+		return nil, errors.WrapIff(err, "error in Marshaling: `%s`", typeMapper.GetTypeName(event))
+	}
+
+	return &EventSerializationResult{Data: data, ContentType: s.ContentType()}, nil
+}
+
+// Deserialize deserializes an event.
+func (s *binaryEventSerializer) Deserialize(
+	data []byte,
+	eventType string,
+	contentType string,
+) (domain.IDomainEvent, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	targetEventPointer := typeMapper.EmptyInstanceByTypeNameAndImplementedInterface[domain.IDomainEvent](
+		eventType,
+	)
+
+	if targetEventPointer == nil {
+		return nil, errors.Errorf(
+			"event type `%s` is not impelemted IDomainEvent or can't be instansiated",
+			eventType,
+		)
+	}
+
+	if !IsBinaryContentType(contentType, s.ContentType()) {
+		return nil, errors.Errorf("contentType: %s is not supported", contentType)
+	}
+
+	if err := s.serializer.Unmarshal(data, targetEventPointer); err != nil {
+		return nil, errors.WrapIff(err, "error in Unmarshaling: `%s`", eventType)
+	}
+
+	domainEvent, ok := targetEventPointer.(domain.IDomainEvent)
+	if !ok {
+		return nil, errors.Errorf(
+			"failed to convert event to IDomainEvent: %v",
+			targetEventPointer,
+		)
+	}
+
+	return domainEvent, nil
+}
+
+// DeserializeObject deserializes an object.
+func (s *binaryEventSerializer) DeserializeObject(
+	data []byte,
+	eventType string,
+	contentType string,
+) (interface{}, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	targetEventPointer := typeMapper.InstanceByTypeName(eventType)
+
+	if targetEventPointer == nil {
+		return nil, errors.Errorf("event type `%s` can't be instansiated", eventType)
+	}
+
+	if !IsBinaryContentType(contentType, s.ContentType()) {
+		return nil, errors.Errorf("contentType: %s is not supported", contentType)
+	}
+
+	if err := s.serializer.Unmarshal(data, targetEventPointer); err != nil {
+		return nil, errors.WrapIff(err, "error in Unmarshaling: `%s`", eventType)
+	}
+
+	return targetEventPointer, nil
+}
+
+// DeserializeType deserializes a type.
+func (s *binaryEventSerializer) DeserializeType(
+	data []byte,
+	eventType reflect.Type,
+	contentType string,
+) (domain.IDomainEvent, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	return s.Deserialize(data, typeMapper.GetTypeName(eventType), contentType)
+}
+
+// ContentType returns the content type.
+func (s *binaryEventSerializer) ContentType() string {
+	return s.contentType
+}
+
+// Serializer returns the serializer.
+func (s *binaryEventSerializer) Serializer() Serializer {
+	return s.serializer
+}
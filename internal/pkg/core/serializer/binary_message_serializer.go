@@ -0,0 +1,143 @@
+// Package serializer provides a binary message serializer.
+package serializer
+
+import (
+	"reflect"
+
+	"emperror.dev/errors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	typeMapper "github.com/raphaeldiscky/go-food-micro/internal/pkg/reflection/typemapper"
+)
+
+// binaryMessageSerializer is a struct that represents a message serializer of a binary format, like avro or protobuf.
+type binaryMessageSerializer struct {
+	serializer  Serializer
+	contentType string
+}
+
+// NewBinaryMessageSerializer creates a new message serializer that serializes the messages with the binary serializer
+// and tags them with its content type.
+func NewBinaryMessageSerializer(serializer Serializer, contentType string) MessageSerializer {
+	return &binaryMessageSerializer{serializer: serializer, contentType: contentType}
+}
+
+// Serialize serializes a message.
+func (m *binaryMessageSerializer) Serialize(
+	message types.IMessage,
+) (*EventSerializationResult, error) {
+	return m.SerializeObject(message)
+}
+
+// SerializeObject serializes an object.
+func (m *binaryMessageSerializer) SerializeObject(
+	message interface{},
+) (*EventSerializationResult, error) {
+	if message == nil {
+		return &EventSerializationResult{Data: nil, ContentType: m.ContentType()}, nil
+	}
+
+	data, err := m.serializer.Marshal(message)
+	if err != nil {
+		return nil, errors.WrapIff(err, "error in Marshaling: `%s`", typeMapper.GetTypeName(message))
+	}
+
+	return &EventSerializationResult{Data: data, ContentType: m.ContentType()}, nil
+}
+
+// SerializeEnvelop is not supported, the message envelopes with their headers are persisted as json.
+func (m *binaryMessageSerializer) SerializeEnvelop(
+	_ types.MessageEnvelope,
+) (*EventSerializationResult, error) {
+	return nil, errors.Errorf("message envelope serialization is not supported by the `%s` serializer", m.contentType)
+}
+
+// Deserialize deserializes a message.
+func (m *binaryMessageSerializer) Deserialize(
+	data []byte,
+	messageType string,
+	contentType string,
+) (types.IMessage, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	targetMessagePointer := typeMapper.EmptyInstanceByTypeNameAndImplementedInterface[types.IMessage](
+		messageType,
+	)
+
+	if targetMessagePointer == nil {
+		return nil, errors.Errorf(
+			"message type `%s` is not impelemted IMessage or can't be instansiated",
+			messageType,
+		)
+	}
+
+	if contentType != m.ContentType() {
+		return nil, errors.Errorf("contentType: %s is not supported", contentType)
+	}
+
+	if err := m.serializer.Unmarshal(data, targetMessagePointer); err != nil {
+		return nil, errors.WrapIff(err, "error in Unmarshaling: `%s`", messageType)
+	}
+
+	message, ok := targetMessagePointer.(types.IMessage)
+	if !ok {
+		return nil, errors.Errorf(
+			"failed to convert message to IMessage: %v",
+			targetMessagePointer,
+		)
+	}
+
+	return message, nil
+}
+
+// DeserializeObject deserializes an object.
+func (m *binaryMessageSerializer) DeserializeObject(
+	data []byte,
+	messageType string,
+	contentType string,
+) (interface{}, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	targetMessagePointer := typeMapper.InstanceByTypeName(messageType)
+
+	if targetMessagePointer == nil {
+		return nil, errors.Errorf("message type `%s` can't be instansiated", messageType)
+	}
+
+	if contentType != m.ContentType() {
+		return nil, errors.Errorf("contentType: %s is not supported", contentType)
+	}
+
+	if err := m.serializer.Unmarshal(data, targetMessagePointer); err != nil {
+		return nil, errors.WrapIff(err, "error in Unmarshaling: `%s`", messageType)
+	}
+
+	return targetMessagePointer, nil
+}
+
+// DeserializeType deserializes a type.
+func (m *binaryMessageSerializer) DeserializeType(
+	data []byte,
+	messageType reflect.Type,
+	contentType string,
+) (types.IMessage, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	return m.Deserialize(data, typeMapper.GetTypeName(messageType), contentType)
+}
+
+// ContentType returns the content type.
+func (m *binaryMessageSerializer) ContentType() string {
+	return m.contentType
+}
+
+// Serializer returns the serializer.
+func (m *binaryMessageSerializer) Serializer() Serializer {
+	return m.serializer
+}
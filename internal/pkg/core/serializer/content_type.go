@@ -0,0 +1,12 @@
+// Package serializer provides the content type helpers.
+package serializer
+
+// BinaryContentType is the content type of the binary payloads that don't carry their format, like the binary
+// events of the event store.
+const BinaryContentType = "application/octet-stream"
+
+// IsBinaryContentType checks if the content type is the content type of a binary serializer, or the generic
+// binary content type.
+func IsBinaryContentType(contentType string, serializerContentType string) bool {
+	return contentType == serializerContentType || contentType == BinaryContentType
+}
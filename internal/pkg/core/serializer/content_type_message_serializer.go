@@ -0,0 +1,124 @@
+// Package serializer provides a content type negotiating message serializer.
+package serializer
+
+import (
+	"reflect"
+
+	"emperror.dev/errors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+)
+
+// ContentTypeMessageSerializer is a message serializer that serializes with a default serializer and deserializes
+// with the serializer of the content type of the message.
+type ContentTypeMessageSerializer struct {
+	defaultSerializer MessageSerializer
+	serializers       map[string]MessageSerializer
+}
+
+// NewContentTypeMessageSerializer creates a new content type message serializer.
+func NewContentTypeMessageSerializer(
+	defaultSerializer MessageSerializer,
+	serializers ...MessageSerializer,
+) *ContentTypeMessageSerializer {
+	s := &ContentTypeMessageSerializer{
+		defaultSerializer: defaultSerializer,
+		serializers:       map[string]MessageSerializer{defaultSerializer.ContentType(): defaultSerializer},
+	}
+
+	for _, messageSerializer := range serializers {
+		s.serializers[messageSerializer.ContentType()] = messageSerializer
+	}
+
+	return s
+}
+
+// SerializerFor returns the serializer of the content type.
+func (s *ContentTypeMessageSerializer) SerializerFor(contentType string) (MessageSerializer, bool) {
+	messageSerializer, ok := s.serializers[contentType]
+
+	return messageSerializer, ok
+}
+
+// Serialize serializes a message with the default serializer.
+func (s *ContentTypeMessageSerializer) Serialize(message types.IMessage) (*EventSerializationResult, error) {
+	return s.defaultSerializer.Serialize(message)
+}
+
+// SerializeObject serializes an object with the default serializer.
+func (s *ContentTypeMessageSerializer) SerializeObject(message interface{}) (*EventSerializationResult, error) {
+	return s.defaultSerializer.SerializeObject(message)
+}
+
+// SerializeEnvelop serializes a message envelop with the default serializer.
+func (s *ContentTypeMessageSerializer) SerializeEnvelop(
+	messageEnvelop types.MessageEnvelope,
+) (*EventSerializationResult, error) {
+	return s.defaultSerializer.SerializeEnvelop(messageEnvelop)
+}
+
+// Deserialize deserializes a message with the serializer of its content type.
+func (s *ContentTypeMessageSerializer) Deserialize(
+	data []byte,
+	messageType string,
+	contentType string,
+) (types.IMessage, error) {
+	messageSerializer, contentType, err := s.negotiate(contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	return messageSerializer.Deserialize(data, messageType, contentType)
+}
+
+// DeserializeObject deserializes an object with the serializer of its content type.
+func (s *ContentTypeMessageSerializer) DeserializeObject(
+	data []byte,
+	messageType string,
+	contentType string,
+) (interface{}, error) {
+	messageSerializer, contentType, err := s.negotiate(contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	return messageSerializer.DeserializeObject(data, messageType, contentType)
+}
+
+// DeserializeType deserializes a type with the serializer of its content type.
+func (s *ContentTypeMessageSerializer) DeserializeType(
+	data []byte,
+	messageType reflect.Type,
+	contentType string,
+) (types.IMessage, error) {
+	messageSerializer, contentType, err := s.negotiate(contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	return messageSerializer.DeserializeType(data, messageType, contentType)
+}
+
+// ContentType returns the content type of the default serializer.
+func (s *ContentTypeMessageSerializer) ContentType() string {
+	return s.defaultSerializer.ContentType()
+}
+
+// Serializer returns the serializer of the default serializer.
+func (s *ContentTypeMessageSerializer) Serializer() Serializer {
+	return s.defaultSerializer.Serializer()
+}
+
+// negotiate returns the serializer of the content type, the messages without a content type use the default.
+func (s *ContentTypeMessageSerializer) negotiate(contentType string) (MessageSerializer, string, error) {
+	if contentType == "" {
+		return s.defaultSerializer, s.defaultSerializer.ContentType(), nil
+	}
+
+	messageSerializer, ok := s.serializers[contentType]
+	if !ok {
+		return nil, "", errors.Errorf("contentType: %s is not supported", contentType)
+	}
+
+	return messageSerializer, contentType, nil
+}
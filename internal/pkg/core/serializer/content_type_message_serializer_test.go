@@ -0,0 +1,71 @@
+//go:build unit
+// +build unit
+
+// Package serializer provides the content type message serializer tests.
+package serializer_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/avro"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/json"
+	typeMapper "github.com/raphaeldiscky/go-food-micro/internal/pkg/reflection/typemapper"
+)
+
+type orderPlacedTest struct {
+	*types.Message
+	Email string `json:"email" avro:"email"`
+}
+
+func newContentTypeMessageSerializer() *serializer.ContentTypeMessageSerializer {
+	return serializer.NewContentTypeMessageSerializer(
+		json.NewDefaultMessageJsonSerializer(json.NewDefaultJsonSerializer()),
+		avro.NewAvroMessageSerializer(),
+	)
+}
+
+func Test_Deserialize_Negotiates_The_Content_Type(t *testing.T) {
+	require.NoError(t, avro.RegisterSchema[*orderPlacedTest](`{
+		"type": "record",
+		"name": "OrderPlaced",
+		"fields": [
+			{"name": "MessageId", "type": "string"},
+			{"name": "Created", "type": {"type": "long", "logicalType": "timestamp-micros"}},
+			{"name": "EventType", "type": "string"},
+			{"name": "email", "type": "string"}
+		]
+	}`))
+
+	messageSerializer := newContentTypeMessageSerializer()
+	message := &orderPlacedTest{Message: types.NewMessage(uuid.NewV4().String()), Email: "a@b.c"}
+	typeName := typeMapper.GetTypeName(message)
+
+	avroResult, err := avro.NewAvroMessageSerializer().Serialize(message)
+	require.NoError(t, err)
+	jsonResult, err := messageSerializer.Serialize(message)
+	require.NoError(t, err)
+	assert.Equal(t, json.ContentType, jsonResult.ContentType)
+
+	for _, result := range []*serializer.EventSerializationResult{avroResult, jsonResult} {
+		deserialized, err := messageSerializer.Deserialize(result.Data, typeName, result.ContentType)
+		require.NoError(t, err)
+		assert.Equal(t, "a@b.c", deserialized.(*orderPlacedTest).Email)
+	}
+
+	// the messages without a content type use the default serializer
+	deserialized, err := messageSerializer.Deserialize(jsonResult.Data, typeName, "")
+	require.NoError(t, err)
+	assert.Equal(t, message.MessageId, deserialized.GeMessageId())
+}
+
+func Test_Deserialize_Rejects_An_Unsupported_Content_Type(t *testing.T) {
+	_, err := newContentTypeMessageSerializer().Deserialize([]byte("{}"), "orderPlacedTest", "text/xml")
+	assert.Error(t, err)
+}
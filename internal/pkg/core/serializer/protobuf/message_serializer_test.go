@@ -0,0 +1,104 @@
+//go:build unit
+// +build unit
+
+// Package protobuf provides the protobuf message serializer tests.
+package protobuf
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	typeMapper "github.com/raphaeldiscky/go-food-micro/internal/pkg/reflection/typemapper"
+)
+
+type productCreatedProtoTest struct {
+	*types.Message
+	Name  string
+	Price float64
+}
+
+func registerProductCreatedProtoTestMapping() {
+	RegisterMapping(
+		func(p *productCreatedProtoTest) (*structpb.Struct, error) {
+			return structpb.NewStruct(map[string]interface{}{
+				"messageId": p.MessageId,
+				"created":   p.Created.Format(time.RFC3339Nano),
+				"name":      p.Name,
+				"price":     p.Price,
+			})
+		},
+		func(s *structpb.Struct) (*productCreatedProtoTest, error) {
+			fields := s.GetFields()
+			created, err := time.Parse(time.RFC3339Nano, fields["created"].GetStringValue())
+			if err != nil {
+				return nil, err
+			}
+
+			return &productCreatedProtoTest{
+				Message: &types.Message{MessageId: fields["messageId"].GetStringValue(), Created: created},
+				Name:    fields["name"].GetStringValue(),
+				Price:   fields["price"].GetNumberValue(),
+			}, nil
+		},
+	)
+}
+
+func Test_Serialize_And_Deserialize_A_Registered_Mapping(t *testing.T) {
+	registerProductCreatedProtoTestMapping()
+	messageSerializer := NewProtobufMessageSerializer()
+	message := &productCreatedProtoTest{Message: types.NewMessage(uuid.NewV4().String()), Name: "Book", Price: 10}
+
+	result, err := messageSerializer.Serialize(message)
+	require.NoError(t, err)
+	assert.Equal(t, ContentType, result.ContentType)
+
+	deserialized, err := messageSerializer.Deserialize(
+		result.Data,
+		typeMapper.GetTypeName(message),
+		result.ContentType,
+	)
+	require.NoError(t, err)
+
+	product, ok := deserialized.(*productCreatedProtoTest)
+	require.True(t, ok)
+	assert.Equal(t, message.MessageId, product.MessageId)
+	assert.True(t, message.Created.Equal(product.Created))
+	assert.Equal(t, "Book", product.Name)
+	assert.InDelta(t, 10, product.Price, 0)
+}
+
+func Test_Serializer_Marshals_The_Generated_Messages(t *testing.T) {
+	protobufSerializer := NewProtobufSerializer()
+	created := timestamppb.Now()
+
+	data, err := protobufSerializer.Marshal(created)
+	require.NoError(t, err)
+
+	deserialized := &timestamppb.Timestamp{}
+	require.NoError(t, protobufSerializer.Unmarshal(data, deserialized))
+	assert.True(t, proto.Equal(created, deserialized))
+}
+
+func Test_Serializer_Rejects_The_Unregistered_Types(t *testing.T) {
+	_, err := NewProtobufSerializer().Marshal(&struct{ Name string }{Name: "Book"})
+	assert.Error(t, err)
+}
+
+func Test_Deserialize_Rejects_Another_Content_Type(t *testing.T) {
+	registerProductCreatedProtoTestMapping()
+	_, err := NewProtobufMessageSerializer().Deserialize(
+		[]byte("{}"),
+		typeMapper.GetTypeName(&productCreatedProtoTest{}),
+		"application/json",
+	)
+	assert.Error(t, err)
+}
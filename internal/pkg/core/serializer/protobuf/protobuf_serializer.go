@@ -0,0 +1,173 @@
+// Package protobuf provides a protobuf serializer.
+package protobuf
+
+import (
+	"reflect"
+	"sync"
+
+	"emperror.dev/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/json"
+	typeMapper "github.com/raphaeldiscky/go-food-micro/internal/pkg/reflection/typemapper"
+)
+
+// ContentType is the content type of the protobuf payloads.
+const ContentType = "application/x-protobuf"
+
+// protoMapping is the conversion of a go type to and from its generated protobuf contract.
+type protoMapping struct {
+	protoType reflect.Type
+	toProto   func(v interface{}) (proto.Message, error)
+	fromProto func(m proto.Message) (interface{}, error)
+}
+
+var (
+	mappingsMu sync.RWMutex
+	mappings   = make(map[reflect.Type]*protoMapping)
+)
+
+// RegisterMapping registers the conversion of the type T to and from its generated protobuf contract TProto, so
+// the types that are not generated from the protobuf files can be serialized with their contract.
+func RegisterMapping[T any, TProto proto.Message](
+	toProto func(T) (TProto, error),
+	fromProto func(TProto) (T, error),
+) {
+	mappingsMu.Lock()
+	defer mappingsMu.Unlock()
+
+	mappings[pointerType(typeMapper.GetGenericTypeByT[T]())] = &protoMapping{
+		protoType: typeMapper.GetGenericTypeByT[TProto](),
+		toProto: func(v interface{}) (proto.Message, error) {
+			return toProto(v.(T))
+		},
+		fromProto: func(m proto.Message) (interface{}, error) {
+			return fromProto(m.(TProto))
+		},
+	}
+}
+
+// protobufSerializer is a struct that represents a protobuf serializer, the non binary operations use json.
+type protobufSerializer struct {
+	serializer.Serializer
+}
+
+// NewProtobufSerializer creates a new protobuf serializer.
+func NewProtobufSerializer() serializer.Serializer {
+	return &protobufSerializer{Serializer: json.NewDefaultJsonSerializer()}
+}
+
+// NewProtobufMessageSerializer creates a new protobuf message serializer.
+func NewProtobufMessageSerializer() serializer.MessageSerializer {
+	return serializer.NewBinaryMessageSerializer(NewProtobufSerializer(), ContentType)
+}
+
+// NewProtobufEventSerializer creates a new protobuf event serializer.
+func NewProtobufEventSerializer() serializer.EventSerializer {
+	return serializer.NewBinaryEventSerializer(NewProtobufSerializer(), ContentType)
+}
+
+// Marshal marshals a protobuf message, or a type with a registered protobuf mapping.
+func (s *protobufSerializer) Marshal(v interface{}) ([]byte, error) {
+	message, err := toProtoMessage(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(message)
+}
+
+// Unmarshal unmarshals the data to a protobuf message pointer, or a pointer of a type with a registered mapping.
+func (s *protobufSerializer) Unmarshal(data []byte, v interface{}) error {
+	if message, ok := v.(proto.Message); ok {
+		return proto.Unmarshal(data, message)
+	}
+
+	mapping, ok := mappingOf(reflect.TypeOf(v))
+	if !ok {
+		return errors.Errorf("type `%T` is not a protobuf message or a registered protobuf mapping", v)
+	}
+
+	message, ok := reflect.New(mapping.protoType.Elem()).Interface().(proto.Message)
+	if !ok {
+		return errors.Errorf("protobuf contract of `%T` can't be instantiated", v)
+	}
+
+	if err := proto.Unmarshal(data, message); err != nil {
+		return err
+	}
+
+	result, err := mapping.fromProto(message)
+	if err != nil {
+		return errors.WrapIff(err, "error in mapping the protobuf contract to `%T`", v)
+	}
+
+	target := reflect.ValueOf(v)
+	if target.Kind() != reflect.Ptr || target.IsNil() {
+		return errors.Errorf("unmarshal target `%T` should be a non nil pointer", v)
+	}
+	target.Elem().Set(reflect.ValueOf(result).Elem())
+
+	return nil
+}
+
+// UnmarshalFromJson unmarshals the protobuf json of a protobuf message.
+func (s *protobufSerializer) UnmarshalFromJson(data string, v interface{}) error {
+	if message, ok := v.(proto.Message); ok {
+		return protojson.Unmarshal([]byte(data), message)
+	}
+
+	return s.Serializer.UnmarshalFromJson(data, v)
+}
+
+// toProtoMessage returns the protobuf message of a value.
+func toProtoMessage(v interface{}) (proto.Message, error) {
+	if message, ok := v.(proto.Message); ok {
+		return message, nil
+	}
+
+	mapping, ok := mappingOf(reflect.TypeOf(v))
+	if !ok {
+		return nil, errors.Errorf("type `%T` is not a protobuf message or a registered protobuf mapping", v)
+	}
+
+	// the mappings are registered for the pointer types
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Ptr {
+		pointer := reflect.New(value.Type())
+		pointer.Elem().Set(value)
+		v = pointer.Interface()
+	}
+
+	message, err := mapping.toProto(v)
+	if err != nil {
+		return nil, errors.WrapIff(err, "error in mapping `%T` to its protobuf contract", v)
+	}
+
+	return message, nil
+}
+
+// mappingOf returns the registered protobuf mapping of a type.
+func mappingOf(typ reflect.Type) (*protoMapping, bool) {
+	if typ == nil {
+		return nil, false
+	}
+
+	mappingsMu.RLock()
+	defer mappingsMu.RUnlock()
+
+	mapping, ok := mappings[pointerType(typ)]
+
+	return mapping, ok
+}
+
+// pointerType returns the pointer type of a struct type.
+func pointerType(typ reflect.Type) reflect.Type {
+	if typ.Kind() == reflect.Ptr {
+		return typ
+	}
+
+	return reflect.PointerTo(typ)
+}
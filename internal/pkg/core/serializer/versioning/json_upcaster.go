@@ -0,0 +1,29 @@
+// Package versioning provides the json payload upcaster.
+package versioning
+
+import (
+	"emperror.dev/errors"
+
+	json "github.com/goccy/go-json"
+)
+
+// JSONUpcaster creates an upcaster that transforms the json object of the payload in place.
+func JSONUpcaster(transform func(payload map[string]interface{}) error) Upcaster {
+	return func(data []byte) ([]byte, error) {
+		payload := make(map[string]interface{})
+		if err := json.Unmarshal(data, &payload); err != nil {
+			return nil, errors.WrapIf(err, "error in unmarshaling the payload to upcast")
+		}
+
+		if err := transform(payload); err != nil {
+			return nil, err
+		}
+
+		upcasted, err := json.Marshal(payload)
+		if err != nil {
+			return nil, errors.WrapIf(err, "error in marshaling the upcasted payload")
+		}
+
+		return upcasted, nil
+	}
+}
@@ -0,0 +1,39 @@
+// Package versioning provides the schema version metadata header.
+package versioning
+
+import (
+	"strconv"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/metadata"
+)
+
+// SchemaVersionHeader is the metadata header of the schema version of a serialized payload.
+const SchemaVersionHeader = "schema-version"
+
+// SetSchemaVersion sets the schema version header.
+func SetSchemaVersion(m metadata.Metadata, version int) {
+	m.Set(SchemaVersionHeader, strconv.Itoa(version))
+}
+
+// GetSchemaVersion gets the schema version header, zero when the payload has no schema version.
+func GetSchemaVersion(m metadata.Metadata) int {
+	switch version := m.Get(SchemaVersionHeader).(type) {
+	case string:
+		v, err := strconv.Atoi(version)
+		if err != nil {
+			return 0
+		}
+
+		return v
+	case int:
+		return version
+	case int32:
+		return int(version)
+	case int64:
+		return int(version)
+	case float64:
+		return int(version)
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,243 @@
+// Package versioning provides the event type registry with the stable type aliases, the schema versions and the
+// upcasters of the serialized events and messages.
+package versioning
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"emperror.dev/errors"
+
+	typeMapper "github.com/raphaeldiscky/go-food-micro/internal/pkg/reflection/typemapper"
+)
+
+// Upcaster transforms a serialized payload of a schema version to the next schema version.
+type Upcaster func(data []byte) ([]byte, error)
+
+// TypeRegistration is a registered event type with its alias, current schema version and upcasters.
+type TypeRegistration struct {
+	Alias         string
+	Type          reflect.Type
+	SchemaVersion int
+	// upcasters are keyed by the schema version they upcast from
+	upcasters map[int]Upcaster
+}
+
+// TypeRegistry is a registry of the event types by their stable aliases, independent of the go type names.
+type TypeRegistry struct {
+	mu      sync.RWMutex
+	aliases map[string]*TypeRegistration
+	// names are the aliases, the legacy names and the go type names of the registrations
+	names map[string][]*TypeRegistration
+	types map[reflect.Type]*TypeRegistration
+}
+
+// NewTypeRegistry creates a new type registry.
+func NewTypeRegistry() *TypeRegistry {
+	return &TypeRegistry{
+		aliases: make(map[string]*TypeRegistration),
+		names:   make(map[string][]*TypeRegistration),
+		types:   make(map[reflect.Type]*TypeRegistration),
+	}
+}
+
+// Register registers the type with a stable alias and its current schema version. The legacy names are the names
+// the type was stored with before, like the go type name of a renamed struct.
+func (r *TypeRegistry) Register(
+	alias string,
+	typ reflect.Type,
+	schemaVersion int,
+	legacyNames ...string,
+) error {
+	if alias == "" {
+		return errors.New("type alias is required")
+	}
+
+	if schemaVersion < 1 {
+		return errors.Errorf("schema version of `%s` should be greater than zero", alias)
+	}
+
+	typ = basePointerType(typ)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if existing, ok := r.aliases[alias]; ok {
+		if existing.Type != typ {
+			return errors.Errorf(
+				"type alias `%s` is already registered for `%s`",
+				alias,
+				existing.Type.String(),
+			)
+		}
+		existing.SchemaVersion = schemaVersion
+
+		return nil
+	}
+
+	if existing, ok := r.types[typ]; ok {
+		return errors.Errorf(
+			"type `%s` is already registered with alias `%s`",
+			typ.String(),
+			existing.Alias,
+		)
+	}
+
+	registration := &TypeRegistration{
+		Alias:         alias,
+		Type:          typ,
+		SchemaVersion: schemaVersion,
+		upcasters:     make(map[int]Upcaster),
+	}
+	r.aliases[alias] = registration
+	r.types[typ] = registration
+
+	for _, name := range append([]string{alias, typeMapper.GetTypeNameByType(typ)}, legacyNames...) {
+		r.names[name] = append(r.names[name], registration)
+	}
+
+	// the serializers instantiate the types by name, so the alias should be resolvable by the type mapper
+	typeMapper.RegisterTypeWithKey(alias, typ)
+
+	return nil
+}
+
+// RegisterUpcaster registers the upcaster of the alias from a schema version to the next one.
+func (r *TypeRegistry) RegisterUpcaster(alias string, fromVersion int, upcaster Upcaster) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	registration, ok := r.aliases[alias]
+	if !ok {
+		return errors.Errorf("type alias `%s` is not registered", alias)
+	}
+
+	if fromVersion < 1 || fromVersion >= registration.SchemaVersion {
+		return errors.Errorf(
+			"upcaster of `%s` from version %d is out of the schema versions 1..%d",
+			alias,
+			fromVersion,
+			registration.SchemaVersion,
+		)
+	}
+
+	registration.upcasters[fromVersion] = upcaster
+
+	return nil
+}
+
+// TypeName returns the alias of the registered type of the object, or its go type name.
+func (r *TypeRegistry) TypeName(obj interface{}) string {
+	if registration, ok := r.registrationByObject(obj); ok {
+		return registration.Alias
+	}
+
+	return typeMapper.GetTypeName(obj)
+}
+
+// SchemaVersion returns the current schema version of the registered type of the object, the unregistered types
+// are on the first version.
+func (r *TypeRegistry) SchemaVersion(obj interface{}) int {
+	if registration, ok := r.registrationByObject(obj); ok {
+		return registration.SchemaVersion
+	}
+
+	return 1
+}
+
+// Registration returns the registration of a type name that implements the kind, the name can be an alias, a
+// legacy name or a go type name.
+func (r *TypeRegistry) Registration(name string, kind reflect.Type) (*TypeRegistration, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, registration := range r.names[name] {
+		if kind == nil || registration.Type.Implements(kind) {
+			return registration, true
+		}
+	}
+
+	return nil, false
+}
+
+// Upcast upcasts the payload of the type name from its schema version to the current schema version of the
+// registered type, and returns the alias to deserialize it with. The unregistered types are returned unchanged.
+func (r *TypeRegistry) Upcast(
+	name string,
+	schemaVersion int,
+	data []byte,
+	kind reflect.Type,
+) (string, []byte, error) {
+	registration, ok := r.Registration(name, kind)
+	if !ok {
+		return name, data, nil
+	}
+
+	// the payloads stored before the versioning have no schema version header
+	if schemaVersion < 1 {
+		schemaVersion = 1
+	}
+
+	if schemaVersion > registration.SchemaVersion {
+		return "", nil, errors.Errorf(
+			"schema version %d of `%s` is newer than the supported version %d",
+			schemaVersion,
+			registration.Alias,
+			registration.SchemaVersion,
+		)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for version := schemaVersion; version < registration.SchemaVersion; version++ {
+		upcaster, ok := registration.upcasters[version]
+		if !ok {
+			return "", nil, errors.Errorf(
+				"no upcaster of `%s` from schema version %d",
+				registration.Alias,
+				version,
+			)
+		}
+
+		upcasted, err := upcaster(data)
+		if err != nil {
+			return "", nil, errors.WrapIf(
+				err,
+				fmt.Sprintf("error in upcasting `%s` from schema version %d", registration.Alias, version),
+			)
+		}
+		data = upcasted
+	}
+
+	return registration.Alias, data, nil
+}
+
+// registrationByObject returns the registration of the type of the object.
+func (r *TypeRegistry) registrationByObject(obj interface{}) (*TypeRegistration, bool) {
+	if obj == nil {
+		return nil, false
+	}
+
+	typ, ok := obj.(reflect.Type)
+	if !ok {
+		typ = reflect.TypeOf(obj)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	registration, ok := r.types[basePointerType(typ)]
+
+	return registration, ok
+}
+
+// basePointerType returns the pointer type of a struct type, the events are always instantiated by pointer.
+func basePointerType(typ reflect.Type) reflect.Type {
+	if typ.Kind() == reflect.Ptr {
+		return typ
+	}
+
+	return reflect.PointerTo(typ)
+}
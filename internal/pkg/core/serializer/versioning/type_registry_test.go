@@ -0,0 +1,183 @@
+//go:build unit
+// +build unit
+
+// Package versioning provides the type registry tests.
+package versioning
+
+import (
+	"reflect"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/metadata"
+	typeMapper "github.com/raphaeldiscky/go-food-micro/internal/pkg/reflection/typemapper"
+)
+
+type named interface {
+	GetName() string
+}
+
+type customerRegisteredV1 struct {
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Email     string `json:"email"`
+}
+
+func (c *customerRegisteredV1) GetName() string {
+	return c.FirstName + " " + c.LastName
+}
+
+type customerRegisteredOther struct{}
+
+var namedKind = reflect.TypeOf((*named)(nil)).Elem()
+
+func newCustomerRegistry(t *testing.T) *TypeRegistry {
+	t.Helper()
+
+	registry := NewTypeRegistry()
+	require.NoError(
+		t,
+		registry.Register(
+			"customers.customer-registered",
+			reflect.TypeOf(&customerRegisteredV1{}),
+			3,
+			"CustomerCreated",
+		),
+	)
+	// v1 had a single name field, v2 split it to the first and last name
+	require.NoError(t, registry.RegisterUpcaster(
+		"customers.customer-registered",
+		1,
+		JSONUpcaster(func(payload map[string]interface{}) error {
+			payload["firstName"], payload["lastName"] = payload["name"], ""
+			delete(payload, "name")
+
+			return nil
+		}),
+	))
+	// v3 added the email
+	require.NoError(t, registry.RegisterUpcaster(
+		"customers.customer-registered",
+		2,
+		JSONUpcaster(func(payload map[string]interface{}) error {
+			payload["email"] = "unknown"
+
+			return nil
+		}),
+	))
+
+	return registry
+}
+
+func Test_TypeName_Returns_The_Alias_Of_A_Registered_Type(t *testing.T) {
+	registry := newCustomerRegistry(t)
+
+	assert.Equal(t, "customers.customer-registered", registry.TypeName(&customerRegisteredV1{}))
+	assert.Equal(t, "customers.customer-registered", registry.TypeName(customerRegisteredV1{}))
+	assert.Equal(t, 3, registry.SchemaVersion(&customerRegisteredV1{}))
+	assert.Equal(t, typeMapper.GetTypeName(&customerRegisteredOther{}), registry.TypeName(&customerRegisteredOther{}))
+	assert.Equal(t, 1, registry.SchemaVersion(&customerRegisteredOther{}))
+}
+
+func Test_Upcast_Runs_The_Upcasters_Chain_Of_A_Legacy_Name(t *testing.T) {
+	registry := newCustomerRegistry(t)
+
+	typeName, data, err := registry.Upcast("CustomerCreated", 1, []byte(`{"name":"John"}`), namedKind)
+	require.NoError(t, err)
+	assert.Equal(t, "customers.customer-registered", typeName)
+
+	event := &customerRegisteredV1{}
+	require.NoError(t, json.Unmarshal(data, event))
+	assert.Equal(t, &customerRegisteredV1{FirstName: "John", Email: "unknown"}, event)
+
+	// the alias is resolvable by the type mapper for the serializers
+	_, ok := typeMapper.EmptyInstanceByTypeNameAndImplementedInterface[named](typeName).(*customerRegisteredV1)
+	assert.True(t, ok)
+}
+
+func Test_Upcast_Treats_A_Missing_Schema_Version_As_The_First(t *testing.T) {
+	registry := newCustomerRegistry(t)
+
+	_, data, err := registry.Upcast("*customerRegisteredV1", 0, []byte(`{"name":"John"}`), namedKind)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"firstName":"John","lastName":"","email":"unknown"}`, string(data))
+}
+
+func Test_Upcast_Keeps_The_Current_Version_And_The_Unregistered_Types(t *testing.T) {
+	registry := newCustomerRegistry(t)
+	payload := []byte(`{"firstName":"John"}`)
+
+	_, data, err := registry.Upcast("customers.customer-registered", 3, payload, namedKind)
+	require.NoError(t, err)
+	assert.Equal(t, payload, data)
+
+	typeName, data, err := registry.Upcast("OrderCreated", 1, payload, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "OrderCreated", typeName)
+	assert.Equal(t, payload, data)
+}
+
+func Test_Upcast_Filters_The_Registrations_By_Kind(t *testing.T) {
+	registry := newCustomerRegistry(t)
+	payload := []byte(`{"name":"John"}`)
+
+	typeName, data, err := registry.Upcast("CustomerCreated", 1, payload, reflect.TypeOf((*error)(nil)).Elem())
+	require.NoError(t, err)
+	assert.Equal(t, "CustomerCreated", typeName)
+	assert.Equal(t, payload, data)
+}
+
+func Test_Upcast_Rejects_A_Newer_Schema_Version(t *testing.T) {
+	registry := newCustomerRegistry(t)
+
+	_, _, err := registry.Upcast("customers.customer-registered", 4, []byte(`{}`), namedKind)
+	assert.Error(t, err)
+}
+
+func Test_Upcast_Fails_On_A_Missing_Upcaster(t *testing.T) {
+	registry := NewTypeRegistry()
+	require.NoError(t, registry.Register("customers.customer-registered", reflect.TypeOf(&customerRegisteredV1{}), 2))
+
+	_, _, err := registry.Upcast("customers.customer-registered", 1, []byte(`{}`), nil)
+	assert.Error(t, err)
+}
+
+func Test_Upcast_Wraps_The_Upcaster_Error(t *testing.T) {
+	registry := NewTypeRegistry()
+	require.NoError(t, registry.Register("customers.customer-registered", reflect.TypeOf(&customerRegisteredV1{}), 2))
+	failure := errors.New("invalid payload")
+	require.NoError(t, registry.RegisterUpcaster("customers.customer-registered", 1, func([]byte) ([]byte, error) {
+		return nil, failure
+	}))
+
+	_, _, err := registry.Upcast("customers.customer-registered", 1, []byte(`{}`), nil)
+	assert.True(t, errors.Is(err, failure))
+}
+
+func Test_Register_Rejects_A_Conflicting_Alias_Or_Type(t *testing.T) {
+	registry := newCustomerRegistry(t)
+
+	assert.Error(t, registry.Register("customers.customer-registered", reflect.TypeOf(&customerRegisteredOther{}), 1))
+	assert.Error(t, registry.Register("customers.customer-created", reflect.TypeOf(&customerRegisteredV1{}), 1))
+	assert.Error(t, registry.RegisterUpcaster("customers.customer-registered", 3, nil))
+	assert.Error(t, registry.RegisterUpcaster("customers.unknown", 1, nil))
+}
+
+func Test_Schema_Version_Header_Survives_The_Serialization(t *testing.T) {
+	meta := metadata.Metadata{}
+	SetSchemaVersion(meta, 3)
+	assert.Equal(t, 3, GetSchemaVersion(meta))
+
+	data, err := json.Marshal(meta)
+	require.NoError(t, err)
+
+	deserialized := metadata.Metadata{}
+	require.NoError(t, json.Unmarshal(data, &deserialized))
+	assert.Equal(t, 3, GetSchemaVersion(deserialized))
+	assert.Equal(t, 0, GetSchemaVersion(metadata.Metadata{}))
+}
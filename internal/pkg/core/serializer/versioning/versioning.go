@@ -0,0 +1,52 @@
+// Package versioning provides the default event type registry.
+package versioning
+
+import (
+	"reflect"
+
+	typeMapper "github.com/raphaeldiscky/go-food-micro/internal/pkg/reflection/typemapper"
+)
+
+// defaultRegistry is the type registry used by the serializers.
+var defaultRegistry = NewTypeRegistry()
+
+// DefaultRegistry returns the type registry used by the serializers.
+func DefaultRegistry() *TypeRegistry {
+	return defaultRegistry
+}
+
+// RegisterType registers the type T in the default registry with a stable alias and its current schema version.
+func RegisterType[T any](alias string, schemaVersion int, legacyNames ...string) error {
+	return defaultRegistry.Register(
+		alias,
+		typeMapper.GetGenericTypeByT[T](),
+		schemaVersion,
+		legacyNames...,
+	)
+}
+
+// RegisterUpcaster registers the upcaster of the alias from a schema version in the default registry.
+func RegisterUpcaster(alias string, fromVersion int, upcaster Upcaster) error {
+	return defaultRegistry.RegisterUpcaster(alias, fromVersion, upcaster)
+}
+
+// TypeName returns the alias of the registered type of the object, or its go type name.
+func TypeName(obj interface{}) string {
+	return defaultRegistry.TypeName(obj)
+}
+
+// SchemaVersion returns the current schema version of the registered type of the object.
+func SchemaVersion(obj interface{}) int {
+	return defaultRegistry.SchemaVersion(obj)
+}
+
+// Upcast upcasts the payload of a type name that implements TKind to its current schema version, and returns the
+// type name to deserialize it with.
+func Upcast[TKind any](name string, schemaVersion int, data []byte) (string, []byte, error) {
+	return defaultRegistry.Upcast(
+		name,
+		schemaVersion,
+		data,
+		reflect.TypeOf((*TKind)(nil)).Elem(),
+	)
+}
@@ -8,6 +8,6 @@ import (
 // Module is the module for the elasticsearch.
 // https://uber-go.github.io/fx/modules.html
 var Module = fx.Module("elasticfx",
-	fx.Provide(provideConfig),
+	fx.Provide(ProvideConfig),
 	fx.Provide(NewElasticClient),
 )
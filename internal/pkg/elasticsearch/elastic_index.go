@@ -0,0 +1,94 @@
+// Package elasticsearch provides the elasticsearch index management.
+package elasticsearch
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"emperror.dev/errors"
+
+	json "github.com/goccy/go-json"
+
+	elasticsearch "github.com/elastic/go-elasticsearch/v8"
+)
+
+// IndexTemplate is a composable index template, applied to every index matching its patterns.
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/index-templates.html
+type IndexTemplate struct {
+	IndexPatterns []string               `json:"index_patterns"`
+	Priority      int                    `json:"priority,omitempty"`
+	Template      IndexTemplateSettings  `json:"template"`
+	Meta          map[string]interface{} `json:"_meta,omitempty"`
+}
+
+// IndexTemplateSettings contains the settings and mappings of an index template.
+type IndexTemplateSettings struct {
+	Settings map[string]interface{} `json:"settings,omitempty"`
+	Mappings map[string]interface{} `json:"mappings,omitempty"`
+}
+
+// PutIndexTemplate creates or updates an index template, it is idempotent, so it can be called on every startup.
+func PutIndexTemplate(
+	ctx context.Context,
+	client *elasticsearch.Client,
+	name string,
+	template *IndexTemplate,
+) error {
+	body, err := json.Marshal(template)
+	if err != nil {
+		return errors.WrapIf(err, "failed to marshal index template")
+	}
+
+	res, err := client.Indices.PutIndexTemplate(
+		name,
+		strings.NewReader(string(body)),
+		client.Indices.PutIndexTemplate.WithContext(ctx),
+	)
+	if err != nil {
+		return errors.WrapIf(err, fmt.Sprintf("failed to put index template %s", name))
+	}
+	defer closeBody(res.Body)
+
+	if res.IsError() {
+		return errors.Errorf("put index template %s error: %s", name, res.String())
+	}
+
+	return nil
+}
+
+// EnsureIndex creates the index if it doesn't exist, the matching index templates are applied on creation.
+func EnsureIndex(ctx context.Context, client *elasticsearch.Client, index string) error {
+	existsRes, err := client.Indices.Exists(
+		[]string{index},
+		client.Indices.Exists.WithContext(ctx),
+	)
+	if err != nil {
+		return errors.WrapIf(err, fmt.Sprintf("failed to check index %s exists", index))
+	}
+	closeBody(existsRes.Body)
+
+	if existsRes.StatusCode == 200 {
+		return nil
+	}
+
+	res, err := client.Indices.Create(index, client.Indices.Create.WithContext(ctx))
+	if err != nil {
+		return errors.WrapIf(err, fmt.Sprintf("failed to create index %s", index))
+	}
+	defer closeBody(res.Body)
+
+	// another instance may create the index concurrently
+	if res.IsError() && !strings.Contains(res.String(), "resource_already_exists_exception") {
+		return errors.Errorf("create index %s error: %s", index, res.String())
+	}
+
+	return nil
+}
+
+// closeBody drains and closes a response body, so the underlying connection can be reused.
+func closeBody(body io.ReadCloser) {
+	_, _ = io.Copy(io.Discard, body)
+	_ = body.Close()
+}
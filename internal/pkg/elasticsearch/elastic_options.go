@@ -17,7 +17,7 @@ type ElasticOptions struct {
 	URL string `mapstructure:"url"`
 }
 
-// provideConfig provides the elasticsearch options.
-func provideConfig(environment environment.Environment) (*ElasticOptions, error) {
+// ProvideConfig provides the elasticsearch options.
+func ProvideConfig(environment environment.Environment) (*ElasticOptions, error) {
 	return config.BindConfigKey[*ElasticOptions](optionName, environment)
 }
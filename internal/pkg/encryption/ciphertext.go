@@ -0,0 +1,91 @@
+// Package encryption provides the AES-GCM ciphertexts of the encrypted values.
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+
+	"emperror.dev/errors"
+)
+
+// ciphertextPrefix is the prefix of the encrypted values, `enc:v1:<key id>:<base64 nonce and ciphertext>`.
+const ciphertextPrefix = "enc:v1:"
+
+// IsEncrypted returns true if a value is encrypted by a field encryptor.
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, ciphertextPrefix)
+}
+
+// seal encrypts a plaintext with a key, the key id is authenticated with the ciphertext.
+func seal(key *Key, plaintext []byte) (string, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", errors.WrapIf(err, "error in generating the nonce")
+	}
+
+	sealed := aead.Seal(nonce, nonce, plaintext, []byte(key.ID))
+
+	return ciphertextPrefix + key.ID + ":" + base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+// ciphertextKeyID returns the id of the key of an encrypted value.
+func ciphertextKeyID(value string) (string, error) {
+	keyID, _, ok := strings.Cut(strings.TrimPrefix(value, ciphertextPrefix), ":")
+	if !ok || keyID == "" {
+		return "", errors.WithMessage(ErrInvalidCiphertext, "the key id is missing")
+	}
+
+	return keyID, nil
+}
+
+// open decrypts an encrypted value with its key.
+func open(key *Key, value string) ([]byte, error) {
+	keyID, encoded, _ := strings.Cut(strings.TrimPrefix(value, ciphertextPrefix), ":")
+	if keyID != key.ID {
+		return nil, errors.WithMessagef(ErrInvalidCiphertext, "the value is not encrypted with the key `%s`", key.ID)
+	}
+
+	sealed, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.WithMessage(ErrInvalidCiphertext, err.Error())
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.WithMessage(ErrInvalidCiphertext, "the nonce is missing")
+	}
+
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(key.ID))
+	if err != nil {
+		return nil, errors.WithMessagef(ErrInvalidCiphertext, "with the key `%s`", key.ID)
+	}
+
+	return plaintext, nil
+}
+
+// newAEAD creates the AES-GCM cipher of a key.
+func newAEAD(key *Key) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key.Material)
+	if err != nil {
+		return nil, errors.WithMessage(ErrInvalidKey, err.Error())
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.WrapIf(err, "error in creating the AES-GCM cipher")
+	}
+
+	return aead, nil
+}
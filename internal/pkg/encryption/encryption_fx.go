@@ -0,0 +1,20 @@
+// Package encryption provides the field encryption fx module.
+package encryption
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/transform"
+)
+
+// Module provided to fxlog, the field encryption transform is run by the rabbitmq producers and consumers and by
+// the event store serializer. The data subject keys are enabled by providing a SubjectKeyStore.
+var Module = fx.Module(
+	"encryptionfx",
+	fx.Provide(
+		ProvideConfig,
+		fx.Annotate(NewLocalKeyProviderFromOptions, fx.As(new(KeyProvider))),
+		fx.Annotate(NewFieldEncryptor, fx.ParamTags(``, `optional:"true"`)),
+		transform.AsTransform(NewFieldEncryptionTransform),
+	),
+)
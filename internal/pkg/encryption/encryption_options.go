@@ -0,0 +1,52 @@
+// Package encryption provides the field encryption options.
+package encryption
+
+import (
+	"encoding/base64"
+
+	"emperror.dev/errors"
+	"github.com/iancoleman/strcase"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/config"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/config/environment"
+	typeMapper "github.com/raphaeldiscky/go-food-micro/internal/pkg/reflection/typemapper"
+)
+
+// optionName is the name of the option for the field encryption.
+var optionName = strcase.ToLowerCamel(typeMapper.GetGenericTypeNameByT[EncryptionOptions]())
+
+// EncryptionOptions is a struct that contains the options for the field encryption, a key is rotated by adding a
+// new key and making it the current key, the previous keys should be kept until their values are gone.
+type EncryptionOptions struct {
+	// CurrentKeyId is the id of the key that encrypts the new values.
+	CurrentKeyId string `mapstructure:"currentKeyId"`
+	// Keys are the encryption keys.
+	Keys []*EncryptionKeyOptions `mapstructure:"keys"`
+}
+
+// EncryptionKeyOptions is a struct that contains an encryption key.
+type EncryptionKeyOptions struct {
+	// Id is the id of the key, it is stored with the encrypted values.
+	Id string `mapstructure:"id"`
+	// Key is the base64 encoded 32 bytes AES-256 key.
+	Key string `mapstructure:"key"`
+}
+
+// ProvideConfig provides the config for the field encryption.
+func ProvideConfig(environment environment.Environment) (*EncryptionOptions, error) {
+	return config.BindConfigKey[*EncryptionOptions](optionName, environment)
+}
+
+// NewLocalKeyProviderFromOptions creates a new local key provider of the configured keys.
+func NewLocalKeyProviderFromOptions(options *EncryptionOptions) (*LocalKeyProvider, error) {
+	keys := make([]*Key, 0, len(options.Keys))
+	for _, keyOptions := range options.Keys {
+		material, err := base64.StdEncoding.DecodeString(keyOptions.Key)
+		if err != nil {
+			return nil, errors.WithMessagef(ErrInvalidKey, "the key `%s` should be base64 encoded", keyOptions.Id)
+		}
+		keys = append(keys, &Key{ID: keyOptions.Id, Material: material})
+	}
+
+	return NewLocalKeyProvider(options.CurrentKeyId, keys...)
+}
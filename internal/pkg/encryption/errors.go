@@ -0,0 +1,19 @@
+// Package encryption provides the field encryption errors.
+package encryption
+
+import (
+	"emperror.dev/errors"
+)
+
+// ErrKeyNotFound is a error that represents an encryption key that is not in the key provider.
+var ErrKeyNotFound = errors.New("encryption key not found")
+
+// ErrInvalidKey is a error that represents an encryption key with an invalid id or size.
+var ErrInvalidKey = errors.New("invalid encryption key")
+
+// ErrInvalidCiphertext is a error that represents an encrypted value that can't be decrypted.
+var ErrInvalidCiphertext = errors.New("invalid ciphertext")
+
+// ErrKeyShredded is a error that represents a data subject key that is destroyed, the values encrypted with it
+// can't be decrypted anymore.
+var ErrKeyShredded = errors.New("encryption key shredded")
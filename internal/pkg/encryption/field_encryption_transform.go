@@ -0,0 +1,28 @@
+// Package encryption provides the field encryption transform of the messages.
+package encryption
+
+// FieldEncryptionTransform is a message transform that encrypts the pii fields of the outgoing messages and decrypts
+// them in the incoming messages.
+type FieldEncryptionTransform struct {
+	encryptor *FieldEncryptor
+}
+
+// NewFieldEncryptionTransform creates a new field encryption transform.
+func NewFieldEncryptionTransform(encryptor *FieldEncryptor) *FieldEncryptionTransform {
+	return &FieldEncryptionTransform{encryptor: encryptor}
+}
+
+// Name returns the name of the transform.
+func (t *FieldEncryptionTransform) Name() string {
+	return "fieldEncryption"
+}
+
+// EncodeMessage returns a copy of a message with its pii fields encrypted.
+func (t *FieldEncryptionTransform) EncodeMessage(message interface{}) (interface{}, error) {
+	return t.encryptor.EncryptFields(message)
+}
+
+// DecodeMessage decrypts the pii fields of a message in place.
+func (t *FieldEncryptionTransform) DecodeMessage(message interface{}) error {
+	return t.encryptor.DecryptFields(message)
+}
@@ -0,0 +1,427 @@
+// Package encryption provides the field level encryption of the struct fields tagged as pii.
+package encryption
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	"emperror.dev/errors"
+)
+
+const (
+	// PIITag is the struct tag of the personal data fields, the string fields tagged with `pii:"true"` are
+	// encrypted.
+	PIITag = "pii"
+	// PIISubjectTagValue tags the pii field that identifies the data subject of a struct, like the email of a
+	// customer, the pii fields of the struct are encrypted with the key of the subject by EncryptSubjectFields.
+	PIISubjectTagValue = "subject"
+	// piiTagValue tags a pii field.
+	piiTagValue = "true"
+
+	// RedactedValue is the decrypted value of a pii field whose data subject key is destroyed.
+	RedactedValue = "[redacted]"
+)
+
+// FieldEncryptor encrypts the pii fields of the messages and the events with AES-GCM.
+type FieldEncryptor struct {
+	keyProvider     KeyProvider
+	subjectKeyStore SubjectKeyStore
+	mu              sync.RWMutex
+	// piiTypes caches whether the types have a pii field
+	piiTypes map[reflect.Type]bool
+}
+
+// encryptionScope is the key that encrypts the pii fields of a struct and the subject keys of the nested structs.
+type encryptionScope struct {
+	key        *Key
+	subjectKey func(subject string) (*Key, error)
+}
+
+// NewFieldEncryptor creates a new field encryptor, the subject key store is optional and it is needed for
+// EncryptSubjectFields and for decrypting the values of the subject keys.
+func NewFieldEncryptor(keyProvider KeyProvider, subjectKeyStore SubjectKeyStore) *FieldEncryptor {
+	return &FieldEncryptor{
+		keyProvider:     keyProvider,
+		subjectKeyStore: subjectKeyStore,
+		piiTypes:        make(map[reflect.Type]bool),
+	}
+}
+
+// Encrypt encrypts a value with the current key, the empty values are not encrypted.
+func (e *FieldEncryptor) Encrypt(value string) (string, error) {
+	key, err := e.keyProvider.CurrentKey()
+	if err != nil {
+		return "", err
+	}
+
+	return encryptValueWithKey(key, value)
+}
+
+// Decrypt decrypts a value with the key it was encrypted with, the plain values are returned unchanged and the
+// values of a destroyed subject key are redacted.
+func (e *FieldEncryptor) Decrypt(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	keyID, err := ciphertextKeyID(value)
+	if err != nil {
+		return "", err
+	}
+
+	key, err := e.key(keyID)
+	if errors.Is(err, ErrKeyShredded) {
+		return RedactedValue, nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	plaintext, err := open(key, value)
+	if err != nil {
+		return "", err
+	}
+
+	return string(plaintext), nil
+}
+
+// EncryptFields returns a copy of an object with its pii fields encrypted with the current key, the object is not
+// changed. The pii fields are found in the nested and the embedded structs, their pointers and their slices.
+func (e *FieldEncryptor) EncryptFields(obj interface{}) (interface{}, error) {
+	return e.encryptFields(obj, nil)
+}
+
+// EncryptSubjectFields returns a copy of an object with the pii fields of the structs with a data subject
+// encrypted with the key of the subject, the key is created for a new subject. The structs without a subject are
+// encrypted with the current key.
+func (e *FieldEncryptor) EncryptSubjectFields(ctx context.Context, obj interface{}) (interface{}, error) {
+	if e.subjectKeyStore == nil {
+		return e.encryptFields(obj, nil)
+	}
+
+	return e.encryptFields(obj, func(subject string) (*Key, error) {
+		return e.subjectKeyStore.GetOrCreateKey(ctx, SubjectID(subject))
+	})
+}
+
+// DecryptFields decrypts the pii fields of an object in place, the object should be a pointer.
+func (e *FieldEncryptor) DecryptFields(obj interface{}) error {
+	if obj == nil {
+		return nil
+	}
+
+	value := reflect.ValueOf(obj)
+	if !e.hasPII(value.Type()) {
+		return nil
+	}
+
+	if value.Kind() != reflect.Ptr {
+		return errors.Errorf("pii fields of `%s` can't be decrypted in place", value.Type().String())
+	}
+
+	return e.decryptValue(value)
+}
+
+// encryptFields returns a copy of an object with its pii fields encrypted.
+func (e *FieldEncryptor) encryptFields(
+	obj interface{},
+	subjectKey func(subject string) (*Key, error),
+) (interface{}, error) {
+	if obj == nil {
+		return nil, nil
+	}
+
+	value := reflect.ValueOf(obj)
+	if !e.hasPII(value.Type()) {
+		return obj, nil
+	}
+
+	key, err := e.keyProvider.CurrentKey()
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted, err := e.encryptValue(value, encryptionScope{key: key, subjectKey: subjectKey})
+	if err != nil {
+		return nil, err
+	}
+
+	return encrypted.Interface(), nil
+}
+
+// encryptValue returns a copy of a value with its pii fields encrypted, only the structs, pointers and slices on
+// the way to a pii field are copied.
+func (e *FieldEncryptor) encryptValue(value reflect.Value, scope encryptionScope) (reflect.Value, error) {
+	if !e.hasPII(value.Type()) {
+		return value, nil
+	}
+
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+			return value, nil
+		}
+
+		elem, err := e.encryptValue(value.Elem(), scope)
+		if err != nil {
+			return value, err
+		}
+
+		copied := reflect.New(value.Type().Elem())
+		copied.Elem().Set(elem)
+
+		return copied, nil
+	case reflect.Slice, reflect.Array:
+		if value.Kind() == reflect.Slice && value.IsNil() {
+			return value, nil
+		}
+
+		var copied reflect.Value
+		if value.Kind() == reflect.Slice {
+			copied = reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		} else {
+			copied = reflect.New(value.Type()).Elem()
+		}
+
+		for i := 0; i < value.Len(); i++ {
+			item, err := e.encryptValue(value.Index(i), scope)
+			if err != nil {
+				return value, err
+			}
+			copied.Index(i).Set(item)
+		}
+
+		return copied, nil
+	case reflect.Struct:
+		copied := reflect.New(value.Type()).Elem()
+		copied.Set(value)
+
+		if err := e.encryptStruct(copied, scope); err != nil {
+			return value, err
+		}
+
+		return copied, nil
+	default:
+		return value, nil
+	}
+}
+
+// encryptStruct encrypts the pii fields of a struct copy, the fields of an unexported embedded struct are a part of
+// the copy and are encrypted in place.
+func (e *FieldEncryptor) encryptStruct(value reflect.Value, scope encryptionScope) error {
+	scope, err := e.subjectScope(value, scope)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !isVisibleField(field) || (!isPIIField(field) && !e.hasPII(field.Type)) {
+			continue
+		}
+
+		fieldValue := value.Field(i)
+		switch {
+		case isPIIField(field):
+			encrypted, err := encryptValueWithKey(scope.key, fieldValue.String())
+			if err != nil {
+				return errors.WrapIff(err, "error in encrypting the field `%s`", field.Name)
+			}
+			fieldValue.SetString(encrypted)
+		case !field.IsExported():
+			if err := e.encryptStruct(fieldValue, scope); err != nil {
+				return err
+			}
+		default:
+			item, err := e.encryptValue(fieldValue, scope)
+			if err != nil {
+				return err
+			}
+			fieldValue.Set(item)
+		}
+	}
+
+	return nil
+}
+
+// subjectScope returns the scope of the data subject of a struct, the structs without a subject keep the scope of
+// their parent.
+func (e *FieldEncryptor) subjectScope(value reflect.Value, scope encryptionScope) (encryptionScope, error) {
+	if scope.subjectKey == nil {
+		return scope, nil
+	}
+
+	subject := subjectOf(value)
+	if subject == "" {
+		return scope, nil
+	}
+
+	key, err := scope.subjectKey(subject)
+	if err != nil {
+		return scope, errors.WrapIf(err, "error in getting the key of the data subject")
+	}
+
+	return encryptionScope{key: key, subjectKey: scope.subjectKey}, nil
+}
+
+// decryptValue decrypts the pii fields of an addressable value in place.
+func (e *FieldEncryptor) decryptValue(value reflect.Value) error {
+	if !e.hasPII(value.Type()) {
+		return nil
+	}
+
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+			return nil
+		}
+
+		return e.decryptValue(value.Elem())
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if err := e.decryptValue(value.Index(i)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if !isVisibleField(field) {
+				continue
+			}
+
+			if isPIIField(field) {
+				decrypted, err := e.Decrypt(value.Field(i).String())
+				if err != nil {
+					return errors.WrapIff(err, "error in decrypting the field `%s`", field.Name)
+				}
+				value.Field(i).SetString(decrypted)
+
+				continue
+			}
+
+			if err := e.decryptValue(value.Field(i)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	default:
+		return nil
+	}
+}
+
+// key returns a key by its id from the key store of its kind, the subject keys are read without a deadline
+// because the serializers have no context.
+func (e *FieldEncryptor) key(id string) (*Key, error) {
+	if !IsSubjectKeyID(id) {
+		return e.keyProvider.Key(id)
+	}
+
+	if e.subjectKeyStore == nil {
+		return nil, errors.WithMessagef(ErrKeyNotFound, "no subject key store for the key `%s`", id)
+	}
+
+	return e.subjectKeyStore.Key(context.Background(), id)
+}
+
+// hasPII returns true if a type has a pii field.
+func (e *FieldEncryptor) hasPII(typ reflect.Type) bool {
+	e.mu.RLock()
+	result, ok := e.piiTypes[typ]
+	e.mu.RUnlock()
+
+	if ok {
+		return result
+	}
+
+	result = typeHasPII(typ, make(map[reflect.Type]bool))
+
+	e.mu.Lock()
+	e.piiTypes[typ] = result
+	e.mu.Unlock()
+
+	return result
+}
+
+// typeHasPII returns true if a type has a pii field, the visiting types stop the recursive types.
+func typeHasPII(typ reflect.Type, visiting map[reflect.Type]bool) bool {
+	switch typ.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array:
+		return typeHasPII(typ.Elem(), visiting)
+	case reflect.Struct:
+		if visiting[typ] {
+			return false
+		}
+		visiting[typ] = true
+		defer delete(visiting, typ)
+
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			if !isVisibleField(field) {
+				continue
+			}
+
+			if isPIIField(field) || typeHasPII(field.Type, visiting) {
+				return true
+			}
+		}
+
+		return false
+	default:
+		return false
+	}
+}
+
+// subjectOf returns the plain value of the subject field of a struct, the fields of the unexported embedded
+// structs are promoted.
+func subjectOf(value reflect.Value) string {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !isVisibleField(field) {
+			continue
+		}
+
+		if isPIIField(field) && field.Tag.Get(PIITag) == PIISubjectTagValue {
+			if subject := value.Field(i).String(); !IsEncrypted(subject) {
+				return subject
+			}
+
+			continue
+		}
+
+		if field.Anonymous && !field.IsExported() {
+			if subject := subjectOf(value.Field(i)); subject != "" {
+				return subject
+			}
+		}
+	}
+
+	return ""
+}
+
+// encryptValueWithKey encrypts a value with a key, the empty and the encrypted values are not encrypted.
+func encryptValueWithKey(key *Key, value string) (string, error) {
+	if value == "" || IsEncrypted(value) {
+		return value, nil
+	}
+
+	return seal(key, []byte(value))
+}
+
+// isVisibleField returns true if a field can be set, the exported fields of an unexported embedded struct are
+// promoted like encoding/json but an unexported embedded pointer can't be copied.
+func isVisibleField(field reflect.StructField) bool {
+	return field.IsExported() || (field.Anonymous && field.Type.Kind() == reflect.Struct)
+}
+
+// isPIIField returns true if a field is a string tagged as pii or as the data subject.
+func isPIIField(field reflect.StructField) bool {
+	tag := field.Tag.Get(PIITag)
+
+	return (tag == piiTagValue || tag == PIISubjectTagValue) && field.Type.Kind() == reflect.String
+}
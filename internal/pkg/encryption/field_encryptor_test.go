@@ -0,0 +1,141 @@
+//go:build unit
+// +build unit
+
+// Package encryption provides the field encryptor tests.
+package encryption
+
+import (
+	"bytes"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+)
+
+type shopItem struct {
+	Title string `json:"title"`
+	Note  string `json:"note"  pii:"true"`
+}
+
+type orderDto struct {
+	AccountEmail    string      `json:"accountEmail"    pii:"true"`
+	DeliveryAddress string      `json:"deliveryAddress" pii:"true"`
+	ShopItems       []*shopItem `json:"shopItems"`
+}
+
+type orderCreated struct {
+	*types.Message
+	Order   *order `json:"order"`
+	OrderID string `json:"orderId"`
+}
+
+// order promotes the fields of an unexported embedded struct.
+type order struct {
+	orderDto
+}
+
+func Test_EncryptFields_Encrypts_A_Copy_And_DecryptFields_Restores_It(t *testing.T) {
+	encryptor := NewFieldEncryptor(newKeyProvider(t, "key-1"), nil)
+
+	message := &orderCreated{
+		Message: types.NewMessage("1"),
+		Order: &order{orderDto: orderDto{
+			AccountEmail: "john@example.com",
+		}},
+		OrderID: "1",
+	}
+	message.Order.DeliveryAddress = "Main Street 1"
+	message.Order.ShopItems = []*shopItem{{Title: "book", Note: "gift for john"}}
+
+	encrypted, err := encryptor.EncryptFields(message)
+	require.NoError(t, err)
+
+	encryptedMessage, ok := encrypted.(*orderCreated)
+	require.True(t, ok)
+	assert.True(t, IsEncrypted(encryptedMessage.Order.AccountEmail))
+	assert.True(t, IsEncrypted(encryptedMessage.Order.DeliveryAddress))
+	assert.True(t, IsEncrypted(encryptedMessage.Order.ShopItems[0].Note))
+	assert.Equal(t, "book", encryptedMessage.Order.ShopItems[0].Title)
+	assert.Equal(t, "1", encryptedMessage.OrderID)
+	assert.Same(t, message.Message, encryptedMessage.Message)
+
+	// the original message is not changed
+	assert.Equal(t, "john@example.com", message.Order.AccountEmail)
+	assert.Equal(t, "gift for john", message.Order.ShopItems[0].Note)
+
+	require.NoError(t, encryptor.DecryptFields(encryptedMessage))
+	assert.Equal(t, "john@example.com", encryptedMessage.Order.AccountEmail)
+	assert.Equal(t, "Main Street 1", encryptedMessage.Order.DeliveryAddress)
+	assert.Equal(t, "gift for john", encryptedMessage.Order.ShopItems[0].Note)
+}
+
+func Test_Decrypt_Uses_The_Previous_Keys_After_A_Rotation(t *testing.T) {
+	keyProvider := newKeyProvider(t, "key-1")
+	encryptor := NewFieldEncryptor(keyProvider, nil)
+
+	oldValue, err := encryptor.Encrypt("john@example.com")
+	require.NoError(t, err)
+
+	require.NoError(t, keyProvider.Rotate(&Key{ID: "key-2", Material: bytes.Repeat([]byte{2}, KeySize)}))
+
+	newValue, err := encryptor.Encrypt("john@example.com")
+	require.NoError(t, err)
+	assert.Contains(t, oldValue, ":key-1:")
+	assert.Contains(t, newValue, ":key-2:")
+
+	for _, value := range []string{oldValue, newValue} {
+		decrypted, err := encryptor.Decrypt(value)
+		require.NoError(t, err)
+		assert.Equal(t, "john@example.com", decrypted)
+	}
+
+	// a value of a removed key can't be decrypted
+	_, err = NewFieldEncryptor(newKeyProvider(t, "key-3"), nil).Decrypt(oldValue)
+	assert.True(t, errors.Is(err, ErrKeyNotFound))
+}
+
+func Test_Decrypt_Rejects_A_Tampered_Value_And_Keeps_The_Plain_Values(t *testing.T) {
+	encryptor := NewFieldEncryptor(newKeyProvider(t, "key-1"), nil)
+
+	value, err := encryptor.Encrypt("john@example.com")
+	require.NoError(t, err)
+
+	tampered := value[:len(value)-2] + "AA"
+	if tampered == value {
+		tampered = value[:len(value)-2] + "BB"
+	}
+	_, err = encryptor.Decrypt(tampered)
+	assert.True(t, errors.Is(err, ErrInvalidCiphertext))
+
+	plain, err := encryptor.Decrypt("john@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "john@example.com", plain)
+}
+
+func Test_NewLocalKeyProviderFromOptions_Validates_The_Keys(t *testing.T) {
+	_, err := NewLocalKeyProviderFromOptions(&EncryptionOptions{
+		CurrentKeyId: "key-1",
+		Keys:         []*EncryptionKeyOptions{{Id: "key-1", Key: "c2hvcnQ="}},
+	})
+	assert.True(t, errors.Is(err, ErrInvalidKey))
+
+	_, err = NewLocalKeyProviderFromOptions(&EncryptionOptions{
+		CurrentKeyId: "key-2",
+		Keys: []*EncryptionKeyOptions{
+			{Id: "key-1", Key: "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="},
+		},
+	})
+	assert.True(t, errors.Is(err, ErrKeyNotFound))
+}
+
+func newKeyProvider(t *testing.T, id string) *LocalKeyProvider {
+	t.Helper()
+
+	keyProvider, err := NewLocalKeyProvider(id, &Key{ID: id, Material: bytes.Repeat([]byte{1}, KeySize)})
+	require.NoError(t, err)
+
+	return keyProvider
+}
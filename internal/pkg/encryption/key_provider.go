@@ -0,0 +1,103 @@
+// Package encryption provides the encryption keys and the local key provider.
+package encryption
+
+import (
+	"strings"
+	"sync"
+
+	"emperror.dev/errors"
+)
+
+// KeySize is the size of the AES-256 encryption keys.
+const KeySize = 32
+
+// Key is an encryption key and its id, the id is stored with the encrypted values to find the key of a value after
+// a key rotation.
+type Key struct {
+	ID       string
+	Material []byte
+}
+
+// KeyProvider provides the current key that encrypts the new values and the older keys that still decrypt the
+// existing values.
+type KeyProvider interface {
+	CurrentKey() (*Key, error)
+	Key(id string) (*Key, error)
+}
+
+// LocalKeyProvider is a key provider of the keys in the configuration.
+type LocalKeyProvider struct {
+	mu           sync.RWMutex
+	keys         map[string]*Key
+	currentKeyID string
+}
+
+// NewLocalKeyProvider creates a new local key provider, the current key encrypts the new values.
+func NewLocalKeyProvider(currentKeyID string, keys ...*Key) (*LocalKeyProvider, error) {
+	provider := &LocalKeyProvider{keys: make(map[string]*Key)}
+	for _, key := range keys {
+		if err := provider.AddKey(key); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, ok := provider.keys[currentKeyID]; !ok {
+		return nil, errors.WithMessagef(ErrKeyNotFound, "current key `%s`", currentKeyID)
+	}
+	provider.currentKeyID = currentKeyID
+
+	return provider, nil
+}
+
+// AddKey adds a key that decrypts the values encrypted with it.
+func (p *LocalKeyProvider) AddKey(key *Key) error {
+	if key == nil || key.ID == "" || strings.Contains(key.ID, ":") {
+		return errors.WithMessage(ErrInvalidKey, "the key id should be set and should not have `:`")
+	}
+
+	if len(key.Material) != KeySize {
+		return errors.WithMessagef(ErrInvalidKey, "the key `%s` should have %d bytes", key.ID, KeySize)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.keys[key.ID] = key
+
+	return nil
+}
+
+// Rotate adds a key and makes it the current key, the previous keys still decrypt their values.
+func (p *LocalKeyProvider) Rotate(key *Key) error {
+	if err := p.AddKey(key); err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.currentKeyID = key.ID
+
+	return nil
+}
+
+// CurrentKey returns the key that encrypts the new values.
+func (p *LocalKeyProvider) CurrentKey() (*Key, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.keys[p.currentKeyID], nil
+}
+
+// Key returns a key by its id.
+func (p *LocalKeyProvider) Key(id string) (*Key, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	key, ok := p.keys[id]
+	if !ok {
+		return nil, errors.WithMessagef(ErrKeyNotFound, "key `%s`", id)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,300 @@
+// Package encryption provides the mongodb store of the data subject keys.
+package encryption
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/mongodb"
+)
+
+const (
+	subjectKeyCollection = "subject_keys"
+	// activeSubjectKeyIndexName is the unique index of the active key of a data subject.
+	activeSubjectKeyIndexName = "subject_keys_active_subject"
+	// subjectKeyCacheTTL bounds how long the other instances decrypt with a destroyed key.
+	subjectKeyCacheTTL = time.Minute
+)
+
+// subjectKeyDocument is a data subject key wrapped with the current master key, the active subject id is only set
+// on the active key of a subject and is removed on its destruction.
+type subjectKeyDocument struct {
+	ID              string     `bson:"_id"`
+	SubjectID       string     `bson:"subjectId"`
+	ActiveSubjectID string     `bson:"activeSubjectId,omitempty"`
+	WrappedKey      string     `bson:"wrappedKey"`
+	CreatedAt       time.Time  `bson:"createdAt"`
+	DestroyedAt     *time.Time `bson:"destroyedAt"`
+}
+
+// cachedSubjectKey is a cached subject key, a nil key is a destroyed key.
+type cachedSubjectKey struct {
+	key       *Key
+	expiresAt time.Time
+}
+
+// MongoSubjectKeyStore is a subject key store in mongodb, the keys are wrapped with the master key and the
+// destroyed keys are kept as tombstones without their key material.
+type MongoSubjectKeyStore struct {
+	client      *mongo.Client
+	options     *mongodb.MongoDbOptions
+	keyProvider KeyProvider
+	mu          sync.RWMutex
+	keys        map[string]cachedSubjectKey
+	subjects    map[string]cachedSubjectKey
+}
+
+// NewMongoSubjectKeyStore creates a new mongodb subject key store.
+func NewMongoSubjectKeyStore(
+	client *mongo.Client,
+	options *mongodb.MongoDbOptions,
+	keyProvider KeyProvider,
+) *MongoSubjectKeyStore {
+	return &MongoSubjectKeyStore{
+		client:      client,
+		options:     options,
+		keyProvider: keyProvider,
+		keys:        make(map[string]cachedSubjectKey),
+		subjects:    make(map[string]cachedSubjectKey),
+	}
+}
+
+// EnsureMongoSubjectKeyIndexes creates the unique index of the active subject keys, a subject has a single active
+// key even when its first events are encrypted concurrently.
+func EnsureMongoSubjectKeyIndexes(
+	ctx context.Context,
+	client *mongo.Client,
+	mongoOptions *mongodb.MongoDbOptions,
+) error {
+	collection := client.Database(mongoOptions.Database).Collection(subjectKeyCollection)
+
+	_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "activeSubjectId", Value: 1}},
+		Options: options.Index().
+			SetName(activeSubjectKeyIndexName).
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"activeSubjectId": bson.M{"$exists": true}}),
+	})
+	if err != nil {
+		return errors.WrapIf(err, "error in creating the subject keys indexes")
+	}
+
+	return nil
+}
+
+// GetOrCreateKey returns the key of a data subject and creates it for a new or a forgotten subject.
+func (s *MongoSubjectKeyStore) GetOrCreateKey(ctx context.Context, subjectID string) (*Key, error) {
+	if key, ok := s.cached(s.subjects, subjectID); ok && key != nil {
+		return key, nil
+	}
+
+	document, err := s.activeKey(ctx, subjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if document == nil {
+		document, err = s.createKey(ctx, subjectID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	key, err := s.unwrap(document)
+	if err != nil {
+		return nil, err
+	}
+	s.cache(subjectID, key)
+
+	return key, nil
+}
+
+// activeKey returns the active key document of a data subject, or nil for a new or a forgotten subject.
+func (s *MongoSubjectKeyStore) activeKey(ctx context.Context, subjectID string) (*subjectKeyDocument, error) {
+	var document subjectKeyDocument
+	err := s.collection().FindOne(ctx, bson.M{"activeSubjectId": subjectID}).Decode(&document)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, errors.WrapIf(err, "error in finding the subject key")
+	}
+
+	return &document, nil
+}
+
+// createKey upserts a new active key of a data subject, a key created concurrently by another call or instance is
+// returned instead of the new key.
+func (s *MongoSubjectKeyStore) createKey(ctx context.Context, subjectID string) (*subjectKeyDocument, error) {
+	key, err := NewSubjectKey()
+	if err != nil {
+		return nil, err
+	}
+
+	masterKey, err := s.keyProvider.CurrentKey()
+	if err != nil {
+		return nil, err
+	}
+
+	wrappedKey, err := seal(masterKey, key.Material)
+	if err != nil {
+		return nil, errors.WrapIf(err, "error in wrapping the subject key")
+	}
+
+	var document subjectKeyDocument
+	err = s.collection().FindOneAndUpdate(
+		ctx,
+		bson.M{"activeSubjectId": subjectID},
+		bson.M{"$setOnInsert": bson.M{
+			"_id":         key.ID,
+			"subjectId":   subjectID,
+			"wrappedKey":  wrappedKey,
+			"createdAt":   time.Now().UTC(),
+			"destroyedAt": nil,
+		}},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&document)
+
+	// the unique index rejects the upsert that lost a race, the winning key is read instead
+	if mongo.IsDuplicateKeyError(err) {
+		winner, err := s.activeKey(ctx, subjectID)
+		if err != nil {
+			return nil, err
+		}
+
+		if winner == nil {
+			return nil, errors.Errorf("the active key of the subject `%s` was destroyed while creating it", subjectID)
+		}
+
+		return winner, nil
+	}
+
+	if err != nil {
+		return nil, errors.WrapIf(err, "error in upserting the subject key")
+	}
+
+	return &document, nil
+}
+
+// Key returns a subject key by its id.
+func (s *MongoSubjectKeyStore) Key(ctx context.Context, id string) (*Key, error) {
+	if key, ok := s.cached(s.keys, id); ok {
+		if key == nil {
+			return nil, errors.WithMessagef(ErrKeyShredded, "the key `%s` is destroyed", id)
+		}
+
+		return key, nil
+	}
+
+	var document subjectKeyDocument
+	err := s.collection().FindOne(ctx, bson.M{"_id": id}).Decode(&document)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, errors.WithMessagef(ErrKeyNotFound, "the key `%s`", id)
+	}
+
+	if err != nil {
+		return nil, errors.WrapIf(err, "error in finding the subject key")
+	}
+
+	if document.DestroyedAt != nil {
+		s.cacheKey(id, nil)
+
+		return nil, errors.WithMessagef(ErrKeyShredded, "the key `%s` is destroyed", id)
+	}
+
+	key, err := s.unwrap(&document)
+	if err != nil {
+		return nil, err
+	}
+	s.cacheKey(id, key)
+
+	return key, nil
+}
+
+// DestroyKeys destroys the keys of a data subject, the wrapped keys are removed from their tombstones.
+func (s *MongoSubjectKeyStore) DestroyKeys(ctx context.Context, subjectID string) error {
+	_, err := s.collection().UpdateMany(
+		ctx,
+		bson.M{"subjectId": subjectID, "destroyedAt": nil},
+		bson.M{
+			"$set":   bson.M{"destroyedAt": time.Now().UTC(), "wrappedKey": ""},
+			"$unset": bson.M{"activeSubjectId": ""},
+		},
+	)
+	if err != nil {
+		return errors.WrapIf(err, "error in destroying the subject keys")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if cached, ok := s.subjects[subjectID]; ok && cached.key != nil {
+		delete(s.keys, cached.key.ID)
+	}
+	delete(s.subjects, subjectID)
+
+	return nil
+}
+
+// unwrap decrypts the key material of a subject key with the master key that wrapped it.
+func (s *MongoSubjectKeyStore) unwrap(document *subjectKeyDocument) (*Key, error) {
+	masterKeyID, err := ciphertextKeyID(document.WrappedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	masterKey, err := s.keyProvider.Key(masterKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	material, err := open(masterKey, document.WrappedKey)
+	if err != nil {
+		return nil, errors.WrapIff(err, "error in unwrapping the subject key `%s`", document.ID)
+	}
+
+	return &Key{ID: document.ID, Material: material}, nil
+}
+
+// cached returns a cached subject key that is not expired.
+func (s *MongoSubjectKeyStore) cached(cache map[string]cachedSubjectKey, id string) (*Key, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	cached, ok := cache[id]
+	if !ok || time.Now().After(cached.expiresAt) {
+		return nil, false
+	}
+
+	return cached.key, true
+}
+
+// cache caches the key of a data subject.
+func (s *MongoSubjectKeyStore) cache(subjectID string, key *Key) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expiresAt := time.Now().Add(subjectKeyCacheTTL)
+	s.subjects[subjectID] = cachedSubjectKey{key: key, expiresAt: expiresAt}
+	s.keys[key.ID] = cachedSubjectKey{key: key, expiresAt: expiresAt}
+}
+
+// cacheKey caches a subject key by its id, a nil key caches a destroyed key.
+func (s *MongoSubjectKeyStore) cacheKey(id string, key *Key) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.keys[id] = cachedSubjectKey{key: key, expiresAt: time.Now().Add(subjectKeyCacheTTL)}
+}
+
+// collection returns the collection of the subject keys.
+func (s *MongoSubjectKeyStore) collection() *mongo.Collection {
+	return s.client.Database(s.options.Database).Collection(subjectKeyCollection)
+}
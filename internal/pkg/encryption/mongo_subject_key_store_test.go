@@ -0,0 +1,86 @@
+//go:build integration
+// +build integration
+
+// Package encryption provides the mongodb subject key store tests.
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger/defaultlogger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/mongodb"
+	mongoContainer "github.com/raphaeldiscky/go-food-micro/internal/pkg/test/containers/testcontainer/mongo"
+)
+
+func newTestMongoSubjectKeyStore(t *testing.T) *MongoSubjectKeyStore {
+	t.Helper()
+	ctx := context.Background()
+
+	options, err := mongoContainer.NewMongoTestContainers(defaultlogger.GetLogger()).
+		PopulateContainerOptions(ctx, t)
+	require.NoError(t, err)
+
+	client, err := mongodb.NewMongoDB(options)
+	require.NoError(t, err)
+	require.NoError(t, EnsureMongoSubjectKeyIndexes(ctx, client, options))
+
+	keyProvider, err := NewLocalKeyProvider("master", &Key{ID: "master", Material: bytes.Repeat([]byte{1}, KeySize)})
+	require.NoError(t, err)
+
+	return NewMongoSubjectKeyStore(client, options, keyProvider)
+}
+
+func Test_MongoSubjectKeyStore_Creates_A_Single_Active_Key_For_Concurrent_Calls(t *testing.T) {
+	ctx := context.Background()
+	subjectID := SubjectID("john@example.com")
+
+	// every call uses its own store, like the instances of a service that share the database
+	stores := make([]*MongoSubjectKeyStore, 8)
+	stores[0] = newTestMongoSubjectKeyStore(t)
+	for i := 1; i < len(stores); i++ {
+		stores[i] = NewMongoSubjectKeyStore(stores[0].client, stores[0].options, stores[0].keyProvider)
+	}
+
+	keys := make([]*Key, len(stores))
+	errs := make([]error, len(stores))
+
+	var wg sync.WaitGroup
+	for i, store := range stores {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			keys[i], errs[i] = store.GetOrCreateKey(ctx, subjectID)
+		}()
+	}
+	wg.Wait()
+
+	for i := range stores {
+		require.NoError(t, errs[i])
+		assert.Equal(t, keys[0].ID, keys[i].ID)
+		assert.Equal(t, keys[0].Material, keys[i].Material)
+	}
+}
+
+func Test_MongoSubjectKeyStore_Creates_A_New_Key_For_A_Forgotten_Subject(t *testing.T) {
+	ctx := context.Background()
+	store := newTestMongoSubjectKeyStore(t)
+	subjectID := SubjectID("john@example.com")
+
+	key, err := store.GetOrCreateKey(ctx, subjectID)
+	require.NoError(t, err)
+
+	require.NoError(t, store.DestroyKeys(ctx, subjectID))
+
+	_, err = store.Key(ctx, key.ID)
+	assert.ErrorIs(t, err, ErrKeyShredded)
+
+	newKey, err := store.GetOrCreateKey(ctx, subjectID)
+	require.NoError(t, err)
+	assert.NotEqual(t, key.ID, newKey.ID)
+}
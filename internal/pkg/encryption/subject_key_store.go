@@ -0,0 +1,120 @@
+// Package encryption provides the data subject keys of the crypto-shredding.
+package encryption
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+
+	"emperror.dev/errors"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// SubjectKeyIDPrefix is the prefix of the ids of the data subject keys.
+const SubjectKeyIDPrefix = "subject."
+
+// SubjectKeyStore stores a key per data subject, destroying the keys of a subject shreds all of its encrypted
+// values.
+type SubjectKeyStore interface {
+	// GetOrCreateKey returns the key of a data subject and creates it for a new or a forgotten subject.
+	GetOrCreateKey(ctx context.Context, subjectID string) (*Key, error)
+	// Key returns a subject key by its id, it fails with ErrKeyShredded for a destroyed key.
+	Key(ctx context.Context, id string) (*Key, error)
+	// DestroyKeys destroys the keys of a data subject, the destroyed keys are kept as tombstones.
+	DestroyKeys(ctx context.Context, subjectID string) error
+}
+
+// SubjectID returns the id of a data subject, the subject value like an email is hashed to keep it out of the key
+// store.
+func SubjectID(subject string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(subject))))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// IsSubjectKeyID returns true if a key id is the id of a data subject key.
+func IsSubjectKeyID(id string) bool {
+	return strings.HasPrefix(id, SubjectKeyIDPrefix)
+}
+
+// NewSubjectKey generates a new random data subject key.
+func NewSubjectKey() (*Key, error) {
+	material := make([]byte, KeySize)
+	if _, err := rand.Read(material); err != nil {
+		return nil, errors.WrapIf(err, "error in generating the subject key")
+	}
+
+	return &Key{ID: SubjectKeyIDPrefix + uuid.NewV4().String(), Material: material}, nil
+}
+
+// InMemorySubjectKeyStore is a subject key store in memory for the tests.
+type InMemorySubjectKeyStore struct {
+	mu       sync.Mutex
+	keys     map[string]*Key
+	subjects map[string]string
+	shredded map[string]bool
+}
+
+// NewInMemorySubjectKeyStore creates a new in memory subject key store.
+func NewInMemorySubjectKeyStore() *InMemorySubjectKeyStore {
+	return &InMemorySubjectKeyStore{
+		keys:     make(map[string]*Key),
+		subjects: make(map[string]string),
+		shredded: make(map[string]bool),
+	}
+}
+
+// GetOrCreateKey returns the key of a data subject and creates it for a new or a forgotten subject.
+func (s *InMemorySubjectKeyStore) GetOrCreateKey(_ context.Context, subjectID string) (*Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if keyID, ok := s.subjects[subjectID]; ok {
+		return s.keys[keyID], nil
+	}
+
+	key, err := NewSubjectKey()
+	if err != nil {
+		return nil, err
+	}
+
+	s.keys[key.ID] = key
+	s.subjects[subjectID] = key.ID
+
+	return key, nil
+}
+
+// Key returns a subject key by its id.
+func (s *InMemorySubjectKeyStore) Key(_ context.Context, id string) (*Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.shredded[id] {
+		return nil, errors.WithMessagef(ErrKeyShredded, "the key `%s` is destroyed", id)
+	}
+
+	key, ok := s.keys[id]
+	if !ok {
+		return nil, errors.WithMessagef(ErrKeyNotFound, "the key `%s`", id)
+	}
+
+	return key, nil
+}
+
+// DestroyKeys destroys the keys of a data subject.
+func (s *InMemorySubjectKeyStore) DestroyKeys(_ context.Context, subjectID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if keyID, ok := s.subjects[subjectID]; ok {
+		delete(s.keys, keyID)
+		delete(s.subjects, subjectID)
+		s.shredded[keyID] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+//go:build unit
+// +build unit
+
+// Package encryption provides the data subject key tests.
+package encryption
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type customerOrder struct {
+	AccountEmail    string            `json:"accountEmail"    pii:"subject"`
+	DeliveryAddress string            `json:"deliveryAddress" pii:"true"`
+	Items           []*customerItem   `json:"items"`
+	Courier         *courierReference `json:"courier"`
+}
+
+type customerItem struct {
+	Note string `json:"note" pii:"true"`
+}
+
+// courierReference has its own data subject.
+type courierReference struct {
+	Email string `json:"email" pii:"subject"`
+	Phone string `json:"phone" pii:"true"`
+}
+
+func Test_EncryptSubjectFields_Encrypts_With_The_Subject_Keys(t *testing.T) {
+	store := NewInMemorySubjectKeyStore()
+	encryptor := NewFieldEncryptor(newKeyProvider(t, "key-1"), store)
+	ctx := context.Background()
+
+	encrypted, err := encryptor.EncryptSubjectFields(ctx, &customerOrder{
+		AccountEmail:    "john@example.com",
+		DeliveryAddress: "Street 1",
+		Items:           []*customerItem{{Note: "ring twice"}},
+		Courier:         &courierReference{Email: "courier@example.com", Phone: "123"},
+	})
+	require.NoError(t, err)
+
+	order := encrypted.(*customerOrder)
+	customerKey, err := store.GetOrCreateKey(ctx, SubjectID(" John@Example.com "))
+	require.NoError(t, err)
+	courierKey, err := store.GetOrCreateKey(ctx, SubjectID("courier@example.com"))
+	require.NoError(t, err)
+
+	assert.Equal(t, customerKey.ID, keyIDOf(t, order.AccountEmail))
+	assert.Equal(t, customerKey.ID, keyIDOf(t, order.DeliveryAddress))
+	assert.Equal(t, customerKey.ID, keyIDOf(t, order.Items[0].Note))
+	assert.Equal(t, courierKey.ID, keyIDOf(t, order.Courier.Phone))
+
+	require.NoError(t, encryptor.DecryptFields(order))
+	assert.Equal(t, "john@example.com", order.AccountEmail)
+	assert.Equal(t, "ring twice", order.Items[0].Note)
+	assert.Equal(t, "123", order.Courier.Phone)
+}
+
+func Test_DecryptFields_Redacts_The_Values_Of_A_Forgotten_Subject(t *testing.T) {
+	store := NewInMemorySubjectKeyStore()
+	encryptor := NewFieldEncryptor(newKeyProvider(t, "key-1"), store)
+	ctx := context.Background()
+
+	encrypted, err := encryptor.EncryptSubjectFields(ctx, &customerOrder{
+		AccountEmail:    "john@example.com",
+		DeliveryAddress: "Street 1",
+		Courier:         &courierReference{Email: "courier@example.com", Phone: "123"},
+	})
+	require.NoError(t, err)
+
+	order := encrypted.(*customerOrder)
+	shreddedKeyID := keyIDOf(t, order.AccountEmail)
+	require.NoError(t, store.DestroyKeys(ctx, SubjectID("john@example.com")))
+
+	require.NoError(t, encryptor.DecryptFields(order))
+	assert.Equal(t, RedactedValue, order.AccountEmail)
+	assert.Equal(t, RedactedValue, order.DeliveryAddress)
+	assert.Equal(t, "123", order.Courier.Phone)
+
+	newKey, err := store.GetOrCreateKey(ctx, SubjectID("john@example.com"))
+	require.NoError(t, err)
+	assert.NotEqual(t, shreddedKeyID, newKey.ID)
+}
+
+func Test_EncryptSubjectFields_Uses_The_Current_Key_Without_A_Subject_Key_Store(t *testing.T) {
+	encryptor := NewFieldEncryptor(newKeyProvider(t, "key-1"), nil)
+
+	encrypted, err := encryptor.EncryptSubjectFields(
+		context.Background(),
+		customerOrder{AccountEmail: "john@example.com"},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "key-1", keyIDOf(t, encrypted.(customerOrder).AccountEmail))
+}
+
+func keyIDOf(t *testing.T, value string) string {
+	t.Helper()
+
+	require.True(t, strings.HasPrefix(value, ciphertextPrefix))
+	keyID, err := ciphertextKeyID(value)
+	require.NoError(t, err)
+
+	return keyID
+}
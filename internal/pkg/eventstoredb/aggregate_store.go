@@ -16,6 +16,7 @@ import (
 
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/domain"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/metadata"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/encryption"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/es/contracts/store"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/es/models"
 	appendResult "github.com/raphaeldiscky/go-food-micro/internal/pkg/es/models/appendresult"
@@ -35,20 +36,24 @@ type esdbAggregateStore[T models.IHaveEventSourcedAggregate] struct {
 	eventStore store.EventStore
 	serializer *EsdbSerializer
 	tracer     trace.Tracer
+	encryptor  *encryption.FieldEncryptor
 }
 
-// NewEventStoreAggregateStore creates a new event store aggregate store.
+// NewEventStoreAggregateStore creates a new event store aggregate store, the field encryptor is optional and it
+// encrypts the pii fields of the events with the keys of their data subjects.
 func NewEventStoreAggregateStore[T models.IHaveEventSourcedAggregate](
 	log logger.Logger,
 	eventStore store.EventStore,
 	serializer *EsdbSerializer,
 	tracer trace.Tracer,
+	encryptor *encryption.FieldEncryptor,
 ) store.AggregateStore[T] {
 	return &esdbAggregateStore[T]{
 		log:        log,
 		eventStore: eventStore,
 		serializer: serializer,
 		tracer:     tracer,
+		encryptor:  encryptor,
 	}
 }
 
@@ -80,24 +85,22 @@ func (a *esdbAggregateStore[T]) StoreWithVersion(
 	streamId := streamName.For[T](aggregate)
 	span.SetAttributes(attribute2.String("StreamId", streamId.String()))
 
-	var streamEvents []*models.StreamEvent
+	streamEvents := make([]*models.StreamEvent, 0, len(aggregate.UncommittedEvents()))
+	for i, domainEvent := range aggregate.UncommittedEvents() {
+		event, err := a.encryptEvent(ctx, domainEvent)
+		if err != nil {
+			return nil, utils.TraceErrStatusFromSpan(span, err)
+		}
 
-	linq.From(aggregate.UncommittedEvents()).
-		SelectIndexedT(func(i int, domainEvent domain.IDomainEvent) *models.StreamEvent {
-			var inInterface map[string]interface{}
-			err := a.serializer.eventSerializer.Serializer().
-				DecodeWithMapStructure(domainEvent, &inInterface)
-			if err != nil {
-				return nil
-			}
-
-			return a.serializer.DomainEventToStreamEvent(
-				domainEvent,
+		streamEvents = append(
+			streamEvents,
+			a.serializer.DomainEventToStreamEvent(
+				event,
 				metadata,
 				int64(i)+aggregate.OriginalVersion(),
-			)
-		}).
-		ToSlice(&streamEvents)
+			),
+		)
+	}
 
 	streamAppendResult, err := a.eventStore.AppendEvents(
 		streamId,
@@ -316,3 +319,24 @@ func (a *esdbAggregateStore[T]) getStreamEvents(
 
 	return streamEvents, nil
 }
+
+// encryptEvent returns a copy of a domain event with its pii fields encrypted with the keys of their data subjects.
+func (a *esdbAggregateStore[T]) encryptEvent(
+	ctx context.Context,
+	domainEvent domain.IDomainEvent,
+) (domain.IDomainEvent, error) {
+	if a.encryptor == nil {
+		return domainEvent, nil
+	}
+
+	encrypted, err := a.encryptor.EncryptSubjectFields(ctx, domainEvent)
+	if err != nil {
+		return nil, errors.WrapIff(
+			err,
+			"[esdbAggregateStore_encryptEvent] error in encrypting the event `%s`",
+			domainEvent.GetEventTypeName(),
+		)
+	}
+
+	return encrypted.(domain.IDomainEvent), nil
+}
@@ -0,0 +1,60 @@
+// Package errors provides a wrong expected version error.
+package errors
+
+import (
+	"fmt"
+
+	"emperror.dev/errors"
+
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+)
+
+// wrongExpectedVersionError is a struct that represents a wrong expected version error.
+type wrongExpectedVersionError struct {
+	customErrors.ConflictError
+}
+
+// WrongExpectedVersionError is a interface that represents a wrong expected version error, the stream
+// was changed by another writer after the expected version.
+type WrongExpectedVersionError interface {
+	customErrors.ConflictError
+	IsWrongExpectedVersionError() bool
+}
+
+// NewWrongExpectedVersionError creates a new wrong expected version error.
+func NewWrongExpectedVersionError(err error, streamID string) error {
+	conflict := customErrors.NewConflictErrorWrap(
+		err,
+		fmt.Sprintf("stream %s was changed concurrently, the expected version doesn't match", streamID),
+	)
+	customErr := customErrors.GetCustomError(conflict)
+
+	conflictErr, ok := customErr.(customErrors.ConflictError)
+	if !ok {
+		return errors.Wrap(
+			err,
+			fmt.Sprintf("failed to convert error to ConflictError: %v", customErr),
+		)
+	}
+
+	br := &wrongExpectedVersionError{
+		ConflictError: conflictErr,
+	}
+
+	return errors.WithStackIf(br)
+}
+
+// IsWrongExpectedVersionError checks if the error is a wrong expected version error.
+func (err *wrongExpectedVersionError) IsWrongExpectedVersionError() bool {
+	return true
+}
+
+// IsWrongExpectedVersionError checks if the error is a wrong expected version error.
+func IsWrongExpectedVersionError(err error) bool {
+	var we WrongExpectedVersionError
+	if errors.As(err, &we) {
+		return we.IsWrongExpectedVersionError()
+	}
+
+	return false
+}
@@ -13,31 +13,36 @@ import (
 	uuid "github.com/satori/go.uuid"
 
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/domain"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/transform"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/metadata"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/versioning"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/es/models"
 	appendResult "github.com/raphaeldiscky/go-food-micro/internal/pkg/es/models/appendresult"
 	readPosition "github.com/raphaeldiscky/go-food-micro/internal/pkg/es/models/streamposition/readposition"
 	truncatePosition "github.com/raphaeldiscky/go-food-micro/internal/pkg/es/models/streamposition/truncateposition"
 	expectedStreamVersion "github.com/raphaeldiscky/go-food-micro/internal/pkg/es/models/streamversion"
 	esErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/eventstoredb/errors"
-	typeMapper "github.com/raphaeldiscky/go-food-micro/internal/pkg/reflection/typemapper"
 )
 
 // EsdbSerializer is a struct that represents a event store db serializer.
 type EsdbSerializer struct {
 	metadataSerializer serializer.MetadataSerializer
 	eventSerializer    serializer.EventSerializer
+	transforms         *transform.Pipeline
 }
 
-// NewEsdbSerializer creates a new event store db serializer.
+// NewEsdbSerializer creates a new event store db serializer, the message transforms of the pipeline, like the
+// encryption of the pii fields, are run on the stored and the read events.
 func NewEsdbSerializer(
 	metadataSerializer serializer.MetadataSerializer,
 	eventSerializer serializer.EventSerializer,
+	transforms *transform.Pipeline,
 ) *EsdbSerializer {
 	return &EsdbSerializer{
 		metadataSerializer: metadataSerializer,
 		eventSerializer:    eventSerializer,
+		transforms:         transforms,
 	}
 }
 
@@ -45,25 +50,18 @@ func NewEsdbSerializer(
 func (e *EsdbSerializer) StreamEventToEventData(
 	streamEvent *models.StreamEvent,
 ) (kdb.EventData, error) {
-	eventSerializationResult, err := e.eventSerializer.Serialize(streamEvent.Event)
+	eventSerializationResult, err := e.serializeEvent(streamEvent.Event)
 	if err != nil {
 		return *new(kdb.EventData), err
 	}
 
-	metadataSerializationResult, err := e.metadataSerializer.Serialize(streamEvent.Metadata)
+	metadataSerializationResult, err := e.metadataSerializer.Serialize(
+		withSchemaVersion(streamEvent.Metadata, streamEvent.Event),
+	)
 	if err != nil {
 		return *new(kdb.EventData), err
 	}
 
-	var contentType kdb.ContentType
-
-	switch eventSerializationResult.ContentType {
-	case "application/json":
-		contentType = kdb.ContentTypeJson
-	default:
-		contentType = kdb.ContentTypeBinary
-	}
-
 	id, err := uuid.FromString(streamEvent.EventID.String())
 	if err != nil {
 		return *new(kdb.EventData), err
@@ -76,10 +74,10 @@ func (e *EsdbSerializer) StreamEventToEventData(
 
 	return kdb.EventData{
 		EventID:     googleID,
-		EventType:   typeMapper.GetTypeName(streamEvent.Event),
+		EventType:   versioning.TypeName(streamEvent.Event),
 		Data:        eventSerializationResult.Data,
 		Metadata:    metadataSerializationResult,
-		ContentType: contentType,
+		ContentType: esdbContentType(eventSerializationResult.ContentType),
 	}, nil
 }
 
@@ -160,16 +158,25 @@ func (e *EsdbSerializer) EsdbPositionToStreamReadPosition(
 func (e *EsdbSerializer) ResolvedEventToStreamEvent(
 	resolveEvent *kdb.ResolvedEvent,
 ) (*models.StreamEvent, error) {
-	deserializedEvent, err := e.eventSerializer.Deserialize(
-		resolveEvent.Event.Data,
+	deserializedMeta, err := e.metadataSerializer.Deserialize(resolveEvent.Event.UserMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	eventType, data, err := versioning.Upcast[domain.IDomainEvent](
 		resolveEvent.Event.EventType,
-		resolveEvent.Event.ContentType,
+		versioning.GetSchemaVersion(deserializedMeta),
+		resolveEvent.Event.Data,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	deserializedMeta, err := e.metadataSerializer.Deserialize(resolveEvent.Event.UserMetadata)
+	deserializedEvent, err := e.deserializeEvent(
+		data,
+		eventType,
+		resolveEvent.Event.ContentType,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -221,12 +228,12 @@ func (e *EsdbSerializer) Serialize(
 	data domain.IDomainEvent,
 	meta metadata.Metadata,
 ) (*kdb.EventData, error) {
-	serializedData, err := e.eventSerializer.Serialize(data)
+	serializedData, err := e.serializeEvent(data)
 	if err != nil {
 		return nil, err
 	}
 
-	serializedMeta, err := e.metadataSerializer.Serialize(meta)
+	serializedMeta, err := e.metadataSerializer.Serialize(withSchemaVersion(meta, data))
 	if err != nil {
 		return nil, err
 	}
@@ -240,9 +247,9 @@ func (e *EsdbSerializer) Serialize(
 
 	return &kdb.EventData{
 		EventID:     googleID,
-		EventType:   typeMapper.GetTypeName(data),
+		EventType:   versioning.TypeName(data),
 		Data:        serializedData.Data,
-		ContentType: kdb.ContentTypeJson,
+		ContentType: esdbContentType(serializedData.ContentType),
 		Metadata:    serializedMeta,
 	}, nil
 }
@@ -252,12 +259,17 @@ func (e *EsdbSerializer) SerializeObject(
 	data interface{},
 	meta metadata.Metadata,
 ) (*kdb.EventData, error) {
-	serializedData, err := e.eventSerializer.SerializeObject(data)
+	encoded, err := e.transforms.EncodeMessage(data)
 	if err != nil {
 		return nil, err
 	}
 
-	serializedMeta, err := e.metadataSerializer.Serialize(meta)
+	serializedData, err := e.eventSerializer.SerializeObject(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	serializedMeta, err := e.metadataSerializer.Serialize(withSchemaVersion(meta, data))
 	if err != nil {
 		return nil, err
 	}
@@ -271,9 +283,9 @@ func (e *EsdbSerializer) SerializeObject(
 
 	return &kdb.EventData{
 		EventID:     googleID,
-		EventType:   typeMapper.GetTypeName(data),
+		EventType:   versioning.TypeName(data),
 		Data:        serializedData.Data,
-		ContentType: kdb.ContentTypeJson,
+		ContentType: esdbContentType(serializedData.ContentType),
 		Metadata:    serializedMeta,
 	}, nil
 }
@@ -282,20 +294,25 @@ func (e *EsdbSerializer) SerializeObject(
 func (e *EsdbSerializer) Deserialize(
 	resolveEvent *kdb.ResolvedEvent,
 ) (domain.IDomainEvent, metadata.Metadata, error) {
-	eventType := resolveEvent.Event.EventType
-	data := resolveEvent.Event.Data
-	userMeta := resolveEvent.Event.UserMetadata
+	meta, err := e.metadataSerializer.Deserialize(resolveEvent.Event.UserMetadata)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	payload, err := e.eventSerializer.Deserialize(
-		data,
-		eventType,
-		resolveEvent.Event.ContentType,
+	eventType, data, err := versioning.Upcast[domain.IDomainEvent](
+		resolveEvent.Event.EventType,
+		versioning.GetSchemaVersion(meta),
+		resolveEvent.Event.Data,
 	)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	meta, err := e.metadataSerializer.Deserialize(userMeta)
+	payload, err := e.deserializeEvent(
+		data,
+		eventType,
+		resolveEvent.Event.ContentType,
+	)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -307,20 +324,25 @@ func (e *EsdbSerializer) Deserialize(
 func (e *EsdbSerializer) DeserializeObject(
 	resolveEvent *kdb.ResolvedEvent,
 ) (interface{}, metadata.Metadata, error) {
-	eventType := resolveEvent.Event.EventType
-	data := resolveEvent.Event.Data
-	userMeta := resolveEvent.Event.UserMetadata
+	meta, err := e.metadataSerializer.Deserialize(resolveEvent.Event.UserMetadata)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	payload, err := e.eventSerializer.Deserialize(
-		data,
-		eventType,
-		resolveEvent.Event.ContentType,
+	eventType, data, err := versioning.Upcast[any](
+		resolveEvent.Event.EventType,
+		versioning.GetSchemaVersion(meta),
+		resolveEvent.Event.Data,
 	)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	meta, err := e.metadataSerializer.Deserialize(userMeta)
+	payload, err := e.deserializeEvent(
+		data,
+		eventType,
+		resolveEvent.Event.ContentType,
+	)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -342,3 +364,57 @@ func (e *EsdbSerializer) DomainEventToStreamEvent(
 		Position: position,
 	}
 }
+
+// withSchemaVersion returns a copy of the metadata with the schema version header of the event.
+func withSchemaVersion(meta metadata.Metadata, event interface{}) metadata.Metadata {
+	versioned := make(metadata.Metadata, len(meta)+1)
+	for key, value := range meta {
+		versioned[key] = value
+	}
+	versioning.SetSchemaVersion(versioned, versioning.SchemaVersion(event))
+
+	return versioned
+}
+
+// esdbContentType returns the event store db content type of a serializer content type, the event store db keeps
+// only the json and the binary content types.
+func esdbContentType(contentType string) kdb.ContentType {
+	if contentType == "application/json" {
+		return kdb.ContentTypeJson
+	}
+
+	return kdb.ContentTypeBinary
+}
+
+// serializeEvent runs the message transforms on a copy of a domain event and serializes the copy.
+func (e *EsdbSerializer) serializeEvent(event domain.IDomainEvent) (*serializer.EventSerializationResult, error) {
+	encoded, err := e.transforms.EncodeMessage(event)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedEvent, ok := encoded.(domain.IDomainEvent)
+	if !ok {
+		return nil, errors.Errorf("transformed event of `%s` is not a domain event", versioning.TypeName(event))
+	}
+
+	return e.eventSerializer.Serialize(encodedEvent)
+}
+
+// deserializeEvent deserializes a domain event and reverts its message transforms.
+func (e *EsdbSerializer) deserializeEvent(
+	data []byte,
+	eventType string,
+	contentType string,
+) (domain.IDomainEvent, error) {
+	event, err := e.eventSerializer.Deserialize(data, eventType, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.transforms.DecodeMessage(event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
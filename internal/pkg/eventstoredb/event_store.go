@@ -119,7 +119,7 @@ func (e *eventStoreDbEventStore) AppendEvents(
 		return nil, utils.TraceErrStatusFromSpan(
 			span,
 			errors.WithMessage(
-				esErrors.NewAppendToStreamError(err, streamName.String()),
+				appendToStreamError(err, streamName.String()),
 				"error in appending to stream",
 			),
 		)
@@ -161,10 +161,7 @@ func (e *eventStoreDbEventStore) AppendNewEvents(
 	if err != nil {
 		return nil, utils.TraceErrStatusFromSpan(
 			span,
-			errors.WithMessage(
-				esErrors.NewAppendToStreamError(err, streamName.String()),
-				"error in appending to stream",
-			),
+			errors.WithMessage(err, "error in appending new events to stream"),
 		)
 	}
 
@@ -466,3 +463,14 @@ func (e *eventStoreDbEventStore) DeleteStream(
 
 	return nil
 }
+
+// appendToStreamError converts the append error of the client, a failed optimistic concurrency check
+// becomes a wrong expected version error and the others an append to stream error.
+func appendToStreamError(err error, streamID string) error {
+	var esdbErr *kdb.Error
+	if errors.As(err, &esdbErr) && esdbErr.IsErrorCode(kdb.ErrorCodeWrongExpectedVersion) {
+		return esErrors.NewWrongExpectedVersionError(err, streamID)
+	}
+
+	return esErrors.NewAppendToStreamError(err, streamID)
+}
@@ -30,7 +30,7 @@ var (
 	// - execute its func only if it requested.
 	eventstoreProviders = fx.Options(fx.Provide(
 		config.ProvideConfig,
-		NewEsdbSerializer,
+		fx.Annotate(NewEsdbSerializer, fx.ParamTags(``, ``, `optional:"true"`)),
 		NewEventStoreDB,
 		NewEventStoreDbEventStore,
 		NewEsdbSubscriptionCheckpointRepository,
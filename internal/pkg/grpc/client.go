@@ -2,7 +2,6 @@
 package grpc
 
 import (
-	"fmt"
 	"time"
 
 	"emperror.dev/errors"
@@ -10,10 +9,13 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
 
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/grpc/config"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/grpc/handlers/otel"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/grpc/interceptors"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger/defaultlogger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/resilience/circuitbreaker"
 )
 
 var Logger = defaultlogger.GetLogger()
@@ -31,18 +33,73 @@ type GrpcClient interface {
 	WaitForAvailableConnection() error
 }
 
-// NewGrpcClient is a function that creates a new grpc client.
+// NewGrpcClient is a function that creates a new grpc client, the calls get a default deadline, are retried
+// by the retry policy of the service config, are rejected while the circuit breaker is open and return
+// custom errors instead of grpc status errors.
 func NewGrpcClient(config *config.GrpcOptions) (GrpcClient, error) {
-	// Grpc Client to call Grpc Server
-	// https://sahansera.dev/building-grpc-client-go/
-	// https://github.com/open-telemetry/opentelemetry-go-contrib/blob/df16f32df86b40077c9c90d06f33c4cdb6dd5afa/instrumentation/google.golang.org/grpc/otelgrpc/example_interceptor_test.go
-	conn, err := grpc.NewClient(fmt.Sprintf("%s%s", config.Host, config.Port),
+	clientOptions := config.Client
+
+	target, resolverOption, err := clientTarget(config)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceConfig, err := serviceConfigJSON(&clientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	unaryInterceptors := []grpc.UnaryClientInterceptor{
+		interceptors.UnaryClientTimeoutInterceptor(clientOptions.Timeout),
+		interceptors.UnaryClientErrorInterceptor(),
+	}
+	streamInterceptors := []grpc.StreamClientInterceptor{
+		interceptors.StreamClientErrorInterceptor(),
+	}
+
+	if clientOptions.CircuitBreaker.Enabled {
+		cb := circuitbreaker.NewCircuitBreaker(
+			target,
+			&clientOptions.CircuitBreaker,
+			circuitbreaker.WithIsFailure(interceptors.IsServerFailure),
+			circuitbreaker.WithOnStateChange(func(name string, from circuitbreaker.State, to circuitbreaker.State) {
+				Logger.Warnf("grpc circuit breaker %s changed from %s to %s", name, from, to)
+			}),
+		)
+
+		// the breaker is inside the error interceptor, so it sees the raw grpc status codes
+		unaryInterceptors = append(unaryInterceptors, interceptors.UnaryClientCircuitBreakerInterceptor(cb))
+		streamInterceptors = append(streamInterceptors, interceptors.StreamClientCircuitBreakerInterceptor(cb))
+	}
+
+	dialOptions := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		// https://github.com/open-telemetry/opentelemetry-go-contrib/blob/main/instrumentation/google.golang.org/grpc/otelgrpc/example/client/main.go#L47C3-L47C52
 		// https://github.com/open-telemetry/opentelemetry-go-contrib/blob/main/instrumentation/google.golang.org/grpc/otelgrpc/doc.go
 		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
 		grpc.WithStatsHandler(otel.NewClientHandler()),
-	)
+		// https://github.com/grpc/grpc/blob/master/doc/service_config.md
+		grpc.WithDefaultServiceConfig(serviceConfig),
+		grpc.WithChainUnaryInterceptor(unaryInterceptors...),
+		grpc.WithChainStreamInterceptor(streamInterceptors...),
+	}
+
+	if clientOptions.KeepaliveTime > 0 {
+		dialOptions = append(dialOptions, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                clientOptions.KeepaliveTime,
+			Timeout:             clientOptions.KeepaliveTimeout,
+			PermitWithoutStream: true,
+		}))
+	}
+
+	if resolverOption != nil {
+		dialOptions = append(dialOptions, resolverOption)
+	}
+
+	// Grpc Client to call Grpc Server
+	// https://sahansera.dev/building-grpc-client-go/
+	// https://github.com/open-telemetry/opentelemetry-go-contrib/blob/df16f32df86b40077c9c90d06f33c4cdb6dd5afa/instrumentation/google.golang.org/grpc/otelgrpc/example_interceptor_test.go
+	conn, err := grpc.NewClient(target, dialOptions...)
 	if err != nil {
 		return nil, err
 	}
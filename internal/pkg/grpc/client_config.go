@@ -0,0 +1,146 @@
+// Package grpc provides the grpc client service config and target.
+package grpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/grpc/config"
+)
+
+// defaultRetryableStatusCodes are retried when the retry policy doesn't set its own codes.
+var defaultRetryableStatusCodes = []string{"UNAVAILABLE"}
+
+// serviceConfig is the grpc service config, https://github.com/grpc/grpc/blob/master/doc/service_config.md.
+type serviceConfig struct {
+	LoadBalancingConfig []map[string]struct{} `json:"loadBalancingConfig,omitempty"`
+	MethodConfig        []methodConfig        `json:"methodConfig,omitempty"`
+}
+
+// methodConfig is the config of the methods matched by the names.
+type methodConfig struct {
+	Name        []methodName `json:"name"`
+	RetryPolicy *retryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// methodName matches all the services when empty, all the methods of a service when the method is empty.
+type methodName struct {
+	Service string `json:"service,omitempty"`
+	Method  string `json:"method,omitempty"`
+}
+
+// retryPolicy is the retry policy of the grpc service config.
+type retryPolicy struct {
+	MaxAttempts          int      `json:"maxAttempts"`
+	InitialBackoff       string   `json:"initialBackoff"`
+	MaxBackoff           string   `json:"maxBackoff"`
+	BackoffMultiplier    float64  `json:"backoffMultiplier"`
+	RetryableStatusCodes []string `json:"retryableStatusCodes"`
+}
+
+// clientTarget returns the target of the client and the dial option of its resolver.
+func clientTarget(options *config.GrpcOptions) (string, grpc.DialOption, error) {
+	switch options.Client.Resolver {
+	case config.ResolverStatic:
+		if len(options.Client.Targets) == 0 {
+			return "", nil, errors.New("the static grpc resolver needs at least one target")
+		}
+
+		addresses := make([]resolver.Address, 0, len(options.Client.Targets))
+		for _, target := range options.Client.Targets {
+			addresses = append(addresses, resolver.Address{Addr: target})
+		}
+
+		r := manual.NewBuilderWithScheme(config.ResolverStatic)
+		r.InitialState(resolver.State{Addresses: addresses})
+
+		return fmt.Sprintf("%s:///%s", config.ResolverStatic, options.Name), grpc.WithResolvers(r), nil
+	case config.ResolverDNS, "":
+		return fmt.Sprintf("%s:///%s%s", config.ResolverDNS, options.Host, options.Port), nil, nil
+	default:
+		return "", nil, errors.Errorf("unknown grpc resolver %s", options.Client.Resolver)
+	}
+}
+
+// serviceConfigJSON builds the service config of the load balancing and the retry policies.
+func serviceConfigJSON(options *config.GrpcClientOptions) (string, error) {
+	cfg := serviceConfig{}
+
+	if options.LoadBalancingPolicy != "" {
+		cfg.LoadBalancingConfig = []map[string]struct{}{{options.LoadBalancingPolicy: {}}}
+	}
+
+	if policy := newRetryPolicy(options.Retry); policy != nil {
+		cfg.MethodConfig = append(cfg.MethodConfig, methodConfig{
+			Name:        []methodName{{}},
+			RetryPolicy: policy,
+		})
+	}
+
+	for _, methodRetry := range options.MethodRetries {
+		if methodRetry.Service == "" {
+			return "", errors.New("the service of a grpc method retry policy is required")
+		}
+
+		cfg.MethodConfig = append(cfg.MethodConfig, methodConfig{
+			Name: []methodName{{
+				Service: methodRetry.Service,
+				Method:  methodRetry.Method,
+			}},
+			RetryPolicy: newRetryPolicy(methodRetry.Retry),
+		})
+	}
+
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		return "", errors.WrapIf(err, "failed to marshal the grpc service config")
+	}
+
+	return string(b), nil
+}
+
+// newRetryPolicy creates the retry policy, a policy with less than two attempts disables the retries.
+func newRetryPolicy(options config.GrpcRetryOptions) *retryPolicy {
+	if options.MaxAttempts < 2 {
+		return nil
+	}
+
+	codes := options.RetryableStatusCodes
+	if len(codes) == 0 {
+		codes = defaultRetryableStatusCodes
+	}
+
+	upperCodes := make([]string, 0, len(codes))
+	for _, code := range codes {
+		upperCodes = append(upperCodes, strings.ToUpper(code))
+	}
+
+	multiplier := options.BackoffMultiplier
+	if multiplier <= 0 {
+		multiplier = 1
+	}
+
+	return &retryPolicy{
+		MaxAttempts:          options.MaxAttempts,
+		InitialBackoff:       durationSeconds(options.InitialBackoff, 100*time.Millisecond),
+		MaxBackoff:           durationSeconds(options.MaxBackoff, time.Second),
+		BackoffMultiplier:    multiplier,
+		RetryableStatusCodes: upperCodes,
+	}
+}
+
+// durationSeconds formats a duration the way the service config expects it, e.g. `0.1s`.
+func durationSeconds(d time.Duration, fallback time.Duration) string {
+	if d <= 0 {
+		d = fallback
+	}
+
+	return fmt.Sprintf("%gs", d.Seconds())
+}
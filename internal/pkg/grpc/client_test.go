@@ -0,0 +1,131 @@
+//go:build unit
+// +build unit
+
+// Package grpc provides the grpc client tests.
+package grpc
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+	googleGrpc "google.golang.org/grpc"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/grpc/config"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/grpc/grpcerrors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/resilience/circuitbreaker"
+)
+
+// fakeHealthServer fails the first `failures` calls with `code`.
+type fakeHealthServer struct {
+	grpc_health_v1.UnimplementedHealthServer
+	calls    atomic.Int32
+	failures int32
+	code     codes.Code
+}
+
+func (f *fakeHealthServer) Check(
+	context.Context,
+	*grpc_health_v1.HealthCheckRequest,
+) (*grpc_health_v1.HealthCheckResponse, error) {
+	if f.calls.Add(1) <= f.failures {
+		return nil, grpcerrors.NewGrpcError(f.code, f.code.String(), "fake failure", "").ToGrpcResponseErr()
+	}
+
+	return &grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_SERVING}, nil
+}
+
+func startFakeServer(t *testing.T, server *fakeHealthServer) string {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := googleGrpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(s, server)
+
+	go func() { _ = s.Serve(l) }()
+	t.Cleanup(s.Stop)
+
+	return l.Addr().String()
+}
+
+func newTestClient(t *testing.T, address string, clientOptions config.GrpcClientOptions) grpc_health_v1.HealthClient {
+	t.Helper()
+
+	clientOptions.Resolver = config.ResolverStatic
+	clientOptions.Targets = []string{address}
+
+	client, err := NewGrpcClient(&config.GrpcOptions{Name: "test", Client: clientOptions})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+
+	return grpc_health_v1.NewHealthClient(client.GetGrpcConnection())
+}
+
+// TestClientRetriesUnavailable tests the client retries the unavailable errors by the retry policy.
+func TestClientRetriesUnavailable(t *testing.T) {
+	server := &fakeHealthServer{failures: 2, code: codes.Unavailable}
+	client := newTestClient(t, startFakeServer(t, server), config.GrpcClientOptions{
+		Timeout: 5 * time.Second,
+		Retry: config.GrpcRetryOptions{
+			MaxAttempts:       3,
+			InitialBackoff:    10 * time.Millisecond,
+			MaxBackoff:        50 * time.Millisecond,
+			BackoffMultiplier: 2,
+		},
+	})
+
+	res, err := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+
+	require.NoError(t, err)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, res.GetStatus())
+	assert.Equal(t, int32(3), server.calls.Load())
+}
+
+// TestClientConvertsErrorsToCustomErrors tests the grpc errors are returned as custom errors.
+func TestClientConvertsErrorsToCustomErrors(t *testing.T) {
+	server := &fakeHealthServer{failures: 1, code: codes.NotFound}
+	client := newTestClient(t, startFakeServer(t, server), config.GrpcClientOptions{
+		Retry: config.GrpcRetryOptions{MaxAttempts: 3},
+	})
+
+	_, err := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+
+	require.Error(t, err)
+	assert.True(t, customErrors.IsNotFoundError(err))
+	assert.Equal(t, "fake failure", customErrors.GetCustomError(err).Message())
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, int32(1), server.calls.Load())
+}
+
+// TestClientCircuitBreakerOpens tests the circuit opens after the server failures and the calls are rejected.
+func TestClientCircuitBreakerOpens(t *testing.T) {
+	server := &fakeHealthServer{failures: 100, code: codes.Internal}
+	client := newTestClient(t, startFakeServer(t, server), config.GrpcClientOptions{
+		CircuitBreaker: circuitbreaker.Options{
+			Enabled:          true,
+			FailureThreshold: 2,
+			OpenTimeout:      time.Minute,
+		},
+	})
+
+	for range 3 {
+		_, err := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+		require.Error(t, err)
+	}
+
+	_, err := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, int32(2), server.calls.Load())
+}
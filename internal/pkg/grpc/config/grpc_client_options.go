@@ -0,0 +1,49 @@
+// Package config provides a grpc client options.
+package config
+
+import (
+	"time"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/resilience/circuitbreaker"
+)
+
+const (
+	// ResolverDNS resolves the host of the options through dns and balances between the resolved addresses.
+	ResolverDNS = "dns"
+	// ResolverStatic balances between the static list of targets of the client options.
+	ResolverStatic = "static"
+)
+
+// GrpcClientOptions is a struct that represents the options of the grpc client.
+type GrpcClientOptions struct {
+	// Resolver is `dns` or `static`.
+	Resolver string `mapstructure:"resolver" default:"dns"`
+	// Targets is the static list of `host:port` addresses of the static resolver.
+	Targets             []string      `mapstructure:"targets"`
+	LoadBalancingPolicy string        `mapstructure:"loadBalancingPolicy" default:"round_robin"`
+	Timeout             time.Duration `mapstructure:"timeout"             default:"10s"`
+	KeepaliveTime       time.Duration `mapstructure:"keepaliveTime"       default:"30s"`
+	KeepaliveTimeout    time.Duration `mapstructure:"keepaliveTimeout"    default:"10s"`
+	// Retry is the default retry policy of all the methods.
+	Retry GrpcRetryOptions `mapstructure:"retry"`
+	// MethodRetries overrides the retry policy of a service or of a single method.
+	MethodRetries  []GrpcMethodRetryOptions `mapstructure:"methodRetries"`
+	CircuitBreaker circuitbreaker.Options   `mapstructure:"circuitBreaker"`
+}
+
+// GrpcRetryOptions is a struct that represents a retry policy of the grpc service config.
+type GrpcRetryOptions struct {
+	MaxAttempts          int           `mapstructure:"maxAttempts"          default:"3"`
+	InitialBackoff       time.Duration `mapstructure:"initialBackoff"       default:"100ms"`
+	MaxBackoff           time.Duration `mapstructure:"maxBackoff"           default:"1s"`
+	BackoffMultiplier    float64       `mapstructure:"backoffMultiplier"    default:"2"`
+	RetryableStatusCodes []string      `mapstructure:"retryableStatusCodes"`
+}
+
+// GrpcMethodRetryOptions is a struct that represents the retry policy of a service or a method,
+// an empty method applies the policy to all the methods of the service.
+type GrpcMethodRetryOptions struct {
+	Service string           `mapstructure:"service"`
+	Method  string           `mapstructure:"method"`
+	Retry   GrpcRetryOptions `mapstructure:"retry"`
+}
@@ -2,6 +2,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/iancoleman/strcase"
 
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/config"
@@ -17,6 +19,27 @@ type GrpcOptions struct {
 	Host        string `mapstructure:"host"        env:"Host"`
 	Development bool   `mapstructure:"development" env:"Development"`
 	Name        string `mapstructure:"name"        env:"ShortTypeName"`
+	// Reflection registers the server reflection, it is always registered in development.
+	Reflection bool `mapstructure:"reflection" env:"Reflection"`
+	// HealthCheckInterval is the interval of syncing the health checks into the grpc health service.
+	HealthCheckInterval time.Duration `mapstructure:"healthCheckInterval" default:"5s"`
+	// ServiceHealthChecks are the health checks a grpc service depends on, a service without them follows the
+	// critical health checks.
+	ServiceHealthChecks []GrpcServiceHealthOptions `mapstructure:"serviceHealthChecks"`
+	// ShutdownDrainDelay is how long the health service reports NOT_SERVING on shutdown before the server stops
+	// accepting rpcs, so the load balancers see the status and stop routing new rpcs first.
+	ShutdownDrainDelay time.Duration `mapstructure:"shutdownDrainDelay" default:"0s"`
+	// ShutdownTimeout is the maximum duration of draining the in-flight rpcs on shutdown.
+	ShutdownTimeout time.Duration `mapstructure:"shutdownTimeout" default:"15s"`
+	// Client contains the resilience options of the grpc client.
+	Client GrpcClientOptions `mapstructure:"client"`
+}
+
+// GrpcServiceHealthOptions is a struct that represents the health checks of a grpc service, e.g. the service
+// `orders_service.OrdersService` is SERVING while its `postgres` and `eventstoredb` checks are up.
+type GrpcServiceHealthOptions struct {
+	Service string   `mapstructure:"service"`
+	Checks  []string `mapstructure:"checks"`
 }
 
 // ProvideConfig is a function that provides a grpc options.
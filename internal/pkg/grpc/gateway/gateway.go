@@ -0,0 +1,244 @@
+// Package gateway exposes grpc services as rest endpoints through grpc-gateway.
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/grpc/grpcerrors"
+	echocontracts "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/customecho/contracts"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/http/customecho/etag"
+	problemDetails "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/problemdetails"
+)
+
+// ForwardedHeaders are the request headers passed to the grpc services as metadata besides the default ones,
+// e.g. the idempotency key, the expected version and the caller set by the api gateway.
+var ForwardedHeaders = []string{"Idempotency-Key", etag.HeaderIfMatch, "X-User-Id", "X-User-Email"}
+
+// RegisterHandlerFunc registers the generated gateway handlers of a grpc service on the mux,
+// e.g. `RegisterProductsServiceHandler`.
+type RegisterHandlerFunc func(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error
+
+// Service is a grpc service served by the gateway, its rest routes are the http annotations of its descriptor.
+type Service struct {
+	Descriptor protoreflect.ServiceDescriptor
+	Register   RegisterHandlerFunc
+}
+
+// Route is a rest route of a grpc method in the echo path syntax, e.g. `GET /api/v1/products/:ProductID`.
+type Route struct {
+	Method string
+	Path   string
+}
+
+// NewServeMux creates a gateway mux that writes grpc errors as problem details, forwards the `ForwardedHeaders`
+// and responds with the status code and the `ETag` set by the grpc services.
+func NewServeMux(opts ...runtime.ServeMuxOption) *runtime.ServeMux {
+	opts = append(
+		[]runtime.ServeMuxOption{
+			runtime.WithErrorHandler(problemDetailsErrorHandler),
+			runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+			runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+			runtime.WithForwardResponseOption(forwardStatusCode),
+		},
+		opts...,
+	)
+
+	return runtime.NewServeMux(opts...)
+}
+
+// incomingHeaderMatcher maps the `ForwardedHeaders` to lower case metadata keys, the rest to the default ones.
+func incomingHeaderMatcher(header string) (string, bool) {
+	for _, forwarded := range ForwardedHeaders {
+		if strings.EqualFold(header, forwarded) {
+			return strings.ToLower(forwarded), true
+		}
+	}
+
+	return runtime.DefaultHeaderMatcher(header)
+}
+
+// outgoingHeaderMatcher writes the `ETag` metadata as the response header, the status code is not a header.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	switch strings.ToLower(key) {
+	case etagMetadata:
+		return etag.HeaderETag, true
+	case statusCodeMetadata:
+		return "", false
+	default:
+		return runtime.MetadataHeaderPrefix + key, true
+	}
+}
+
+// MapGateway registers the gateway handlers of the services over the grpc connection and mounts the annotated
+// routes of their methods on echo, so the proto is the only definition of the rest endpoints of the services.
+func MapGateway(
+	ctx context.Context,
+	routeBuilder *echocontracts.RouteBuilder,
+	conn *grpc.ClientConn,
+	services ...Service,
+) error {
+	mux := NewServeMux()
+
+	var routes []Route
+
+	for _, service := range services {
+		if err := service.Register(ctx, mux, conn); err != nil {
+			return errors.WrapIf(err, "error in registering the gateway handlers")
+		}
+
+		serviceRoutes, err := Routes(service.Descriptor)
+		if err != nil {
+			return err
+		}
+
+		routes = append(routes, serviceRoutes...)
+	}
+
+	handler := func(c echo.Context) error {
+		mux.ServeHTTP(&noContentWriter{ResponseWriter: c.Response()}, c.Request())
+
+		return nil
+	}
+
+	routeBuilder.RegisterRoutes(func(e *echo.Echo) {
+		for _, route := range routes {
+			e.Add(route.Method, route.Path, handler)
+		}
+	})
+
+	return nil
+}
+
+// Routes returns the routes of the http annotations of the service methods, the methods without an annotation,
+// like the streaming ones, have no route.
+func Routes(descriptor protoreflect.ServiceDescriptor) ([]Route, error) {
+	var routes []Route
+
+	methods := descriptor.Methods()
+	for i := 0; i < methods.Len(); i++ {
+		method := methods.Get(i)
+
+		rule, ok := proto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+		if !ok || rule == nil {
+			continue
+		}
+
+		for _, binding := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+			route, err := httpRuleRoute(binding)
+			if err != nil {
+				return nil, errors.WrapIf(err, fmt.Sprintf("invalid http annotation of %s", method.FullName()))
+			}
+
+			if route != nil {
+				routes = append(routes, *route)
+			}
+		}
+	}
+
+	return routes, nil
+}
+
+// httpRuleRoute converts the pattern of an http rule to an echo route, e.g. `/products/{ProductID}` to
+// `/products/:ProductID`.
+func httpRuleRoute(rule *annotations.HttpRule) (*Route, error) {
+	var method, pattern string
+
+	switch p := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		method, pattern = http.MethodGet, p.Get
+	case *annotations.HttpRule_Post:
+		method, pattern = http.MethodPost, p.Post
+	case *annotations.HttpRule_Put:
+		method, pattern = http.MethodPut, p.Put
+	case *annotations.HttpRule_Delete:
+		method, pattern = http.MethodDelete, p.Delete
+	case *annotations.HttpRule_Patch:
+		method, pattern = http.MethodPatch, p.Patch
+	case *annotations.HttpRule_Custom:
+		method, pattern = p.Custom.GetKind(), p.Custom.GetPath()
+	default:
+		return nil, nil
+	}
+
+	segments := strings.Split(pattern, "/")
+	for i, segment := range segments {
+		if !strings.HasPrefix(segment, "{") {
+			if strings.ContainsAny(segment, "{}*:") {
+				return nil, errors.Errorf("the path segment %s of %s is not supported", segment, pattern)
+			}
+
+			continue
+		}
+
+		variable := strings.TrimSuffix(strings.TrimPrefix(segment, "{"), "}")
+		if variable == "" || strings.ContainsAny(variable, "{}=*/.:") {
+			return nil, errors.Errorf("the path variable %s of %s is not supported", segment, pattern)
+		}
+
+		segments[i] = ":" + variable
+	}
+
+	return &Route{Method: method, Path: strings.Join(segments, "/")}, nil
+}
+
+// noContentWriter drops the body of the no content responses, the gateway marshals every reply, even an empty one.
+type noContentWriter struct {
+	http.ResponseWriter
+	noContent bool
+}
+
+// WriteHeader writes the status code of the response.
+func (w *noContentWriter) WriteHeader(code int) {
+	w.noContent = code == http.StatusNoContent
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// Write writes the body of the response, unless it is a no content response.
+func (w *noContentWriter) Write(b []byte) (int, error) {
+	if w.noContent {
+		return len(b), nil
+	}
+
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush flushes the buffered response.
+func (w *noContentWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// problemDetailsErrorHandler converts the grpc status back to a custom error and writes it the same way as the echo error handler.
+func problemDetailsErrorHandler(
+	_ context.Context,
+	_ *runtime.ServeMux,
+	_ runtime.Marshaler,
+	w http.ResponseWriter,
+	_ *http.Request,
+	err error,
+) {
+	prb := problemDetails.ParseError(grpcerrors.ToCustomError(err))
+	if prb == nil {
+		st := status.Convert(err)
+		prb = problemDetails.NewProblemDetailFromCodeAndDetail(
+			runtime.HTTPStatusFromCode(st.Code()),
+			st.Message(),
+			"",
+		)
+	}
+
+	_, _ = problemDetails.WriteTo(prb, w)
+}
@@ -0,0 +1,253 @@
+//go:build unit
+// +build unit
+
+// Package gateway provides the grpc gateway tests.
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	echocontracts "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/customecho/contracts"
+	problemDetails "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/problemdetails"
+)
+
+// testService returns the descriptor of a products service with the http annotations of its methods.
+func testService(t *testing.T) protoreflect.ServiceDescriptor {
+	t.Helper()
+
+	method := func(name string, rule *annotations.HttpRule) *descriptorpb.MethodDescriptorProto {
+		options := &descriptorpb.MethodOptions{}
+		if rule != nil {
+			proto.SetExtension(options, annotations.E_Http, rule)
+		}
+
+		return &descriptorpb.MethodDescriptorProto{
+			Name:       proto.String(name),
+			InputType:  proto.String(".google.protobuf.Empty"),
+			OutputType: proto.String(".google.protobuf.Empty"),
+			Options:    options,
+		}
+	}
+
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:       proto.String("products_test.proto"),
+		Package:    proto.String("products"),
+		Dependency: []string{"google/protobuf/empty.proto"},
+		Syntax:     proto.String("proto3"),
+		Service: []*descriptorpb.ServiceDescriptorProto{{
+			Name: proto.String("ProductsService"),
+			Method: []*descriptorpb.MethodDescriptorProto{
+				method("GetProductByID", &annotations.HttpRule{
+					Pattern: &annotations.HttpRule_Get{Get: "/api/v1/products/{ProductID}"},
+					AdditionalBindings: []*annotations.HttpRule{{
+						Pattern: &annotations.HttpRule_Get{Get: "/api/v1/products/{ProductID}/details"},
+					}},
+				}),
+				method("CreateProduct", &annotations.HttpRule{
+					Pattern: &annotations.HttpRule_Post{Post: "/api/v1/products"},
+					Body:    "*",
+				}),
+				method("WatchProducts", nil),
+			},
+		}},
+	}, protoregistry.GlobalFiles)
+	require.NoError(t, err)
+
+	return file.Services().ByName("ProductsService")
+}
+
+func Test_Routes_Of_Http_Annotations(t *testing.T) {
+	routes, err := Routes(testService(t))
+	require.NoError(t, err)
+
+	assert.Equal(t, []Route{
+		{Method: http.MethodGet, Path: "/api/v1/products/:ProductID"},
+		{Method: http.MethodGet, Path: "/api/v1/products/:ProductID/details"},
+		{Method: http.MethodPost, Path: "/api/v1/products"},
+	}, routes)
+}
+
+func Test_HttpRuleRoute_Rejects_Complex_Patterns(t *testing.T) {
+	_, err := httpRuleRoute(&annotations.HttpRule{
+		Pattern: &annotations.HttpRule_Get{Get: "/api/v1/{name=products/*}"},
+	})
+	assert.Error(t, err)
+
+	_, err = httpRuleRoute(&annotations.HttpRule{
+		Pattern: &annotations.HttpRule_Post{Post: "/api/v1/products:import"},
+	})
+	assert.Error(t, err)
+}
+
+func Test_MapGateway_Serves_Annotated_Routes(t *testing.T) {
+	e := echo.New()
+
+	err := MapGateway(
+		context.Background(),
+		echocontracts.NewRouteBuilder(e),
+		nil,
+		Service{
+			Descriptor: testService(t),
+			Register: func(_ context.Context, mux *runtime.ServeMux, _ *grpc.ClientConn) error {
+				err := mux.HandlePath(
+					http.MethodGet,
+					"/api/v1/products/{ProductID}",
+					func(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+						_, _ = w.Write([]byte(params["ProductID"]))
+					},
+				)
+				if err != nil {
+					return err
+				}
+
+				return mux.HandlePath(
+					http.MethodPost,
+					"/api/v1/products",
+					func(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+						w.WriteHeader(http.StatusNoContent)
+						_, _ = w.Write([]byte("{}"))
+					},
+				)
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/products/1", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "1", rec.Body.String())
+
+	// the body the gateway marshals for an empty reply is dropped from a no content response
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/v1/products", nil))
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Empty(t, rec.Body.String())
+
+	// the routes without an annotation are not served
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/products", nil))
+
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func Test_ServeMux_Forwards_Status_Code_And_ETag(t *testing.T) {
+	mux := NewServeMux()
+	err := mux.HandlePath(
+		http.MethodPost,
+		"/api/v1/products",
+		func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+			ctx := runtime.NewServerMetadataContext(r.Context(), runtime.ServerMetadata{
+				HeaderMD: metadata.Pairs(statusCodeMetadata, "201", etagMetadata, `"3"`, "x-request", "1"),
+			})
+			_, outbound := runtime.MarshalerForRequest(mux, r)
+			// the generated handlers forward the replies with the options of the mux
+			runtime.ForwardResponseMessage(
+				ctx,
+				mux,
+				outbound,
+				w,
+				r,
+				&emptypb.Empty{},
+				mux.GetForwardResponseOptions()...,
+			)
+		},
+	)
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/v1/products", nil))
+
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, `"3"`, rec.Header().Get("ETag"))
+	assert.Equal(t, "1", rec.Header().Get(runtime.MetadataHeaderPrefix+"x-request"))
+	assert.Empty(t, rec.Header().Get(runtime.MetadataHeaderPrefix+statusCodeMetadata))
+}
+
+func Test_ServeMux_Writes_Problem_Details(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{
+			name:   "not found",
+			err:    status.Error(codes.NotFound, "product not found"),
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "invalid argument",
+			err:    status.Error(codes.InvalidArgument, "name is required"),
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "unavailable",
+			err:    status.Error(codes.Unavailable, "connection refused"),
+			status: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := NewServeMux()
+			err := mux.HandlePath(
+				http.MethodGet,
+				"/fail",
+				func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+					_, outbound := runtime.MarshalerForRequest(mux, r)
+					runtime.HTTPError(r.Context(), mux, outbound, w, r, tt.err)
+				},
+			)
+			require.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/fail", nil))
+
+			assert.Equal(t, tt.status, rec.Code)
+			assert.Equal(t, problemDetails.ContentTypeJSON, rec.Header().Get("Content-Type"))
+
+			var body map[string]any
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			assert.EqualValues(t, tt.status, body["status"])
+		})
+	}
+}
+
+func Test_IncomingHeaderMatcher_Forwards_Idempotency_Headers(t *testing.T) {
+	key, ok := incomingHeaderMatcher("idempotency-key")
+	assert.True(t, ok)
+	assert.Equal(t, "idempotency-key", key)
+
+	key, ok = incomingHeaderMatcher("If-Match")
+	assert.True(t, ok)
+	assert.Equal(t, "if-match", key)
+
+	key, ok = incomingHeaderMatcher("X-User-Id")
+	assert.True(t, ok)
+	assert.Equal(t, "x-user-id", key)
+
+	_, ok = incomingHeaderMatcher("X-Unknown")
+	assert.False(t, ok)
+}
@@ -0,0 +1,68 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/http/customecho/etag"
+)
+
+const (
+	// statusCodeMetadata is the header metadata of the http status code the gateway responds with.
+	statusCodeMetadata = "x-http-code"
+	// etagMetadata is the header metadata of the `ETag` the gateway responds with.
+	etagMetadata = "etag"
+	// ifMatchMetadata is the metadata of the `If-Match` header forwarded by the gateway.
+	ifMatchMetadata = "if-match"
+)
+
+// SetStatusCode sets the http status code the gateway responds with instead of 200, e.g. 201 for a created resource,
+// the grpc clients ignore it.
+func SetStatusCode(ctx context.Context, code int) {
+	// it fails only outside a grpc call, where there is no gateway response
+	_ = grpc.SetHeader(ctx, metadata.Pairs(statusCodeMetadata, strconv.Itoa(code)))
+}
+
+// SetETag sets the `ETag` header of the resource version the gateway responds with.
+func SetETag(ctx context.Context, version int64) {
+	_ = grpc.SetHeader(ctx, metadata.Pairs(etagMetadata, etag.Format(version)))
+}
+
+// IfMatchVersion returns the version of the `If-Match` header forwarded by the gateway, it returns 0 when the call
+// has no header or `*`, so the call is not conditional.
+func IfMatchVersion(ctx context.Context) (int64, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(ifMatchMetadata); len(values) > 0 {
+		return etag.ParseIfMatch(values[0])
+	}
+
+	return 0, nil
+}
+
+// forwardStatusCode writes the status code set by the grpc service with SetStatusCode.
+func forwardStatusCode(ctx context.Context, w http.ResponseWriter, _ proto.Message) error {
+	md, ok := runtime.ServerMetadataFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	values := md.HeaderMD.Get(statusCodeMetadata)
+	if len(values) == 0 {
+		return nil
+	}
+
+	code, err := strconv.Atoi(values[0])
+	if err != nil || code < http.StatusOK || code > 599 {
+		return nil
+	}
+
+	w.WriteHeader(code)
+
+	return nil
+}
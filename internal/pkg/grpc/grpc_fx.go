@@ -30,7 +30,7 @@ var (
 		// https://uber-go.github.io/fx/annotate.html
 		fx.Annotate(
 			NewGrpcServer,
-			fx.ParamTags(``, ``),
+			fx.ParamTags(``, ``, `optional:"true"`, `optional:"true"`, `optional:"true"`),
 		),
 		NewGrpcClient,
 	))
@@ -78,7 +78,7 @@ func registerHooks(
 		OnStop: func(ctx context.Context) error {
 			// https://github.com/uber-go/fx/blob/v1.20.0/app.go#L573
 			// this ctx is just for stopping callbacks or OnStop callbacks, and it has short timeout 15s, and it is not alive in whole lifetime app
-			grpcServer.GracefulShutdown()
+			grpcServer.GracefulShutdown(ctx)
 			logger.Info("server shutdown gracefully")
 
 			if err := grpcClient.Close(); err != nil {
@@ -33,6 +33,29 @@ func NewConflictGrpcError(detail string, stackTrace string) GrpcErr {
 	}
 }
 
+// NewAbortedGrpcError is a function that creates a new aborted grpc error, for a write that lost an optimistic
+// concurrency check and can be retried on the current state.
+func NewAbortedGrpcError(detail string, stackTrace string) GrpcErr {
+	return &grpcErr{
+		Title:      constants.ErrConflictTitle,
+		Detail:     detail,
+		Status:     codes.Aborted,
+		Timestamp:  time.Now(),
+		StackTrace: stackTrace,
+	}
+}
+
+// NewPreconditionFailedGrpcError is a function that creates a new precondition failed grpc error.
+func NewPreconditionFailedGrpcError(detail string, stackTrace string) GrpcErr {
+	return &grpcErr{
+		Title:      constants.ErrPreconditionFailedTitle,
+		Detail:     detail,
+		Status:     codes.FailedPrecondition,
+		Timestamp:  time.Now(),
+		StackTrace: stackTrace,
+	}
+}
+
 // NewBadRequestGrpcError is a function that creates a new bad request grpc error.
 func NewBadRequestGrpcError(detail string, stackTrace string) GrpcErr {
 	return &grpcErr{
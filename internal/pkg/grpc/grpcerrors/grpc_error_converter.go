@@ -0,0 +1,54 @@
+// Package grpcerrors provides custom grpc errors.
+package grpcerrors
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+)
+
+// ToCustomError converts a grpc error received by a client back into a custom error, the grpc status stays
+// in the chain of the returned error, so `status.Code` still works on it.
+func ToCustomError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	detail := st.Message()
+
+	// the servers of this repository send a json serialized `grpcErr` as the status message
+	var body grpcErr
+	if jsonErr := json.Unmarshal([]byte(st.Message()), &body); jsonErr == nil && body.Detail != "" {
+		detail = body.Detail
+	}
+
+	switch st.Code() {
+	case codes.OK:
+		return nil
+	case codes.InvalidArgument, codes.OutOfRange, codes.FailedPrecondition:
+		return customErrors.NewBadRequestErrorWrap(err, detail)
+	case codes.NotFound:
+		return customErrors.NewNotFoundErrorWrap(err, detail)
+	case codes.AlreadyExists, codes.Aborted:
+		return customErrors.NewConflictErrorWrap(err, detail)
+	case codes.Unauthenticated:
+		return customErrors.NewUnAuthorizedErrorWrap(err, detail)
+	case codes.PermissionDenied:
+		return customErrors.NewForbiddenErrorWrap(err, detail)
+	case codes.DeadlineExceeded:
+		return customErrors.NewAPIErrorWrap(err, http.StatusGatewayTimeout, detail)
+	case codes.Unavailable, codes.ResourceExhausted, codes.Canceled:
+		return customErrors.NewAPIErrorWrap(err, http.StatusServiceUnavailable, detail)
+	default:
+		return customErrors.NewInternalServerErrorWrap(err, detail)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/grpc/codes"
 
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/constants"
+	esErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/eventstoredb/errors"
 	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
 	errorUtils "github.com/raphaeldiscky/go-food-micro/internal/pkg/utils/errorutils"
 )
@@ -56,8 +57,12 @@ func ParseError(err error) GrpcErr {
 			return NewUnAuthorizedErrorGrpcError(customErr.Error(), stackTrace)
 		case customErrors.IsForbiddenError(err):
 			return NewForbiddenGrpcError(customErr.Error(), stackTrace)
+		case esErrors.IsWrongExpectedVersionError(err):
+			return NewAbortedGrpcError(customErr.Error(), stackTrace)
 		case customErrors.IsConflictError(err):
 			return NewConflictGrpcError(customErr.Error(), stackTrace)
+		case customErrors.IsPreconditionFailedError(err):
+			return NewPreconditionFailedGrpcError(customErr.Error(), stackTrace)
 		case customErrors.IsInternalServerError(err):
 			return NewInternalServerGrpcError(customErr.Error(), stackTrace)
 		case customErrors.IsCustomError(err):
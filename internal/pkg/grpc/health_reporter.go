@@ -0,0 +1,149 @@
+// Package grpc provides the grpc health reporter.
+package grpc
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	googleGrpc "google.golang.org/grpc"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/health/contracts"
+)
+
+// healthReporter surfaces the health checks through the standard grpc health service, the overall status and
+// the server name are SERVING while all the critical checks are up, a registered grpc service is SERVING while its
+// own checks are up, or while the critical checks are up when it has no checks.
+type healthReporter struct {
+	healthServer  *health.Server
+	healthService contracts.HealthService
+	serviceName   string
+	interval      time.Duration
+	serviceChecks map[string][]string
+	services      []string
+	cancel        context.CancelFunc
+	done          chan struct{}
+	mu            sync.Mutex
+}
+
+// newHealthReporter creates a new health reporter, without a health service the statuses are always SERVING.
+func newHealthReporter(
+	healthServer *health.Server,
+	healthService contracts.HealthService,
+	serviceName string,
+	interval time.Duration,
+	serviceChecks map[string][]string,
+) *healthReporter {
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	return &healthReporter{
+		healthServer:  healthServer,
+		healthService: healthService,
+		serviceName:   serviceName,
+		interval:      interval,
+		serviceChecks: serviceChecks,
+	}
+}
+
+// start sets the initial statuses of the registered services and syncs them until stop is called.
+func (r *healthReporter) start(server *googleGrpc.Server) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.services = []string{"", r.serviceName}
+	for name := range server.GetServiceInfo() {
+		if name == grpc_health_v1.Health_ServiceDesc.ServiceName ||
+			strings.HasPrefix(name, "grpc.reflection.") {
+			continue
+		}
+
+		r.services = append(r.services, name)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.done = make(chan struct{})
+
+	r.sync(ctx)
+
+	go r.run(ctx, r.done)
+}
+
+// stop stops syncing the statuses.
+func (r *healthReporter) stop() {
+	r.mu.Lock()
+	cancel, done := r.cancel, r.done
+	r.cancel, r.done = nil, nil
+	r.mu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+
+	cancel()
+	<-done
+}
+
+// run syncs the statuses on every interval.
+func (r *healthReporter) run(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.sync(ctx)
+		}
+	}
+}
+
+// sync sets the status of all the services from their health checks.
+func (r *healthReporter) sync(ctx context.Context) {
+	var check contracts.Check
+	if r.healthService != nil {
+		check = r.healthService.CheckHealth(ctx)
+	}
+
+	// after the health server shutdown, the statuses are kept NOT_SERVING by the health server itself
+	if ctx.Err() != nil {
+		return
+	}
+
+	for _, service := range r.services {
+		r.healthServer.SetServingStatus(service, r.status(service, check))
+	}
+}
+
+// status returns the status of a service, a check of the service that isn't registered is down.
+func (r *healthReporter) status(service string, check contracts.Check) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	if r.healthService == nil {
+		return grpc_health_v1.HealthCheckResponse_SERVING
+	}
+
+	names, ok := r.serviceChecks[service]
+	if !ok || service == "" || service == r.serviceName {
+		if check.CriticalUp() {
+			return grpc_health_v1.HealthCheckResponse_SERVING
+		}
+
+		return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+
+	for _, name := range names {
+		if status, ok := check[name]; !ok || !status.IsUp() {
+			return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+		}
+	}
+
+	return grpc_health_v1.HealthCheckResponse_SERVING
+}
@@ -0,0 +1,158 @@
+//go:build unit
+// +build unit
+
+// Package grpc provides the grpc health reporter tests.
+package grpc
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/health/contracts"
+)
+
+// fakeHealthService returns the statuses set by the test.
+type fakeHealthService struct {
+	mu    sync.Mutex
+	check contracts.Check
+}
+
+func (f *fakeHealthService) set(name string, critical bool, up bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	status := contracts.Status{Status: contracts.StatusDown, Critical: critical}
+	if up {
+		status.Status = contracts.StatusUp
+	}
+
+	f.check[name] = status
+}
+
+func (f *fakeHealthService) CheckHealth(context.Context) contracts.Check {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	check := make(contracts.Check, len(f.check))
+	for name, status := range f.check {
+		check[name] = status
+	}
+
+	return check
+}
+
+func (f *fakeHealthService) IsStarted() bool { return true }
+
+func (f *fakeHealthService) Start(context.Context) error { return nil }
+
+func (f *fakeHealthService) Stop(context.Context) error { return nil }
+
+func newTestHealthReporter(
+	healthService contracts.HealthService,
+	serviceChecks map[string][]string,
+) (*healthReporter, *health.Server) {
+	healthServer := health.NewServer()
+	reporter := newHealthReporter(healthServer, healthService, "catalogs", time.Hour, serviceChecks)
+	reporter.services = []string{"", "catalogs", "products.ProductsService", "prices.PricesService"}
+
+	return reporter, healthServer
+}
+
+func servingStatus(t *testing.T, healthServer *health.Server, service string) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	t.Helper()
+
+	response, err := healthServer.Check(
+		context.Background(),
+		&grpc_health_v1.HealthCheckRequest{Service: service},
+	)
+	require.NoError(t, err)
+
+	return response.GetStatus()
+}
+
+func Test_HealthReporter_Follows_The_Critical_Checks_And_The_Service_Checks(t *testing.T) {
+	healthService := &fakeHealthService{check: contracts.Check{}}
+	healthService.set("postgres", true, true)
+	healthService.set("redis", false, true)
+
+	reporter, healthServer := newTestHealthReporter(healthService, map[string][]string{
+		"prices.PricesService": {"redis"},
+	})
+
+	reporter.sync(context.Background())
+	for _, service := range reporter.services {
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, servingStatus(t, healthServer, service), service)
+	}
+
+	// a non-critical check only takes down the services that depend on it
+	healthService.set("redis", false, false)
+	reporter.sync(context.Background())
+
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, servingStatus(t, healthServer, ""))
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, servingStatus(t, healthServer, "catalogs"))
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, servingStatus(t, healthServer, "products.ProductsService"))
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus(t, healthServer, "prices.PricesService"))
+
+	// a critical check takes down the server and the services without their own checks
+	healthService.set("redis", false, true)
+	healthService.set("postgres", true, false)
+	reporter.sync(context.Background())
+
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus(t, healthServer, ""))
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus(t, healthServer, "catalogs"))
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus(t, healthServer, "products.ProductsService"))
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, servingStatus(t, healthServer, "prices.PricesService"))
+
+	// the statuses recover with the checks
+	healthService.set("postgres", true, true)
+	reporter.sync(context.Background())
+	for _, service := range reporter.services {
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, servingStatus(t, healthServer, service), service)
+	}
+}
+
+func Test_HealthReporter_Reports_A_Service_With_An_Unknown_Check_As_Not_Serving(t *testing.T) {
+	healthService := &fakeHealthService{check: contracts.Check{}}
+	healthService.set("postgres", true, true)
+
+	reporter, healthServer := newTestHealthReporter(healthService, map[string][]string{
+		"prices.PricesService": {"kafka"},
+	})
+	reporter.sync(context.Background())
+
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, servingStatus(t, healthServer, ""))
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus(t, healthServer, "prices.PricesService"))
+}
+
+func Test_HealthReporter_Without_A_Health_Service_Is_Always_Serving(t *testing.T) {
+	reporter, healthServer := newTestHealthReporter(nil, map[string][]string{
+		"prices.PricesService": {"redis"},
+	})
+	reporter.sync(context.Background())
+
+	for _, service := range reporter.services {
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, servingStatus(t, healthServer, service), service)
+	}
+}
+
+func Test_HealthReporter_Keeps_Not_Serving_After_The_Shutdown(t *testing.T) {
+	healthService := &fakeHealthService{check: contracts.Check{}}
+	healthService.set("postgres", true, true)
+
+	reporter, healthServer := newTestHealthReporter(healthService, nil)
+	reporter.sync(context.Background())
+
+	healthServer.Shutdown()
+	reporter.sync(context.Background())
+
+	for _, service := range reporter.services {
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus(t, healthServer, service), service)
+	}
+}
@@ -0,0 +1,93 @@
+// Package interceptors provides an authentication interceptor.
+package interceptors
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/authentication"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/postgresgorm/audit"
+)
+
+// authorizationMetadata is the metadata of the bearer token, the gateway forwards the `Authorization` header to it.
+const authorizationMetadata = "authorization"
+
+// bearerScheme is the scheme of the authorization metadata of a bearer token.
+const bearerScheme = "Bearer "
+
+// AuthenticationUnaryServerInterceptor is a function that validates the bearer token of the `authorization`
+// metadata and sets its principal and audit actor in the context, it is the grpc equivalent of the authentication
+// and audit actor echo middlewares, a call without a token stays anonymous and the services decide whether it is allowed.
+func AuthenticationUnaryServerInterceptor(validator *authentication.TokenValidator) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		ctx, err := authenticate(ctx, validator)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// AuthenticationStreamServerInterceptor is a function that validates the bearer token of the `authorization`
+// metadata of a stream and sets its principal and audit actor in the stream context.
+func AuthenticationStreamServerInterceptor(validator *authentication.TokenValidator) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		_ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, err := authenticate(ss.Context(), validator)
+		if err != nil {
+			return err
+		}
+
+		stream := grpcMiddleware.WrapServerStream(ss)
+		stream.WrappedContext = ctx
+
+		return handler(srv, stream)
+	}
+}
+
+// authenticate returns the context with the principal of the bearer token, the context is unchanged for an
+// anonymous call or when authentication is not configured.
+func authenticate(ctx context.Context, validator *authentication.TokenValidator) (context.Context, error) {
+	if !validator.Enabled() {
+		return ctx, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	header := firstMetadataValue(md, authorizationMetadata)
+	if header == "" {
+		return ctx, nil
+	}
+
+	if len(header) <= len(bearerScheme) || !strings.EqualFold(header[:len(bearerScheme)], bearerScheme) {
+		return nil, customErrors.NewUnAuthorizedError("the authorization metadata is not a bearer token")
+	}
+
+	principal, err := validator.Validate(strings.TrimSpace(header[len(bearerScheme):]))
+	if err != nil {
+		return nil, customErrors.NewUnAuthorizedErrorWrap(err, "the bearer token is not valid")
+	}
+
+	ctx = authentication.WithPrincipal(ctx, principal)
+	if identity := principal.Identity(); identity != "" {
+		ctx = audit.WithActor(ctx, identity)
+	}
+
+	return ctx, nil
+}
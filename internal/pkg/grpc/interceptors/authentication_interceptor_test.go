@@ -0,0 +1,88 @@
+//go:build unit
+// +build unit
+
+// Package interceptors provides the authentication interceptor tests.
+package interceptors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/authentication"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/postgresgorm/audit"
+)
+
+// authenticatedCall calls the authentication interceptor with the authorization metadata and returns the context
+// of the handler.
+func authenticatedCall(
+	validator *authentication.TokenValidator,
+	authorization string,
+) (context.Context, error) {
+	ctx := context.Background()
+	if authorization != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(authorizationMetadata, authorization))
+	}
+
+	var handlerCtx context.Context
+
+	_, err := AuthenticationUnaryServerInterceptor(validator)(
+		ctx,
+		nil,
+		&grpc.UnaryServerInfo{},
+		func(ctx context.Context, _ interface{}) (interface{}, error) {
+			handlerCtx = ctx
+
+			return nil, nil
+		},
+	)
+
+	return handlerCtx, err
+}
+
+func Test_AuthenticationInterceptor_Sets_Principal_And_Actor(t *testing.T) {
+	validator := authentication.NewTokenValidator(&authentication.AuthenticationOptions{SigningKey: "key"})
+
+	token, err := validator.Issue(&authentication.Principal{Subject: "user-1", Email: "user@test.com"}, time.Minute)
+	require.NoError(t, err)
+
+	ctx, err := authenticatedCall(validator, "Bearer "+token)
+	require.NoError(t, err)
+
+	principal, ok := authentication.PrincipalFromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, "user-1", principal.Subject)
+	assert.Equal(t, "user-1", audit.ActorFromContext(ctx))
+}
+
+func Test_AuthenticationInterceptor_Rejects_Invalid_Token(t *testing.T) {
+	validator := authentication.NewTokenValidator(&authentication.AuthenticationOptions{SigningKey: "key"})
+
+	_, err := authenticatedCall(validator, "Bearer invalid")
+	assert.Error(t, err)
+
+	_, err = authenticatedCall(validator, "Basic dXNlcg==")
+	assert.Error(t, err)
+}
+
+func Test_AuthenticationInterceptor_Keeps_Anonymous_Calls(t *testing.T) {
+	validator := authentication.NewTokenValidator(&authentication.AuthenticationOptions{SigningKey: "key"})
+
+	ctx, err := authenticatedCall(validator, "")
+	require.NoError(t, err)
+
+	_, ok := authentication.PrincipalFromContext(ctx)
+	assert.False(t, ok)
+
+	// the tokens are not validated when authentication is not configured
+	ctx, err = authenticatedCall(authentication.NewTokenValidator(&authentication.AuthenticationOptions{}), "Bearer invalid")
+	require.NoError(t, err)
+
+	_, ok = authentication.PrincipalFromContext(ctx)
+	assert.False(t, ok)
+}
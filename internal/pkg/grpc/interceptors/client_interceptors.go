@@ -0,0 +1,187 @@
+// Package interceptors provides the grpc client interceptors.
+package interceptors
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/grpc/grpcerrors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/resilience/circuitbreaker"
+)
+
+// UnaryClientErrorInterceptor is a function that converts the grpc errors into custom errors.
+func UnaryClientErrorInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		return grpcerrors.ToCustomError(invoker(ctx, method, req, reply, cc, opts...))
+	}
+}
+
+// StreamClientErrorInterceptor is a function that converts the grpc errors of opening a stream into custom errors.
+func StreamClientErrorInterceptor() grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			return nil, grpcerrors.ToCustomError(err)
+		}
+
+		return stream, nil
+	}
+}
+
+// UnaryClientTimeoutInterceptor is a function that sets the default deadline on the calls without a deadline.
+func UnaryClientTimeoutInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if _, ok := ctx.Deadline(); !ok && timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// UnaryClientCircuitBreakerInterceptor is a function that rejects the calls while the circuit is open, only the
+// errors of an unhealthy server count as failures, so business errors never open the circuit.
+func UnaryClientCircuitBreakerInterceptor(cb *circuitbreaker.CircuitBreaker) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		done, err := cb.Allow()
+		if err != nil {
+			return status.Error(codes.Unavailable, err.Error())
+		}
+
+		err = invoker(ctx, method, req, reply, cc, opts...)
+		done(err)
+
+		return err
+	}
+}
+
+// StreamClientCircuitBreakerInterceptor is a function that rejects opening streams while the circuit is open, the
+// result of a stream is the error it completes with, so the failures after the stream is opened count too.
+func StreamClientCircuitBreakerInterceptor(cb *circuitbreaker.CircuitBreaker) grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		done, err := cb.Allow()
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			done(err)
+
+			return nil, err
+		}
+
+		return newCircuitBreakerClientStream(stream, desc, done), nil
+	}
+}
+
+// circuitBreakerClientStream records the completion of a client stream in the circuit breaker.
+type circuitBreakerClientStream struct {
+	grpc.ClientStream
+	serverStreams bool
+	once          sync.Once
+	done          func(err error)
+}
+
+// newCircuitBreakerClientStream wraps the stream, a stream that is never read to its end completes with its context.
+func newCircuitBreakerClientStream(
+	stream grpc.ClientStream,
+	desc *grpc.StreamDesc,
+	done func(err error),
+) *circuitBreakerClientStream {
+	s := &circuitBreakerClientStream{ClientStream: stream, serverStreams: desc.ServerStreams, done: done}
+
+	go func() {
+		<-stream.Context().Done()
+		s.complete(status.FromContextError(stream.Context().Err()).Err())
+	}()
+
+	return s
+}
+
+// SendMsg sends a message, a send error completes the stream.
+func (s *circuitBreakerClientStream) SendMsg(m interface{}) error {
+	err := s.ClientStream.SendMsg(m)
+	if err != nil && !errors.Is(err, io.EOF) {
+		s.complete(err)
+	}
+
+	return err
+}
+
+// RecvMsg receives a message, the stream completes successfully with io.EOF or fails with any other error.
+func (s *circuitBreakerClientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	switch {
+	case errors.Is(err, io.EOF):
+		s.complete(nil)
+	case err != nil:
+		s.complete(err)
+	case !s.serverStreams:
+		// a stream without server streaming completes with its single response
+		s.complete(nil)
+	}
+
+	return err
+}
+
+// complete records the first completion of the stream.
+func (s *circuitBreakerClientStream) complete(err error) {
+	s.once.Do(func() {
+		s.done(err)
+	})
+}
+
+// IsServerFailure returns true for the grpc errors of an unavailable or overloaded server.
+func IsServerFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Internal, codes.Unknown:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,138 @@
+//go:build unit
+// +build unit
+
+// Package interceptors provides the grpc client interceptors tests.
+package interceptors
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/resilience/circuitbreaker"
+)
+
+// fakeClientStream completes with err after its messages are received.
+type fakeClientStream struct {
+	grpc.ClientStream
+	ctx      context.Context
+	messages int
+	err      error
+}
+
+func (f *fakeClientStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeClientStream) RecvMsg(interface{}) error {
+	if f.messages > 0 {
+		f.messages--
+
+		return nil
+	}
+
+	return f.err
+}
+
+func openStream(
+	t *testing.T,
+	cb *circuitbreaker.CircuitBreaker,
+	stream *fakeClientStream,
+) (grpc.ClientStream, error) {
+	t.Helper()
+
+	return StreamClientCircuitBreakerInterceptor(cb)(
+		context.Background(),
+		&grpc.StreamDesc{ServerStreams: true},
+		nil,
+		"/test.Service/Stream",
+		func(context.Context, *grpc.StreamDesc, *grpc.ClientConn, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+			return stream, nil
+		},
+	)
+}
+
+func readAll(stream grpc.ClientStream) error {
+	for {
+		if err := stream.RecvMsg(nil); err != nil {
+			return err
+		}
+	}
+}
+
+func Test_StreamClientCircuitBreakerInterceptor_Counts_The_Stream_Completion_Errors(t *testing.T) {
+	cb := circuitbreaker.NewCircuitBreaker(
+		"test",
+		&circuitbreaker.Options{FailureThreshold: 2, OpenTimeout: time.Minute},
+		circuitbreaker.WithIsFailure(IsServerFailure),
+	)
+
+	for i := 0; i < 2; i++ {
+		stream, err := openStream(t, cb, &fakeClientStream{
+			ctx:      context.Background(),
+			messages: 2,
+			err:      status.Error(codes.Unavailable, "connection reset"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, codes.Unavailable, status.Code(readAll(stream)))
+	}
+
+	assert.Equal(t, circuitbreaker.StateOpen, cb.State())
+
+	_, err := openStream(t, cb, &fakeClientStream{ctx: context.Background(), err: io.EOF})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func Test_StreamClientCircuitBreakerInterceptor_Counts_A_Stream_Completed_With_EOF_As_Success(t *testing.T) {
+	cb := circuitbreaker.NewCircuitBreaker(
+		"test",
+		&circuitbreaker.Options{FailureThreshold: 2, OpenTimeout: time.Minute},
+		circuitbreaker.WithIsFailure(IsServerFailure),
+	)
+
+	failed, err := openStream(t, cb, &fakeClientStream{
+		ctx: context.Background(),
+		err: status.Error(codes.Unavailable, "connection reset"),
+	})
+	require.NoError(t, err)
+	_ = readAll(failed)
+
+	succeeded, err := openStream(t, cb, &fakeClientStream{ctx: context.Background(), messages: 1, err: io.EOF})
+	require.NoError(t, err)
+	assert.ErrorIs(t, readAll(succeeded), io.EOF)
+
+	// the success resets the failures, so one more failure doesn't open the circuit
+	failed, err = openStream(t, cb, &fakeClientStream{
+		ctx: context.Background(),
+		err: status.Error(codes.Unavailable, "connection reset"),
+	})
+	require.NoError(t, err)
+	_ = readAll(failed)
+
+	assert.Equal(t, circuitbreaker.StateClosed, cb.State())
+}
+
+func Test_StreamClientCircuitBreakerInterceptor_Completes_An_Abandoned_Stream_With_Its_Context(t *testing.T) {
+	cb := circuitbreaker.NewCircuitBreaker(
+		"test",
+		&circuitbreaker.Options{FailureThreshold: 1, OpenTimeout: time.Minute},
+		circuitbreaker.WithIsFailure(IsServerFailure),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	_, err := openStream(t, cb, &fakeClientStream{ctx: ctx, err: io.EOF})
+	require.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		return cb.State() == circuitbreaker.StateOpen
+	}, time.Second, time.Millisecond)
+}
@@ -0,0 +1,142 @@
+// Package interceptors provides a idempotency interceptor.
+package interceptors
+
+import (
+	"context"
+	"strings"
+
+	"emperror.dev/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/grpc/grpcerrors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/idempotency"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+)
+
+// IdempotencyUnaryServerInterceptor is a function that returns the first reply of the `idempotency-key` metadata
+// of a caller for the retries, it is the grpc equivalent of the idempotency echo middleware.
+func IdempotencyUnaryServerInterceptor(
+	manager *idempotency.Manager,
+	log logger.Logger,
+) grpc.UnaryServerInterceptor {
+	options := manager.Options()
+	keyMetadata := strings.ToLower(options.Header)
+	principalMetadata := strings.ToLower(options.PrincipalHeader)
+
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		key := firstMetadataValue(md, keyMetadata)
+
+		message, ok := req.(proto.Message)
+		if !options.Enabled || key == "" || !ok {
+			return handler(ctx, req)
+		}
+
+		request, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+		if err != nil {
+			return nil, errors.WrapIf(err, "error in marshaling the request")
+		}
+
+		principal := manager.Principal(ctx, firstMetadataValue(md, principalMetadata))
+		fingerprint := idempotency.Fingerprint([]byte(info.FullMethod), request)
+
+		record, err := manager.Begin(ctx, principal, key, fingerprint)
+		if err != nil {
+			return nil, toGrpcError(err)
+		}
+
+		if record != nil {
+			return replayReply(record)
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			// the failed rpcs are not stored, so the caller can retry them with the same key
+			if releaseErr := manager.Release(ctx, principal, key); releaseErr != nil {
+				return nil, errors.Combine(err, releaseErr)
+			}
+
+			return nil, err
+		}
+
+		reply, ok := resp.(proto.Message)
+		if !ok {
+			return resp, nil
+		}
+
+		if err := completeReply(ctx, manager, principal, key, fingerprint, reply); err != nil {
+			log.Errorf("error in storing the idempotent reply: %v", err)
+		}
+
+		return resp, nil
+	}
+}
+
+// completeReply stores the reply of the first rpc as an `Any`, so it is replayed with its type.
+func completeReply(
+	ctx context.Context,
+	manager *idempotency.Manager,
+	principal string,
+	key string,
+	fingerprint string,
+	reply proto.Message,
+) error {
+	body, err := anypb.New(reply)
+	if err != nil {
+		return errors.WrapIf(err, "error in wrapping the reply")
+	}
+
+	data, err := proto.Marshal(body)
+	if err != nil {
+		return errors.WrapIf(err, "error in marshaling the reply")
+	}
+
+	return manager.Complete(ctx, principal, key, fingerprint, int(codes.OK), nil, data)
+}
+
+// replayReply returns the stored reply of the first rpc.
+func replayReply(record *idempotency.Record) (interface{}, error) {
+	body := &anypb.Any{}
+	if err := proto.Unmarshal(record.Body, body); err != nil {
+		return nil, errors.WrapIf(err, "error in unmarshaling the idempotent reply")
+	}
+
+	reply, err := body.UnmarshalNew()
+	if err != nil {
+		return nil, errors.WrapIf(err, "error in unmarshaling the idempotent reply")
+	}
+
+	return reply, nil
+}
+
+// toGrpcError converts the errors of the idempotency manager to the grpc errors.
+func toGrpcError(err error) error {
+	switch {
+	case errors.Is(err, idempotency.ErrRequestInFlight):
+		return grpcerrors.NewConflictGrpcError(err.Error(), "")
+	case errors.Is(err, idempotency.ErrKeyReused):
+		return grpcerrors.NewGrpcError(codes.FailedPrecondition, "Idempotency Key Reused", err.Error(), "")
+	case errors.Is(err, idempotency.ErrKeyTooLong):
+		return grpcerrors.NewBadRequestGrpcError(err.Error(), "")
+	default:
+		return errors.WrapIf(err, "error in checking the idempotency key")
+	}
+}
+
+// firstMetadataValue returns the first value of the metadata key.
+func firstMetadataValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
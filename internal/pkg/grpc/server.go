@@ -2,6 +2,7 @@
 package grpc
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"time"
@@ -18,9 +19,12 @@ import (
 	grpcCtxTags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
 	googleGrpc "google.golang.org/grpc"
 
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/authentication"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/grpc/config"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/grpc/handlers/otel"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/grpc/interceptors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/health/contracts"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/idempotency"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
 )
 
@@ -29,12 +33,13 @@ const (
 	gRPCTimeout       = 15
 	maxConnectionAge  = 5
 	gRPCTime          = 10
+	keepaliveMinTime  = 10
 )
 
 // GrpcServer is an interface that represents a grpc server.
 type GrpcServer interface {
 	RunGrpcServer(configGrpc ...func(grpcServer *googleGrpc.Server)) error
-	GracefulShutdown()
+	GracefulShutdown(ctx context.Context)
 	GetCurrentGrpcServer() *googleGrpc.Server
 	GrpcServiceBuilder() *GrpcServiceBuilder
 }
@@ -46,21 +51,49 @@ type grpcServer struct {
 	log            logger.Logger
 	serviceName    string
 	serviceBuilder *GrpcServiceBuilder
+	healthServer   *health.Server
+	healthReporter *healthReporter
 }
 
-// NewGrpcServer is a function that creates a new grpc server.
+// NewGrpcServer is a function that creates a new grpc server, the health checks of the health service are
+// surfaced through the grpc health service, the bearer tokens are validated when the token validator is provided
+// and the unary rpcs with an idempotency key are deduplicated when the idempotency manager is provided.
 func NewGrpcServer(
 	config *config.GrpcOptions,
 	logger logger.Logger,
+	healthService contracts.HealthService,
+	idempotencyManager *idempotency.Manager,
+	tokenValidator *authentication.TokenValidator,
 ) GrpcServer {
 	unaryServerInterceptors := []googleGrpc.UnaryServerInterceptor{
 		interceptors.UnaryServerInterceptor(),
-		grpcCtxTags.UnaryServerInterceptor(),
-		grpcRecovery.UnaryServerInterceptor(),
 	}
 	streamServerInterceptors := []googleGrpc.StreamServerInterceptor{
 		interceptors.StreamServerInterceptor(),
 	}
+	if tokenValidator != nil {
+		// before the idempotency interceptor, so the keys are scoped by the principal of the token
+		unaryServerInterceptors = append(
+			unaryServerInterceptors,
+			interceptors.AuthenticationUnaryServerInterceptor(tokenValidator),
+		)
+		streamServerInterceptors = append(
+			streamServerInterceptors,
+			interceptors.AuthenticationStreamServerInterceptor(tokenValidator),
+		)
+	}
+	if idempotencyManager != nil {
+		// inside the error interceptor, so its conflicts are returned as they are
+		unaryServerInterceptors = append(
+			unaryServerInterceptors,
+			interceptors.IdempotencyUnaryServerInterceptor(idempotencyManager, logger),
+		)
+	}
+	unaryServerInterceptors = append(
+		unaryServerInterceptors,
+		grpcCtxTags.UnaryServerInterceptor(),
+		grpcRecovery.UnaryServerInterceptor(),
+	)
 
 	s := googleGrpc.NewServer(
 		// https://github.com/open-telemetry/opentelemetry-go-contrib/issues/2840
@@ -70,6 +103,11 @@ func NewGrpcServer(
 		googleGrpc.StatsHandler(otelgrpc.NewServerHandler()),
 		googleGrpc.StatsHandler(otel.NewServerHandler()),
 
+		// the clients ping every `keepaliveTime`, the server must permit it or it closes their connections
+		googleGrpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             keepaliveMinTime * time.Second,
+			PermitWithoutStream: true,
+		}),
 		googleGrpc.KeepaliveParams(keepalive.ServerParameters{
 			MaxConnectionIdle: maxConnectionIdle * time.Minute,
 			Timeout:           gRPCTimeout * time.Second,
@@ -87,9 +125,12 @@ func NewGrpcServer(
 	)
 	healthServer := health.NewServer()
 	grpc_health_v1.RegisterHealthServer(s, healthServer)
+
+	// NOT_SERVING until the server is running and the statuses are synced with the health checks
+	healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
 	healthServer.SetServingStatus(
 		config.Name,
-		grpc_health_v1.HealthCheckResponse_SERVING,
+		grpc_health_v1.HealthCheckResponse_NOT_SERVING,
 	)
 
 	return &grpcServer{
@@ -98,6 +139,14 @@ func NewGrpcServer(
 		log:            logger,
 		serviceName:    config.Name,
 		serviceBuilder: NewGrpcServiceBuilder(s),
+		healthServer:   healthServer,
+		healthReporter: newHealthReporter(
+			healthServer,
+			healthService,
+			config.Name,
+			config.HealthCheckInterval,
+			serviceHealthChecks(config.ServiceHealthChecks),
+		),
 	}
 }
 
@@ -117,10 +166,12 @@ func (s *grpcServer) RunGrpcServer(
 		}
 	}
 
-	if s.config.Development {
+	if s.config.Development || s.config.Reflection {
 		reflection.Register(s.server)
 	}
 
+	s.healthReporter.start(s.server)
+
 	s.log.Infof(
 		"[grpcServer.RunGrpcServer] Writer gRPC server is listening on port: %s",
 		s.config.Port,
@@ -149,8 +200,48 @@ func (s *grpcServer) GetCurrentGrpcServer() *googleGrpc.Server {
 	return s.server
 }
 
-// GracefulShutdown is a function that gracefully shuts down the grpc server.
-func (s *grpcServer) GracefulShutdown() {
-	s.server.Stop()
-	s.server.GracefulStop()
+// GracefulShutdown is a function that gracefully shuts down the grpc server, the health service flips to
+// NOT_SERVING first and stays so for the drain delay, so the load balancers stop routing new rpcs, then the
+// in-flight rpcs are drained.
+func (s *grpcServer) GracefulShutdown(ctx context.Context) {
+	s.healthReporter.stop()
+	s.healthServer.Shutdown()
+
+	if s.config.ShutdownDrainDelay > 0 {
+		select {
+		case <-time.After(s.config.ShutdownDrainDelay):
+		case <-ctx.Done():
+		}
+	}
+
+	timeout := s.config.ShutdownTimeout
+	if timeout <= 0 {
+		timeout = gRPCTimeout * time.Second
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		s.log.Warn("[grpcServer.GracefulShutdown] draining timed out, stopping the remaining rpcs")
+		s.server.Stop()
+	}
+}
+
+// serviceHealthChecks returns the health checks of the grpc services by their name.
+func serviceHealthChecks(options []config.GrpcServiceHealthOptions) map[string][]string {
+	checks := make(map[string][]string, len(options))
+	for _, option := range options {
+		checks[option.Service] = append(checks[option.Service], option.Checks...)
+	}
+
+	return checks
 }
@@ -14,3 +14,14 @@ func (check Check) AllUp() bool {
 
 	return true
 }
+
+// CriticalUp is a function that checks if all the critical statuses are up.
+func (check Check) CriticalUp() bool {
+	for _, status := range check {
+		if status.Critical && !status.IsUp() {
+			return false
+		}
+	}
+
+	return true
+}
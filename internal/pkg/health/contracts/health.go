@@ -3,13 +3,36 @@ package contracts
 
 import "context"
 
+// Criticality is the criticality of a health check for the readiness of the service.
+type Criticality string
+
+const (
+	// CriticalityCritical makes the service unready when the check is down.
+	CriticalityCritical Criticality = "critical"
+	// CriticalityNonCritical only reports the check as down, the service stays ready.
+	CriticalityNonCritical Criticality = "non-critical"
+)
+
 // Health is an interface that represents a health check.
 type Health interface {
 	CheckHealth(ctx context.Context) error
 	GetHealthName() string
 }
 
+// HealthCriticality is an optional interface a Health implements to declare its default criticality,
+// checks that don't implement it are critical.
+type HealthCriticality interface {
+	GetCriticality() Criticality
+}
+
 // HealthService is an interface that represents a health check service.
 type HealthService interface {
+	// CheckHealth returns the latest results of all the checks.
 	CheckHealth(ctx context.Context) Check
+	// IsStarted returns true once all the critical checks have been up at least once.
+	IsStarted() bool
+	// Start runs the checks in the background until Stop is called.
+	Start(ctx context.Context) error
+	// Stop stops the background checks.
+	Stop(ctx context.Context) error
 }
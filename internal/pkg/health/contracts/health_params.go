@@ -2,6 +2,7 @@
 package contracts
 
 import (
+	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/fx"
 )
 
@@ -9,5 +10,6 @@ import (
 type HealthParams struct {
 	fx.In
 
-	Healths []Health `group:"healths"`
+	Healths []Health     `group:"healths"`
+	Meter   metric.Meter `optional:"true"`
 }
@@ -1,6 +1,8 @@
 // Package contracts provides a health check contracts.
 package contracts
 
+import "time"
+
 const (
 	StatusUp   = "up"
 	StatusDown = "down"
@@ -8,13 +10,17 @@ const (
 
 // Status is a struct that represents a status.
 type Status struct {
-	Status string `json:"status"`
+	Status    string    `json:"status"`
+	Critical  bool      `json:"critical"`
+	Error     string    `json:"error,omitempty"`
+	Duration  string    `json:"duration,omitempty"`
+	CheckedAt time.Time `json:"checkedAt"`
 }
 
 // NewStatus is a function that creates a new status.
 func NewStatus(err error) Status {
 	if err != nil {
-		return Status{Status: StatusDown}
+		return Status{Status: StatusDown, Error: err.Error()}
 	}
 
 	return Status{Status: StatusUp}
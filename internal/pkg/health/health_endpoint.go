@@ -26,13 +26,35 @@ func NewHealthCheckEndpoint(
 
 // RegisterEndpoints is a function that registers the endpoints.
 func (s *HealthCheckEndpoint) RegisterEndpoints() {
-	s.echoServer.GetEchoInstance().GET("health", s.checkHealth)
+	e := s.echoServer.GetEchoInstance()
+
+	// `health` is kept for the existing probes, it behaves like the readiness probe
+	e.GET("health", s.checkReadiness)
+	e.GET("health/live", s.checkLiveness)
+	e.GET("health/ready", s.checkReadiness)
+	e.GET("health/startup", s.checkStartup)
+}
+
+// checkLiveness is a function that checks the liveness, the process is alive when it can serve the request,
+// so the dependencies are not checked and a flapping dependency never restarts the pod.
+func (s *HealthCheckEndpoint) checkLiveness(c echo.Context) error {
+	return c.JSON(http.StatusOK, contracts2.Status{Status: contracts2.StatusUp})
+}
+
+// checkReadiness is a function that checks the readiness, only the critical checks make the service unready.
+func (s *HealthCheckEndpoint) checkReadiness(c echo.Context) error {
+	check := s.service.CheckHealth(c.Request().Context())
+	if !check.CriticalUp() {
+		return c.JSON(http.StatusServiceUnavailable, check)
+	}
+
+	return c.JSON(http.StatusOK, check)
 }
 
-// checkHealth is a function that checks the health.
-func (s *HealthCheckEndpoint) checkHealth(c echo.Context) error {
+// checkStartup is a function that checks the startup, the service is started once the critical checks were up.
+func (s *HealthCheckEndpoint) checkStartup(c echo.Context) error {
 	check := s.service.CheckHealth(c.Request().Context())
-	if !check.AllUp() {
+	if !s.service.IsStarted() {
 		return c.JSON(http.StatusServiceUnavailable, check)
 	}
 
@@ -2,12 +2,17 @@
 package health
 
 import (
+	"context"
+
 	"go.uber.org/fx"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/health/contracts"
 )
 
 // Module is a fx.Options that provides the health check module.
 var Module = fx.Options(
 	fx.Provide(
+		ProvideHealthConfig,
 		NewHealthService,
 		NewHealthCheckEndpoint,
 	),
@@ -15,4 +20,17 @@ var Module = fx.Options(
 	fx.Invoke(func(endpoint *HealthCheckEndpoint) {
 		endpoint.RegisterEndpoints()
 	}),
+	fx.Invoke(registerHooks),
 )
+
+// registerHooks runs the health checks in the background for the lifetime of the application.
+func registerHooks(lc fx.Lifecycle, service contracts.HealthService) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			return service.Start(ctx)
+		},
+		OnStop: func(ctx context.Context) error {
+			return service.Stop(ctx)
+		},
+	})
+}
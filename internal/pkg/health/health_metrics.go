@@ -0,0 +1,84 @@
+// Package health provides the health check metrics.
+package health
+
+import (
+	"context"
+	"time"
+
+	"emperror.dev/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/health/contracts"
+)
+
+const meterName = "github.com/raphaeldiscky/go-food-micro/internal/pkg/health"
+
+// healthMetrics exports the health state as otel metrics.
+type healthMetrics struct {
+	duration metric.Float64Histogram
+}
+
+// newHealthMetrics creates the health metrics, the status gauge is observed from the cached results.
+func newHealthMetrics(
+	meter metric.Meter,
+	snapshot func() contracts.Check,
+) (*healthMetrics, error) {
+	if meter == nil {
+		meter = otel.Meter(meterName)
+	}
+
+	_, err := meter.Int64ObservableGauge(
+		"health_check_status",
+		metric.WithDescription("The status of the health check, 1 when it is up and 0 when it is down"),
+		metric.WithInt64Callback(func(_ context.Context, observer metric.Int64Observer) error {
+			for name, status := range snapshot() {
+				var value int64
+				if status.IsUp() {
+					value = 1
+				}
+
+				observer.Observe(value, metric.WithAttributes(checkAttributes(name, status)...))
+			}
+
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to create health_check_status gauge")
+	}
+
+	duration, err := meter.Float64Histogram(
+		"health_check_duration",
+		metric.WithDescription("The duration of the health check"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to create health_check_duration histogram")
+	}
+
+	return &healthMetrics{duration: duration}, nil
+}
+
+// recordDuration records the duration of a check.
+func (m *healthMetrics) recordDuration(
+	ctx context.Context,
+	name string,
+	status contracts.Status,
+	duration time.Duration,
+) {
+	m.duration.Record(
+		context.WithoutCancel(ctx),
+		duration.Seconds(),
+		metric.WithAttributes(append(checkAttributes(name, status), attribute.String("status", status.Status))...),
+	)
+}
+
+// checkAttributes returns the attributes of a check.
+func checkAttributes(name string, status contracts.Status) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("check", name),
+		attribute.Bool("critical", status.Critical),
+	}
+}
@@ -0,0 +1,30 @@
+// Package health provides the health check options.
+package health
+
+import (
+	"time"
+
+	"github.com/iancoleman/strcase"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/config"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/config/environment"
+	typeMapper "github.com/raphaeldiscky/go-food-micro/internal/pkg/reflection/typemapper"
+)
+
+// optionName is the name of the option for the health checks.
+var optionName = strcase.ToLowerCamel(typeMapper.GetGenericTypeNameByT[HealthOptions]())
+
+// HealthOptions is a struct that contains the options for the health checks.
+type HealthOptions struct {
+	// Timeout is the maximum duration of a single check.
+	Timeout time.Duration `mapstructure:"timeout"           default:"5s"`
+	// Interval is the duration between two background runs of the checks, the endpoints serve the cached results.
+	Interval time.Duration `mapstructure:"interval"          default:"15s"`
+	// NonCriticalChecks are the names of the checks that don't make the service unready when they are down.
+	NonCriticalChecks []string `mapstructure:"nonCriticalChecks"`
+}
+
+// ProvideHealthConfig provides the config for the health checks.
+func ProvideHealthConfig(environment environment.Environment) (*HealthOptions, error) {
+	return config.BindConfigKey[*HealthOptions](optionName, environment)
+}
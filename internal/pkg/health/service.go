@@ -3,33 +3,251 @@ package health
 
 import (
 	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
 
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/health/contracts"
 )
 
-// healthService is a struct that represents a health service.
+// healthService is a struct that represents a health service, it runs the checks in parallel in the background
+// and serves the cached results, so the probes don't hit the dependencies on every request.
 type healthService struct {
-	healthParams contracts.HealthParams
+	healths     []contracts.Health
+	critical    map[string]bool
+	options     *HealthOptions
+	metrics     *healthMetrics
+	mu          sync.RWMutex
+	results     contracts.Check
+	lastChecked time.Time
+	started     bool
+	refreshMu   sync.Mutex
+	cancel      context.CancelFunc
+	done        chan struct{}
 }
 
 // NewHealthService is a function that creates a new health service.
 func NewHealthService(
 	healthParams contracts.HealthParams,
-) contracts.HealthService {
-	return &healthService{
-		healthParams: healthParams,
+	options *HealthOptions,
+) (contracts.HealthService, error) {
+	if options == nil {
+		options = &HealthOptions{}
+	}
+
+	if options.Timeout <= 0 {
+		options.Timeout = 5 * time.Second
+	}
+
+	if options.Interval <= 0 {
+		options.Interval = 15 * time.Second
+	}
+
+	critical := make(map[string]bool, len(healthParams.Healths))
+	for _, health := range healthParams.Healths {
+		critical[health.GetHealthName()] = isCritical(health, options)
+	}
+
+	service := &healthService{
+		healths:  healthParams.Healths,
+		critical: critical,
+		options:  options,
+		results:  make(contracts.Check),
 	}
+
+	metrics, err := newHealthMetrics(healthParams.Meter, service.snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	service.metrics = metrics
+
+	return service, nil
 }
 
-// CheckHealth is a function that checks the health.
+// CheckHealth is a function that checks the health, the cached results are returned while they are fresh.
 func (service *healthService) CheckHealth(ctx context.Context) contracts.Check {
-	checks := make(contracts.Check)
+	service.mu.RLock()
+	fresh := !service.lastChecked.IsZero() &&
+		time.Since(service.lastChecked) < service.options.Interval
+	service.mu.RUnlock()
+
+	if !fresh {
+		service.refresh(ctx)
+	}
+
+	return service.snapshot()
+}
+
+// IsStarted returns true once all the critical checks have been up at least once.
+func (service *healthService) IsStarted() bool {
+	service.mu.RLock()
+	defer service.mu.RUnlock()
+
+	return service.started
+}
+
+// Start runs the checks in the background until Stop is called.
+func (service *healthService) Start(ctx context.Context) error {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	if service.cancel != nil {
+		return errors.New("health service is already started")
+	}
+
+	// the lifecycle context is canceled after the start hooks, so the background loop gets its own context
+	bgCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	service.cancel = cancel
+	service.done = make(chan struct{})
+
+	go service.run(bgCtx, service.done)
+
+	return nil
+}
+
+// Stop stops the background checks.
+func (service *healthService) Stop(ctx context.Context) error {
+	service.mu.Lock()
+	cancel, done := service.cancel, service.done
+	service.cancel, service.done = nil, nil
+	service.mu.Unlock()
+
+	if cancel == nil {
+		return nil
+	}
+
+	cancel()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run refreshes the results on every interval.
+func (service *healthService) run(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(service.options.Interval)
+	defer ticker.Stop()
 
-	for _, health := range service.healthParams.Healths {
-		checks[health.GetHealthName()] = contracts.NewStatus(
-			health.CheckHealth(ctx),
-		)
+	for {
+		service.refresh(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// refresh runs all the checks in parallel, each with its own timeout, and caches the results.
+func (service *healthService) refresh(ctx context.Context) {
+	// concurrent probes wait for the running refresh instead of checking the dependencies again
+	service.refreshMu.Lock()
+	defer service.refreshMu.Unlock()
+
+	service.mu.RLock()
+	fresh := !service.lastChecked.IsZero() &&
+		time.Since(service.lastChecked) < service.options.Interval/2
+	service.mu.RUnlock()
+
+	if fresh {
+		return
+	}
+
+	results := make(contracts.Check, len(service.healths))
+
+	var (
+		wg        sync.WaitGroup
+		resultsMu sync.Mutex
+	)
+
+	for _, health := range service.healths {
+		wg.Add(1)
+
+		go func(health contracts.Health) {
+			defer wg.Done()
+
+			status := service.check(ctx, health)
+
+			resultsMu.Lock()
+			results[health.GetHealthName()] = status
+			resultsMu.Unlock()
+		}(health)
+	}
+
+	wg.Wait()
+
+	service.mu.Lock()
+	service.results = results
+	service.lastChecked = time.Now()
+	if !service.started && results.CriticalUp() {
+		service.started = true
+	}
+	service.mu.Unlock()
+}
+
+// check runs a single check with the configured timeout.
+func (service *healthService) check(ctx context.Context, health contracts.Health) contracts.Status {
+	checkCtx, cancel := context.WithTimeout(ctx, service.options.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	errCh := make(chan error, 1)
+
+	// a check that ignores its context can't block the other checks
+	go func() {
+		errCh <- health.CheckHealth(checkCtx)
+	}()
+
+	var err error
+	select {
+	case err = <-errCh:
+	case <-checkCtx.Done():
+		err = errors.WrapIf(checkCtx.Err(), "health check timed out")
+	}
+
+	duration := time.Since(start)
+
+	status := contracts.NewStatus(err)
+	status.Critical = service.critical[health.GetHealthName()]
+	status.Duration = duration.String()
+	status.CheckedAt = start
+
+	service.metrics.recordDuration(ctx, health.GetHealthName(), status, duration)
+
+	return status
+}
+
+// snapshot returns a copy of the cached results.
+func (service *healthService) snapshot() contracts.Check {
+	service.mu.RLock()
+	defer service.mu.RUnlock()
+
+	checks := make(contracts.Check, len(service.results))
+	for name, status := range service.results {
+		checks[name] = status
 	}
 
 	return checks
 }
+
+// isCritical returns the criticality of the check, the options override the default of the check.
+func isCritical(health contracts.Health, options *HealthOptions) bool {
+	if slices.Contains(options.NonCriticalChecks, health.GetHealthName()) {
+		return false
+	}
+
+	if h, ok := health.(contracts.HealthCriticality); ok {
+		return h.GetCriticality() != contracts.CriticalityNonCritical
+	}
+
+	return true
+}
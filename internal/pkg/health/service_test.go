@@ -0,0 +1,149 @@
+//go:build unit
+// +build unit
+
+// Package health provides the health service tests.
+package health
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/health/contracts"
+)
+
+// fakeHealth is a health check with a configurable result.
+type fakeHealth struct {
+	name        string
+	err         error
+	delay       time.Duration
+	criticality contracts.Criticality
+	calls       atomic.Int32
+}
+
+func (f *fakeHealth) CheckHealth(ctx context.Context) error {
+	f.calls.Add(1)
+
+	select {
+	case <-time.After(f.delay):
+		return f.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (f *fakeHealth) GetHealthName() string {
+	return f.name
+}
+
+func (f *fakeHealth) GetCriticality() contracts.Criticality {
+	if f.criticality == "" {
+		return contracts.CriticalityCritical
+	}
+
+	return f.criticality
+}
+
+func newTestHealthService(t *testing.T, options *HealthOptions, healths ...contracts.Health) contracts.HealthService {
+	t.Helper()
+
+	service, err := NewHealthService(contracts.HealthParams{Healths: healths}, options)
+	require.NoError(t, err)
+
+	return service
+}
+
+// TestNonCriticalCheckDoesNotMakeServiceUnready tests a non-critical check that is down keeps the service ready.
+func TestNonCriticalCheckDoesNotMakeServiceUnready(t *testing.T) {
+	service := newTestHealthService(
+		t,
+		&HealthOptions{},
+		&fakeHealth{name: "postgres"},
+		&fakeHealth{name: "redis", err: errors.New("connection refused"), criticality: contracts.CriticalityNonCritical},
+	)
+
+	check := service.CheckHealth(context.Background())
+
+	assert.False(t, check.AllUp())
+	assert.True(t, check.CriticalUp())
+	assert.False(t, check["redis"].Critical)
+	assert.Equal(t, "connection refused", check["redis"].Error)
+	assert.True(t, service.IsStarted())
+}
+
+// TestOptionsOverrideCriticality tests the non-critical checks of the options override the check criticality.
+func TestOptionsOverrideCriticality(t *testing.T) {
+	service := newTestHealthService(
+		t,
+		&HealthOptions{NonCriticalChecks: []string{"mongo"}},
+		&fakeHealth{name: "mongo", err: errors.New("down")},
+	)
+
+	check := service.CheckHealth(context.Background())
+
+	assert.True(t, check.CriticalUp())
+}
+
+// TestCriticalCheckDownKeepsServiceNotStarted tests the service is not started while a critical check is down.
+func TestCriticalCheckDownKeepsServiceNotStarted(t *testing.T) {
+	service := newTestHealthService(
+		t,
+		&HealthOptions{},
+		&fakeHealth{name: "postgres", err: errors.New("down")},
+	)
+
+	check := service.CheckHealth(context.Background())
+
+	assert.False(t, check.CriticalUp())
+	assert.False(t, service.IsStarted())
+}
+
+// TestChecksRunInParallelWithTimeout tests the slow checks time out without delaying the other checks.
+func TestChecksRunInParallelWithTimeout(t *testing.T) {
+	service := newTestHealthService(
+		t,
+		&HealthOptions{Timeout: 50 * time.Millisecond},
+		&fakeHealth{name: "slow1", delay: time.Second},
+		&fakeHealth{name: "slow2", delay: time.Second},
+		&fakeHealth{name: "fast"},
+	)
+
+	start := time.Now()
+	check := service.CheckHealth(context.Background())
+
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+	assert.False(t, check["slow1"].IsUp())
+	assert.False(t, check["slow2"].IsUp())
+	assert.True(t, check["fast"].IsUp())
+}
+
+// TestResultsAreCached tests the checks are not run again while the results are fresh.
+func TestResultsAreCached(t *testing.T) {
+	health := &fakeHealth{name: "postgres"}
+	service := newTestHealthService(t, &HealthOptions{Interval: time.Minute}, health)
+
+	service.CheckHealth(context.Background())
+	service.CheckHealth(context.Background())
+
+	assert.Equal(t, int32(1), health.calls.Load())
+}
+
+// TestBackgroundRefresh tests the started service refreshes the results in the background.
+func TestBackgroundRefresh(t *testing.T) {
+	health := &fakeHealth{name: "postgres"}
+	service := newTestHealthService(t, &HealthOptions{Interval: 20 * time.Millisecond}, health)
+
+	require.NoError(t, service.Start(context.Background()))
+
+	assert.Eventually(t, func() bool {
+		return health.calls.Load() >= 3
+	}, time.Second, 10*time.Millisecond)
+
+	require.NoError(t, service.Stop(context.Background()))
+	assert.True(t, service.IsStarted())
+}
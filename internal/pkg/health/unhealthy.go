@@ -20,7 +20,22 @@ func (service UnhealthyHealthService) CheckHealth(
 	context.Context,
 ) contracts.Check {
 	return contracts.Check{
-		"postgres": contracts.Status{Status: contracts.StatusDown},
-		"redis":    contracts.Status{Status: contracts.StatusDown},
+		"postgres": contracts.Status{Status: contracts.StatusDown, Critical: true},
+		"redis":    contracts.Status{Status: contracts.StatusDown, Critical: true},
 	}
 }
+
+// IsStarted is a function that checks if the service is started.
+func (service UnhealthyHealthService) IsStarted() bool {
+	return false
+}
+
+// Start is a function that starts the service.
+func (service UnhealthyHealthService) Start(context.Context) error {
+	return nil
+}
+
+// Stop is a function that stops the service.
+func (service UnhealthyHealthService) Stop(context.Context) error {
+	return nil
+}
@@ -2,30 +2,70 @@
 package client
 
 import (
+	"net"
+	"net/http"
 	"time"
 
 	resty "github.com/go-resty/resty/v2"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger/defaultlogger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/resilience/circuitbreaker"
 )
 
 // Constants for the http client.
 const (
-	timeout               = 5 * time.Second
 	dialContextTimeout    = 5 * time.Second
 	tLSHandshakeTimeout   = 5 * time.Second
 	xaxIdleConns          = 20
 	maxConnsPerHost       = 40
-	retryCount            = 3
-	retryWaitTime         = 300 * time.Millisecond
 	idleConnTimeout       = 120 * time.Second
 	responseHeaderTimeout = 5 * time.Second
 )
 
-// NewHTTPClient is a function that creates a new http client.
-func NewHTTPClient() *resty.Client {
-	client := resty.New().
-		SetTimeout(timeout).
-		SetRetryCount(retryCount).
-		SetRetryWaitTime(retryWaitTime)
+// NewHTTPClient is a function that creates a new http client, the requests run through the otel, timeout,
+// retry, circuit breaker and bulkhead policies in this order, and the error responses are returned as custom errors.
+func NewHTTPClient(options *HTTPClientOptions) *resty.Client {
+	if options == nil {
+		options = &HTTPClientOptions{}
+	}
+
+	policies := []Policy{
+		OtelPolicy(),
+		TimeoutPolicy(options.Timeout),
+		RetryPolicy(options.Retry),
+	}
+
+	if options.CircuitBreaker.Enabled {
+		policies = append(policies, CircuitBreakerPolicy(
+			&options.CircuitBreaker,
+			circuitbreaker.WithOnStateChange(func(name string, from circuitbreaker.State, to circuitbreaker.State) {
+				defaultlogger.GetLogger().Warnf("http circuit breaker %s changed from %s to %s", name, from, to)
+			}),
+		))
+	}
+
+	if options.MaxConcurrentRequests > 0 {
+		policies = append(policies, BulkheadPolicy(options.MaxConcurrentRequests))
+	}
+
+	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout: dialContextTimeout,
+		}).DialContext,
+		TLSHandshakeTimeout:   tLSHandshakeTimeout,
+		MaxIdleConns:          xaxIdleConns,
+		MaxConnsPerHost:       maxConnsPerHost,
+		IdleConnTimeout:       idleConnTimeout,
+		ResponseHeaderTimeout: responseHeaderTimeout,
+	}
+
+	// the retries are done by the retry policy inside the timeout budget, so resty doesn't retry
+	client := resty.NewWithClient(&http.Client{
+		Transport: NewPolicyTransport(transport, policies...),
+	}).
+		SetRetryCount(0).
+		OnAfterResponse(ProblemDetailsMiddleware)
 
 	return client
 }
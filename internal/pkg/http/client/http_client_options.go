@@ -0,0 +1,38 @@
+// Package client provides the http client options.
+package client
+
+import (
+	"time"
+
+	"github.com/iancoleman/strcase"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/config"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/config/environment"
+	typeMapper "github.com/raphaeldiscky/go-food-micro/internal/pkg/reflection/typemapper"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/resilience/circuitbreaker"
+)
+
+// optionName is the name of the option for the http client.
+var optionName = strcase.ToLowerCamel(typeMapper.GetGenericTypeNameByT[HTTPClientOptions]())
+
+// HTTPClientOptions is a struct that contains the resilience options of the http client.
+type HTTPClientOptions struct {
+	// Timeout is the budget of a request including all of its retries.
+	Timeout time.Duration `mapstructure:"timeout" default:"5s"`
+	Retry   RetryOptions  `mapstructure:"retry"`
+	// MaxConcurrentRequests is the size of the bulkhead, zero disables it.
+	MaxConcurrentRequests int                    `mapstructure:"maxConcurrentRequests" default:"100"`
+	CircuitBreaker        circuitbreaker.Options `mapstructure:"circuitBreaker"`
+}
+
+// RetryOptions is a struct that contains the retry options of the http client, only the idempotent requests are retried.
+type RetryOptions struct {
+	MaxAttempts    int           `mapstructure:"maxAttempts"    default:"3"`
+	InitialBackoff time.Duration `mapstructure:"initialBackoff" default:"100ms"`
+	MaxBackoff     time.Duration `mapstructure:"maxBackoff"     default:"2s"`
+}
+
+// ProvideConfig provides the config for the http client.
+func ProvideConfig(environment environment.Environment) (*HTTPClientOptions, error) {
+	return config.BindConfigKey[*HTTPClientOptions](optionName, environment)
+}
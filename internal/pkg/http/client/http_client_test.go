@@ -0,0 +1,196 @@
+//go:build unit
+// +build unit
+
+// Package client provides the http client tests.
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/resilience/circuitbreaker"
+)
+
+func newTestOptions() *HTTPClientOptions {
+	return &HTTPClientOptions{
+		Timeout: 2 * time.Second,
+		Retry: RetryOptions{
+			MaxAttempts:    3,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     5 * time.Millisecond,
+		},
+		MaxConcurrentRequests: 10,
+	}
+}
+
+// TestRetriesIdempotentRequests tests the idempotent requests are retried on the transient status codes.
+func TestRetriesIdempotentRequests(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"name":"product"}`))
+	}))
+	defer server.Close()
+
+	res, err := NewHTTPClient(newTestOptions()).R().Get(server.URL)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode())
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+// TestDoesNotRetryNonIdempotentRequests tests the post requests are not retried.
+func TestDoesNotRetryNonIdempotentRequests(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	_, err := NewHTTPClient(newTestOptions()).R().SetBody(`{}`).Post(server.URL)
+
+	require.Error(t, err)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+// TestDecodesProblemDetails tests the problem details responses are returned as custom errors.
+func TestDecodesProblemDetails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"status":404,"title":"Not Found","detail":"product with id 1 not found"}`))
+	}))
+	defer server.Close()
+
+	_, err := NewHTTPClient(newTestOptions()).R().Get(server.URL)
+
+	require.Error(t, err)
+	assert.True(t, customErrors.IsNotFoundError(err))
+	assert.Equal(t, "product with id 1 not found", customErrors.GetCustomError(err).Message())
+}
+
+// TestCircuitBreakerOpensOnServerErrors tests the circuit opens after the server errors and rejects the requests.
+func TestCircuitBreakerOpensOnServerErrors(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	options := newTestOptions()
+	options.CircuitBreaker = circuitbreaker.Options{Enabled: true, FailureThreshold: 2, OpenTimeout: time.Minute}
+	client := NewHTTPClient(options)
+
+	for range 2 {
+		_, err := client.R().Get(server.URL)
+		require.True(t, customErrors.IsInternalServerError(err))
+	}
+
+	_, err := client.R().Get(server.URL)
+
+	assert.ErrorIs(t, err, circuitbreaker.ErrOpenState)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+// TestCircuitBreakerIsPerHost tests an open circuit of a host doesn't reject the requests to another host.
+func TestCircuitBreakerIsPerHost(t *testing.T) {
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+
+	var healthyCalls atomic.Int32
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		healthyCalls.Add(1)
+		_, _ = w.Write([]byte(`{"name":"product"}`))
+	}))
+	defer healthy.Close()
+
+	options := newTestOptions()
+	options.CircuitBreaker = circuitbreaker.Options{Enabled: true, FailureThreshold: 2, OpenTimeout: time.Minute}
+	client := NewHTTPClient(options)
+
+	for range 2 {
+		_, _ = client.R().Get(failing.URL)
+	}
+
+	_, err := client.R().Get(failing.URL)
+	require.ErrorIs(t, err, circuitbreaker.ErrOpenState)
+
+	res, err := client.R().Get(healthy.URL)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode())
+	assert.Equal(t, int32(1), healthyCalls.Load())
+}
+
+// TestBulkheadRejectsWhenFull tests the requests waiting for a full bulkhead fail when they are canceled.
+func TestBulkheadRejectsWhenFull(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+	handler := NewPolicyTransport(
+		roundTripperFunc(func(*http.Request) (*http.Response, error) {
+			close(started)
+			<-release
+
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		}),
+		BulkheadPolicy(1),
+	)
+
+	go func() {
+		req, _ := http.NewRequest(http.MethodGet, "http://localhost", nil)
+		_, _ = handler.RoundTrip(req)
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost", nil)
+	_, err := handler.RoundTrip(req)
+	close(release)
+
+	assert.ErrorIs(t, err, ErrBulkheadFull)
+}
+
+// TestTimeoutBoundsRetries tests the timeout is the budget of all the retries.
+func TestTimeoutBoundsRetries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	options := newTestOptions()
+	options.Timeout = 50 * time.Millisecond
+	options.Retry = RetryOptions{MaxAttempts: 100, InitialBackoff: 20 * time.Millisecond, MaxBackoff: 20 * time.Millisecond}
+
+	start := time.Now()
+	_, err := NewHTTPClient(options).R().Get(server.URL)
+
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.True(t, strings.Contains(err.Error(), "deadline") || customErrors.IsCustomError(err))
+}
+
+// roundTripperFunc is a function that implements the http.RoundTripper.
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
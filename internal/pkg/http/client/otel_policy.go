@@ -0,0 +1,73 @@
+// Package client provides the otel instrumentation of the http client.
+package client
+
+import (
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/client"
+
+// OtelPolicy creates a client span for the request, propagates the trace context in the request headers
+// and records the duration of the request.
+func OtelPolicy() Policy {
+	tracer := otel.Tracer(instrumentationName)
+
+	// the global meter provider delegates to the provider that is registered later by the metrics module
+	duration, err := otel.Meter(instrumentationName).Float64Histogram(
+		"http.client.request.duration",
+		metric.WithDescription("The duration of the outgoing http requests"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	return PolicyFunc(func(req *http.Request, next Handler) (*http.Response, error) {
+		attrs := []attribute.KeyValue{
+			attribute.String("http.request.method", req.Method),
+			attribute.String("server.address", req.URL.Host),
+		}
+
+		ctx, span := tracer.Start(
+			req.Context(),
+			"HTTP "+req.Method,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(append(attrs, attribute.String("url.full", req.URL.Redacted()))...),
+		)
+		defer span.End()
+
+		// the request must not be changed, so its headers are cloned before injecting the trace context
+		req = req.Clone(ctx)
+		otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+		start := time.Now()
+		res, err := next(req)
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			attrs = append(attrs, attribute.String("error.type", "transport"))
+		} else {
+			attrs = append(attrs, attribute.Int("http.response.status_code", res.StatusCode))
+			span.SetAttributes(attribute.Int("http.response.status_code", res.StatusCode))
+
+			if res.StatusCode >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, res.Status)
+			}
+		}
+
+		if duration != nil {
+			duration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(attrs...))
+		}
+
+		return res, err
+	})
+}
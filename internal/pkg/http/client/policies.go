@@ -0,0 +1,206 @@
+// Package client provides the resilience policies of the http client.
+package client
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/resilience/circuitbreaker"
+)
+
+// ErrBulkheadFull is returned when the bulkhead has no free slot before the request is canceled.
+var ErrBulkheadFull = errors.New("http client bulkhead is full")
+
+// idempotentMethods are the methods that are safe to retry.
+var idempotentMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+	http.MethodTrace:   true,
+	http.MethodPut:     true,
+	http.MethodDelete:  true,
+}
+
+// TimeoutPolicy bounds the duration of the request, the retries of the inner policies share this budget.
+func TimeoutPolicy(timeout time.Duration) Policy {
+	return PolicyFunc(func(req *http.Request, next Handler) (*http.Response, error) {
+		if timeout <= 0 {
+			return next(req)
+		}
+
+		ctx, cancel := context.WithTimeout(req.Context(), timeout)
+
+		res, err := next(req.WithContext(ctx))
+		if err != nil || res == nil {
+			cancel()
+
+			return res, err
+		}
+
+		// the context is canceled once the body is read, canceling it here would abort reading the body
+		res.Body = &cancelOnCloseBody{ReadCloser: res.Body, cancel: cancel}
+
+		return res, nil
+	})
+}
+
+// cancelOnCloseBody cancels the context of the request when the body is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close closes the body and cancels the context.
+func (b *cancelOnCloseBody) Close() error {
+	defer b.cancel()
+
+	return b.ReadCloser.Close()
+}
+
+// RetryPolicy retries the idempotent requests that failed with a network error or a transient status code,
+// waiting an exponential backoff with full jitter or the `Retry-After` of the response between the attempts.
+func RetryPolicy(options RetryOptions) Policy {
+	return PolicyFunc(func(req *http.Request, next Handler) (*http.Response, error) {
+		if options.MaxAttempts < 2 || !idempotentMethods[req.Method] ||
+			(req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+			return next(req)
+		}
+
+		for attempt := 1; ; attempt++ {
+			res, err := next(req)
+			if attempt >= options.MaxAttempts || !isTransient(res, err) || req.Context().Err() != nil {
+				return res, err
+			}
+
+			wait := retryAfter(res)
+			if wait == 0 {
+				wait = backoff(options, attempt)
+			}
+
+			if res != nil {
+				// the connection can only be reused when the body is drained
+				_, _ = io.Copy(io.Discard, res.Body)
+				_ = res.Body.Close()
+			}
+
+			select {
+			case <-req.Context().Done():
+				return nil, req.Context().Err()
+			case <-time.After(wait):
+			}
+
+			if req.GetBody != nil {
+				body, bodyErr := req.GetBody()
+				if bodyErr != nil {
+					return nil, errors.WrapIf(bodyErr, "failed to rewind the request body")
+				}
+
+				req = req.Clone(req.Context())
+				req.Body = body
+			}
+		}
+	})
+}
+
+// CircuitBreakerPolicy rejects the requests while the circuit of their host is open, every host has its own circuit
+// breaker, so a failing service doesn't reject the requests to the others, the network errors and the server errors
+// count as failures.
+func CircuitBreakerPolicy(options *circuitbreaker.Options, opts ...circuitbreaker.Option) Policy {
+	var breakers sync.Map
+
+	return PolicyFunc(func(req *http.Request, next Handler) (*http.Response, error) {
+		host := req.URL.Host
+
+		cb, ok := breakers.Load(host)
+		if !ok {
+			cb, _ = breakers.LoadOrStore(host, circuitbreaker.NewCircuitBreaker(host, options, opts...))
+		}
+
+		done, err := cb.(*circuitbreaker.CircuitBreaker).Allow()
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := next(req)
+		if err == nil && res.StatusCode >= http.StatusInternalServerError {
+			done(errors.Errorf("server error %d", res.StatusCode))
+		} else {
+			done(err)
+		}
+
+		return res, err
+	})
+}
+
+// BulkheadPolicy limits the number of concurrent requests, the requests wait for a free slot until they are canceled.
+func BulkheadPolicy(maxConcurrentRequests int) Policy {
+	slots := make(chan struct{}, max(maxConcurrentRequests, 1))
+
+	return PolicyFunc(func(req *http.Request, next Handler) (*http.Response, error) {
+		select {
+		case slots <- struct{}{}:
+		case <-req.Context().Done():
+			return nil, errors.WithMessage(ErrBulkheadFull, req.Context().Err().Error())
+		}
+		defer func() { <-slots }()
+
+		return next(req)
+	})
+}
+
+// isTransient returns true for the network errors and the status codes that can succeed on a retry.
+func isTransient(res *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, circuitbreaker.ErrOpenState) &&
+			!errors.Is(err, ErrBulkheadFull)
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// backoff returns the exponential backoff of the attempt with full jitter.
+func backoff(options RetryOptions, attempt int) time.Duration {
+	initial := options.InitialBackoff
+	if initial <= 0 {
+		initial = 100 * time.Millisecond
+	}
+
+	maxBackoff := options.MaxBackoff
+	if maxBackoff < initial {
+		maxBackoff = initial
+	}
+
+	d := initial << (attempt - 1)
+	if d <= 0 || d > maxBackoff {
+		d = maxBackoff
+	}
+
+	//nolint:gosec // G404: the jitter doesn't need a secure random
+	return time.Duration(rand.Int64N(int64(d)) + 1)
+}
+
+// retryAfter returns the delay of the `Retry-After` header in seconds, zero when it is missing.
+func retryAfter(res *http.Response) time.Duration {
+	if res == nil {
+		return 0
+	}
+
+	seconds, err := strconv.Atoi(res.Header.Get("Retry-After"))
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,49 @@
+// Package client provides the resilience policies of the http client.
+package client
+
+import (
+	"net/http"
+)
+
+// Handler sends a request and returns its response.
+type Handler func(req *http.Request) (*http.Response, error)
+
+// Policy wraps the sending of a request, e.g. to retry it or to reject it.
+type Policy interface {
+	Execute(req *http.Request, next Handler) (*http.Response, error)
+}
+
+// PolicyFunc is a function that implements the Policy.
+type PolicyFunc func(req *http.Request, next Handler) (*http.Response, error)
+
+// Execute runs the policy function.
+func (f PolicyFunc) Execute(req *http.Request, next Handler) (*http.Response, error) {
+	return f(req, next)
+}
+
+// policyTransport is a http.RoundTripper that runs the requests through a chain of policies.
+type policyTransport struct {
+	handler Handler
+}
+
+// NewPolicyTransport creates a http.RoundTripper that runs the policies in order, the first policy is the outermost.
+func NewPolicyTransport(base http.RoundTripper, policies ...Policy) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	handler := Handler(base.RoundTrip)
+	for i := len(policies) - 1; i >= 0; i-- {
+		policy, next := policies[i], handler
+		handler = func(req *http.Request) (*http.Response, error) {
+			return policy.Execute(req, next)
+		}
+	}
+
+	return &policyTransport{handler: handler}
+}
+
+// RoundTrip sends the request through the policies.
+func (t *policyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.handler(req)
+}
@@ -0,0 +1,71 @@
+// Package client provides the decoder of the problem details responses.
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	resty "github.com/go-resty/resty/v2"
+
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+)
+
+// problemDetails is the body of a RFC 7807 response.
+type problemDetails struct {
+	Status int    `json:"status"`
+	Title  string `json:"title"`
+	Detail string `json:"detail"`
+	Type   string `json:"type"`
+}
+
+// DecodeProblemDetails converts an error response into a custom error, the RFC 7807 body is used when the
+// response has one, otherwise the status text. It returns nil for the successful responses.
+func DecodeProblemDetails(statusCode int, contentType string, body []byte) error {
+	if statusCode < http.StatusBadRequest {
+		return nil
+	}
+
+	problem := problemDetails{Status: statusCode}
+
+	if strings.Contains(contentType, "json") {
+		_ = json.Unmarshal(body, &problem)
+	}
+
+	// the status of the response is the source of truth when the body disagrees with it
+	problem.Status = statusCode
+
+	detail := problem.Detail
+	if detail == "" {
+		detail = problem.Title
+	}
+
+	if detail == "" {
+		detail = http.StatusText(statusCode)
+	}
+
+	switch statusCode {
+	case http.StatusBadRequest:
+		return customErrors.NewBadRequestError(detail)
+	case http.StatusUnauthorized:
+		return customErrors.NewUnAuthorizedError(detail)
+	case http.StatusForbidden:
+		return customErrors.NewForbiddenError(detail)
+	case http.StatusNotFound:
+		return customErrors.NewNotFoundError(detail)
+	case http.StatusConflict:
+		return customErrors.NewConflictError(detail)
+	case http.StatusUnprocessableEntity:
+		return customErrors.NewValidationError(detail)
+	case http.StatusInternalServerError:
+		return customErrors.NewInternalServerError(detail)
+	default:
+		return customErrors.NewAPIError(fmt.Sprintf("%s: %s", http.StatusText(statusCode), detail), statusCode)
+	}
+}
+
+// ProblemDetailsMiddleware is a resty response middleware that returns the error responses as custom errors.
+func ProblemDetailsMiddleware(_ *resty.Client, res *resty.Response) error {
+	return DecodeProblemDetails(res.StatusCode(), res.Header().Get("Content-Type"), res.Body())
+}
@@ -4,6 +4,7 @@ package customecho
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/labstack/echo/v4/middleware"
@@ -150,8 +151,10 @@ func (s *echoHTTPServer) SetupDefaultMiddlewares() {
 	s.echo.Use(ipratelimit.IPRateLimit())
 	s.echo.Use(middleware.RequestID())
 	s.echo.Use(middleware.GzipWithConfig(middleware.GzipConfig{
-		Level:   constants.GzipLevel,
-		Skipper: skipper,
+		Level: constants.GzipLevel,
+		Skipper: func(c echo.Context) bool {
+			return skipper(c) || isStreamingRequest(c.Request())
+		},
 	}))
 	// should be last middleware
 	s.echo.Use(problemdetail.ProblemDetail(problemdetail.WithSkipper(skipper)))
@@ -167,6 +170,13 @@ func (s *echoHTTPServer) GetEchoInstance() *echo.Echo {
 	return s.echo
 }
 
+// isStreamingRequest reports whether the request is a server-sent events or websocket stream, the gzip writer
+// hides the connection of the stream responses.
+func isStreamingRequest(r *http.Request) bool {
+	return strings.Contains(r.Header.Get(echo.HeaderAccept), "text/event-stream") ||
+		strings.EqualFold(r.Header.Get(echo.HeaderUpgrade), "websocket")
+}
+
 // apiVersion is the api version.
 func apiVersion(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
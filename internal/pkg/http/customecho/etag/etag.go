@@ -0,0 +1,72 @@
+// Package etag provides the `ETag` and `If-Match` helpers for the optimistic concurrency of the versioned resources.
+package etag
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	echo "github.com/labstack/echo/v4"
+
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+)
+
+const (
+	// HeaderETag is the response header of the resource version.
+	HeaderETag = "ETag"
+	// HeaderIfMatch is the request header of the expected version.
+	HeaderIfMatch = "If-Match"
+)
+
+// Format returns the entity tag of a resource version, e.g. `"3"`.
+func Format(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// Parse returns the version of an entity tag, the weak `W/` prefix is ignored.
+func Parse(tag string) (int64, error) {
+	tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+
+	unquoted, err := strconv.Unquote(tag)
+	if err != nil {
+		return 0, fmt.Errorf("entity tag %s is not quoted", tag)
+	}
+
+	version, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, fmt.Errorf("entity tag %s is not a resource version", tag)
+	}
+
+	return version, nil
+}
+
+// SetETag sets the `ETag` response header of the resource version.
+func SetETag(c echo.Context, version int64) {
+	c.Response().Header().Set(HeaderETag, Format(version))
+}
+
+// IfMatchVersion returns the version of the `If-Match` request header, it returns 0 when the header is absent
+// or `*`, so the request is not conditional.
+func IfMatchVersion(c echo.Context) (int64, error) {
+	return ParseIfMatch(c.Request().Header.Get(HeaderIfMatch))
+}
+
+// ParseIfMatch returns the version of an `If-Match` header value, it returns 0 when the value is empty or `*`.
+func ParseIfMatch(ifMatch string) (int64, error) {
+	ifMatch = strings.TrimSpace(ifMatch)
+	if ifMatch == "" || ifMatch == "*" {
+		return 0, nil
+	}
+
+	// a resource has a single current version, so a list of tags can't be matched
+	if strings.Contains(ifMatch, ",") {
+		return 0, customErrors.NewBadRequestError("If-Match header must contain a single entity tag")
+	}
+
+	version, err := Parse(ifMatch)
+	if err != nil {
+		return 0, customErrors.NewBadRequestErrorWrap(err, "invalid If-Match header")
+	}
+
+	return version, nil
+}
@@ -0,0 +1,81 @@
+//go:build unit
+// +build unit
+
+// Package etag provides the etag tests.
+package etag
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	echo "github.com/labstack/echo/v4"
+
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+)
+
+func newContext(ifMatch string) echo.Context {
+	req := httptest.NewRequest(http.MethodPut, "/", nil)
+	if ifMatch != "" {
+		req.Header.Set(HeaderIfMatch, ifMatch)
+	}
+
+	return echo.New().NewContext(req, httptest.NewRecorder())
+}
+
+func Test_Format_And_Parse(t *testing.T) {
+	assert.Equal(t, `"3"`, Format(3))
+
+	version, err := Parse(`"3"`)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), version)
+
+	version, err = Parse(`W/"4"`)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), version)
+
+	_, err = Parse(`3`)
+	assert.Error(t, err)
+
+	_, err = Parse(`"abc"`)
+	assert.Error(t, err)
+}
+
+func Test_IfMatchVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		ifMatch string
+		version int64
+		invalid bool
+	}{
+		{name: "absent", ifMatch: "", version: 0},
+		{name: "any", ifMatch: "*", version: 0},
+		{name: "version", ifMatch: `"7"`, version: 7},
+		{name: "list", ifMatch: `"7", "8"`, invalid: true},
+		{name: "unquoted", ifMatch: `7`, invalid: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			version, err := IfMatchVersion(newContext(test.ifMatch))
+			if test.invalid {
+				assert.True(t, customErrors.IsBadRequestError(err))
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.version, version)
+		})
+	}
+}
+
+func Test_SetETag(t *testing.T) {
+	c := newContext("")
+	SetETag(c, 2)
+
+	assert.Equal(t, `"2"`, c.Response().Header().Get(HeaderETag))
+}
@@ -0,0 +1,45 @@
+package auditactor
+
+import (
+	"github.com/labstack/echo/v4/middleware"
+
+	echo "github.com/labstack/echo/v4"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/authentication"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/postgresgorm/audit"
+)
+
+// AuditActor returns echo middleware which sets the authenticated principal of the request as the actor in the
+// request context, so the audit log entries of the request changes record who made them. It runs after the
+// Authentication middleware, an anonymous request keeps the default actor.
+func AuditActor(opts ...Option) echo.MiddlewareFunc {
+	cfg := config{}
+	for _, opt := range opts {
+		opt.apply(&cfg)
+	}
+
+	if cfg.Skipper == nil {
+		cfg.Skipper = middleware.DefaultSkipper
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if cfg.Skipper(c) {
+				return next(c)
+			}
+
+			request := c.Request()
+
+			principal, authenticated := authentication.PrincipalFromContext(request.Context())
+			if !authenticated {
+				return next(c)
+			}
+
+			if identity := principal.Identity(); identity != "" {
+				c.SetRequest(request.WithContext(audit.WithActor(request.Context(), identity)))
+			}
+
+			return next(c)
+		}
+	}
+}
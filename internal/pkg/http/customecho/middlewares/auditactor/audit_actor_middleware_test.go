@@ -0,0 +1,50 @@
+//go:build unit
+// +build unit
+
+// Package auditactor provides the audit actor middleware tests.
+package auditactor
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	echo "github.com/labstack/echo/v4"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/authentication"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/postgresgorm/audit"
+)
+
+func serve(t *testing.T, principal *authentication.Principal, userHeader string) string {
+	t.Helper()
+
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	request.Header.Set("X-User-Id", userHeader)
+	if principal != nil {
+		request = request.WithContext(authentication.WithPrincipal(request.Context(), principal))
+	}
+
+	var actor string
+	handler := AuditActor()(func(c echo.Context) error {
+		actor = audit.ActorFromContext(c.Request().Context())
+
+		return nil
+	})
+
+	require.NoError(t, handler(echo.New().NewContext(request, httptest.NewRecorder())))
+
+	return actor
+}
+
+func Test_AuditActor_Sets_The_Subject_Of_The_Principal(t *testing.T) {
+	actor := serve(t, &authentication.Principal{Subject: "user-1", Email: "john@example.com"}, "spoofed")
+	assert.Equal(t, "user-1", actor)
+}
+
+func Test_AuditActor_Ignores_The_User_Header_Of_An_Anonymous_Request(t *testing.T) {
+	actor := serve(t, nil, "spoofed")
+	assert.Equal(t, audit.DefaultActor, actor)
+}
@@ -0,0 +1,30 @@
+// Package auditactor provides a echo http server middleware that sets the actor of the audit log entries.
+package auditactor
+
+import "github.com/labstack/echo/v4/middleware"
+
+// config defines the config for AuditActor middleware.
+type config struct {
+	// Skipper defines a function to skip middleware.
+	Skipper middleware.Skipper
+}
+
+// Option specifies the middleware configuration options.
+type Option interface {
+	apply(*config)
+}
+
+// optionFunc is a function that represents a option func.
+type optionFunc func(*config)
+
+// apply is a function that applies the option.
+func (o optionFunc) apply(c *config) {
+	o(c)
+}
+
+// WithSkipper specifies a skipper for allowing requests to skip the middleware.
+func WithSkipper(skipper middleware.Skipper) Option {
+	return optionFunc(func(cfg *config) {
+		cfg.Skipper = skipper
+	})
+}
@@ -0,0 +1,55 @@
+package authentication
+
+import (
+	"strings"
+
+	"github.com/labstack/echo/v4/middleware"
+
+	echo "github.com/labstack/echo/v4"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/authentication"
+)
+
+// bearerScheme is the scheme of the authorization header of a bearer token.
+const bearerScheme = "Bearer "
+
+// Authentication returns echo middleware which validates the bearer token of the request and sets its principal in
+// the request context, a request without a token stays anonymous and the endpoints decide whether it is allowed.
+func Authentication(validator *authentication.TokenValidator, opts ...Option) echo.MiddlewareFunc {
+	cfg := config{}
+	for _, opt := range opts {
+		opt.apply(&cfg)
+	}
+
+	if cfg.Skipper == nil {
+		cfg.Skipper = middleware.DefaultSkipper
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if cfg.Skipper(c) || !validator.Enabled() {
+				return next(c)
+			}
+
+			header := c.Request().Header.Get(echo.HeaderAuthorization)
+			if header == "" {
+				return next(c)
+			}
+
+			if len(header) <= len(bearerScheme) || !strings.EqualFold(header[:len(bearerScheme)], bearerScheme) {
+				return customErrors.NewUnAuthorizedError("the authorization header is not a bearer token")
+			}
+
+			principal, err := validator.Validate(strings.TrimSpace(header[len(bearerScheme):]))
+			if err != nil {
+				return customErrors.NewUnAuthorizedErrorWrap(err, "the bearer token is not valid")
+			}
+
+			request := c.Request()
+			c.SetRequest(request.WithContext(authentication.WithPrincipal(request.Context(), principal)))
+
+			return next(c)
+		}
+	}
+}
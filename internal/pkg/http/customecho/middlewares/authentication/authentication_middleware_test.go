@@ -0,0 +1,103 @@
+//go:build unit
+// +build unit
+
+// Package authentication provides the authentication middleware tests.
+package authentication
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	echo "github.com/labstack/echo/v4"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/authentication"
+)
+
+func serve(t *testing.T, validator *authentication.TokenValidator, header string) (*authentication.Principal, error) {
+	t.Helper()
+
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	if header != "" {
+		request.Header.Set(echo.HeaderAuthorization, header)
+	}
+
+	var principal *authentication.Principal
+	handler := Authentication(validator)(func(c echo.Context) error {
+		principal, _ = authentication.PrincipalFromContext(c.Request().Context())
+
+		return nil
+	})
+
+	err := handler(echo.New().NewContext(request, httptest.NewRecorder()))
+
+	return principal, err
+}
+
+func Test_Authentication_Sets_The_Principal_Of_A_Valid_Token(t *testing.T) {
+	validator := authentication.NewTokenValidator(&authentication.AuthenticationOptions{SigningKey: "key"})
+	token, err := validator.Issue(&authentication.Principal{Subject: "user-1", Email: "john@example.com"}, time.Minute)
+	require.NoError(t, err)
+
+	principal, err := serve(t, validator, "Bearer "+token)
+	require.NoError(t, err)
+	require.NotNil(t, principal)
+	assert.Equal(t, "john@example.com", principal.Email)
+}
+
+func Test_Authentication_Keeps_A_Request_Without_A_Token_Anonymous(t *testing.T) {
+	validator := authentication.NewTokenValidator(&authentication.AuthenticationOptions{SigningKey: "key"})
+
+	principal, err := serve(t, validator, "")
+	require.NoError(t, err)
+	assert.Nil(t, principal)
+}
+
+func Test_Authentication_Rejects_An_Invalid_Token(t *testing.T) {
+	validator := authentication.NewTokenValidator(&authentication.AuthenticationOptions{SigningKey: "key"})
+
+	for _, header := range []string{"Bearer invalid", "Basic dXNlcjpwYXNz"} {
+		principal, err := serve(t, validator, header)
+		assert.True(t, customErrors.IsUnAuthorizedError(err), header)
+		assert.Nil(t, principal)
+	}
+}
+
+func serveWithRole(t *testing.T, principal *authentication.Principal, role string) error {
+	t.Helper()
+
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	if principal != nil {
+		request = request.WithContext(authentication.WithPrincipal(request.Context(), principal))
+	}
+
+	handler := RequireRole(role)(func(c echo.Context) error {
+		return nil
+	})
+
+	return handler(echo.New().NewContext(request, httptest.NewRecorder()))
+}
+
+func Test_RequireRole_Allows_A_Principal_With_The_Role(t *testing.T) {
+	err := serveWithRole(
+		t,
+		&authentication.Principal{Subject: "admin-1", Roles: []string{"Admin"}},
+		authentication.RoleAdmin,
+	)
+	assert.NoError(t, err)
+}
+
+func Test_RequireRole_Rejects_An_Anonymous_Request(t *testing.T) {
+	err := serveWithRole(t, nil, authentication.RoleAdmin)
+	assert.True(t, customErrors.IsUnAuthorizedError(err))
+}
+
+func Test_RequireRole_Forbids_A_Principal_Without_The_Role(t *testing.T) {
+	err := serveWithRole(t, &authentication.Principal{Subject: "user-1"}, authentication.RoleAdmin)
+	assert.True(t, customErrors.IsForbiddenError(err))
+}
@@ -0,0 +1,30 @@
+package authentication
+
+import (
+	"fmt"
+
+	echo "github.com/labstack/echo/v4"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/authentication"
+)
+
+// RequireRole returns echo middleware which allows the request only to an authenticated principal with the role,
+// it runs after the Authentication middleware, so an anonymous request is unauthorized even when authentication is
+// not configured.
+func RequireRole(role string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			principal, authenticated := authentication.PrincipalFromContext(c.Request().Context())
+			if !authenticated {
+				return customErrors.NewUnAuthorizedError("the endpoint is only allowed to an authenticated caller")
+			}
+
+			if !principal.HasRole(role) {
+				return customErrors.NewForbiddenError(fmt.Sprintf("the endpoint requires the '%s' role", role))
+			}
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Package idempotency provides a echo http server middleware that replays the first response of an idempotency key.
+package idempotency
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4/middleware"
+)
+
+// ReplayedHeader is the response header set on the replayed responses.
+const ReplayedHeader = "Idempotent-Replayed"
+
+// config defines the config for Idempotency middleware.
+type config struct {
+	// Skipper defines a function to skip middleware.
+	Skipper middleware.Skipper
+	methods map[string]bool
+}
+
+// Option specifies the middleware configuration options.
+type Option interface {
+	apply(*config)
+}
+
+// optionFunc is a function that represents a option func.
+type optionFunc func(*config)
+
+// apply is a function that applies the option.
+func (o optionFunc) apply(c *config) {
+	o(c)
+}
+
+// defaultMethods are the methods that create or change a resource and aren't idempotent by themselves.
+func defaultMethods() map[string]bool {
+	return map[string]bool{http.MethodPost: true, http.MethodPatch: true}
+}
+
+// WithSkipper specifies a skipper for allowing requests to skip the middleware.
+func WithSkipper(skipper middleware.Skipper) Option {
+	return optionFunc(func(cfg *config) {
+		cfg.Skipper = skipper
+	})
+}
+
+// WithMethods specifies the request methods guarded by the middleware.
+func WithMethods(methods ...string) Option {
+	return optionFunc(func(cfg *config) {
+		if len(methods) == 0 {
+			return
+		}
+
+		cfg.methods = make(map[string]bool, len(methods))
+		for _, method := range methods {
+			cfg.methods[method] = true
+		}
+	})
+}
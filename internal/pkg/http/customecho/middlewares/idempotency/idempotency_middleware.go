@@ -0,0 +1,174 @@
+// Package idempotency provides a echo http server middleware that replays the first response of an idempotency key.
+package idempotency
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net"
+	"net/http"
+
+	"emperror.dev/errors"
+	"github.com/labstack/echo/v4/middleware"
+
+	echo "github.com/labstack/echo/v4"
+
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/idempotency"
+)
+
+// Idempotency returns echo middleware which stores the first response of the `Idempotency-Key` of a caller and
+// replays it for the retries, so a retried command doesn't create a duplicate. A retry while the first request is
+// still in-flight gets a 409 and a key reused for a different request gets a 422.
+func Idempotency(manager *idempotency.Manager, opts ...Option) echo.MiddlewareFunc {
+	cfg := config{methods: defaultMethods()}
+	for _, opt := range opts {
+		opt.apply(&cfg)
+	}
+
+	if cfg.Skipper == nil {
+		cfg.Skipper = middleware.DefaultSkipper
+	}
+
+	options := manager.Options()
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			request := c.Request()
+			key := request.Header.Get(options.Header)
+
+			if !options.Enabled || key == "" || !cfg.methods[request.Method] || cfg.Skipper(c) {
+				return next(c)
+			}
+
+			body, err := io.ReadAll(request.Body)
+			if err != nil {
+				return customErrors.NewBadRequestErrorWrap(err, "error in reading the request body")
+			}
+			request.Body = io.NopCloser(bytes.NewReader(body))
+
+			ctx := request.Context()
+			principal := manager.Principal(ctx, request.Header.Get(options.PrincipalHeader))
+			fingerprint := idempotency.Fingerprint(
+				[]byte(request.Method),
+				[]byte(request.URL.RequestURI()),
+				body,
+			)
+
+			record, err := manager.Begin(ctx, principal, key, fingerprint)
+			if err != nil {
+				return toHTTPError(err)
+			}
+
+			if record != nil {
+				return replay(c, record)
+			}
+
+			response := c.Response()
+			writer := &captureResponseWriter{ResponseWriter: response.Writer, body: &bytes.Buffer{}}
+			response.Writer = writer
+
+			if err := next(c); err != nil || response.Status >= http.StatusInternalServerError {
+				// the failed requests are not stored, so the caller can retry them with the same key
+				if releaseErr := manager.Release(ctx, principal, key); releaseErr != nil {
+					c.Logger().Errorf("error in releasing the idempotency key: %v", releaseErr)
+				}
+
+				return err
+			}
+
+			if err := manager.Complete(
+				ctx,
+				principal,
+				key,
+				fingerprint,
+				response.Status,
+				storedHeaders(response.Header()),
+				writer.body.Bytes(),
+			); err != nil {
+				c.Logger().Errorf("error in storing the idempotent response: %v", err)
+			}
+
+			return nil
+		}
+	}
+}
+
+// transportHeaders are set by the outer middlewares for each response, they are not replayed.
+var transportHeaders = map[string]bool{
+	echo.HeaderContentEncoding: true,
+	echo.HeaderContentLength:   true,
+	echo.HeaderVary:            true,
+	echo.HeaderXRequestID:      true,
+	"Transfer-Encoding":        true,
+}
+
+// storedHeaders returns the response headers of the first request without the transport headers.
+func storedHeaders(header http.Header) map[string][]string {
+	headers := make(map[string][]string, len(header))
+	for name, values := range header {
+		if transportHeaders[http.CanonicalHeaderKey(name)] {
+			continue
+		}
+		headers[name] = append([]string(nil), values...)
+	}
+
+	return headers
+}
+
+// replay writes the stored response of the first request.
+func replay(c echo.Context, record *idempotency.Record) error {
+	header := c.Response().Header()
+	for name, values := range record.Headers {
+		header[name] = values
+	}
+	header.Set(ReplayedHeader, "true")
+
+	contentType := header.Get(echo.HeaderContentType)
+
+	return c.Blob(record.StatusCode, contentType, record.Body)
+}
+
+// toHTTPError converts the errors of the idempotency manager to the http errors.
+func toHTTPError(err error) error {
+	switch {
+	case errors.Is(err, idempotency.ErrRequestInFlight):
+		return customErrors.NewConflictErrorWrap(err, err.Error())
+	case errors.Is(err, idempotency.ErrKeyReused):
+		return customErrors.NewCustomError(err, http.StatusUnprocessableEntity, err.Error())
+	case errors.Is(err, idempotency.ErrKeyTooLong):
+		return customErrors.NewBadRequestErrorWrap(err, err.Error())
+	default:
+		return errors.WrapIf(err, "error in checking the idempotency key")
+	}
+}
+
+// captureResponseWriter is a response writer that keeps a copy of the written body.
+type captureResponseWriter struct {
+	http.ResponseWriter
+	body *bytes.Buffer
+}
+
+// Write writes the data to the connection and the copy.
+func (w *captureResponseWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush implements the http.Flusher interface.
+func (w *captureResponseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack implements the http.Hijacker interface.
+func (w *captureResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return http.NewResponseController(w.ResponseWriter).Hijack()
+}
+
+// Unwrap returns the original response writer for the http.ResponseController.
+func (w *captureResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
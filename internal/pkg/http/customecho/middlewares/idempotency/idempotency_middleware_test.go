@@ -0,0 +1,160 @@
+//go:build unit
+// +build unit
+
+// Package idempotency provides the idempotency middleware tests.
+package idempotency
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	echo "github.com/labstack/echo/v4"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/authentication"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/idempotency"
+)
+
+func newTestManager(tokenValidator *authentication.TokenValidator) *idempotency.Manager {
+	return idempotency.NewManager(idempotency.NewInMemoryStore(), &idempotency.IdempotencyOptions{
+		Enabled:         true,
+		Header:          "Idempotency-Key",
+		PrincipalHeader: "X-User-Id",
+		TTL:             time.Hour,
+		LockTimeout:     time.Minute,
+		MaxKeyLength:    255,
+		KeyPrefix:       "idempotency",
+	}, tokenValidator)
+}
+
+func serve(handler echo.HandlerFunc, body string, key string) (*httptest.ResponseRecorder, error) {
+	return serveAs(handler, body, key, "")
+}
+
+// serveAs serves the request of an authenticated principal, the principal header names another caller.
+func serveAs(handler echo.HandlerFunc, body string, key string, subject string) (*httptest.ResponseRecorder, error) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/orders", strings.NewReader(body))
+	if key != "" {
+		req.Header.Set("Idempotency-Key", key)
+	}
+	req.Header.Set("X-User-Id", "spoofed")
+	if subject != "" {
+		req = req.WithContext(
+			authentication.WithPrincipal(req.Context(), &authentication.Principal{Subject: subject}),
+		)
+	}
+	rec := httptest.NewRecorder()
+
+	return rec, handler(e.NewContext(req, rec))
+}
+
+func Test_Idempotency_Replays_The_First_Response(t *testing.T) {
+	calls := 0
+	handler := Idempotency(newTestManager(nil))(func(c echo.Context) error {
+		calls++
+
+		return c.JSON(http.StatusCreated, map[string]int{"call": calls})
+	})
+
+	first, err := serve(handler, `{"a":1}`, "key")
+	assert.NoError(t, err)
+
+	second, err := serve(handler, `{"a":1}`, "key")
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, http.StatusCreated, second.Code)
+	assert.Equal(t, first.Body.String(), second.Body.String())
+	assert.Equal(t, "true", second.Header().Get(ReplayedHeader))
+}
+
+func Test_Idempotency_Rejects_A_Concurrent_Duplicate(t *testing.T) {
+	var duplicateErr error
+	var handler echo.HandlerFunc
+	handler = Idempotency(newTestManager(nil))(func(c echo.Context) error {
+		// the duplicate arrives while the first request is in-flight
+		_, duplicateErr = serve(handler, `{"a":1}`, "key")
+
+		return c.NoContent(http.StatusCreated)
+	})
+
+	_, err := serve(handler, `{"a":1}`, "key")
+	assert.NoError(t, err)
+	assert.True(t, customErrors.IsConflictError(duplicateErr))
+}
+
+func Test_Idempotency_Rejects_A_Key_Reused_For_A_Different_Body(t *testing.T) {
+	handler := Idempotency(newTestManager(nil))(func(c echo.Context) error {
+		return c.NoContent(http.StatusCreated)
+	})
+
+	_, err := serve(handler, `{"a":1}`, "key")
+	assert.NoError(t, err)
+
+	_, err = serve(handler, `{"a":2}`, "key")
+	assert.Equal(t, http.StatusUnprocessableEntity, customErrors.GetCustomError(err).Status())
+}
+
+func Test_Idempotency_Releases_The_Key_Of_A_Failed_Request(t *testing.T) {
+	calls := 0
+	handler := Idempotency(newTestManager(nil))(func(c echo.Context) error {
+		calls++
+		if calls == 1 {
+			return customErrors.NewInternalServerError("failed")
+		}
+
+		return c.NoContent(http.StatusCreated)
+	})
+
+	_, err := serve(handler, `{"a":1}`, "key")
+	assert.Error(t, err)
+
+	rec, err := serve(handler, `{"a":1}`, "key")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+}
+
+func Test_Idempotency_Skips_The_Requests_Without_A_Key(t *testing.T) {
+	calls := 0
+	handler := Idempotency(newTestManager(nil))(func(c echo.Context) error {
+		calls++
+
+		return c.NoContent(http.StatusCreated)
+	})
+
+	_, _ = serve(handler, `{"a":1}`, "")
+	_, _ = serve(handler, `{"a":1}`, "")
+
+	assert.Equal(t, 2, calls)
+}
+
+func Test_Idempotency_Scopes_The_Keys_By_The_Authenticated_Principal(t *testing.T) {
+	tokenValidator := authentication.NewTokenValidator(&authentication.AuthenticationOptions{SigningKey: "key"})
+
+	calls := 0
+	handler := Idempotency(newTestManager(tokenValidator))(func(c echo.Context) error {
+		calls++
+
+		return c.NoContent(http.StatusCreated)
+	})
+
+	// the same principal header doesn't share the keys of the authenticated callers
+	_, err := serveAs(handler, `{"a":1}`, "key", "john")
+	assert.NoError(t, err)
+
+	rec, err := serveAs(handler, `{"a":1}`, "key", "jane")
+	assert.NoError(t, err)
+	assert.Empty(t, rec.Header().Get(ReplayedHeader))
+
+	rec, err = serveAs(handler, `{"a":1}`, "key", "john")
+	assert.NoError(t, err)
+	assert.Equal(t, "true", rec.Header().Get(ReplayedHeader))
+	assert.Equal(t, 2, calls)
+}
@@ -506,3 +506,22 @@ func TestConflictErr(t *testing.T) {
 		defaultLogger.Info(errorUtils.ErrorsWithStack(err))
 	}
 }
+
+// TestPreconditionFailedErr tests the precondition failed error.
+func TestPreconditionFailedErr(t *testing.T) {
+	t.Parallel()
+	rootErr := errors.NewPlain("version 2 doesn't match 3")
+	preconditionErr := NewPreconditionFailedErrorWrap(rootErr, "product was modified")
+	err := errors.WithMessage(preconditionErr, "this is a top error message")
+
+	assert.True(t, IsCustomError(err))
+	assert.True(t, IsPreconditionFailedError(err))
+	assert.False(t, IsConflictError(err))
+	assert.False(t, IsPreconditionFailedError(NewConflictError("conflict error")))
+
+	var preconditionFailedError PreconditionFailedError
+	errors.As(err, &preconditionFailedError)
+
+	assert.Equal(t, http.StatusPreconditionFailed, preconditionFailedError.Status())
+	assert.Equal(t, "product was modified", preconditionFailedError.Message())
+}
@@ -0,0 +1,61 @@
+// Package customerrors provides custom errors.
+package customerrors
+
+import (
+	"net/http"
+
+	"emperror.dev/errors"
+)
+
+// NewPreconditionFailedError creates a new precondition failed error, e.g. for a stale `If-Match` version.
+func NewPreconditionFailedError(message string) PreconditionFailedError {
+	// `NewPlain` doesn't add stack-trace at all
+	preconditionErrMessage := errors.NewPlain("precondition failed error")
+	// `WrapIf` add stack-trace if not added before
+	stackErr := errors.WrapIf(preconditionErrMessage, message)
+
+	preconditionFailedError := &preconditionFailedError{
+		CustomError: NewCustomError(stackErr, http.StatusPreconditionFailed, message),
+	}
+
+	return preconditionFailedError
+}
+
+// NewPreconditionFailedErrorWrap creates a new precondition failed error wrap.
+func NewPreconditionFailedErrorWrap(err error, message string) PreconditionFailedError {
+	if err == nil {
+		return NewPreconditionFailedError(message)
+	}
+
+	// `WithMessage` doesn't add stack-trace at all
+	preconditionErrMessage := errors.WithMessage(err, "precondition failed error")
+	// `WrapIf` add stack-trace if not added before
+	stackErr := errors.WrapIf(preconditionErrMessage, message)
+
+	preconditionFailedError := &preconditionFailedError{
+		CustomError: NewCustomError(stackErr, http.StatusPreconditionFailed, message),
+	}
+
+	return preconditionFailedError
+}
+
+// preconditionFailedError is a struct that represents a precondition failed error.
+type preconditionFailedError struct {
+	CustomError
+}
+
+// PreconditionFailedError is a contract that represents a precondition failed error.
+type PreconditionFailedError interface {
+	CustomError
+	isPreconditionFailedError()
+}
+
+func (p *preconditionFailedError) isPreconditionFailedError() {
+}
+
+// IsPreconditionFailedError checks if the error is a precondition failed error.
+func IsPreconditionFailedError(err error) bool {
+	var preconditionFailedError PreconditionFailedError
+
+	return errors.As(err, &preconditionFailedError)
+}
@@ -34,6 +34,18 @@ func NewConflictProblemDetail(detail string, stackTrace string) ProblemDetailErr
 	}
 }
 
+// NewPreconditionFailedProblemDetail creates a new precondition failed problem detail.
+func NewPreconditionFailedProblemDetail(detail string, stackTrace string) ProblemDetailErr {
+	return &problemDetail{
+		Title:      constants.ErrPreconditionFailedTitle,
+		Detail:     detail,
+		Status:     http.StatusPreconditionFailed,
+		Type:       getDefaultType(http.StatusPreconditionFailed),
+		Timestamp:  time.Now(),
+		StackTrace: stackTrace,
+	}
+}
+
 // NewBadRequestProblemDetail creates a new bad request problem detail.
 func NewBadRequestProblemDetail(detail string, stackTrace string) ProblemDetailErr {
 	return &problemDetail{
@@ -87,6 +87,8 @@ func ParseError(err error) ProblemDetailErr {
 			return NewForbiddenProblemDetail(customErr.Error(), stackTrace)
 		case customErrors.IsConflictError(err):
 			return NewConflictProblemDetail(customErr.Error(), stackTrace)
+		case customErrors.IsPreconditionFailedError(err):
+			return NewPreconditionFailedProblemDetail(customErr.Error(), stackTrace)
 		case customErrors.IsInternalServerError(err):
 			return NewInternalServerProblemDetail(customErr.Error(), stackTrace)
 		case customErrors.IsCustomError(err):
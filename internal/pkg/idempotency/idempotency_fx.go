@@ -0,0 +1,19 @@
+// Package idempotency provides the idempotency fx module.
+package idempotency
+
+import (
+	"go.uber.org/fx"
+)
+
+// Module provided to fxlog, it needs the redis module for the store.
+var Module = fx.Module(
+	"idempotencyfx",
+	fx.Provide(
+		ProvideConfig,
+		NewRedisStore,
+		fx.Annotate(
+			NewManager,
+			fx.ParamTags(``, ``, `optional:"true"`),
+		),
+	),
+)
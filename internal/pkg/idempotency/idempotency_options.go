@@ -0,0 +1,38 @@
+// Package idempotency provides the idempotency options.
+package idempotency
+
+import (
+	"time"
+
+	"github.com/iancoleman/strcase"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/config"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/config/environment"
+	typeMapper "github.com/raphaeldiscky/go-food-micro/internal/pkg/reflection/typemapper"
+)
+
+// optionName is the name of the option for the idempotency keys.
+var optionName = strcase.ToLowerCamel(typeMapper.GetGenericTypeNameByT[IdempotencyOptions]())
+
+// IdempotencyOptions is a struct that contains the options for the idempotency keys.
+type IdempotencyOptions struct {
+	Enabled bool `mapstructure:"enabled"         default:"true"`
+	// Header is the http header of the key, the grpc metadata key is its lower case form.
+	Header string `mapstructure:"header"          default:"Idempotency-Key"`
+	// PrincipalHeader is the header of the caller set by the gateway, the keys are scoped per caller, it is only used
+	// when authentication is disabled, otherwise the keys are scoped by the subject of the bearer token.
+	PrincipalHeader string `mapstructure:"principalHeader" default:"X-User-Id"`
+	// TTL is how long the first response of a key is replayed.
+	TTL time.Duration `mapstructure:"ttl"             default:"24h"`
+	// LockTimeout is how long a key stays in-flight, so a crashed request doesn't block its key forever.
+	LockTimeout time.Duration `mapstructure:"lockTimeout"     default:"1m"`
+	// MaxKeyLength is the maximum length of a key.
+	MaxKeyLength int `mapstructure:"maxKeyLength"    default:"255"`
+	// KeyPrefix is the prefix of the keys in the store.
+	KeyPrefix string `mapstructure:"keyPrefix"       default:"idempotency"`
+}
+
+// ProvideConfig provides the config for the idempotency keys.
+func ProvideConfig(environment environment.Environment) (*IdempotencyOptions, error) {
+	return config.BindConfigKey[*IdempotencyOptions](optionName, environment)
+}
@@ -0,0 +1,64 @@
+// Package idempotency provides the in-memory store of the idempotency records.
+package idempotency
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// inMemoryEntry is a record with its expiry.
+type inMemoryEntry struct {
+	record    *Record
+	expiresAt time.Time
+}
+
+// inMemoryStore is a store that keeps the records in the memory of a single instance, for the tests and local runs.
+type inMemoryStore struct {
+	mu      sync.Mutex
+	entries map[string]inMemoryEntry
+}
+
+// NewInMemoryStore creates a new in-memory store.
+func NewInMemoryStore() Store {
+	return &inMemoryStore{entries: make(map[string]inMemoryEntry)}
+}
+
+// Acquire saves the record when the key is free, otherwise it returns the existing record.
+func (s *inMemoryStore) Acquire(
+	_ context.Context,
+	key string,
+	record *Record,
+	ttl time.Duration,
+) (*Record, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if entry, ok := s.entries[key]; ok && time.Now().Before(entry.expiresAt) {
+		return entry.record, false, nil
+	}
+
+	s.entries[key] = inMemoryEntry{record: record, expiresAt: time.Now().Add(ttl)}
+
+	return nil, true, nil
+}
+
+// Save replaces the record of the key.
+func (s *inMemoryStore) Save(_ context.Context, key string, record *Record, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[key] = inMemoryEntry{record: record, expiresAt: time.Now().Add(ttl)}
+
+	return nil
+}
+
+// Delete removes the record of the key.
+func (s *inMemoryStore) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+
+	return nil
+}
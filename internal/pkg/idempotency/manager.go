@@ -0,0 +1,146 @@
+// Package idempotency provides the manager of the idempotency keys.
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/authentication"
+)
+
+var (
+	// ErrRequestInFlight is returned when the first request of the key is still being processed.
+	ErrRequestInFlight = errors.New("a request with the same idempotency key is in progress")
+	// ErrKeyReused is returned when the key was already used for a different request.
+	ErrKeyReused = errors.New("the idempotency key was already used for a different request")
+	// ErrKeyTooLong is returned when the key is longer than the allowed length.
+	ErrKeyTooLong = errors.New("the idempotency key is too long")
+)
+
+// Manager scopes the idempotency keys per caller and guards them in the store.
+type Manager struct {
+	store          Store
+	options        *IdempotencyOptions
+	tokenValidator *authentication.TokenValidator
+}
+
+// NewManager creates a new idempotency manager, the keys are scoped by the authenticated principal when the token
+// validator is enabled.
+func NewManager(
+	store Store,
+	options *IdempotencyOptions,
+	tokenValidator *authentication.TokenValidator,
+) *Manager {
+	return &Manager{store: store, options: options, tokenValidator: tokenValidator}
+}
+
+// Options returns the idempotency options.
+func (m *Manager) Options() *IdempotencyOptions {
+	return m.options
+}
+
+// Principal returns the caller the keys of a request are scoped by, the subject of the authenticated principal of the
+// context, the principal header set by the gateway is only trusted when authentication is disabled, since any caller
+// could set it.
+func (m *Manager) Principal(ctx context.Context, principalHeader string) string {
+	if !m.tokenValidator.Enabled() {
+		return principalHeader
+	}
+
+	if principal, ok := authentication.PrincipalFromContext(ctx); ok {
+		return principal.Subject
+	}
+
+	return ""
+}
+
+// Fingerprint returns the hash of the parts of a request, to detect a key reused for a different request.
+func Fingerprint(parts ...[]byte) string {
+	hash := sha256.New()
+	for _, part := range parts {
+		// length prefix keeps `ab`+`c` and `a`+`bc` apart
+		_, _ = fmt.Fprintf(hash, "%d:", len(part))
+		_, _ = hash.Write(part)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// Begin locks the key of the caller for a new request, or returns the completed record of the first request to replay.
+func (m *Manager) Begin(
+	ctx context.Context,
+	principal string,
+	key string,
+	fingerprint string,
+) (*Record, error) {
+	if len(key) > m.options.MaxKeyLength {
+		return nil, ErrKeyTooLong
+	}
+
+	record := &Record{Fingerprint: fingerprint, CreatedAt: time.Now().UTC()}
+
+	existing, acquired, err := m.store.Acquire(
+		ctx,
+		m.storeKey(principal, key),
+		record,
+		m.options.LockTimeout,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if acquired {
+		return nil, nil
+	}
+
+	if existing.Fingerprint != fingerprint {
+		return nil, ErrKeyReused
+	}
+
+	if !existing.Completed {
+		return nil, ErrRequestInFlight
+	}
+
+	return existing, nil
+}
+
+// Complete stores the response of the first request of the key, to replay it for the duplicates.
+func (m *Manager) Complete(
+	ctx context.Context,
+	principal string,
+	key string,
+	fingerprint string,
+	statusCode int,
+	headers map[string][]string,
+	body []byte,
+) error {
+	record := &Record{
+		Fingerprint: fingerprint,
+		Completed:   true,
+		StatusCode:  statusCode,
+		Headers:     headers,
+		Body:        body,
+		CreatedAt:   time.Now().UTC(),
+	}
+
+	return m.store.Save(ctx, m.storeKey(principal, key), record, m.options.TTL)
+}
+
+// Release unlocks the key of a failed request, so the caller can retry it.
+func (m *Manager) Release(ctx context.Context, principal string, key string) error {
+	return m.store.Delete(ctx, m.storeKey(principal, key))
+}
+
+// storeKey returns the key of the record in the store.
+func (m *Manager) storeKey(principal string, key string) string {
+	if principal == "" {
+		principal = "anonymous"
+	}
+
+	return fmt.Sprintf("%s:%s:%s", m.options.KeyPrefix, principal, key)
+}
@@ -0,0 +1,133 @@
+//go:build unit
+// +build unit
+
+// Package idempotency provides the idempotency manager tests.
+package idempotency
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/authentication"
+)
+
+func newTestManager() *Manager {
+	return NewManager(NewInMemoryStore(), &IdempotencyOptions{
+		Enabled:      true,
+		TTL:          time.Hour,
+		LockTimeout:  time.Minute,
+		MaxKeyLength: 16,
+		KeyPrefix:    "idempotency",
+	}, nil)
+}
+
+func Test_Begin_Acquires_A_New_Key(t *testing.T) {
+	manager := newTestManager()
+
+	record, err := manager.Begin(context.Background(), "user", "key", Fingerprint([]byte("a")))
+	require.NoError(t, err)
+	assert.Nil(t, record)
+}
+
+func Test_Begin_Returns_In_Flight_For_A_Concurrent_Duplicate(t *testing.T) {
+	manager := newTestManager()
+	ctx := context.Background()
+	fingerprint := Fingerprint([]byte("a"))
+
+	_, err := manager.Begin(ctx, "user", "key", fingerprint)
+	require.NoError(t, err)
+
+	_, err = manager.Begin(ctx, "user", "key", fingerprint)
+	assert.ErrorIs(t, err, ErrRequestInFlight)
+}
+
+func Test_Begin_Replays_The_Completed_Response(t *testing.T) {
+	manager := newTestManager()
+	ctx := context.Background()
+	fingerprint := Fingerprint([]byte("a"))
+
+	_, err := manager.Begin(ctx, "user", "key", fingerprint)
+	require.NoError(t, err)
+	require.NoError(
+		t,
+		manager.Complete(ctx, "user", "key", fingerprint, http.StatusCreated, nil, []byte(`{"id":1}`)),
+	)
+
+	record, err := manager.Begin(ctx, "user", "key", fingerprint)
+	require.NoError(t, err)
+	require.NotNil(t, record)
+	assert.Equal(t, http.StatusCreated, record.StatusCode)
+	assert.Equal(t, `{"id":1}`, string(record.Body))
+}
+
+func Test_Begin_Rejects_A_Key_Reused_For_A_Different_Request(t *testing.T) {
+	manager := newTestManager()
+	ctx := context.Background()
+
+	_, err := manager.Begin(ctx, "user", "key", Fingerprint([]byte("a")))
+	require.NoError(t, err)
+
+	_, err = manager.Begin(ctx, "user", "key", Fingerprint([]byte("b")))
+	assert.ErrorIs(t, err, ErrKeyReused)
+}
+
+func Test_Begin_Scopes_The_Keys_Per_Principal(t *testing.T) {
+	manager := newTestManager()
+	ctx := context.Background()
+	fingerprint := Fingerprint([]byte("a"))
+
+	_, err := manager.Begin(ctx, "user-1", "key", fingerprint)
+	require.NoError(t, err)
+
+	record, err := manager.Begin(ctx, "user-2", "key", fingerprint)
+	require.NoError(t, err)
+	assert.Nil(t, record)
+}
+
+func Test_Release_Frees_The_Key(t *testing.T) {
+	manager := newTestManager()
+	ctx := context.Background()
+	fingerprint := Fingerprint([]byte("a"))
+
+	_, err := manager.Begin(ctx, "user", "key", fingerprint)
+	require.NoError(t, err)
+	require.NoError(t, manager.Release(ctx, "user", "key"))
+
+	record, err := manager.Begin(ctx, "user", "key", fingerprint)
+	require.NoError(t, err)
+	assert.Nil(t, record)
+}
+
+func Test_Begin_Rejects_A_Too_Long_Key(t *testing.T) {
+	manager := newTestManager()
+
+	_, err := manager.Begin(context.Background(), "user", "a-key-longer-than-16", "")
+	assert.ErrorIs(t, err, ErrKeyTooLong)
+}
+
+func Test_Fingerprint_Separates_The_Parts(t *testing.T) {
+	assert.NotEqual(
+		t,
+		Fingerprint([]byte("ab"), []byte("c")),
+		Fingerprint([]byte("a"), []byte("bc")),
+	)
+}
+
+func Test_Principal_Trusts_The_Header_Only_Without_Authentication(t *testing.T) {
+	ctx := authentication.WithPrincipal(context.Background(), &authentication.Principal{Subject: "user"})
+
+	assert.Equal(t, "header", newTestManager().Principal(ctx, "header"))
+
+	manager := NewManager(
+		NewInMemoryStore(),
+		&IdempotencyOptions{Enabled: true},
+		authentication.NewTokenValidator(&authentication.AuthenticationOptions{SigningKey: "key"}),
+	)
+	assert.Equal(t, "user", manager.Principal(ctx, "header"))
+	assert.Empty(t, manager.Principal(context.Background(), "header"))
+}
@@ -0,0 +1,83 @@
+// Package idempotency provides the redis store of the idempotency records.
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"emperror.dev/errors"
+
+	redis "github.com/redis/go-redis/v9"
+)
+
+// redisStore is a store that keeps the records in redis, they expire with their ttl.
+type redisStore struct {
+	client redis.UniversalClient
+}
+
+// NewRedisStore creates a new redis store.
+func NewRedisStore(client redis.UniversalClient) Store {
+	return &redisStore{client: client}
+}
+
+// Acquire saves the record with `SET NX` when the key is free, otherwise it returns the existing record.
+func (s *redisStore) Acquire(
+	ctx context.Context,
+	key string,
+	record *Record,
+	ttl time.Duration,
+) (*Record, bool, error) {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return nil, false, errors.WrapIf(err, "error in marshaling the idempotency record")
+	}
+
+	acquired, err := s.client.SetNX(ctx, key, data, ttl).Result()
+	if err != nil {
+		return nil, false, errors.WrapIf(err, "error in acquiring the idempotency key")
+	}
+
+	if acquired {
+		return nil, true, nil
+	}
+
+	existing, err := s.client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		// the existing record expired in between, the caller treats it as in-flight and retries
+		return &Record{Fingerprint: record.Fingerprint}, false, nil
+	}
+	if err != nil {
+		return nil, false, errors.WrapIf(err, "error in getting the idempotency record")
+	}
+
+	existingRecord := &Record{}
+	if err := json.Unmarshal(existing, existingRecord); err != nil {
+		return nil, false, errors.WrapIf(err, "error in unmarshaling the idempotency record")
+	}
+
+	return existingRecord, false, nil
+}
+
+// Save replaces the record of the key.
+func (s *redisStore) Save(ctx context.Context, key string, record *Record, ttl time.Duration) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return errors.WrapIf(err, "error in marshaling the idempotency record")
+	}
+
+	if err := s.client.Set(ctx, key, data, ttl).Err(); err != nil {
+		return errors.WrapIf(err, "error in saving the idempotency record")
+	}
+
+	return nil
+}
+
+// Delete removes the record of the key.
+func (s *redisStore) Delete(ctx context.Context, key string) error {
+	if err := s.client.Del(ctx, key).Err(); err != nil {
+		return errors.WrapIf(err, "error in deleting the idempotency record")
+	}
+
+	return nil
+}
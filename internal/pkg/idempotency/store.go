@@ -0,0 +1,28 @@
+// Package idempotency provides the store of the idempotency records.
+package idempotency
+
+import (
+	"context"
+	"time"
+)
+
+// Record is the state of an idempotency key, the response is set once the first request completed.
+type Record struct {
+	// Fingerprint is the hash of the first request, a key can't be reused for a different request.
+	Fingerprint string              `json:"fingerprint"`
+	Completed   bool                `json:"completed"`
+	StatusCode  int                 `json:"statusCode,omitempty"`
+	Headers     map[string][]string `json:"headers,omitempty"`
+	Body        []byte              `json:"body,omitempty"`
+	CreatedAt   time.Time           `json:"createdAt"`
+}
+
+// Store persists the idempotency records.
+type Store interface {
+	// Acquire saves the record when the key is free and returns true, otherwise it returns the existing record.
+	Acquire(ctx context.Context, key string, record *Record, ttl time.Duration) (*Record, bool, error)
+	// Save replaces the record of the key.
+	Save(ctx context.Context, key string, record *Record, ttl time.Duration) error
+	// Delete removes the record of the key.
+	Delete(ctx context.Context, key string) error
+}
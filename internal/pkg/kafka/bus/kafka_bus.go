@@ -0,0 +1,289 @@
+// Package bus provides a kafka bus.
+package bus
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"emperror.dev/errors"
+	"github.com/samber/lo"
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/bus"
+	consumer2 "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/consumer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/producer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/transform"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/utils"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/metadata"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/kafka/config"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/kafka/configurations"
+	kafkaConsumer "github.com/raphaeldiscky/go-food-micro/internal/pkg/kafka/consumer"
+	consumerConfigurations "github.com/raphaeldiscky/go-food-micro/internal/pkg/kafka/consumer/configurations"
+	kafkaProducer "github.com/raphaeldiscky/go-food-micro/internal/pkg/kafka/producer"
+	producerConfigurations "github.com/raphaeldiscky/go-food-micro/internal/pkg/kafka/producer/configurations"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+	typeMapper "github.com/raphaeldiscky/go-food-micro/internal/pkg/reflection/typemapper"
+)
+
+// KafkaBus is the interface for the kafka bus.
+type KafkaBus interface {
+	bus.Bus
+	producer.BatchProducer
+	consumerConfigurations.KafkaConsumerConnector
+}
+
+// kafkaBus is a struct that represents a kafka bus.
+type kafkaBus struct {
+	messageTypeConsumers    map[reflect.Type][]consumer2.Consumer
+	producer                producer.BatchProducer
+	kafkaOptions            *config.KafkaOptions
+	kafkaConfiguration      *configurations.KafkaConfiguration
+	logger                  logger.Logger
+	messageSerializer       serializer.MessageSerializer
+	transforms              *transform.Pipeline
+	isConsumedNotifications []func(message types.IMessage)
+	isProducedNotifications []func(message types.IMessage)
+}
+
+// NewKafkaBus creates a new kafka bus.
+func NewKafkaBus(
+	kafkaOptions *config.KafkaOptions,
+	client *kgo.Client,
+	logger logger.Logger,
+	messageSerializer serializer.MessageSerializer,
+	transforms *transform.Pipeline,
+	kafkaBuilderFunc configurations.KafkaConfigurationBuilderFuc,
+) (KafkaBus, error) {
+	builder := configurations.NewKafkaConfigurationBuilder()
+	if kafkaBuilderFunc != nil {
+		kafkaBuilderFunc(builder)
+	}
+
+	kafkaBus := &kafkaBus{
+		logger:               logger,
+		kafkaOptions:         kafkaOptions,
+		kafkaConfiguration:   builder.Build(),
+		messageSerializer:    messageSerializer,
+		transforms:           transforms,
+		messageTypeConsumers: map[reflect.Type][]consumer2.Consumer{},
+	}
+
+	producersConfigurationMap := make(map[string]*producerConfigurations.KafkaProducerConfiguration)
+	lo.ForEach(
+		kafkaBus.kafkaConfiguration.ProducersConfigurations,
+		func(config *producerConfigurations.KafkaProducerConfiguration, _ int) {
+			producersConfigurationMap[config.ProducerMessageType.String()] = config
+		},
+	)
+
+	for _, consumerConfiguration := range kafkaBus.kafkaConfiguration.ConsumersConfigurations {
+		kafkaConsumer, err := kafkaBus.createConsumer(consumerConfiguration)
+		if err != nil {
+			return nil, err
+		}
+		kafkaBus.messageTypeConsumers[consumerConfiguration.ConsumerMessageType] = append(
+			kafkaBus.messageTypeConsumers[consumerConfiguration.ConsumerMessageType],
+			kafkaConsumer,
+		)
+	}
+
+	kafkaBus.producer = kafkaProducer.NewKafkaProducer(
+		kafkaOptions,
+		client,
+		producersConfigurationMap,
+		logger,
+		messageSerializer,
+		transforms,
+		// IsProduced Notification
+		func(message types.IMessage) {
+			for _, notification := range kafkaBus.isProducedNotifications {
+				if notification != nil {
+					notification(message)
+				}
+			}
+		},
+	)
+
+	return kafkaBus, nil
+}
+
+// IsConsumed adds a notification to the kafka bus.
+func (k *kafkaBus) IsConsumed(h func(message types.IMessage)) {
+	k.isConsumedNotifications = append(k.isConsumedNotifications, h)
+}
+
+// IsProduced adds a notification to the kafka bus.
+func (k *kafkaBus) IsProduced(h func(message types.IMessage)) {
+	k.isProducedNotifications = append(k.isProducedNotifications, h)
+}
+
+// ConnectConsumer adds a new consumer to existing message type consumers. if there is no consumer, will create a new consumer for the message type.
+func (k *kafkaBus) ConnectConsumer(
+	messageType types.IMessage,
+	consumer consumer2.Consumer,
+) error {
+	typeName := utils.GetMessageBaseReflectType(messageType)
+
+	k.messageTypeConsumers[typeName] = append(k.messageTypeConsumers[typeName], consumer)
+
+	return nil
+}
+
+// ConnectKafkaConsumer adds a new consumer to existing message type consumers. if there is no consumer, will create a new consumer for the message type.
+func (k *kafkaBus) ConnectKafkaConsumer(
+	messageType types.IMessage,
+	consumerBuilderFunc consumerConfigurations.KafkaConsumerConfigurationBuilderFuc,
+) error {
+	typeName := utils.GetMessageBaseReflectType(messageType)
+
+	builder := consumerConfigurations.NewKafkaConsumerConfigurationBuilder(messageType)
+	if consumerBuilderFunc != nil {
+		consumerBuilderFunc(builder)
+	}
+
+	kafkaConsumer, err := k.createConsumer(builder.Build())
+	if err != nil {
+		return err
+	}
+
+	k.messageTypeConsumers[typeName] = append(k.messageTypeConsumers[typeName], kafkaConsumer)
+
+	return nil
+}
+
+// ConnectConsumerHandler adds a handler to existing consumer. creates new consumer if not exist.
+func (k *kafkaBus) ConnectConsumerHandler(
+	messageType types.IMessage,
+	consumerHandler consumer2.ConsumerHandler,
+) error {
+	typeName := utils.GetMessageBaseReflectType(messageType)
+
+	consumersForType := k.messageTypeConsumers[typeName]
+	if consumersForType != nil {
+		for _, c := range consumersForType {
+			c.ConnectHandler(consumerHandler)
+		}
+
+		return nil
+	}
+
+	builder := consumerConfigurations.NewKafkaConsumerConfigurationBuilder(messageType)
+	builder.WithHandlers(func(builder consumer2.ConsumerHandlerConfigurationBuilder) {
+		builder.AddHandler(consumerHandler)
+	})
+
+	kafkaConsumer, err := k.createConsumer(builder.Build())
+	if err != nil {
+		return err
+	}
+
+	k.messageTypeConsumers[typeName] = append(k.messageTypeConsumers[typeName], kafkaConsumer)
+
+	return nil
+}
+
+// createConsumer creates a new consumer that notifies the consumed notifications of the bus.
+func (k *kafkaBus) createConsumer(
+	consumerConfiguration *consumerConfigurations.KafkaConsumerConfiguration,
+) (consumer2.Consumer, error) {
+	return kafkaConsumer.NewKafkaConsumer(
+		k.kafkaOptions,
+		consumerConfiguration,
+		k.messageSerializer,
+		k.logger,
+		k.transforms,
+		// IsConsumed Notification
+		func(message types.IMessage) {
+			for _, notification := range k.isConsumedNotifications {
+				if notification != nil {
+					notification(message)
+				}
+			}
+		},
+	)
+}
+
+// Start starts the kafka bus.
+func (k *kafkaBus) Start(ctx context.Context) error {
+	k.logger.Infof("kafka is running on brokers: %v", k.kafkaOptions.Brokers)
+
+	for messageType, consumers := range k.messageTypeConsumers {
+		name := typeMapper.GetTypeNameByType(messageType)
+		k.logger.Info(fmt.Sprintf("consuming message type %s", name))
+		for _, kafkaConsumer := range consumers {
+			if err := kafkaConsumer.Start(ctx); err != nil {
+				k.logger.Error(
+					fmt.Sprintf(
+						"error in consumer %s, with err: %v",
+						kafkaConsumer.GetName(),
+						err,
+					),
+				)
+				err2 := k.Stop()
+				if err2 != nil {
+					return errors.WrapIf(err, err2.Error())
+				}
+
+				return err
+			}
+			k.logger.Info(fmt.Sprintf("consumer %s, started", kafkaConsumer.GetName()))
+		}
+	}
+
+	return nil
+}
+
+// Stop stops the kafka bus.
+func (k *kafkaBus) Stop() error {
+	waitGroup := sync.WaitGroup{}
+
+	for _, consumers := range k.messageTypeConsumers {
+		for _, c := range consumers {
+			waitGroup.Add(1)
+
+			go func(c consumer2.Consumer) {
+				defer waitGroup.Done()
+
+				err := c.Stop()
+				if err != nil {
+					k.logger.Error("error in the unconsuming")
+				}
+			}(c)
+		}
+	}
+	waitGroup.Wait()
+
+	return nil
+}
+
+// PublishMessage publishes a message to the kafka bus.
+func (k *kafkaBus) PublishMessage(
+	ctx context.Context,
+	message types.IMessage,
+	meta metadata.Metadata,
+) error {
+	return k.producer.PublishMessage(ctx, message, meta)
+}
+
+// PublishMessages publishes a batch of messages to the kafka bus and waits for the brokers to acknowledge all of them.
+func (k *kafkaBus) PublishMessages(
+	ctx context.Context,
+	messages []types.IMessage,
+	meta metadata.Metadata,
+) error {
+	return k.producer.PublishMessages(ctx, messages, meta)
+}
+
+// PublishMessageWithTopicName publishes a message to the kafka bus with a topic name.
+func (k *kafkaBus) PublishMessageWithTopicName(
+	ctx context.Context,
+	message types.IMessage,
+	meta metadata.Metadata,
+	topicOrExchangeName string,
+) error {
+	return k.producer.PublishMessageWithTopicName(ctx, message, meta, topicOrExchangeName)
+}
@@ -0,0 +1,277 @@
+//go:build unit
+// +build unit
+
+// Package bus provides the tests of the kafka bus.
+package bus
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	uuid "github.com/satori/go.uuid"
+
+	messageConsumer "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/consumer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/pipeline"
+	messagingTypes "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/utils"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/json"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/kafka/config"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/kafka/configurations"
+	consumerConfigurations "github.com/raphaeldiscky/go-food-micro/internal/pkg/kafka/consumer/configurations"
+	producerConfigurations "github.com/raphaeldiscky/go-food-micro/internal/pkg/kafka/producer/configurations"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/kafka/test/inmemory"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/kafka/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger/defaultlogger"
+	typeMapper "github.com/raphaeldiscky/go-food-micro/internal/pkg/reflection/typemapper"
+)
+
+// KafkaTestMessage is the message of the kafka bus tests.
+type KafkaTestMessage struct {
+	messagingTypes.Message
+	Key  string
+	Data string
+}
+
+// GetMessageTypeName returns the type name of the message.
+func (m *KafkaTestMessage) GetMessageTypeName() string {
+	return typeMapper.GetTypeName(m)
+}
+
+// GetMessageFullTypeName returns the full type name of the message.
+func (m *KafkaTestMessage) GetMessageFullTypeName() string {
+	return typeMapper.GetFullTypeName(m)
+}
+
+// GetPartitionKey returns the partition key of the message.
+func (m *KafkaTestMessage) GetPartitionKey() string {
+	return m.Key
+}
+
+// newKafkaTestMessage creates a new kafka test message.
+func newKafkaTestMessage(key string, data string) *KafkaTestMessage {
+	return &KafkaTestMessage{
+		Message: *messagingTypes.NewMessage(uuid.NewV4().String()),
+		Key:     key,
+		Data:    data,
+	}
+}
+
+// recordingHandler records the handled messages and fails the first failures calls.
+type recordingHandler struct {
+	mu       sync.Mutex
+	data     []string
+	calls    atomic.Int32
+	failures int32
+}
+
+// Handle handles a message.
+func (h *recordingHandler) Handle(_ context.Context, consumeContext messagingTypes.MessageConsumeContext) error {
+	if h.calls.Add(1) <= h.failures {
+		return errors.New("handler failed")
+	}
+
+	message, ok := consumeContext.Message().(*KafkaTestMessage)
+	if !ok {
+		return errors.New("unexpected message type")
+	}
+
+	h.mu.Lock()
+	h.data = append(h.data, message.Data)
+	h.mu.Unlock()
+
+	return nil
+}
+
+// handled returns the data of the handled messages.
+func (h *recordingHandler) handled() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return append([]string(nil), h.data...)
+}
+
+// recordingPipeline records the messages that passed the pipeline.
+type recordingPipeline struct {
+	calls atomic.Int32
+}
+
+// Handle handles a message.
+func (p *recordingPipeline) Handle(
+	ctx context.Context,
+	_ messagingTypes.MessageConsumeContext,
+	next pipeline.ConsumerHandlerFunc,
+) error {
+	p.calls.Add(1)
+
+	return next(ctx)
+}
+
+// newKafkaOptions creates the kafka options of a new in-process cluster.
+func newKafkaOptions(t *testing.T) *config.KafkaOptions {
+	t.Helper()
+
+	return &config.KafkaOptions{
+		Brokers:                inmemory.NewKafkaCluster(t),
+		AllowAutoTopicCreation: true,
+		ProduceTimeout:         10 * time.Second,
+	}
+}
+
+// newTestBus creates and starts a bus with a consumer of the test message.
+func newTestBus(
+	t *testing.T,
+	kafkaOptions *config.KafkaOptions,
+	consumerBuilder consumerConfigurations.KafkaConsumerConfigurationBuilderFuc,
+) KafkaBus {
+	t.Helper()
+
+	client, err := types.NewKafkaClient(kafkaOptions)
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+
+	b, err := NewKafkaBus(
+		kafkaOptions,
+		client,
+		defaultlogger.GetLogger(),
+		json.NewDefaultMessageJsonSerializer(json.NewDefaultJsonSerializer()),
+		nil,
+		func(builder configurations.KafkaConfigurationBuilder) {
+			builder.AddProducer(
+				&KafkaTestMessage{},
+				func(_ producerConfigurations.KafkaProducerConfigurationBuilder) {},
+			)
+			builder.AddConsumer(&KafkaTestMessage{}, consumerBuilder)
+		},
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, b.Start(context.Background()))
+	t.Cleanup(func() {
+		_ = b.Stop()
+	})
+
+	return b
+}
+
+// TestPublishAndConsume tests a message published and consumed through the bus with the pipelines and the
+// notifications.
+func TestPublishAndConsume(t *testing.T) {
+	handler := &recordingHandler{}
+	consumerPipeline := &recordingPipeline{}
+
+	b := newTestBus(t, newKafkaOptions(t), func(builder consumerConfigurations.KafkaConsumerConfigurationBuilder) {
+		builder.WithPipelines(func(builder pipeline.ConsumerPipelineConfigurationBuilder) {
+			builder.AddPipeline(consumerPipeline)
+		})
+		builder.WithHandlers(func(builder messageConsumer.ConsumerHandlerConfigurationBuilder) {
+			builder.AddHandler(handler)
+		})
+	})
+
+	var produced, consumed atomic.Int32
+	b.IsProduced(func(_ messagingTypes.IMessage) { produced.Add(1) })
+	b.IsConsumed(func(_ messagingTypes.IMessage) { consumed.Add(1) })
+
+	err := b.PublishMessage(context.Background(), newKafkaTestMessage("", "hello"), nil)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return consumed.Load() == 1
+	}, 20*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, []string{"hello"}, handler.handled())
+	assert.Equal(t, int32(1), consumerPipeline.calls.Load())
+	assert.Equal(t, int32(1), produced.Load())
+}
+
+// TestPartitionKey tests the messages with the same partition key are produced to the same partition in order.
+func TestPartitionKey(t *testing.T) {
+	kafkaOptions := newKafkaOptions(t)
+	b := newTestBus(t, kafkaOptions, func(builder consumerConfigurations.KafkaConsumerConfigurationBuilder) {
+		builder.WithHandlers(func(builder messageConsumer.ConsumerHandlerConfigurationBuilder) {
+			builder.AddHandler(&recordingHandler{})
+		})
+	})
+
+	messages := []messagingTypes.IMessage{
+		newKafkaTestMessage("product-1", "created"),
+		newKafkaTestMessage("product-1", "updated"),
+		newKafkaTestMessage("product-1", "deleted"),
+	}
+	require.NoError(t, b.PublishMessages(context.Background(), messages, nil))
+
+	client, err := kgo.NewClient(
+		kgo.SeedBrokers(kafkaOptions.Brokers...),
+		kgo.ConsumeTopics(utils.GetTopicOrExchangeName(&KafkaTestMessage{})),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()),
+	)
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var records []*kgo.Record
+	for len(records) < len(messages) && ctx.Err() == nil {
+		client.PollFetches(ctx).EachRecord(func(record *kgo.Record) {
+			records = append(records, record)
+		})
+	}
+	require.Len(t, records, len(messages))
+
+	for i, record := range records {
+		assert.Equal(t, "product-1", string(record.Key))
+		assert.Equal(t, records[0].Partition, record.Partition)
+		assert.Equal(t, int64(i), record.Offset-records[0].Offset)
+	}
+}
+
+// TestFailedRecordIsRedelivered tests a record is fetched again after its handler retries are exhausted and its
+// offset is committed after it is handled.
+func TestFailedRecordIsRedelivered(t *testing.T) {
+	kafkaOptions := newKafkaOptions(t)
+	consumerBuilder := func(handler *recordingHandler) consumerConfigurations.KafkaConsumerConfigurationBuilderFuc {
+		return func(builder consumerConfigurations.KafkaConsumerConfigurationBuilder) {
+			builder.WithConsumerID("redelivery-group")
+			builder.WithHandlers(func(builder messageConsumer.ConsumerHandlerConfigurationBuilder) {
+				builder.AddHandler(handler)
+			})
+		}
+	}
+
+	// the handler fails all the retries of the first delivery
+	handler := &recordingHandler{failures: 3}
+	b := newTestBus(t, kafkaOptions, consumerBuilder(handler))
+
+	err := b.PublishMessage(context.Background(), newKafkaTestMessage("", "retried"), nil)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return len(handler.handled()) == 1
+	}, 20*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, []string{"retried"}, handler.handled())
+	assert.Equal(t, int32(4), handler.calls.Load())
+	require.NoError(t, b.Stop())
+
+	// a new member of the group starts after the committed offset
+	nextHandler := &recordingHandler{}
+	next := newTestBus(t, kafkaOptions, consumerBuilder(nextHandler))
+
+	err = next.PublishMessage(context.Background(), newKafkaTestMessage("", "next"), nil)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return len(nextHandler.handled()) == 1
+	}, 20*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, []string{"next"}, nextHandler.handled())
+}
@@ -0,0 +1,35 @@
+// Package config provides the kafka options.
+package config
+
+import (
+	"time"
+
+	"github.com/iancoleman/strcase"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/config"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/config/environment"
+	typeMapper "github.com/raphaeldiscky/go-food-micro/internal/pkg/reflection/typemapper"
+)
+
+// KafkaOptions is a struct that contains the kafka options.
+type KafkaOptions struct {
+	// Brokers are the seed brokers of the kafka cluster, like `localhost:9092`.
+	Brokers   []string `mapstructure:"brokers"`
+	ClientID  string   `mapstructure:"clientId"`
+	AutoStart bool     `mapstructure:"autoStart" default:"true"`
+	// AllowAutoTopicCreation creates the missing topics of the producers and the consumers.
+	AllowAutoTopicCreation bool `mapstructure:"allowAutoTopicCreation" default:"true"`
+	// ProduceTimeout is the max time to wait for the brokers to acknowledge a published record.
+	ProduceTimeout time.Duration `mapstructure:"produceTimeout" default:"30s"`
+	// Compression is the compression codec of the produced batches, `gzip`, `snappy`, `lz4` or `zstd`, an empty
+	// compression doesn't compress the batches.
+	Compression string `mapstructure:"compression"`
+}
+
+// ProvideConfig provides the kafka options.
+func ProvideConfig(environment environment.Environment) (*KafkaOptions, error) {
+	optionName := strcase.ToLowerCamel(typeMapper.GetGenericTypeNameByT[KafkaOptions]())
+	cfg, err := config.BindConfigKey[*KafkaOptions](optionName, environment)
+
+	return cfg, err
+}
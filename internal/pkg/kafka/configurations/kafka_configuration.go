@@ -0,0 +1,13 @@
+// Package configurations provides a set of functions for the kafka configurations.
+package configurations
+
+import (
+	consumerConfigurations "github.com/raphaeldiscky/go-food-micro/internal/pkg/kafka/consumer/configurations"
+	producerConfigurations "github.com/raphaeldiscky/go-food-micro/internal/pkg/kafka/producer/configurations"
+)
+
+// KafkaConfiguration is a struct that contains the kafka configuration.
+type KafkaConfiguration struct {
+	ProducersConfigurations []*producerConfigurations.KafkaProducerConfiguration
+	ConsumersConfigurations []*consumerConfigurations.KafkaConsumerConfiguration
+}
@@ -0,0 +1,86 @@
+// Package configurations provides a set of functions for the kafka configurations.
+package configurations
+
+import (
+	"github.com/samber/lo"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	consumerConfigurations "github.com/raphaeldiscky/go-food-micro/internal/pkg/kafka/consumer/configurations"
+	producerConfigurations "github.com/raphaeldiscky/go-food-micro/internal/pkg/kafka/producer/configurations"
+)
+
+// KafkaConfigurationBuilder is a interface that contains the kafka configuration builder.
+type KafkaConfigurationBuilder interface {
+	AddProducer(
+		producerMessageType types.IMessage,
+		producerBuilderFunc producerConfigurations.KafkaProducerConfigurationBuilderFuc,
+	) KafkaConfigurationBuilder
+	AddConsumer(
+		consumerMessageType types.IMessage,
+		consumerBuilderFunc consumerConfigurations.KafkaConsumerConfigurationBuilderFuc,
+	) KafkaConfigurationBuilder
+	Build() *KafkaConfiguration
+}
+
+// kafkaConfigurationBuilder is a struct that contains the kafka configuration builder.
+type kafkaConfigurationBuilder struct {
+	kafkaConfiguration *KafkaConfiguration
+	consumerBuilders   []consumerConfigurations.KafkaConsumerConfigurationBuilder
+	producerBuilders   []producerConfigurations.KafkaProducerConfigurationBuilder
+}
+
+// NewKafkaConfigurationBuilder creates a new kafka configuration builder.
+func NewKafkaConfigurationBuilder() KafkaConfigurationBuilder {
+	return &kafkaConfigurationBuilder{
+		kafkaConfiguration: &KafkaConfiguration{},
+	}
+}
+
+// AddProducer adds a new producer to the kafka configuration.
+func (k *kafkaConfigurationBuilder) AddProducer(
+	producerMessageType types.IMessage,
+	producerBuilderFunc producerConfigurations.KafkaProducerConfigurationBuilderFuc,
+) KafkaConfigurationBuilder {
+	builder := producerConfigurations.NewKafkaProducerConfigurationBuilder(producerMessageType)
+	if producerBuilderFunc != nil {
+		producerBuilderFunc(builder)
+	}
+
+	k.producerBuilders = append(k.producerBuilders, builder)
+
+	return k
+}
+
+// AddConsumer adds a new consumer to the kafka configuration.
+func (k *kafkaConfigurationBuilder) AddConsumer(
+	consumerMessageType types.IMessage,
+	consumerBuilderFunc consumerConfigurations.KafkaConsumerConfigurationBuilderFuc,
+) KafkaConfigurationBuilder {
+	builder := consumerConfigurations.NewKafkaConsumerConfigurationBuilder(consumerMessageType)
+	if consumerBuilderFunc != nil {
+		consumerBuilderFunc(builder)
+	}
+
+	k.consumerBuilders = append(k.consumerBuilders, builder)
+
+	return k
+}
+
+// Build builds the kafka configuration.
+func (k *kafkaConfigurationBuilder) Build() *KafkaConfiguration {
+	k.kafkaConfiguration.ConsumersConfigurations = lo.Map(
+		k.consumerBuilders,
+		func(builder consumerConfigurations.KafkaConsumerConfigurationBuilder, _ int) *consumerConfigurations.KafkaConsumerConfiguration {
+			return builder.Build()
+		},
+	)
+
+	k.kafkaConfiguration.ProducersConfigurations = lo.Map(
+		k.producerBuilders,
+		func(builder producerConfigurations.KafkaProducerConfigurationBuilder, _ int) *producerConfigurations.KafkaProducerConfiguration {
+			return builder.Build()
+		},
+	)
+
+	return k.kafkaConfiguration
+}
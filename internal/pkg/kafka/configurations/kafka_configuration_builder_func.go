@@ -0,0 +1,5 @@
+// Package configurations provides a set of functions for the kafka configurations.
+package configurations
+
+// KafkaConfigurationBuilderFuc is a function that builds a kafka configuration.
+type KafkaConfigurationBuilderFuc func(builder KafkaConfigurationBuilder)
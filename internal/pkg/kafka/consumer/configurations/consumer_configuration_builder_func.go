@@ -0,0 +1,5 @@
+// Package configurations provides a set of functions for the kafka consumer configurations.
+package configurations
+
+// KafkaConsumerConfigurationBuilderFuc is a function that builds a kafka consumer configuration.
+type KafkaConsumerConfigurationBuilderFuc func(builder KafkaConsumerConfigurationBuilder)
@@ -0,0 +1,17 @@
+// Package configurations provides a set of functions for the kafka consumer configurations.
+package configurations
+
+import (
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/consumer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+)
+
+// KafkaConsumerConnector is a interface that contains the kafka consumer connector.
+type KafkaConsumerConnector interface {
+	consumer.ConsumerConnector
+	// ConnectKafkaConsumer Add a new consumer to existing message type consumers. if there is no consumer, will create a new consumer for the message type
+	ConnectKafkaConsumer(
+		messageType types.IMessage,
+		consumerBuilderFunc KafkaConsumerConfigurationBuilderFuc,
+	) error
+}
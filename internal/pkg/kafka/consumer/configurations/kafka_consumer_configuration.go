@@ -0,0 +1,50 @@
+// Package configurations provides a set of functions for the kafka consumer configurations.
+package configurations
+
+import (
+	"fmt"
+	"reflect"
+
+	consumer2 "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/consumer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/pipeline"
+	types2 "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/utils"
+)
+
+// KafkaConsumerConfiguration is a struct that contains the kafka consumer configuration. The consumer id of the
+// consumer options is the consumer group of the consumer, the consumers of a group share the partitions of the
+// topic and an ExitOnError consumer stops on a message that its handlers can't handle.
+type KafkaConsumerConfiguration struct {
+	Name                string
+	ConsumerMessageType reflect.Type
+	Pipelines           []pipeline.ConsumerPipeline
+	Handlers            []consumer2.ConsumerHandler
+	*consumer2.ConsumerOptions
+	Topic string
+	// FromBeginning starts a new consumer group from the earliest offset of the partitions instead of the latest.
+	FromBeginning bool
+}
+
+// NewDefaultKafkaConsumerConfiguration creates a new default kafka consumer configuration, the topic and the
+// consumer group are the snake case name of the message type.
+func NewDefaultKafkaConsumerConfiguration(messageType types2.IMessage) *KafkaConsumerConfiguration {
+	name := fmt.Sprintf("%s_consumer", utils.GetMessageName(messageType))
+
+	return &KafkaConsumerConfiguration{
+		ConsumerOptions:     &consumer2.ConsumerOptions{ExitOnError: false, ConsumerId: ""},
+		Topic:               utils.GetTopicOrExchangeName(messageType),
+		FromBeginning:       true,
+		ConsumerMessageType: utils.GetMessageBaseReflectType(messageType),
+		Name:                name,
+	}
+}
+
+// GroupID returns the consumer group of the consumer, the snake case name of the message type for a consumer
+// without a consumer id.
+func (c *KafkaConsumerConfiguration) GroupID() string {
+	if c.ConsumerId != "" {
+		return c.ConsumerId
+	}
+
+	return utils.GetQueueNameFromType(c.ConsumerMessageType)
+}
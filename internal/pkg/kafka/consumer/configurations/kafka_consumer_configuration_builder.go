@@ -0,0 +1,113 @@
+// Package configurations provides a set of functions for the kafka consumer configurations.
+package configurations
+
+import (
+	messageConsumer "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/consumer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/pipeline"
+	types2 "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+)
+
+// KafkaConsumerConfigurationBuilder is a interface that contains the kafka consumer configuration builder.
+type KafkaConsumerConfigurationBuilder interface {
+	WithHandlers(
+		consumerBuilderFunc messageConsumer.ConsumerHandlerConfigurationBuilderFunc,
+	) KafkaConsumerConfigurationBuilder
+	WithPipelines(
+		pipelineBuilderFunc pipeline.ConsumerPipelineConfigurationBuilderFunc,
+	) KafkaConsumerConfigurationBuilder
+	WithExitOnError(exitOnError bool) KafkaConsumerConfigurationBuilder
+	WithConsumerID(consumerID string) KafkaConsumerConfigurationBuilder
+	WithTopic(topic string) KafkaConsumerConfigurationBuilder
+	WithFromBeginning(fromBeginning bool) KafkaConsumerConfigurationBuilder
+	WithName(name string) KafkaConsumerConfigurationBuilder
+	Build() *KafkaConsumerConfiguration
+}
+
+// kafkaConsumerConfigurationBuilder is a struct that represents the kafka consumer configuration builder.
+type kafkaConsumerConfigurationBuilder struct {
+	kafkaConsumerConfiguration *KafkaConsumerConfiguration
+	pipelinesBuilder           pipeline.ConsumerPipelineConfigurationBuilder
+	handlersBuilder            messageConsumer.ConsumerHandlerConfigurationBuilder
+}
+
+// NewKafkaConsumerConfigurationBuilder creates a new kafka consumer configuration builder.
+func NewKafkaConsumerConfigurationBuilder(messageType types2.IMessage) KafkaConsumerConfigurationBuilder {
+	return &kafkaConsumerConfigurationBuilder{
+		kafkaConsumerConfiguration: NewDefaultKafkaConsumerConfiguration(messageType),
+	}
+}
+
+// WithHandlers adds the handlers to the kafka consumer configuration.
+func (b *kafkaConsumerConfigurationBuilder) WithHandlers(
+	consumerBuilderFunc messageConsumer.ConsumerHandlerConfigurationBuilderFunc,
+) KafkaConsumerConfigurationBuilder {
+	builder := messageConsumer.NewConsumerHandlersConfigurationBuilder()
+	if consumerBuilderFunc != nil {
+		consumerBuilderFunc(builder)
+	}
+	b.handlersBuilder = builder
+
+	return b
+}
+
+// WithPipelines adds the pipelines to the kafka consumer configuration.
+func (b *kafkaConsumerConfigurationBuilder) WithPipelines(
+	pipelineBuilderFunc pipeline.ConsumerPipelineConfigurationBuilderFunc,
+) KafkaConsumerConfigurationBuilder {
+	builder := pipeline.NewConsumerPipelineConfigurationBuilder()
+	if pipelineBuilderFunc != nil {
+		pipelineBuilderFunc(builder)
+	}
+	b.pipelinesBuilder = builder
+
+	return b
+}
+
+// WithExitOnError sets the exit on error flag.
+func (b *kafkaConsumerConfigurationBuilder) WithExitOnError(exitOnError bool) KafkaConsumerConfigurationBuilder {
+	b.kafkaConsumerConfiguration.ExitOnError = exitOnError
+
+	return b
+}
+
+// WithConsumerID sets the consumer id, it is the consumer group of the consumer.
+func (b *kafkaConsumerConfigurationBuilder) WithConsumerID(consumerID string) KafkaConsumerConfigurationBuilder {
+	b.kafkaConsumerConfiguration.ConsumerId = consumerID
+
+	return b
+}
+
+// WithTopic sets the topic of the consumer.
+func (b *kafkaConsumerConfigurationBuilder) WithTopic(topic string) KafkaConsumerConfigurationBuilder {
+	b.kafkaConsumerConfiguration.Topic = topic
+
+	return b
+}
+
+// WithFromBeginning sets whether a new consumer group starts from the earliest offset.
+func (b *kafkaConsumerConfigurationBuilder) WithFromBeginning(
+	fromBeginning bool,
+) KafkaConsumerConfigurationBuilder {
+	b.kafkaConsumerConfiguration.FromBeginning = fromBeginning
+
+	return b
+}
+
+// WithName sets the name of the kafka consumer configuration.
+func (b *kafkaConsumerConfigurationBuilder) WithName(name string) KafkaConsumerConfigurationBuilder {
+	b.kafkaConsumerConfiguration.Name = name
+
+	return b
+}
+
+// Build builds the kafka consumer configuration.
+func (b *kafkaConsumerConfigurationBuilder) Build() *KafkaConsumerConfiguration {
+	if b.pipelinesBuilder != nil {
+		b.kafkaConsumerConfiguration.Pipelines = b.pipelinesBuilder.Build().Pipelines
+	}
+	if b.handlersBuilder != nil {
+		b.kafkaConsumerConfiguration.Handlers = b.handlersBuilder.Build().Handlers
+	}
+
+	return b.kafkaConsumerConfiguration
+}
@@ -0,0 +1,329 @@
+// Package consumer provides the kafka consumer.
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"emperror.dev/errors"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/consumer"
+	messageHeader "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/messageheader"
+	consumertracing "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/otel/tracing/consumer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/pipeline"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/transform"
+	messagingTypes "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/versioning"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/kafka/config"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/kafka/consumer/configurations"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/kafka/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+)
+
+// ContentType is the content type of the records without a content type header.
+const ContentType = "application/json"
+
+// kafkaConsumer consumes the records of a topic as a member of a consumer group. The partitions of a poll are
+// handled concurrently and the records of a partition in order, the offset of a record is committed after its
+// handlers succeed and a failed record is fetched again with the records after it.
+type kafkaConsumer struct {
+	kafkaOptions            *config.KafkaOptions
+	consumerConfiguration   *configurations.KafkaConsumerConfiguration
+	messageSerializer       serializer.MessageSerializer
+	logger                  logger.Logger
+	transforms              *transform.Pipeline
+	handlers                []consumer.ConsumerHandler
+	pipelines               []pipeline.ConsumerPipeline
+	isConsumedNotifications []func(message messagingTypes.IMessage)
+	mu                      sync.Mutex
+	client                  *kgo.Client
+	cancel                  context.CancelFunc
+	done                    chan struct{}
+}
+
+// NewKafkaConsumer creates a new kafka consumer.
+func NewKafkaConsumer(
+	kafkaOptions *config.KafkaOptions,
+	consumerConfiguration *configurations.KafkaConsumerConfiguration,
+	messageSerializer serializer.MessageSerializer,
+	logger logger.Logger,
+	transforms *transform.Pipeline,
+	isConsumedNotifications ...func(message messagingTypes.IMessage),
+) (consumer.Consumer, error) {
+	if consumerConfiguration == nil {
+		return nil, errors.New("consumer configuration is required")
+	}
+
+	if consumerConfiguration.ConsumerMessageType == nil {
+		return nil, errors.New("consumer ConsumerMessageType property is required")
+	}
+
+	return &kafkaConsumer{
+		kafkaOptions:            kafkaOptions,
+		consumerConfiguration:   consumerConfiguration,
+		messageSerializer:       messageSerializer,
+		logger:                  logger,
+		transforms:              transforms,
+		handlers:                consumerConfiguration.Handlers,
+		pipelines:               consumerConfiguration.Pipelines,
+		isConsumedNotifications: isConsumedNotifications,
+	}, nil
+}
+
+// IsConsumed adds a new consumed notification.
+func (c *kafkaConsumer) IsConsumed(h func(message messagingTypes.IMessage)) {
+	c.isConsumedNotifications = append(c.isConsumedNotifications, h)
+}
+
+// ConnectHandler adds a new consumer handler.
+func (c *kafkaConsumer) ConnectHandler(handler consumer.ConsumerHandler) {
+	c.handlers = append(c.handlers, handler)
+}
+
+// GetName returns the name of the consumer.
+func (c *kafkaConsumer) GetName() string {
+	return c.consumerConfiguration.Name
+}
+
+// Start joins the consumer group and starts polling the topic.
+func (c *kafkaConsumer) Start(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.client != nil {
+		return nil
+	}
+
+	opts, err := types.ClientOptions(c.kafkaOptions)
+	if err != nil {
+		return err
+	}
+
+	opts = append(
+		opts,
+		kgo.ConsumerGroup(c.consumerConfiguration.GroupID()),
+		kgo.ConsumeTopics(c.consumerConfiguration.Topic),
+		// the offsets are committed after the handlers and the partitions aren't revoked in the middle of a poll
+		kgo.DisableAutoCommit(),
+		kgo.BlockRebalanceOnPoll(),
+	)
+	if c.consumerConfiguration.FromBeginning {
+		opts = append(opts, kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()))
+	}
+
+	client, err := kgo.NewClient(opts...)
+	if err != nil {
+		return errors.WrapIf(err, "error in creating the kafka consumer client")
+	}
+
+	pollCtx, cancel := context.WithCancel(ctx)
+	c.client = client
+	c.cancel = cancel
+	c.done = make(chan struct{})
+
+	go c.poll(pollCtx, client, c.done)
+
+	return nil
+}
+
+// Stop stops polling after the in flight records and leaves the consumer group.
+func (c *kafkaConsumer) Stop() error {
+	c.mu.Lock()
+	client, cancel, done := c.client, c.cancel, c.done
+	c.client, c.cancel, c.done = nil, nil, nil
+	c.mu.Unlock()
+
+	if client == nil {
+		return nil
+	}
+
+	cancel()
+	<-done
+	client.Close()
+
+	return nil
+}
+
+// poll polls the records of the assigned partitions until the context is done.
+func (c *kafkaConsumer) poll(ctx context.Context, client *kgo.Client, done chan struct{}) {
+	defer close(done)
+
+	for {
+		fetches := client.PollFetches(ctx)
+		if fetches.IsClientClosed() || ctx.Err() != nil {
+			client.AllowRebalance()
+
+			return
+		}
+
+		fetches.EachError(func(topic string, partition int32, err error) {
+			c.logger.Errorf(
+				"[kafkaConsumer.poll] error in fetching the partition %d of the topic `%s`: %v",
+				partition,
+				topic,
+				err,
+			)
+		})
+
+		failed := c.handleFetches(ctx, client, fetches)
+		if len(failed) > 0 && c.consumerConfiguration.ExitOnError {
+			c.logger.Errorf(
+				"[kafkaConsumer.poll] consumer %s stopped on a message that can't be handled",
+				c.GetName(),
+			)
+			client.AllowRebalance()
+
+			return
+		}
+
+		// the failed records are fetched again, with the records after them
+		if len(failed) > 0 {
+			client.SetOffsets(failed)
+		}
+		client.AllowRebalance()
+	}
+}
+
+// handleFetches handles the partitions of a poll concurrently, commits the offsets of the handled records and
+// returns the offsets of the failed records.
+func (c *kafkaConsumer) handleFetches(
+	ctx context.Context,
+	client *kgo.Client,
+	fetches kgo.Fetches,
+) map[string]map[int32]kgo.EpochOffset {
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		handled []*kgo.Record
+		failed  = map[string]map[int32]kgo.EpochOffset{}
+	)
+
+	fetches.EachPartition(func(partition kgo.FetchTopicPartition) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for _, record := range partition.Records {
+				if err := c.handleRecord(ctx, record); err != nil {
+					mu.Lock()
+					if failed[record.Topic] == nil {
+						failed[record.Topic] = map[int32]kgo.EpochOffset{}
+					}
+					failed[record.Topic][record.Partition] = kgo.EpochOffset{
+						Epoch:  record.LeaderEpoch,
+						Offset: record.Offset,
+					}
+					mu.Unlock()
+
+					return
+				}
+
+				mu.Lock()
+				handled = append(handled, record)
+				mu.Unlock()
+			}
+		}()
+	})
+	wg.Wait()
+
+	if len(handled) > 0 {
+		// the handled records are committed even when the consumer is stopping
+		if err := client.CommitRecords(context.WithoutCancel(ctx), handled...); err != nil {
+			c.logger.Errorf("[kafkaConsumer.handleFetches] error in committing the offsets: %v", err)
+		}
+	}
+
+	return failed
+}
+
+// handleRecord handles a record with the handlers of the consumer, the records that can't be deserialized are
+// skipped instead of being fetched forever.
+func (c *kafkaConsumer) handleRecord(ctx context.Context, record *kgo.Record) error {
+	meta := types.MetadataFromHeaders(record.Headers)
+
+	ctx, span := consumertracing.StartConsumerSpan(
+		ctx,
+		&meta,
+		string(record.Value),
+		&consumertracing.ConsumerTracingOptions{
+			MessagingSystem: "kafka",
+			DestinationKind: "topic",
+			Destination:     record.Topic,
+			OtherAttributes: []attribute.KeyValue{
+				semconv.MessagingKafkaConsumerGroup(c.consumerConfiguration.GroupID()),
+				semconv.MessagingKafkaDestinationPartition(int(record.Partition)),
+				semconv.MessagingKafkaMessageOffset(int(record.Offset)),
+				semconv.MessagingKafkaMessageKey(string(record.Key)),
+			},
+		},
+	)
+
+	contentType := messageHeader.GetMessageContentType(meta)
+	if contentType == "" {
+		contentType = ContentType
+	}
+
+	message, err := consumer.DeserializeMessage(
+		c.messageSerializer,
+		c.transforms,
+		contentType,
+		meta.GetString(types.ContentEncodingHeader),
+		messageHeader.GetMessageType(meta),
+		versioning.GetSchemaVersion(meta),
+		record.Value,
+	)
+	if err != nil {
+		c.logger.Errorf(fmt.Sprintf("[kafkaConsumer.handleRecord] skipping a record: %v", err))
+		if err := consumertracing.FinishConsumerSpan(span, err); err != nil {
+			c.logger.Error("error in finishing consumer span: %v", err)
+		}
+
+		return nil
+	}
+
+	consumeContext := messagingTypes.NewMessageConsumeContext(
+		message,
+		meta,
+		contentType,
+		messageHeader.GetMessageType(meta),
+		record.Timestamp,
+		uint64(record.Offset),
+		message.GeMessageId(),
+		messageHeader.GetCorrelationId(meta),
+	)
+
+	for _, handler := range c.handlers {
+		err = consumer.HandleWithRetry(ctx, c.pipelines, handler, consumeContext)
+		if err != nil {
+			break
+		}
+	}
+
+	if err != nil {
+		c.logger.Errorf(
+			"[kafkaConsumer.handleRecord] error in handling the record %d of the partition %d of the topic `%s`: %v",
+			record.Offset,
+			record.Partition,
+			record.Topic,
+			err,
+		)
+	}
+
+	if err := consumertracing.FinishConsumerSpan(span, err); err != nil {
+		c.logger.Error("error in finishing consumer span: %v", err)
+	}
+
+	for _, notification := range c.isConsumedNotifications {
+		if notification != nil {
+			notification(message)
+		}
+	}
+
+	return err
+}
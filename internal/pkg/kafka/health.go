@@ -0,0 +1,35 @@
+// Package kafka provides a set of functions for the kafka package.
+package kafka
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/health/contracts"
+)
+
+// kafkaHealthChecker is a struct that contains the kafka client.
+type kafkaHealthChecker struct {
+	client *kgo.Client
+}
+
+// NewKafkaHealthChecker creates a new kafka health checker.
+func NewKafkaHealthChecker(client *kgo.Client) contracts.Health {
+	return &kafkaHealthChecker{client}
+}
+
+// CheckHealth checks one of the kafka brokers is reachable.
+func (k kafkaHealthChecker) CheckHealth(ctx context.Context) error {
+	if err := k.client.Ping(ctx); err != nil {
+		return errors.WrapIf(err, "kafka is not available")
+	}
+
+	return nil
+}
+
+// GetHealthName returns the name of the kafka health checker.
+func (k kafkaHealthChecker) GetHealthName() string {
+	return "kafka"
+}
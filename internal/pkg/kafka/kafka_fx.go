@@ -0,0 +1,110 @@
+// Package kafka provides a set of functions for the kafka package.
+package kafka
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.uber.org/fx"
+
+	bus2 "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/bus"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/producer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/health/contracts"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/kafka/bus"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/kafka/config"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/kafka/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+)
+
+var (
+	// ModuleFunc provided to fxlog
+	// https://uber-go.github.io/fx/modules.html
+	ModuleFunc = func(kafkaConfigurationConstructor interface{}) fx.Option {
+		return fx.Module(
+			"kafkafx",
+			fx.Provide(kafkaConfigurationConstructor),
+			kafkaProviders,
+			kafkaInvokes,
+		)
+	}
+
+	// - order is not important in provide
+	// - provide can have parameter and will resolve if registered
+	// - execute its func only if it requested.
+	kafkaProviders = fx.Options(
+		fx.Provide(config.ProvideConfig),
+		fx.Provide(types.NewKafkaClient),
+		fx.Provide(fx.Annotate(
+			bus.NewKafkaBus,
+			fx.ParamTags(``, ``, ``, ``, `optional:"true"`, `optional:"true"`),
+			fx.As(new(producer.Producer)),
+			fx.As(new(producer.BatchProducer)),
+			fx.As(new(bus2.Bus)),
+			fx.As(new(bus.KafkaBus)),
+		)),
+		fx.Provide(fx.Annotate(
+			NewKafkaHealthChecker,
+			fx.As(new(contracts.Health)),
+			fx.ResultTags(fmt.Sprintf(`group:"%s"`, "healths")),
+		)))
+
+	// - execute after registering all of our provided
+	// - they execute by their orders
+	// - invokes always execute its func compare to provides that only run when we request for them.
+	// - return value will be discarded and can not be provided.
+	kafkaInvokes = fx.Options(
+		fx.Invoke(registerHooks),
+	)
+)
+
+// we don't want to register any dependencies here, its func body should execute always even we don't request for that, so we should use `invoke`.
+func registerHooks(
+	lc fx.Lifecycle,
+	bus bus.KafkaBus,
+	client *kgo.Client,
+	kafkaOptions *config.KafkaOptions,
+	logger logger.Logger,
+) {
+	lc.Append(fx.Hook{
+		OnStop: func(_ context.Context) error {
+			// the producer client is closed after the consumers, its buffered records are flushed by the close
+			client.Close()
+
+			return nil
+		},
+	})
+
+	if !kafkaOptions.AutoStart {
+		return
+	}
+
+	lifeTimeCtx := context.Background()
+
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			// the consumers poll until the bus is stopped, so they get the app lifetime context instead of the short
+			// startup context
+			go func() {
+				if err := bus.Start(lifeTimeCtx); err != nil {
+					logger.Errorf(
+						"(bus.Start) error in running kafka consumers: {%v}",
+						err,
+					)
+				}
+			}()
+			logger.Info("kafka is listening.")
+
+			return nil
+		},
+		OnStop: func(_ context.Context) error {
+			if err := bus.Stop(); err != nil {
+				logger.Errorf("error shutting down kafka consumers: %v", err)
+			} else {
+				logger.Info("kafka consumers shutdown gracefully")
+			}
+
+			return nil
+		},
+	})
+}
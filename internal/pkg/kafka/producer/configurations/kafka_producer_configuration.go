@@ -0,0 +1,39 @@
+// Package configurations provides a set of functions for the kafka producer configurations.
+package configurations
+
+import (
+	"reflect"
+
+	types2 "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/utils"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer"
+)
+
+// KafkaProducerConfiguration is a struct that contains the kafka producer configuration.
+type KafkaProducerConfiguration struct {
+	ProducerMessageType reflect.Type
+	Topic               string
+	// PartitionKey returns the record key of a message, nil for the partition key of the message
+	PartitionKey    func(message types2.IMessage) string
+	ContentEncoding string
+	// MessageSerializer serializes the messages of the producer, nil for the default message serializer
+	MessageSerializer serializer.MessageSerializer
+}
+
+// NewDefaultKafkaProducerConfiguration creates a new default kafka producer configuration, the topic is the
+// snake case name of the message type.
+func NewDefaultKafkaProducerConfiguration(messageType types2.IMessage) *KafkaProducerConfiguration {
+	return &KafkaProducerConfiguration{
+		Topic:               utils.GetTopicOrExchangeName(messageType),
+		ProducerMessageType: utils.GetMessageBaseReflectType(messageType),
+	}
+}
+
+// GetPartitionKey returns the record key of a message.
+func (c *KafkaProducerConfiguration) GetPartitionKey(message types2.IMessage) string {
+	if c.PartitionKey != nil {
+		return c.PartitionKey(message)
+	}
+
+	return utils.GetPartitionKey(message)
+}
@@ -0,0 +1,67 @@
+// Package configurations provides a set of functions for the kafka producer configurations.
+package configurations
+
+import (
+	types2 "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer"
+)
+
+// KafkaProducerConfigurationBuilder is a interface that contains the kafka producer configuration builder.
+type KafkaProducerConfigurationBuilder interface {
+	WithTopic(topic string) KafkaProducerConfigurationBuilder
+	WithPartitionKey(partitionKey func(message types2.IMessage) string) KafkaProducerConfigurationBuilder
+	WithContentEncoding(contentEncoding string) KafkaProducerConfigurationBuilder
+	WithMessageSerializer(messageSerializer serializer.MessageSerializer) KafkaProducerConfigurationBuilder
+	Build() *KafkaProducerConfiguration
+}
+
+// kafkaProducerConfigurationBuilder is a struct that contains the kafka producer configuration builder.
+type kafkaProducerConfigurationBuilder struct {
+	kafkaProducerConfiguration *KafkaProducerConfiguration
+}
+
+// NewKafkaProducerConfigurationBuilder creates a new kafka producer configuration builder.
+func NewKafkaProducerConfigurationBuilder(messageType types2.IMessage) KafkaProducerConfigurationBuilder {
+	return &kafkaProducerConfigurationBuilder{
+		kafkaProducerConfiguration: NewDefaultKafkaProducerConfiguration(messageType),
+	}
+}
+
+// WithTopic sets the topic of the messages.
+func (b *kafkaProducerConfigurationBuilder) WithTopic(topic string) KafkaProducerConfigurationBuilder {
+	b.kafkaProducerConfiguration.Topic = topic
+
+	return b
+}
+
+// WithPartitionKey sets the record key of the messages, the messages with the same key go to the same partition.
+func (b *kafkaProducerConfigurationBuilder) WithPartitionKey(
+	partitionKey func(message types2.IMessage) string,
+) KafkaProducerConfigurationBuilder {
+	b.kafkaProducerConfiguration.PartitionKey = partitionKey
+
+	return b
+}
+
+// WithContentEncoding sets the content encoding of the payloads that are already encoded.
+func (b *kafkaProducerConfigurationBuilder) WithContentEncoding(
+	contentEncoding string,
+) KafkaProducerConfigurationBuilder {
+	b.kafkaProducerConfiguration.ContentEncoding = contentEncoding
+
+	return b
+}
+
+// WithMessageSerializer sets the message serializer of the producer.
+func (b *kafkaProducerConfigurationBuilder) WithMessageSerializer(
+	messageSerializer serializer.MessageSerializer,
+) KafkaProducerConfigurationBuilder {
+	b.kafkaProducerConfiguration.MessageSerializer = messageSerializer
+
+	return b
+}
+
+// Build builds the kafka producer configuration.
+func (b *kafkaProducerConfigurationBuilder) Build() *KafkaProducerConfiguration {
+	return b.kafkaProducerConfiguration
+}
@@ -0,0 +1,5 @@
+// Package configurations provides a set of functions for the kafka producer configurations.
+package configurations
+
+// KafkaProducerConfigurationBuilderFuc is a function that builds a kafka producer configuration.
+type KafkaProducerConfigurationBuilderFuc func(builder KafkaProducerConfigurationBuilder)
@@ -0,0 +1,266 @@
+// Package producer provides the kafka producer.
+package producer
+
+import (
+	"context"
+	"time"
+
+	"emperror.dev/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+
+	producertracing "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/otel/tracing/producer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/producer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/transform"
+	messagingTypes "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/utils"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/metadata"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/versioning"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/kafka/config"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/kafka/producer/configurations"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/kafka/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+)
+
+// kafkaProducer produces the messages as records of the topics of their producer configurations, the partition key
+// of a message is the key of its record.
+type kafkaProducer struct {
+	client                  *kgo.Client
+	logger                  logger.Logger
+	messageSerializer       serializer.MessageSerializer
+	producersConfigurations map[string]*configurations.KafkaProducerConfiguration
+	produceTimeout          time.Duration
+	transforms              *transform.Pipeline
+	isProducedNotifications []func(message messagingTypes.IMessage)
+}
+
+// record is a serialized message and its producer span.
+type record struct {
+	message messagingTypes.IMessage
+	record  *kgo.Record
+	span    trace.Span
+}
+
+// NewKafkaProducer creates a new kafka producer.
+func NewKafkaProducer(
+	kafkaOptions *config.KafkaOptions,
+	client *kgo.Client,
+	producersConfigurations map[string]*configurations.KafkaProducerConfiguration,
+	logger logger.Logger,
+	messageSerializer serializer.MessageSerializer,
+	transforms *transform.Pipeline,
+	isProducedNotifications ...func(message messagingTypes.IMessage),
+) producer.BatchProducer {
+	return &kafkaProducer{
+		client:                  client,
+		logger:                  logger,
+		messageSerializer:       messageSerializer,
+		producersConfigurations: producersConfigurations,
+		produceTimeout:          kafkaOptions.ProduceTimeout,
+		transforms:              transforms,
+		isProducedNotifications: isProducedNotifications,
+	}
+}
+
+// IsProduced adds a new produced notification.
+func (p *kafkaProducer) IsProduced(h func(message messagingTypes.IMessage)) {
+	p.isProducedNotifications = append(p.isProducedNotifications, h)
+}
+
+// PublishMessage publishes a message to the topic of its producer configuration.
+func (p *kafkaProducer) PublishMessage(
+	ctx context.Context,
+	message messagingTypes.IMessage,
+	meta metadata.Metadata,
+) error {
+	return p.PublishMessageWithTopicName(ctx, message, meta, "")
+}
+
+// PublishMessageWithTopicName publishes a message to a topic, an empty topic is the topic of the producer
+// configuration of the message.
+func (p *kafkaProducer) PublishMessageWithTopicName(
+	ctx context.Context,
+	message messagingTypes.IMessage,
+	meta metadata.Metadata,
+	topicOrExchangeName string,
+) error {
+	r, err := p.prepareRecord(ctx, message, meta, topicOrExchangeName)
+	if err != nil {
+		return err
+	}
+
+	return p.produce(ctx, []*record{r})
+}
+
+// PublishMessages publishes a batch of messages and waits for the brokers to acknowledge all of them, the returned
+// error combines the errors of the failed messages.
+func (p *kafkaProducer) PublishMessages(
+	ctx context.Context,
+	messages []messagingTypes.IMessage,
+	meta metadata.Metadata,
+) error {
+	records := make([]*record, 0, len(messages))
+	for _, message := range messages {
+		// every message gets its own copy of the headers, the message id of one message isn't reused by the others
+		r, err := p.prepareRecord(ctx, message, copyMetadata(meta), "")
+		if err != nil {
+			for _, prepared := range records {
+				_ = producertracing.FinishProducerSpan(prepared.span, err)
+			}
+
+			return err
+		}
+		records = append(records, r)
+	}
+
+	return p.produce(ctx, records)
+}
+
+// prepareRecord serializes a message, starts its producer span and builds its record.
+func (p *kafkaProducer) prepareRecord(
+	ctx context.Context,
+	message messagingTypes.IMessage,
+	meta metadata.Metadata,
+	topicOrExchangeName string,
+) (*record, error) {
+	producerConfiguration := p.producersConfigurations[utils.GetMessageBaseReflectType(message).String()]
+	if producerConfiguration == nil {
+		producerConfiguration = configurations.NewDefaultKafkaProducerConfiguration(message)
+	}
+
+	messageSerializer := p.messageSerializer
+	if producerConfiguration.MessageSerializer != nil {
+		messageSerializer = producerConfiguration.MessageSerializer
+	}
+
+	topic := topicOrExchangeName
+	if topic == "" {
+		topic = producerConfiguration.Topic
+	}
+
+	meta = producer.PublishingMetadata(message, meta, messageSerializer)
+	partitionKey := producerConfiguration.GetPartitionKey(message)
+
+	serializedObj, err := p.serialize(message, messageSerializer)
+	if err != nil {
+		return nil, err
+	}
+
+	// the content encoding of the producer configuration marks a payload that is already encoded
+	payload := &transform.Payload{Data: serializedObj.Data, ContentEncoding: producerConfiguration.ContentEncoding}
+	if err := p.transforms.EncodePayload(payload); err != nil {
+		return nil, err
+	}
+
+	_, span := producertracing.StartProducerSpan(
+		ctx,
+		message,
+		&meta,
+		string(serializedObj.Data),
+		&producertracing.ProducerTracingOptions{
+			MessagingSystem: "kafka",
+			DestinationKind: "topic",
+			Destination:     topic,
+			OtherAttributes: []attribute.KeyValue{
+				semconv.MessagingKafkaMessageKey(partitionKey),
+			},
+		},
+	)
+
+	headers := types.HeadersFromMetadata(meta)
+	if payload.ContentEncoding != "" {
+		headers = append(
+			headers,
+			kgo.RecordHeader{Key: types.ContentEncodingHeader, Value: []byte(payload.ContentEncoding)},
+		)
+	}
+
+	kafkaRecord := &kgo.Record{
+		Topic:     topic,
+		Value:     payload.Data,
+		Headers:   headers,
+		Timestamp: time.Now(),
+	}
+	// the records without a key are spread over the partitions
+	if partitionKey != "" {
+		kafkaRecord.Key = []byte(partitionKey)
+	}
+
+	return &record{message: message, record: kafkaRecord, span: span}, nil
+}
+
+// produce produces the records and waits for the brokers to acknowledge them.
+func (p *kafkaProducer) produce(ctx context.Context, records []*record) error {
+	if p.produceTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.produceTimeout)
+		defer cancel()
+	}
+
+	kafkaRecords := make([]*kgo.Record, len(records))
+	for i, r := range records {
+		kafkaRecords[i] = r.record
+	}
+
+	results := p.client.ProduceSync(ctx, kafkaRecords...)
+
+	var errs []error
+	for i, result := range results {
+		r := records[i]
+		if result.Err != nil {
+			err := errors.WrapIff(result.Err, "error in producing the message to the topic `%s`", r.record.Topic)
+			errs = append(errs, producertracing.FinishProducerSpan(r.span, err))
+
+			continue
+		}
+
+		r.span.SetAttributes(
+			semconv.MessagingKafkaDestinationPartition(int(result.Record.Partition)),
+			semconv.MessagingKafkaMessageOffset(int(result.Record.Offset)),
+		)
+
+		for _, notification := range p.isProducedNotifications {
+			if notification != nil {
+				notification(r.message)
+			}
+		}
+
+		if err := producertracing.FinishProducerSpan(r.span, nil); err != nil {
+			p.logger.Error("error in finishing producer span: %v", err)
+		}
+	}
+
+	return errors.Combine(errs...)
+}
+
+// serialize runs the message transforms on a copy of the message and serializes the copy.
+func (p *kafkaProducer) serialize(
+	message messagingTypes.IMessage,
+	messageSerializer serializer.MessageSerializer,
+) (*serializer.EventSerializationResult, error) {
+	encoded, err := p.transforms.EncodeMessage(message)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedMessage, ok := encoded.(messagingTypes.IMessage)
+	if !ok {
+		return nil, errors.Errorf("transformed message of `%s` is not a message", versioning.TypeName(message))
+	}
+
+	return messageSerializer.Serialize(encodedMessage)
+}
+
+// copyMetadata returns a shallow copy of a metadata.
+func copyMetadata(meta metadata.Metadata) metadata.Metadata {
+	copied := make(metadata.Metadata, len(meta))
+	for key, value := range meta {
+		copied[key] = value
+	}
+
+	return copied
+}
@@ -0,0 +1,47 @@
+// Package inmemory provides an in-process kafka cluster for the tests.
+package inmemory
+
+import (
+	"testing"
+
+	"github.com/twmb/franz-go/pkg/kfake"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/kafka/config"
+)
+
+// DefaultNumPartitions is the partition count of the topics of the in-process cluster.
+const DefaultNumPartitions = 3
+
+// NewKafkaCluster starts an in-process kafka cluster that is closed at the end of the test and returns its
+// brokers, the topics are created up front and the other topics on their first use.
+func NewKafkaCluster(t *testing.T, topics ...string) []string {
+	t.Helper()
+
+	opts := []kfake.Opt{
+		kfake.NumBrokers(1),
+		kfake.AllowAutoTopicCreation(),
+		kfake.DefaultNumPartitions(DefaultNumPartitions),
+	}
+	if len(topics) > 0 {
+		opts = append(opts, kfake.SeedTopics(DefaultNumPartitions, topics...))
+	}
+
+	cluster, err := kfake.NewCluster(opts...)
+	if err != nil {
+		t.Fatalf("failed to start the kafka cluster: %v", err)
+	}
+	t.Cleanup(cluster.Close)
+
+	return cluster.ListenAddrs()
+}
+
+// KafkaClusterOptionsDecorator is a decorator for the kafka options that points them to an in-process cluster.
+var KafkaClusterOptionsDecorator = func(t *testing.T) interface{} {
+	t.Helper()
+
+	return func(c *config.KafkaOptions) *config.KafkaOptions {
+		c.Brokers = NewKafkaCluster(t)
+
+		return c
+	}
+}
@@ -0,0 +1,69 @@
+// Package types provides the kafka clients.
+package types
+
+import (
+	"emperror.dev/errors"
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/kafka/config"
+)
+
+// ErrNoBrokers is returned for the kafka options without a seed broker.
+var ErrNoBrokers = errors.New("kafka options have no brokers")
+
+// NewKafkaClient creates a new kafka client for the producers and the health checks.
+func NewKafkaClient(kafkaOptions *config.KafkaOptions) (*kgo.Client, error) {
+	opts, err := ClientOptions(kafkaOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	compression, err := compressionCodec(kafkaOptions.Compression)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := kgo.NewClient(append(opts, kgo.ProducerBatchCompression(compression))...)
+	if err != nil {
+		return nil, errors.WrapIf(err, "error in creating the kafka client")
+	}
+
+	return client, nil
+}
+
+// ClientOptions returns the client options of the kafka options that are shared by the producer and the consumer
+// clients.
+func ClientOptions(kafkaOptions *config.KafkaOptions) ([]kgo.Opt, error) {
+	if kafkaOptions == nil || len(kafkaOptions.Brokers) == 0 {
+		return nil, ErrNoBrokers
+	}
+
+	opts := []kgo.Opt{kgo.SeedBrokers(kafkaOptions.Brokers...)}
+	if kafkaOptions.ClientID != "" {
+		opts = append(opts, kgo.ClientID(kafkaOptions.ClientID))
+	}
+
+	if kafkaOptions.AllowAutoTopicCreation {
+		opts = append(opts, kgo.AllowAutoTopicCreation())
+	}
+
+	return opts, nil
+}
+
+// compressionCodec returns the codec of a compression name.
+func compressionCodec(compression string) (kgo.CompressionCodec, error) {
+	switch compression {
+	case "":
+		return kgo.NoCompression(), nil
+	case "gzip":
+		return kgo.GzipCompression(), nil
+	case "snappy":
+		return kgo.SnappyCompression(), nil
+	case "lz4":
+		return kgo.Lz4Compression(), nil
+	case "zstd":
+		return kgo.ZstdCompression(), nil
+	default:
+		return kgo.NoCompression(), errors.Errorf("kafka compression `%s` is not supported", compression)
+	}
+}
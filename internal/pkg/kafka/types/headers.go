@@ -0,0 +1,53 @@
+// Package types provides the conversion of the message metadata to the kafka record headers.
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	messageHeader "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/messageheader"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/metadata"
+)
+
+// ContentEncodingHeader is the record header of the content encoding of an encoded payload.
+const ContentEncodingHeader = "content-encoding"
+
+// HeadersFromMetadata converts the metadata of a message to the record headers, the values are sent as strings and
+// the times in RFC 3339 format.
+func HeadersFromMetadata(meta metadata.Metadata) []kgo.RecordHeader {
+	headers := make([]kgo.RecordHeader, 0, len(meta))
+	for _, key := range meta.Keys() {
+		var value string
+		switch v := meta.Get(key).(type) {
+		case string:
+			value = v
+		case time.Time:
+			value = v.Format(time.RFC3339Nano)
+		case []byte:
+			value = string(v)
+		default:
+			value = fmt.Sprint(v)
+		}
+
+		headers = append(headers, kgo.RecordHeader{Key: key, Value: []byte(value)})
+	}
+
+	return headers
+}
+
+// MetadataFromHeaders converts the record headers to the metadata of a message, the created time header is parsed
+// back to a time.
+func MetadataFromHeaders(headers []kgo.RecordHeader) metadata.Metadata {
+	meta := metadata.Metadata{}
+	for _, header := range headers {
+		meta.Set(header.Key, string(header.Value))
+	}
+
+	if created, err := time.Parse(time.RFC3339Nano, meta.GetString(messageHeader.Created)); err == nil {
+		messageHeader.SetMessageCreated(meta, created)
+	}
+
+	return meta
+}
@@ -4,9 +4,12 @@ package mongodb
 import (
 	"context"
 	"log"
+	"regexp"
+	"strings"
 
 	"emperror.dev/errors"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
@@ -21,6 +24,17 @@ func Paginate[T any](
 	listQuery *utils.ListQuery,
 	collection *mongo.Collection,
 	filter interface{},
+) (*utils.ListResult[T], error) {
+	return PaginateWithOptions[T](ctx, listQuery, collection, filter, nil)
+}
+
+// PaginateWithOptions paginates the mongodb with additional find options like sort and projection.
+func PaginateWithOptions[T any](
+	ctx context.Context,
+	listQuery *utils.ListQuery,
+	collection *mongo.Collection,
+	filter interface{},
+	findOptions *options.FindOptions,
 ) (*utils.ListResult[T], error) {
 	if filter == nil {
 		filter = bson.D{}
@@ -37,6 +51,7 @@ func Paginate[T any](
 	cursor, err := collection.Find(
 		ctx,
 		filter,
+		findOptions,
 		&options.FindOptions{
 			Limit: &limit,
 			Skip:  &skip,
@@ -66,3 +81,33 @@ func Paginate[T any](
 		count,
 	), nil
 }
+
+// EscapeRegex escapes the regex meta characters of a user supplied term, so it matches literally.
+func EscapeRegex(term string) string {
+	return regexp.QuoteMeta(term)
+}
+
+// ContainsRegex creates a case-insensitive regex that matches the literal term anywhere in a field.
+func ContainsRegex(term string) primitive.Regex {
+	return primitive.Regex{Pattern: EscapeRegex(term), Options: "i"}
+}
+
+// EscapeTextSearch removes the $text operators (phrases and negations) from a user supplied search,
+// so every word is treated as a plain search term.
+// https://www.mongodb.com/docs/manual/reference/operator/query/text/#-search-field
+func EscapeTextSearch(search string) string {
+	return strings.Join(TextSearchTerms(search), " ")
+}
+
+// TextSearchTerms splits a user supplied search into its plain terms.
+func TextSearchTerms(search string) []string {
+	var terms []string
+	for _, term := range strings.Fields(strings.ReplaceAll(search, `"`, " ")) {
+		term = strings.TrimLeft(term, "-")
+		if term != "" {
+			terms = append(terms, term)
+		}
+	}
+
+	return terms
+}
@@ -9,7 +9,6 @@ import (
 	"emperror.dev/errors"
 	"github.com/iancoleman/strcase"
 	"go.mongodb.org/mongo-driver/bson"
-	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
@@ -259,7 +258,7 @@ func (m *mongoGenericRepository[TDataModel, TEntity]) Search(
 			a = append(
 				a,
 				bson.D{
-					{Key: name, Value: primitive.Regex{Pattern: searchTerm}},
+					{Key: name, Value: mongodb.ContainsRegex(searchTerm)},
 				},
 			)
 		}
@@ -285,7 +284,7 @@ func (m *mongoGenericRepository[TDataModel, TEntity]) Search(
 			continue
 		}
 		name := strcase.ToLowerCamel(field.Name)
-		a = append(a, bson.D{{Key: name, Value: primitive.Regex{Pattern: searchTerm}}})
+		a = append(a, bson.D{{Key: name, Value: mongodb.ContainsRegex(searchTerm)}})
 	}
 	filter := bson.D{
 		{Key: "$or", Value: a},
@@ -0,0 +1,60 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// EntriesTable is the name of the audit log table.
+const EntriesTable = "audit_logs"
+
+// DefaultActor is the actor of the changes without an actor in the context.
+const DefaultActor = "system"
+
+// Audit actions.
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+// Entry is a struct that contains an audit log entry, the snapshots are the json of the row columns.
+type Entry struct {
+	ID        uuid.UUID `gorm:"primaryKey"`
+	Table     string    `gorm:"column:table_name"`
+	RecordID  string
+	Action    string
+	Actor     string
+	Before    *string `gorm:"type:jsonb"`
+	After     *string `gorm:"type:jsonb"`
+	ChangedAt time.Time
+}
+
+// TableName overrides the table name used by Entry to `audit_logs`.
+func (e *Entry) TableName() string {
+	return EntriesTable
+}
+
+// actorKey is the context key of the actor.
+type actorKey struct{}
+
+// WithActor returns a context with the actor recorded in the audit log entries of its changes.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor of the context, or DefaultActor when there is none.
+func ActorFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return DefaultActor
+	}
+
+	actor, ok := ctx.Value(actorKey{}).(string)
+	if !ok || actor == "" {
+		return DefaultActor
+	}
+
+	return actor
+}
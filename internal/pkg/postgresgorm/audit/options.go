@@ -0,0 +1,33 @@
+// Package audit provides a gorm plugin that records an audit log entry with the before and after snapshot of every change.
+package audit
+
+// config is a struct that contains the audit plugin config.
+type config struct {
+	tables map[string]struct{}
+}
+
+// Option is a function that applies a config.
+type Option interface {
+	apply(*config)
+}
+
+// optionFunc is a function that represents a option func.
+type optionFunc func(*config)
+
+// apply is a function that applies the option.
+func (o optionFunc) apply(c *config) {
+	o(c)
+}
+
+// WithTables is a function that restricts the audit to the given tables, all the tables are audited by default.
+func WithTables(tables ...string) Option {
+	return optionFunc(func(cfg *config) {
+		if cfg.tables == nil {
+			cfg.tables = make(map[string]struct{}, len(tables))
+		}
+
+		for _, table := range tables {
+			cfg.tables[table] = struct{}{}
+		}
+	})
+}
@@ -0,0 +1,487 @@
+package audit
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	json "github.com/goccy/go-json"
+	uuid "github.com/satori/go.uuid"
+)
+
+// beforeSnapshotKey is the statement instance key of the rows before an update or a delete.
+const beforeSnapshotKey = "audit:before"
+
+// plugin is a gorm plugin that records the changes of the audited tables in the audit log table,
+// in the same transaction as the change.
+type plugin struct {
+	config
+}
+
+// NewPlugin creates the audit gorm plugin, creates are recorded with their after snapshot, updates, deletes and
+// the rows an upsert updated with the snapshot of the changed rows before and after the statement.
+// Raw sql statements are not recorded.
+func NewPlugin(opts ...Option) gorm.Plugin {
+	p := &plugin{}
+	for _, opt := range opts {
+		opt.apply(&p.config)
+	}
+
+	return p
+}
+
+// Name returns the name of the plugin.
+func (p *plugin) Name() string {
+	return "audit"
+}
+
+// Initialize registers the audit callbacks.
+func (p *plugin) Initialize(db *gorm.DB) error {
+	callback := db.Callback()
+
+	err := callback.Create().Before("gorm:create").Register("audit:before_create", p.beforeCreate)
+	if err != nil {
+		return err
+	}
+
+	err = callback.Create().After("gorm:create").Register("audit:after_create", p.afterCreate)
+	if err != nil {
+		return err
+	}
+
+	err = callback.Update().Before("gorm:update").Register("audit:before_update", p.beforeChange)
+	if err != nil {
+		return err
+	}
+
+	err = callback.Update().After("gorm:update").Register("audit:after_update", p.afterChange(ActionUpdate))
+	if err != nil {
+		return err
+	}
+
+	err = callback.Delete().Before("gorm:delete").Register("audit:before_delete", p.beforeChange)
+	if err != nil {
+		return err
+	}
+
+	return callback.Delete().After("gorm:delete").Register("audit:after_delete", p.afterChange(ActionDelete))
+}
+
+// enabled returns true when the table of the statement is audited.
+func (p *plugin) enabled(db *gorm.DB) bool {
+	table := db.Statement.Table
+	if db.DryRun || table == "" || table == EntriesTable {
+		return false
+	}
+
+	if p.tables == nil {
+		return true
+	}
+
+	_, ok := p.tables[table]
+
+	return ok
+}
+
+// beforeCreate keeps the existing rows an upsert conflicts with, they are the rows it updates instead of creating.
+func (p *plugin) beforeCreate(db *gorm.DB) {
+	if db.Error != nil || !p.enabled(db) {
+		return
+	}
+
+	columns, ok := conflictColumns(db)
+	if !ok {
+		return
+	}
+
+	rows, err := snapshot(db, []clause.Expression{conflictCondition(columns, createdRows(db))})
+	if err != nil {
+		_ = db.AddError(errors.WrapIf(err, "error in reading the audit before snapshot"))
+
+		return
+	}
+
+	db.InstanceSet(beforeSnapshotKey, rows)
+}
+
+// afterCreate records the created rows, the rows of an upsert that existed before it are recorded as updates.
+func (p *plugin) afterCreate(db *gorm.DB) {
+	if db.Error != nil || db.RowsAffected == 0 || !p.enabled(db) {
+		return
+	}
+
+	rows := createdRows(db)
+
+	if columns, ok := conflictColumns(db); ok {
+		p.afterUpsert(db, columns, rows)
+
+		return
+	}
+
+	entries := make([]*Entry, 0, len(rows))
+
+	for _, row := range rows {
+		entry, err := newEntry(db, ActionCreate, row, nil, row)
+		if err != nil {
+			_ = db.AddError(err)
+
+			return
+		}
+
+		entries = append(entries, entry)
+	}
+
+	saveEntries(db, entries)
+}
+
+// afterUpsert records the rows of an upsert with the rows read after it, the rows that conflicted with an existing
+// row are updates of it and the unchanged ones, e.g. of a `DO NOTHING` conflict, are skipped.
+func (p *plugin) afterUpsert(db *gorm.DB, columns []string, rows []map[string]interface{}) {
+	value, _ := db.InstanceGet(beforeSnapshotKey)
+	before, _ := value.([]map[string]interface{})
+
+	after, err := snapshot(db, []clause.Expression{conflictCondition(columns, rows)})
+	if err != nil {
+		_ = db.AddError(errors.WrapIf(err, "error in reading the audit after snapshot"))
+
+		return
+	}
+
+	beforeByKey := make(map[string]map[string]interface{}, len(before))
+	for _, row := range before {
+		beforeByKey[recordID(columns, row)] = row
+	}
+
+	entries := make([]*Entry, 0, len(after))
+
+	for _, afterRow := range after {
+		action := ActionCreate
+		beforeRow, existed := beforeByKey[recordID(columns, afterRow)]
+		if existed {
+			action = ActionUpdate
+		}
+
+		entry, err := newEntry(db, action, afterRow, beforeRow, afterRow)
+		if err != nil {
+			_ = db.AddError(err)
+
+			return
+		}
+
+		if existed && *entry.Before == *entry.After {
+			continue
+		}
+
+		entries = append(entries, entry)
+	}
+
+	saveEntries(db, entries)
+}
+
+// conflictColumns returns the conflict columns of an upsert statement, the primary keys when the `ON CONFLICT`
+// clause has no columns, and false for a plain create.
+func conflictColumns(db *gorm.DB) ([]string, bool) {
+	c, ok := db.Statement.Clauses["ON CONFLICT"]
+	if !ok {
+		return nil, false
+	}
+
+	onConflict, ok := c.Expression.(clause.OnConflict)
+	if !ok {
+		return nil, false
+	}
+
+	if len(onConflict.Columns) == 0 {
+		return primaryKeys(db), true
+	}
+
+	columns := make([]string, 0, len(onConflict.Columns))
+	for _, column := range onConflict.Columns {
+		columns = append(columns, column.Name)
+	}
+
+	return columns, true
+}
+
+// conflictCondition returns the condition of the rows with the conflict column values of the rows.
+func conflictCondition(columns []string, rows []map[string]interface{}) clause.Expression {
+	if len(columns) == 1 {
+		values := make([]interface{}, 0, len(rows))
+		for _, row := range rows {
+			values = append(values, row[columns[0]])
+		}
+
+		return clause.IN{Column: clause.Column{Name: columns[0]}, Values: values}
+	}
+
+	conditions := make([]clause.Expression, 0, len(rows))
+	for _, row := range rows {
+		equals := make([]clause.Expression, 0, len(columns))
+		for _, column := range columns {
+			equals = append(equals, clause.Eq{Column: clause.Column{Name: column}, Value: row[column]})
+		}
+
+		conditions = append(conditions, clause.And(equals...))
+	}
+
+	return clause.Or(conditions...)
+}
+
+// beforeChange keeps the rows the statement is going to change.
+func (p *plugin) beforeChange(db *gorm.DB) {
+	if db.Error != nil || !p.enabled(db) {
+		return
+	}
+
+	conditions := statementConditions(db)
+
+	// a global update or delete is rejected by gorm, so there is nothing to read
+	if len(conditions) == 0 {
+		return
+	}
+
+	rows, err := snapshot(db, conditions)
+	if err != nil {
+		_ = db.AddError(errors.WrapIf(err, "error in reading the audit before snapshot"))
+
+		return
+	}
+
+	db.InstanceSet(beforeSnapshotKey, rows)
+}
+
+// afterChange records the rows that changed, a soft deleted row is in the after snapshot and a hard deleted one isn't.
+func (p *plugin) afterChange(action string) func(db *gorm.DB) {
+	return func(db *gorm.DB) {
+		if db.Error != nil || db.RowsAffected == 0 || !p.enabled(db) {
+			return
+		}
+
+		value, ok := db.InstanceGet(beforeSnapshotKey)
+		if !ok {
+			return
+		}
+
+		before, _ := value.([]map[string]interface{})
+		if len(before) == 0 {
+			return
+		}
+
+		keys := primaryKeys(db)
+		values := make([]interface{}, 0, len(before))
+		for _, row := range before {
+			values = append(values, row[keys[0]])
+		}
+
+		after, err := snapshot(db, []clause.Expression{
+			clause.IN{Column: clause.Column{Name: keys[0]}, Values: values},
+		})
+		if err != nil {
+			_ = db.AddError(errors.WrapIf(err, "error in reading the audit after snapshot"))
+
+			return
+		}
+
+		afterByID := make(map[string]map[string]interface{}, len(after))
+		for _, row := range after {
+			afterByID[recordID(keys, row)] = row
+		}
+
+		entries := make([]*Entry, 0, len(before))
+
+		for _, row := range before {
+			afterRow := afterByID[recordID(keys, row)]
+
+			entry, err := newEntry(db, action, row, row, afterRow)
+			if err != nil {
+				_ = db.AddError(err)
+
+				return
+			}
+
+			// the rows matched by the conditions but not changed by the statement
+			if entry.After != nil && *entry.Before == *entry.After {
+				continue
+			}
+
+			entries = append(entries, entry)
+		}
+
+		saveEntries(db, entries)
+	}
+}
+
+// statementConditions returns the where conditions of the statement and the primary keys of its model,
+// gorm adds the primary key conditions itself while building the update and delete statements.
+func statementConditions(db *gorm.DB) []clause.Expression {
+	stmt := db.Statement
+
+	var conditions []clause.Expression
+
+	if c, ok := stmt.Clauses["WHERE"]; ok {
+		if where, ok := c.Expression.(clause.Where); ok {
+			conditions = append(conditions, where.Exprs...)
+		}
+	}
+
+	if stmt.Schema == nil || len(stmt.Schema.PrimaryFields) == 0 {
+		return conditions
+	}
+
+	for _, value := range []reflect.Value{stmt.ReflectValue, reflect.ValueOf(stmt.Model)} {
+		if !value.IsValid() || (value.Kind() == reflect.Ptr && value.IsNil()) {
+			continue
+		}
+
+		_, queryValues := schema.GetIdentityFieldValuesMap(
+			stmt.Context,
+			value,
+			stmt.Schema.PrimaryFields,
+		)
+
+		column, values := schema.ToQueryValues(
+			stmt.Table,
+			stmt.Schema.PrimaryFieldDBNames,
+			queryValues,
+		)
+		if len(values) > 0 {
+			conditions = append(conditions, clause.IN{Column: column, Values: values})
+
+			break
+		}
+	}
+
+	return conditions
+}
+
+// snapshot reads the rows of the statement table with the conditions, inside the transaction of the statement.
+func snapshot(db *gorm.DB, conditions []clause.Expression) ([]map[string]interface{}, error) {
+	var rows []map[string]interface{}
+
+	err := db.Session(&gorm.Session{NewDB: true, SkipHooks: true}).
+		Table(db.Statement.Table).
+		Clauses(clause.Where{Exprs: conditions}).
+		Find(&rows).Error
+
+	return rows, err
+}
+
+// createdRows returns the columns of the created values.
+func createdRows(db *gorm.DB) []map[string]interface{} {
+	stmt := db.Statement
+	if stmt.Schema == nil {
+		return nil
+	}
+
+	rowOf := func(value reflect.Value) map[string]interface{} {
+		row := make(map[string]interface{}, len(stmt.Schema.Fields))
+
+		for _, field := range stmt.Schema.Fields {
+			if field.DBName == "" {
+				continue
+			}
+
+			row[field.DBName], _ = field.ValueOf(stmt.Context, value)
+		}
+
+		return row
+	}
+
+	switch stmt.ReflectValue.Kind() {
+	case reflect.Slice, reflect.Array:
+		rows := make([]map[string]interface{}, 0, stmt.ReflectValue.Len())
+		for i := 0; i < stmt.ReflectValue.Len(); i++ {
+			rows = append(rows, rowOf(reflect.Indirect(stmt.ReflectValue.Index(i))))
+		}
+
+		return rows
+	case reflect.Struct:
+		return []map[string]interface{}{rowOf(stmt.ReflectValue)}
+	default:
+		return nil
+	}
+}
+
+// primaryKeys returns the primary key columns of the statement table, `id` when the schema is unknown.
+func primaryKeys(db *gorm.DB) []string {
+	if db.Statement.Schema != nil && len(db.Statement.Schema.PrimaryFieldDBNames) > 0 {
+		return db.Statement.Schema.PrimaryFieldDBNames
+	}
+
+	return []string{"id"}
+}
+
+// recordID returns the primary key of the row, the values of a composite key are comma separated.
+func recordID(keys []string, row map[string]interface{}) string {
+	values := make([]string, 0, len(keys))
+	for _, key := range keys {
+		values = append(values, fmt.Sprint(row[key]))
+	}
+
+	return strings.Join(values, ",")
+}
+
+// newEntry creates the audit entry of a row.
+func newEntry(
+	db *gorm.DB,
+	action string,
+	row map[string]interface{},
+	before map[string]interface{},
+	after map[string]interface{},
+) (*Entry, error) {
+	beforeJSON, err := marshalRow(before)
+	if err != nil {
+		return nil, err
+	}
+
+	afterJSON, err := marshalRow(after)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Entry{
+		ID:        uuid.NewV4(),
+		Table:     db.Statement.Table,
+		RecordID:  recordID(primaryKeys(db), row),
+		Action:    action,
+		Actor:     ActorFromContext(db.Statement.Context),
+		Before:    beforeJSON,
+		After:     afterJSON,
+		ChangedAt: time.Now(),
+	}, nil
+}
+
+// marshalRow returns the json of the row, or nil for a missing row.
+func marshalRow(row map[string]interface{}) (*string, error) {
+	if row == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(row)
+	if err != nil {
+		return nil, errors.WrapIf(err, "error in marshaling the audit snapshot")
+	}
+
+	s := string(b)
+
+	return &s, nil
+}
+
+// saveEntries adds the entries in the transaction of the statement, so they are rolled back with the change.
+func saveEntries(db *gorm.DB, entries []*Entry) {
+	if len(entries) == 0 {
+		return
+	}
+
+	err := db.Session(&gorm.Session{NewDB: true, SkipHooks: true}).
+		Create(&entries).Error
+	if err != nil {
+		_ = db.AddError(errors.WrapIf(err, "error in saving the audit log entries"))
+	}
+}
@@ -0,0 +1,183 @@
+//go:build unit
+// +build unit
+
+// Package audit provides the audit gorm plugin.
+package audit
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// auditedProduct is the data model of the audited table.
+type auditedProduct struct {
+	ID        uuid.UUID `gorm:"primaryKey"`
+	Name      string
+	Price     float64
+	CreatedAt time.Time
+	gorm.DeletedAt
+}
+
+// TableName overrides the table name used by auditedProduct to `products`.
+func (p *auditedProduct) TableName() string {
+	return "products"
+}
+
+type auditPluginTests struct {
+	suite.Suite
+	db  *gorm.DB
+	ctx context.Context
+}
+
+func TestAuditPlugin(t *testing.T) {
+	suite.Run(t, &auditPluginTests{})
+}
+
+func (a *auditPluginTests) SetupTest() {
+	db, err := gorm.Open(
+		sqlite.Open(filepath.Join(a.T().TempDir(), "audit.db")),
+		&gorm.Config{},
+	)
+	a.Require().NoError(err)
+	a.Require().NoError(db.AutoMigrate(&auditedProduct{}, &Entry{}))
+	a.Require().NoError(db.Use(NewPlugin(WithTables("products"))))
+
+	a.db = db
+	a.ctx = WithActor(context.Background(), "admin")
+}
+
+// TestShouldRecordCreateUpdateAndDelete tests the plugin should record the snapshots of every change.
+func (a *auditPluginTests) TestShouldRecordCreateUpdateAndDelete() {
+	product := &auditedProduct{ID: uuid.NewV4(), Name: "first", Price: 10}
+	a.Require().NoError(a.db.WithContext(a.ctx).Create(product).Error)
+
+	err := a.db.WithContext(a.ctx).
+		Model(&auditedProduct{}).
+		Where("id = ?", product.ID).
+		Update("price", 20).Error
+	a.Require().NoError(err)
+
+	a.Require().NoError(a.db.WithContext(a.ctx).Delete(product).Error)
+
+	var entries []*Entry
+	err = a.db.Order("changed_at").Find(&entries).Error
+	a.Require().NoError(err)
+	a.Require().Len(entries, 3)
+
+	a.Assert().Equal(ActionCreate, entries[0].Action)
+	a.Assert().Nil(entries[0].Before)
+	a.Assert().NotNil(entries[0].After)
+
+	a.Assert().Equal(ActionUpdate, entries[1].Action)
+	a.Assert().Contains(*entries[1].Before, `"price":10`)
+	a.Assert().Contains(*entries[1].After, `"price":20`)
+
+	// a soft delete keeps the row, so it is in the after snapshot
+	a.Assert().Equal(ActionDelete, entries[2].Action)
+	a.Assert().NotNil(entries[2].After)
+
+	for _, entry := range entries {
+		a.Assert().Equal("products", entry.Table)
+		a.Assert().Equal(product.ID.String(), entry.RecordID)
+		a.Assert().Equal("admin", entry.Actor)
+	}
+}
+
+// TestShouldRecordHardDeleteWithoutAfterSnapshot tests a permanent delete should have no after snapshot.
+func (a *auditPluginTests) TestShouldRecordHardDeleteWithoutAfterSnapshot() {
+	product := &auditedProduct{ID: uuid.NewV4(), Name: "first", Price: 10}
+	a.Require().NoError(a.db.Create(product).Error)
+	a.Require().NoError(a.db.Unscoped().Delete(product).Error)
+
+	var entry Entry
+	err := a.db.Where("action = ?", ActionDelete).First(&entry).Error
+	a.Require().NoError(err)
+	a.Assert().NotNil(entry.Before)
+	a.Assert().Nil(entry.After)
+	a.Assert().Equal(DefaultActor, entry.Actor)
+}
+
+// TestShouldSkipUnchangedRows tests a statement that changes nothing should not be recorded.
+func (a *auditPluginTests) TestShouldSkipUnchangedRows() {
+	product := &auditedProduct{ID: uuid.NewV4(), Name: "first", Price: 10}
+	a.Require().NoError(a.db.Create(product).Error)
+
+	err := a.db.Model(product).Update("price", 10).Error
+	a.Require().NoError(err)
+
+	var count int64
+	a.Require().NoError(a.db.Model(&Entry{}).Where("action = ?", ActionUpdate).Count(&count).Error)
+	a.Assert().Zero(count)
+}
+
+// TestShouldIgnoreTablesNotAudited tests the tables out of the audited ones should not be recorded.
+func (a *auditPluginTests) TestShouldIgnoreTablesNotAudited() {
+	type otherModel struct {
+		ID   uuid.UUID `gorm:"primaryKey"`
+		Name string
+	}
+
+	a.Require().NoError(a.db.AutoMigrate(&otherModel{}))
+	a.Require().NoError(a.db.Create(&otherModel{ID: uuid.NewV4(), Name: "other"}).Error)
+
+	var count int64
+	a.Require().NoError(a.db.Model(&Entry{}).Count(&count).Error)
+	a.Assert().Zero(count)
+}
+
+// TestShouldRecordUpsertOfExistingRowAsUpdate tests an upsert should record the conflicting rows as updates
+// with their before snapshot and the new rows as creates.
+func (a *auditPluginTests) TestShouldRecordUpsertOfExistingRowAsUpdate() {
+	existing := &auditedProduct{ID: uuid.NewV4(), Name: "first", Price: 10}
+	a.Require().NoError(a.db.Create(existing).Error)
+
+	products := []*auditedProduct{
+		{ID: existing.ID, Name: "first", Price: 20},
+		{ID: uuid.NewV4(), Name: "second", Price: 30},
+	}
+	err := a.db.WithContext(a.ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"price"}),
+		}).
+		Create(&products).Error
+	a.Require().NoError(err)
+
+	var update Entry
+	err = a.db.Where("action = ?", ActionUpdate).First(&update).Error
+	a.Require().NoError(err)
+	a.Assert().Equal(existing.ID.String(), update.RecordID)
+	a.Assert().Contains(*update.Before, `"price":10`)
+	a.Assert().Contains(*update.After, `"price":20`)
+	a.Assert().Equal("admin", update.Actor)
+
+	var creates []*Entry
+	err = a.db.Where("action = ?", ActionCreate).Order("changed_at").Find(&creates).Error
+	a.Require().NoError(err)
+	a.Require().Len(creates, 2)
+	a.Assert().Equal(products[1].ID.String(), creates[1].RecordID)
+	a.Assert().Nil(creates[1].Before)
+}
+
+// TestShouldSkipUpsertConflictsWithoutChanges tests an upsert that does nothing on a conflict should not be recorded.
+func (a *auditPluginTests) TestShouldSkipUpsertConflictsWithoutChanges() {
+	existing := &auditedProduct{ID: uuid.NewV4(), Name: "first", Price: 10}
+	a.Require().NoError(a.db.Create(existing).Error)
+
+	err := a.db.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&auditedProduct{ID: existing.ID, Name: "first", Price: 20}).Error
+	a.Require().NoError(err)
+
+	var count int64
+	a.Require().NoError(a.db.Model(&Entry{}).Count(&count).Error)
+	a.Assert().Equal(int64(1), count)
+}
@@ -6,3 +6,6 @@ type contextKey string
 
 // TxKey is a context key that represents a transaction.
 const TxKey contextKey = "tx_key"
+
+// AfterCommitActionsKey is a context key that represents the actions that run after a transaction commits.
+const AfterCommitActionsKey contextKey = "after_commit_actions_key"
@@ -52,7 +52,7 @@ func (c *gormDBContext) WithTxIfExists(
 	return NewGormDBContext(tx)
 }
 
-// RunInTx runs a transaction.
+// RunInTx runs a transaction, the after commit actions of the action run once the transaction is committed.
 func (c *gormDBContext) RunInTx(
 	ctx context.Context,
 	action contracts.ActionFunc,
@@ -92,7 +92,9 @@ func (c *gormDBContext) RunInTx(
 
 	if err = tx.WithContext(ctx).Commit().Error; err != nil {
 		defaultlogger.GetLogger().Errorf("transaction commit error: %+v", err)
+
+		return err
 	}
 
-	return err
+	return gormextensions.RunAfterCommitActions(ctx)
 }
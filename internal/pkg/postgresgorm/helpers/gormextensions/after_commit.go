@@ -0,0 +1,106 @@
+package gormextensions
+
+import (
+	"context"
+	"sync"
+
+	"emperror.dev/errors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/postgresgorm/constants"
+)
+
+// AfterCommitAction is an action that runs after the transaction of its context commits.
+type AfterCommitAction func(ctx context.Context) error
+
+// afterCommitActions are the actions of a transaction that run after it commits.
+type afterCommitActions struct {
+	mu         sync.Mutex
+	actions    []AfterCommitAction
+	savePoints map[string]int
+}
+
+// newAfterCommitActions creates the after commit actions of a new transaction.
+func newAfterCommitActions() *afterCommitActions {
+	return &afterCommitActions{savePoints: map[string]int{}}
+}
+
+// AfterCommit runs the action after the transaction of the context commits and right away without a transaction, so
+// the messages of the changes are only published once the changes are committed. The actions of a rolled back
+// transaction never run.
+func AfterCommit(ctx context.Context, action AfterCommitAction) error {
+	actions, ok := ctx.Value(constants.AfterCommitActionsKey).(*afterCommitActions)
+	if !ok {
+		return action(ctx)
+	}
+
+	actions.mu.Lock()
+	defer actions.mu.Unlock()
+
+	actions.actions = append(actions.actions, action)
+
+	return nil
+}
+
+// RunAfterCommitActions runs the after commit actions of the committed transaction of the context, the changes of
+// the transaction stay committed when an action fails.
+func RunAfterCommitActions(ctx context.Context) error {
+	actions, ok := ctx.Value(constants.AfterCommitActionsKey).(*afterCommitActions)
+	if !ok {
+		return nil
+	}
+
+	actions.mu.Lock()
+	pending := actions.actions
+	actions.actions = nil
+	actions.mu.Unlock()
+
+	var err error
+	for _, action := range pending {
+		err = errors.Append(err, action(ctx))
+	}
+
+	return err
+}
+
+// SavePoint creates a savepoint in the transaction of the context.
+func SavePoint(ctx context.Context, name string) error {
+	tx, err := GetTxFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.SavePoint(name).Error; err != nil {
+		return errors.WrapIf(err, "error in creating the savepoint")
+	}
+
+	if actions, ok := ctx.Value(constants.AfterCommitActionsKey).(*afterCommitActions); ok {
+		actions.mu.Lock()
+		actions.savePoints[name] = len(actions.actions)
+		actions.mu.Unlock()
+	}
+
+	return nil
+}
+
+// RollbackToSavePoint rolls the transaction of the context back to the savepoint and drops the after commit actions
+// that were added after the savepoint.
+func RollbackToSavePoint(ctx context.Context, name string) error {
+	tx, err := GetTxFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.RollbackTo(name).Error; err != nil {
+		return errors.WrapIf(err, "error in rolling back to the savepoint")
+	}
+
+	if actions, ok := ctx.Value(constants.AfterCommitActionsKey).(*afterCommitActions); ok {
+		actions.mu.Lock()
+		if count, ok := actions.savePoints[name]; ok && count < len(actions.actions) {
+			actions.actions = actions.actions[:count]
+		}
+		actions.mu.Unlock()
+	}
+
+	return nil
+}
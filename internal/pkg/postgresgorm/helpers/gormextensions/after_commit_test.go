@@ -0,0 +1,120 @@
+//go:build unit
+// +build unit
+
+// Package gormextensions provides a set of functions for the gorm extensions.
+package gormextensions
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type afterCommitTests struct {
+	suite.Suite
+	db *gorm.DB
+}
+
+func TestAfterCommit(t *testing.T) {
+	suite.Run(t, &afterCommitTests{})
+}
+
+func (a *afterCommitTests) SetupTest() {
+	db, err := gorm.Open(
+		sqlite.Open(filepath.Join(a.T().TempDir(), "after_commit.db")),
+		&gorm.Config{},
+	)
+	a.Require().NoError(err)
+
+	a.db = db
+}
+
+// TestShouldRunRightAwayWithoutTransaction tests an action without a transaction runs right away.
+func (a *afterCommitTests) TestShouldRunRightAwayWithoutTransaction() {
+	ran := false
+
+	err := AfterCommit(context.Background(), func(_ context.Context) error {
+		ran = true
+
+		return nil
+	})
+
+	a.Require().NoError(err)
+	a.True(ran)
+}
+
+// TestShouldRunAfterCommit tests the actions of a transaction run only when the after commit actions are run.
+func (a *afterCommitTests) TestShouldRunAfterCommit() {
+	tx := a.db.Begin()
+	ctx := SetTxToContext(context.Background(), tx)
+
+	var ran []string
+	for _, name := range []string{"first", "second"} {
+		a.Require().NoError(AfterCommit(ctx, func(_ context.Context) error {
+			ran = append(ran, name)
+
+			return nil
+		}))
+	}
+
+	a.Empty(ran)
+	a.Require().NoError(tx.Commit().Error)
+	a.Require().NoError(RunAfterCommitActions(ctx))
+	a.Equal([]string{"first", "second"}, ran)
+
+	// the actions run once
+	a.Require().NoError(RunAfterCommitActions(ctx))
+	a.Equal([]string{"first", "second"}, ran)
+}
+
+// TestShouldDropTheActionsAfterTheSavePoint tests rolling back to a savepoint drops the actions added after it.
+func (a *afterCommitTests) TestShouldDropTheActionsAfterTheSavePoint() {
+	tx := a.db.Begin()
+	ctx := SetTxToContext(context.Background(), tx)
+
+	var ran []string
+	add := func(name string) {
+		a.Require().NoError(AfterCommit(ctx, func(_ context.Context) error {
+			ran = append(ran, name)
+
+			return nil
+		}))
+	}
+
+	add("before")
+	a.Require().NoError(SavePoint(ctx, "change"))
+	add("rolled back")
+	a.Require().NoError(RollbackToSavePoint(ctx, "change"))
+	add("after")
+
+	a.Require().NoError(tx.Commit().Error)
+	a.Require().NoError(RunAfterCommitActions(ctx))
+	a.Equal([]string{"before", "after"}, ran)
+}
+
+// TestShouldReturnTheErrorsOfTheActions tests every action runs and their errors are returned.
+func (a *afterCommitTests) TestShouldReturnTheErrorsOfTheActions() {
+	tx := a.db.Begin()
+	ctx := SetTxToContext(context.Background(), tx)
+
+	ran := 0
+	a.Require().NoError(AfterCommit(ctx, func(_ context.Context) error {
+		ran++
+
+		return errors.New("publish failed")
+	}))
+	a.Require().NoError(AfterCommit(ctx, func(_ context.Context) error {
+		ran++
+
+		return nil
+	}))
+
+	a.Require().NoError(tx.Commit().Error)
+	a.ErrorContains(RunAfterCommitActions(ctx), "publish failed")
+	a.Equal(2, ran)
+}
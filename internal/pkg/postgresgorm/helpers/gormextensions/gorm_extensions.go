@@ -46,6 +46,7 @@ func GetTxFromContextIfExists(ctx context.Context) *gorm.DB {
 // SetTxToContext sets the transaction to the context.
 func SetTxToContext(ctx context.Context, tx *gorm.DB) *contracts.GormContext {
 	newCtx := context.WithValue(ctx, constants.TxKey, tx)
+	newCtx = context.WithValue(newCtx, constants.AfterCommitActionsKey, newAfterCommitActions())
 	gormContext := &contracts.GormContext{Tx: tx, Context: newCtx}
 
 	return gormContext
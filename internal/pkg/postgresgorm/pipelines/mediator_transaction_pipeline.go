@@ -32,7 +32,7 @@ func NewMediatorTransactionPipeline(
 	}
 }
 
-// Handle handles the mediator transaction pipeline.
+// Handle runs the tx requests in a transaction and runs their after commit actions once the transaction is committed.
 func (m *mediatorTransactionPipeline) Handle(
 	ctx context.Context,
 	request interface{},
@@ -45,6 +45,11 @@ func (m *mediatorTransactionPipeline) Handle(
 		return next(ctx)
 	}
 
+	// a request that is sent inside a transaction, like the requests of a worker, joins it
+	if gormextensions.GetTxFromContextIfExists(ctx) != nil {
+		return next(ctx)
+	}
+
 	var result interface{}
 
 	// https://gorm.io/docs/transactions.html#Transaction
@@ -96,5 +101,11 @@ func (m *mediatorTransactionPipeline) Handle(
 		return nil, err
 	}
 
+	if err = gormextensions.RunAfterCommitActions(ctx); err != nil {
+		m.logger.Errorf("after commit actions error for request `%s`: %+v", requestName, err)
+
+		return nil, err
+	}
+
 	return result, nil
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/bus"
 	consumer2 "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/consumer"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/producer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/requestreply"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/utils"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/metadata"
@@ -29,12 +30,18 @@ import (
 // RabbitmqBus is the interface for the rabbitmq bus.
 type RabbitmqBus interface {
 	bus.Bus
+	producer.BatchProducer
 	consumerConfigurations.RabbitMQConsumerConnector
+	requestreply.Requester
+	requestreply.Responder
 }
 
 // rabbitmqBus is a struct that represents a rabbitmq bus.
 type rabbitmqBus struct {
-	messageTypeConsumers    map[reflect.Type][]consumer2.Consumer
+	messageTypeConsumers map[reflect.Type][]consumer2.Consumer
+	// boundTypeConsumers are the consumers of the bound message types of their queues, they are started with their
+	// own message type and only looked up to connect the handlers of the bound message types.
+	boundTypeConsumers      map[reflect.Type][]consumer2.Consumer
 	producer                producer.Producer
 	rabbitmqConfiguration   *configurations.RabbitMQConfiguration
 	rabbitmqConfigBuilder   configurations.RabbitMQConfigurationBuilder
@@ -65,6 +72,7 @@ func NewRabbitmqBus(
 		producerFactory:       producerFactory,
 		rabbitmqConfigBuilder: builder,
 		messageTypeConsumers:  map[reflect.Type][]consumer2.Consumer{},
+		boundTypeConsumers:    map[reflect.Type][]consumer2.Consumer{},
 	}
 
 	producersConfigurationMap := make(
@@ -84,7 +92,8 @@ func NewRabbitmqBus(
 	lo.ForEach(
 		rabbitBus.rabbitmqConfiguration.ConsumersConfigurations,
 		func(config *consumerConfigurations.RabbitMQConsumerConfiguration, _ int) {
-			key := config.ConsumerMessageType.String()
+			// a message type can have multiple consumers with different names, each with its own queue
+			key := fmt.Sprintf("%s:%s", config.ConsumerMessageType.String(), config.Name)
 			consumersConfigurationMap[key] = config
 		},
 	)
@@ -108,6 +117,7 @@ func NewRabbitmqBus(
 			rabbitBus.messageTypeConsumers[consumerConfiguration.ConsumerMessageType],
 			mqConsumer,
 		)
+		rabbitBus.addBoundTypeConsumer(consumerConfiguration, mqConsumer)
 	}
 
 	mqProducer, err := producerFactory.CreateProducer(
@@ -189,10 +199,21 @@ func (r *rabbitmqBus) ConnectRabbitMQConsumer(
 		r.messageTypeConsumers[typeName],
 		mqConsumer,
 	)
+	r.addBoundTypeConsumer(consumerConfig, mqConsumer)
 
 	return nil
 }
 
+// addBoundTypeConsumer adds a consumer to the consumers of its bound message types.
+func (r *rabbitmqBus) addBoundTypeConsumer(
+	consumerConfiguration *consumerConfigurations.RabbitMQConsumerConfiguration,
+	mqConsumer consumer2.Consumer,
+) {
+	for _, messageType := range consumerConfiguration.BoundMessageTypes {
+		r.boundTypeConsumers[messageType] = append(r.boundTypeConsumers[messageType], mqConsumer)
+	}
+}
+
 // createNewConsumer creates a new consumer for the given message type and handler.
 func (r *rabbitmqBus) createNewConsumer(
 	messageType types.IMessage,
@@ -218,7 +239,9 @@ func (r *rabbitmqBus) createNewConsumer(
 	)
 }
 
-// ConnectConsumerHandler adds a handler to existing consumer. creates new consumer if not exist.
+// ConnectConsumerHandler adds a handler to existing consumer. creates new consumer if not exist. The consumers that
+// have the message type as a bound message type are existing consumers too, their handlers receive the messages of
+// all the message types of their queues.
 func (r *rabbitmqBus) ConnectConsumerHandler(
 	messageType types.IMessage,
 	consumerHandler consumer2.ConsumerHandler,
@@ -226,6 +249,9 @@ func (r *rabbitmqBus) ConnectConsumerHandler(
 	typeName := utils.GetMessageBaseReflectType(messageType)
 
 	consumersForType := r.messageTypeConsumers[typeName]
+	if consumersForType == nil {
+		consumersForType = r.boundTypeConsumers[typeName]
+	}
 	if consumersForType != nil {
 		for _, c := range consumersForType {
 			c.ConnectHandler(consumerHandler)
@@ -244,12 +270,45 @@ func (r *rabbitmqBus) ConnectConsumerHandler(
 	return nil
 }
 
+// ConnectResponder adds a consumer handler that replies to the requests of the request type with a request handler,
+// the consumers of the request type are created like ConnectConsumerHandler creates them.
+func (r *rabbitmqBus) ConnectResponder(
+	requestType types.IMessage,
+	handler requestreply.RequestHandler,
+) error {
+	replier, ok := r.producer.(requestreply.Replier)
+	if !ok {
+		return requestreply.ErrRequestsNotSupported
+	}
+
+	return r.ConnectConsumerHandler(requestType, requestreply.NewResponderHandler(handler, replier, r.logger))
+}
+
+// SendRequest sends a request to the responders of its type and waits for the reply.
+func (r *rabbitmqBus) SendRequest(
+	ctx context.Context,
+	request types.IMessage,
+	meta metadata.Metadata,
+) (types.IMessage, error) {
+	requester, ok := r.producer.(requestreply.Requester)
+	if !ok {
+		return nil, requestreply.ErrRequestsNotSupported
+	}
+
+	return requester.SendRequest(ctx, request, meta)
+}
+
 // Start starts the rabbitmq bus.
 func (r *rabbitmqBus) Start(ctx context.Context) error {
-	r.logger.Infof(
-		"rabbitmq is running on host: %s",
-		r.consumerFactory.Connection().Raw().LocalAddr().String(),
-	)
+	// the in-memory transport has no amqp connection
+	if connection := r.consumerFactory.Connection(); connection != nil && connection.Raw() != nil {
+		r.logger.Infof(
+			"rabbitmq is running on host: %s",
+			connection.Raw().LocalAddr().String(),
+		)
+	} else {
+		r.logger.Info("rabbitmq is running in memory")
+	}
 
 	for messageType, consumers := range r.messageTypeConsumers {
 		name := typeMapper.GetTypeNameByType(messageType)
@@ -331,6 +390,30 @@ func (r *rabbitmqBus) PublishMessage(
 	return r.producer.PublishMessage(ctx, message, meta)
 }
 
+// PublishMessages publishes a batch of messages to the rabbitmq bus and waits for all of their confirms together.
+func (r *rabbitmqBus) PublishMessages(
+	ctx context.Context,
+	messages []types.IMessage,
+	meta metadata.Metadata,
+) error {
+	if r.producer == nil {
+		r.logger.Fatal("can't find a producer for publishing messages")
+	}
+
+	if batchProducer, ok := r.producer.(producer.BatchProducer); ok {
+		return batchProducer.PublishMessages(ctx, messages, meta)
+	}
+
+	var errs []error
+	for _, message := range messages {
+		if err := r.producer.PublishMessage(ctx, message, meta); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Combine(errs...)
+}
+
 // PublishMessageWithTopicName publishes a message to the rabbitmq bus with a topic name.
 func (r *rabbitmqBus) PublishMessageWithTopicName(
 	ctx context.Context,
@@ -65,12 +65,14 @@ func TestAddRabbitMQ(t *testing.T) {
 		conn,
 		serializer,
 		Logger,
+		nil,
 	)
 	producerFactory := rabbitmqproducer.NewProducerFactory(
 		options,
 		conn,
 		serializer,
 		Logger,
+		nil,
 	)
 
 	b, err := NewRabbitmqBus(
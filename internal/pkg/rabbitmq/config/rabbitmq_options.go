@@ -18,8 +18,59 @@ type RabbitmqOptions struct {
 	DeliveryMode        uint8
 	Persisted           bool
 	AppId               string
-	AutoStart           bool `mapstructure:"autoStart"           default:"true"`
-	Reconnecting        bool `mapstructure:"reconnecting"        default:"true"`
+	AutoStart           bool                     `mapstructure:"autoStart"           default:"true"`
+	Reconnecting        bool                     `mapstructure:"reconnecting"        default:"true"`
+	ProducerOptions     *RabbitmqProducerOptions `mapstructure:"producerOptions"`
+	// InMemory runs the bus on an in-memory broker of the process instead of a rabbitmq server, for the tests.
+	InMemory bool `mapstructure:"inMemory"`
+}
+
+// RabbitmqProducerOptions is a struct that contains the rabbitmq producer channel pool and confirm options.
+type RabbitmqProducerOptions struct {
+	// ChannelPoolSize is the max number of the confirm mode channels that are kept open for publishing.
+	ChannelPoolSize int `mapstructure:"channelPoolSize" default:"8"`
+	// ConfirmTimeout is the max time to wait for the broker to confirm a published message.
+	ConfirmTimeout time.Duration `mapstructure:"confirmTimeout" default:"30s"`
+	// Compression is the content encoding of the published payloads, `gzip` or `zstd`, an empty compression
+	// doesn't compress the payloads.
+	Compression string `mapstructure:"compression"`
+	// CompressionMinSize is the min size in bytes of a compressed payload, the smaller payloads are sent as is.
+	CompressionMinSize int `mapstructure:"compressionMinSize" default:"1024"`
+	// RequestTimeout is the max time to wait for the reply of a request, a sooner deadline of the request context
+	// is kept.
+	RequestTimeout time.Duration `mapstructure:"requestTimeout" default:"30s"`
+}
+
+// GetProducerOptions returns the producer options, with the defaults for the missing values.
+func (o *RabbitmqOptions) GetProducerOptions() *RabbitmqProducerOptions {
+	producerOptions := &RabbitmqProducerOptions{
+		ChannelPoolSize:    8,
+		ConfirmTimeout:     30 * time.Second,
+		CompressionMinSize: 1024,
+		RequestTimeout:     30 * time.Second,
+	}
+	if o == nil || o.ProducerOptions == nil {
+		return producerOptions
+	}
+
+	if o.ProducerOptions.ChannelPoolSize > 0 {
+		producerOptions.ChannelPoolSize = o.ProducerOptions.ChannelPoolSize
+	}
+
+	if o.ProducerOptions.ConfirmTimeout > 0 {
+		producerOptions.ConfirmTimeout = o.ProducerOptions.ConfirmTimeout
+	}
+
+	producerOptions.Compression = o.ProducerOptions.Compression
+	if o.ProducerOptions.CompressionMinSize > 0 {
+		producerOptions.CompressionMinSize = o.ProducerOptions.CompressionMinSize
+	}
+
+	if o.ProducerOptions.RequestTimeout > 0 {
+		producerOptions.RequestTimeout = o.ProducerOptions.RequestTimeout
+	}
+
+	return producerOptions
 }
 
 // RabbitmqHostOptions is a struct that contains the rabbitmq host options.
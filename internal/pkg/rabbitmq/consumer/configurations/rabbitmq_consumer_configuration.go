@@ -17,10 +17,20 @@ import (
 type RabbitMQConsumerConfiguration struct {
 	Name                string
 	ConsumerMessageType reflect.Type
-	Pipelines           []pipeline.ConsumerPipeline
-	Handlers            []consumer2.ConsumerHandler
+	// BoundMessageTypes are the other message types of the queue of the consumer, their exchanges are bound to the
+	// queue so the messages of all the types are delivered in one queue, like the events of an aggregate that should
+	// be ordered by their partition key across their types.
+	BoundMessageTypes []reflect.Type
+	Pipelines         []pipeline.ConsumerPipeline
+	Handlers          []consumer2.ConsumerHandler
 	*consumer2.ConsumerOptions
 	ConcurrencyLimit int
+	// OrderByPartitionKey handles the messages of the same partition key one after another when the concurrency limit
+	// is more than one, the messages of the other partition keys are still handled concurrently. The order is only
+	// kept within the queue of the consumer and within one instance, the competing consumers of the other instances
+	// receive the other messages of the queue. A message that is nacked and requeued after its retries is handled
+	// again after the messages of its partition key that were already received, so it breaks the order of its key.
+	OrderByPartitionKey bool
 	// The prefetch count tells the Rabbit connection how many messages to retrieve from the server per request.
 	PrefetchCount   int
 	AutoAck         bool
@@ -5,6 +5,7 @@ import (
 	messageConsumer "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/consumer"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/pipeline"
 	types2 "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/utils"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/types"
 )
 
@@ -21,6 +22,8 @@ type RabbitMQConsumerConfigurationBuilder interface {
 	WithNoLocal(noLocal bool) RabbitMQConsumerConfigurationBuilder
 	WithNoWait(noWait bool) RabbitMQConsumerConfigurationBuilder
 	WithConcurrencyLimit(limit int) RabbitMQConsumerConfigurationBuilder
+	WithOrderByPartitionKey(ordered bool) RabbitMQConsumerConfigurationBuilder
+	WithBoundMessages(messages ...types2.IMessage) RabbitMQConsumerConfigurationBuilder
 	WithPrefetchCount(count int) RabbitMQConsumerConfigurationBuilder
 	WithConsumerID(consumerId string) RabbitMQConsumerConfigurationBuilder
 	WithQueueName(queueName string) RabbitMQConsumerConfigurationBuilder
@@ -134,6 +137,29 @@ func (b *rabbitMQConsumerConfigurationBuilder) WithConcurrencyLimit(
 	return b
 }
 
+// WithOrderByPartitionKey sets the ordering of the messages by their partition key.
+func (b *rabbitMQConsumerConfigurationBuilder) WithOrderByPartitionKey(
+	ordered bool,
+) RabbitMQConsumerConfigurationBuilder {
+	b.rabbitmqConsumerConfigurations.OrderByPartitionKey = ordered
+
+	return b
+}
+
+// WithBoundMessages binds the exchanges of other message types to the queue of the consumer.
+func (b *rabbitMQConsumerConfigurationBuilder) WithBoundMessages(
+	messages ...types2.IMessage,
+) RabbitMQConsumerConfigurationBuilder {
+	for _, message := range messages {
+		b.rabbitmqConsumerConfigurations.BoundMessageTypes = append(
+			b.rabbitmqConsumerConfigurations.BoundMessageTypes,
+			utils.GetMessageBaseReflectType(message),
+		)
+	}
+
+	return b
+}
+
 // WithPrefetchCount sets the prefetch count.
 func (b *rabbitMQConsumerConfigurationBuilder) WithPrefetchCount(
 	count int,
@@ -3,6 +3,7 @@ package consumer
 
 import (
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/consumer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/transform"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
 	serializer "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
@@ -18,6 +19,7 @@ type consumerFactory struct {
 	eventSerializer serializer.MessageSerializer
 	logger          logger.Logger
 	rabbitmqOptions *config.RabbitmqOptions
+	transforms      *transform.Pipeline
 }
 
 // NewConsumerFactory creates a new consumer factory.
@@ -26,12 +28,14 @@ func NewConsumerFactory(
 	connection types2.IConnection,
 	eventSerializer serializer.MessageSerializer,
 	l logger.Logger,
+	transforms *transform.Pipeline,
 ) consumercontracts.ConsumerFactory {
 	return &consumerFactory{
 		rabbitmqOptions: rabbitmqOptions,
 		logger:          l,
 		eventSerializer: eventSerializer,
 		connection:      connection,
+		transforms:      transforms,
 	}
 }
 
@@ -46,6 +50,7 @@ func (c *consumerFactory) CreateConsumer(
 		consumerConfiguration,
 		c.eventSerializer,
 		c.logger,
+		c.transforms,
 		isConsumedNotifications...)
 }
 
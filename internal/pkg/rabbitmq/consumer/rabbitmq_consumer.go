@@ -5,24 +5,25 @@ import (
 	"context"
 	"fmt"
 	"reflect"
-	"time"
 
 	"emperror.dev/errors"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
-	linq "github.com/ahmetb/go-linq/v3"
-	retry "github.com/avast/retry-go"
 	amqp091 "github.com/rabbitmq/amqp091-go"
 	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
 
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/consumer"
+	messageHeader "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/messageheader"
 	consumertracing "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/otel/tracing/consumer"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/pipeline"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/transform"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/transform/compression"
 	messagingTypes "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/utils"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/metadata"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/versioning"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/config"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/consumer/configurations"
@@ -35,17 +36,6 @@ const (
 	ContentType = "application/json"
 )
 
-const (
-	retryAttempts = 3
-	retryDelay    = 300 * time.Millisecond
-)
-
-var retryOptions = []retry.Option{
-	retry.Attempts(retryAttempts),
-	retry.Delay(retryDelay),
-	retry.DelayType(retry.BackOffDelay),
-}
-
 // rabbitMQConsumer is a struct that contains the rabbitmq consumer.
 type rabbitMQConsumer struct {
 	rabbitmqConsumerOptions *configurations.RabbitMQConsumerConfiguration
@@ -59,6 +49,7 @@ type rabbitMQConsumer struct {
 	handlers                []consumer.ConsumerHandler
 	pipelines               []pipeline.ConsumerPipeline
 	isConsumedNotifications []func(message messagingTypes.IMessage)
+	transforms              *transform.Pipeline
 }
 
 // NewRabbitMQConsumer creates a new generic RabbitMQ consumer.
@@ -68,6 +59,7 @@ func NewRabbitMQConsumer(
 	consumerConfiguration *configurations.RabbitMQConsumerConfiguration,
 	messageSerializer serializer.MessageSerializer,
 	logger logger.Logger,
+	transforms *transform.Pipeline,
 	isConsumedNotifications ...func(message messagingTypes.IMessage),
 ) (consumer.Consumer, error) {
 	if consumerConfiguration == nil {
@@ -80,6 +72,12 @@ func NewRabbitMQConsumer(
 		)
 	}
 
+	// the compressed payloads are decompressed whatever the compression of the producer is
+	compressionTransform, err := compression.NewCompressionTransform("", 0)
+	if err != nil {
+		return nil, err
+	}
+
 	deliveryRoutines := make(
 		chan struct{},
 		consumerConfiguration.ConcurrencyLimit,
@@ -94,6 +92,7 @@ func NewRabbitMQConsumer(
 		connection:              connection,
 		handlers:                consumerConfiguration.Handlers,
 		pipelines:               consumerConfiguration.Pipelines,
+		transforms:              transforms.With(compressionTransform),
 	}
 
 	cons.isConsumedNotifications = isConsumedNotifications
@@ -164,6 +163,7 @@ func (r *rabbitMQConsumer) handleMessages(
 	ctx context.Context,
 	msgs <-chan amqp091.Delivery,
 	chClosedCh chan *amqp091.Error,
+	handle func(ctx context.Context, delivery amqp091.Delivery),
 ) {
 	for {
 		select {
@@ -186,7 +186,7 @@ func (r *rabbitMQConsumer) handleMessages(
 			}
 
 			// handle received message and remove message form queue with a manual ack
-			r.handleReceived(ctx, msg)
+			handle(ctx, msg)
 		}
 	}
 }
@@ -232,6 +232,10 @@ func (r *rabbitMQConsumer) Start(ctx context.Context) error {
 		return err
 	}
 
+	if err := r.bindMessageTypes(queue); err != nil {
+		return err
+	}
+
 	msgs, err := r.channel.Consume(
 		queue,
 		r.rabbitmqConsumerOptions.ConsumerId,
@@ -249,14 +253,77 @@ func (r *rabbitMQConsumer) Start(ctx context.Context) error {
 	chClosedCh := make(chan *amqp091.Error, 1)
 	r.channel.NotifyClose(chClosedCh)
 
+	if r.rabbitmqConsumerOptions.OrderByPartitionKey && r.rabbitmqConsumerOptions.ConcurrencyLimit > 1 {
+		r.logger.Infof(
+			"Processing messages ordered by partition key on %d lanes",
+			r.rabbitmqConsumerOptions.ConcurrencyLimit,
+		)
+		go r.handleOrderedMessages(ctx, msgs, chClosedCh)
+
+		return nil
+	}
+
 	for i := 0; i < r.rabbitmqConsumerOptions.ConcurrencyLimit; i++ {
 		r.logger.Infof("Processing messages on thread %d", i)
-		go r.handleMessages(ctx, msgs, chClosedCh)
+		go r.handleMessages(ctx, msgs, chClosedCh, r.handleReceived)
+	}
+
+	return nil
+}
+
+// bindMessageTypes binds the exchanges of the bound message types to the queue of the consumer.
+func (r *rabbitMQConsumer) bindMessageTypes(queue string) error {
+	for _, messageType := range r.rabbitmqConsumerOptions.BoundMessageTypes {
+		exchange := utils.GetTopicOrExchangeNameFromType(messageType)
+		if err := r.setupExchange(exchange); err != nil {
+			return err
+		}
+
+		err := r.channel.QueueBind(
+			queue,
+			utils.GetRoutingKeyFromType(messageType),
+			exchange,
+			r.rabbitmqConsumerOptions.NoWait,
+			r.rabbitmqConsumerOptions.BindingOptions.Args)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// handleOrderedMessages dispatches the messages from the channel to the lanes of their partition keys, so the messages
+// of the same partition key are handled in their delivery order.
+func (r *rabbitMQConsumer) handleOrderedMessages(
+	ctx context.Context,
+	msgs <-chan amqp091.Delivery,
+	chClosedCh chan *amqp091.Error,
+) {
+	lanes := consumer.NewPartitionLanes(
+		ctx,
+		r.rabbitmqConsumerOptions.ConcurrencyLimit,
+		r.rabbitmqConsumerOptions.PrefetchCount,
+		r.handleReceived,
+	)
+	// the deliveries that aren't handled before the shutdown are redelivered after their channel is closed
+	defer lanes.Close()
+
+	r.handleMessages(ctx, msgs, chClosedCh, func(ctx context.Context, delivery amqp091.Delivery) {
+		lanes.Dispatch(ctx, r.partitionKey(delivery), delivery)
+	})
+}
+
+// partitionKey returns the partition key header that the producer sets for the partitioned messages, the deliveries
+// without a partition key are spread over the lanes by their message id.
+func (r *rabbitMQConsumer) partitionKey(delivery amqp091.Delivery) string {
+	if partitionKey, ok := delivery.Headers[messageHeader.PartitionKey].(string); ok && partitionKey != "" {
+		return partitionKey
+	}
+
+	return delivery.MessageId
+}
+
 // Stop stops the rabbitmq consumer.
 func (r *rabbitMQConsumer) Stop() error {
 	defer func() {
@@ -457,70 +524,33 @@ func (r *rabbitMQConsumer) runHandlersWithRetry(
 	handler consumer.ConsumerHandler,
 	messageConsumeContext messagingTypes.MessageConsumeContext,
 ) error {
-	err := retry.Do(func() error {
-		var lastHandler pipeline.ConsumerHandlerFunc
-
-		if len(r.pipelines) > 0 {
-			reversPipes := r.reversOrder(r.pipelines)
-			lastHandler = func(ctx context.Context) error {
-				return handler.Handle(ctx, messageConsumeContext)
-			}
-
-			aggregateResult := linq.From(reversPipes).
-				AggregateWithSeedT(lastHandler, func(next pipeline.ConsumerHandlerFunc, pipe pipeline.ConsumerPipeline) pipeline.ConsumerHandlerFunc {
-					pipeValue := pipe
-					nexValue := next
-
-					return func(ctx context.Context) error {
-						return pipeValue.Handle(
-							ctx,
-							messageConsumeContext,
-							nexValue,
-						)
-					}
-				})
-
-			v, ok := aggregateResult.(pipeline.ConsumerHandlerFunc)
-			if !ok {
-				return errors.New(
-					"failed to convert aggregateResult to pipeline.ConsumerHandlerFunc",
-				)
-			}
-			err := v(ctx)
-			if err != nil {
-				return errors.Wrap(
-					err,
-					"error handling consumer handlers pipeline",
-				)
-			}
-
-			return nil
-		}
-		err := handler.Handle(ctx, messageConsumeContext)
-		if err != nil {
-			return err
-		}
-
-		return nil
-	}, append(retryOptions, retry.Context(ctx))...)
-
-	return err
+	return consumer.HandleWithRetry(ctx, r.pipelines, handler, messageConsumeContext)
 }
 
 func (r *rabbitMQConsumer) createConsumeContext(
 	delivery amqp091.Delivery,
 ) messagingTypes.MessageConsumeContext {
+	var meta metadata.Metadata
+	if delivery.Headers != nil {
+		meta = metadata.MapToMetadata(delivery.Headers)
+	}
+
+	// the reply address of a request is a property of the delivery, the responders read it from the metadata
+	if delivery.ReplyTo != "" {
+		if meta == nil {
+			meta = metadata.Metadata{}
+		}
+		messageHeader.SetReplyTo(meta, delivery.ReplyTo)
+	}
+
 	message := r.deserializeData(
 		delivery.ContentType,
+		delivery.ContentEncoding,
 		delivery.Type,
+		versioning.GetSchemaVersion(meta),
 		delivery.Body,
 	)
 
-	var meta metadata.Metadata
-	if delivery.Headers != nil {
-		meta = metadata.MapToMetadata(delivery.Headers)
-	}
-
 	consumeContext := messagingTypes.NewMessageConsumeContext(
 		message,
 		meta,
@@ -537,7 +567,9 @@ func (r *rabbitMQConsumer) createConsumeContext(
 
 func (r *rabbitMQConsumer) deserializeData(
 	contentType string,
+	contentEncoding string,
 	eventType string,
+	schemaVersion int,
 	body []byte,
 ) messagingTypes.IMessage {
 	if contentType == "" {
@@ -551,49 +583,28 @@ func (r *rabbitMQConsumer) deserializeData(
 	}
 
 	r.logger.Infof(
-		"[DEBUG] Deserializing message - contentType: %s, eventType: %s, body: %s, expected consumer type: %s",
+		"[DEBUG] Deserializing message - contentType: %s, eventType: %s, expected consumer type: %s",
 		contentType,
 		eventType,
-		string(body),
 		r.rabbitmqConsumerOptions.ConsumerMessageType.String(),
 	)
 
-	if contentType == ContentType {
-		// r.rabbitmqConsumerOptions.ConsumerMessageType --> actual type
-		// deserialize, err := r.messageSerializer.DeserializeType(body, r.rabbitmqConsumerOptions.ConsumerMessageType, contentType)
-		deserialize, err := r.messageSerializer.Deserialize(
-			body,
-			eventType,
-			contentType,
-		) // or this to explicit type deserialization
-		if err != nil {
-			r.logger.Errorf(
-				fmt.Sprintf(
-					"error in deserilizng of type '%s' in the consumer: %v",
-					eventType,
-					err,
-				),
-			)
-
-			return nil
-		}
-
-		r.logger.Infof("[DEBUG] Successfully deserialized message of type: %s", eventType)
+	deserialize, err := consumer.DeserializeMessage(
+		r.messageSerializer,
+		r.transforms,
+		contentType,
+		contentEncoding,
+		eventType,
+		schemaVersion,
+		body,
+	)
+	if err != nil {
+		r.logger.Errorf(fmt.Sprintf("error in the consumer: %v", err))
 
-		return deserialize
+		return nil
 	}
 
-	return nil
-}
-
-func (r *rabbitMQConsumer) reversOrder(
-	values []pipeline.ConsumerPipeline,
-) []pipeline.ConsumerPipeline {
-	var reverseValues []pipeline.ConsumerPipeline
-
-	for i := len(values) - 1; i >= 0; i-- {
-		reverseValues = append(reverseValues, values[i])
-	}
+	r.logger.Infof("[DEBUG] Successfully deserialized message of type: %s", eventType)
 
-	return reverseValues
+	return deserialize
 }
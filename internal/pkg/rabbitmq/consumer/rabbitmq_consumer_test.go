@@ -63,12 +63,14 @@ func TestConsumerWithFakeMessage(t *testing.T) {
 		conn,
 		eventSerializer,
 		Logger,
+		nil,
 	)
 	producerFactory := producer.NewProducerFactory(
 		options,
 		conn,
 		eventSerializer,
 		Logger,
+		nil,
 	)
 
 	fakeHandler := consumer.NewRabbitMQFakeTestConsumerHandler[ProducerConsumerMessage]()
@@ -0,0 +1,286 @@
+// Package inmemory provides an in-memory rabbitmq transport with the exchange, routing key and queue semantics of
+// rabbitmq, for running the bus without a rabbitmq server.
+package inmemory
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/types"
+)
+
+// Delivery is a message delivered to a queue of the in-memory broker.
+type Delivery struct {
+	Exchange        string
+	RoutingKey      string
+	MessageId       string
+	CorrelationId   string
+	ReplyTo         string
+	Type            string
+	ContentType     string
+	ContentEncoding string
+	Headers         map[string]interface{}
+	Body            []byte
+	Timestamp       time.Time
+	DeliveryTag     uint64
+	Redelivered     bool
+}
+
+// exchange is an exchange of the in-memory broker and its queue bindings.
+type exchange struct {
+	name     string
+	kind     types.ExchangeType
+	bindings []*binding
+}
+
+// binding binds a queue to an exchange with a routing key.
+type binding struct {
+	queue      *queue
+	routingKey string
+}
+
+// Broker is an in-memory message broker, the messages published to an exchange are routed to the bound queues like
+// rabbitmq routes them and the consumers of a queue compete for its messages.
+type Broker struct {
+	mu        sync.RWMutex
+	exchanges map[string]*exchange
+	queues    map[string]*queue
+}
+
+// NewBroker creates a new in-memory broker.
+func NewBroker() *Broker {
+	return &Broker{
+		exchanges: make(map[string]*exchange),
+		queues:    make(map[string]*queue),
+	}
+}
+
+// DeclareExchange declares an exchange, an existing exchange should have the same type.
+func (b *Broker) DeclareExchange(name string, kind types.ExchangeType) error {
+	if kind == "" {
+		kind = types.ExchangeTopic
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if existing, ok := b.exchanges[name]; ok {
+		if existing.kind != kind {
+			return errors.Errorf(
+				"exchange `%s` is already declared with the type `%s`, not `%s`",
+				name,
+				existing.kind,
+				kind,
+			)
+		}
+
+		return nil
+	}
+
+	b.exchanges[name] = &exchange{name: name, kind: kind}
+
+	return nil
+}
+
+// DeclareQueue declares a queue, the consumers of a declared queue share its messages.
+func (b *Broker) DeclareQueue(name string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.queues[name]; !ok {
+		b.queues[name] = newQueue(name)
+	}
+}
+
+// BindQueue binds a declared queue to a declared exchange with a routing key.
+func (b *Broker) BindQueue(queueName string, routingKey string, exchangeName string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ex, ok := b.exchanges[exchangeName]
+	if !ok {
+		return errors.Errorf("exchange `%s` is not declared", exchangeName)
+	}
+
+	q, ok := b.queues[queueName]
+	if !ok {
+		return errors.Errorf("queue `%s` is not declared", queueName)
+	}
+
+	for _, existing := range ex.bindings {
+		if existing.queue == q && existing.routingKey == routingKey {
+			return nil
+		}
+	}
+	ex.bindings = append(ex.bindings, &binding{queue: q, routingKey: routingKey})
+
+	return nil
+}
+
+// Publish routes a delivery to the queues bound to an exchange, like rabbitmq an unroutable delivery is dropped and
+// the default exchange with the empty name routes a delivery to the queue of its routing key.
+func (b *Broker) Publish(exchangeName string, routingKey string, delivery Delivery) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if exchangeName == "" {
+		delivery.RoutingKey = routingKey
+		if q, ok := b.queues[routingKey]; ok {
+			q.enqueue(delivery)
+		}
+
+		return nil
+	}
+
+	ex, ok := b.exchanges[exchangeName]
+	if !ok {
+		return errors.Errorf("exchange `%s` is not declared", exchangeName)
+	}
+
+	delivery.Exchange = exchangeName
+	delivery.RoutingKey = routingKey
+
+	routed := make(map[*queue]struct{})
+	for _, bind := range ex.bindings {
+		if _, ok := routed[bind.queue]; ok || !routes(ex.kind, bind.routingKey, routingKey) {
+			continue
+		}
+		routed[bind.queue] = struct{}{}
+		bind.queue.enqueue(delivery)
+	}
+
+	return nil
+}
+
+// queue returns a declared queue.
+func (b *Broker) queue(name string) (*queue, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	q, ok := b.queues[name]
+
+	return q, ok
+}
+
+// routes returns true if an exchange routes a routing key to a binding.
+func routes(kind types.ExchangeType, bindingKey string, routingKey string) bool {
+	switch kind {
+	case types.ExchangeFanout:
+		return true
+	case types.ExchangeDirect:
+		return bindingKey == routingKey
+	default:
+		return topicMatches(strings.Split(bindingKey, "."), strings.Split(routingKey, "."))
+	}
+}
+
+// topicMatches matches the words of a routing key with the words of a topic binding key, `*` matches one word and
+// `#` matches zero or more words.
+func topicMatches(pattern []string, words []string) bool {
+	if len(pattern) == 0 {
+		return len(words) == 0
+	}
+
+	switch pattern[0] {
+	case "#":
+		for i := 0; i <= len(words); i++ {
+			if topicMatches(pattern[1:], words[i:]) {
+				return true
+			}
+		}
+
+		return false
+	case "*":
+		return len(words) > 0 && topicMatches(pattern[1:], words[1:])
+	default:
+		return len(words) > 0 && pattern[0] == words[0] && topicMatches(pattern[1:], words[1:])
+	}
+}
+
+// queue is an unbounded queue of deliveries, so a handler that publishes to its own queue doesn't block.
+type queue struct {
+	name        string
+	mu          sync.Mutex
+	deliveries  []Delivery
+	deliveryTag uint64
+	ready       chan struct{}
+}
+
+// newQueue creates a new queue.
+func newQueue(name string) *queue {
+	return &queue{name: name, ready: make(chan struct{}, 1)}
+}
+
+// enqueue adds a delivery to the tail of the queue.
+func (q *queue) enqueue(delivery Delivery) {
+	q.mu.Lock()
+	q.deliveryTag++
+	delivery.DeliveryTag = q.deliveryTag
+	q.deliveries = append(q.deliveries, delivery)
+	q.mu.Unlock()
+
+	q.signal()
+}
+
+// requeue adds a rejected delivery back to the queue as a redelivery.
+func (q *queue) requeue(delivery Delivery) {
+	delivery.Redelivered = true
+	q.enqueue(delivery)
+}
+
+// dequeue waits for the head of the queue until the context is done.
+func (q *queue) dequeue(ctx context.Context) (Delivery, bool) {
+	for {
+		q.mu.Lock()
+		if len(q.deliveries) > 0 {
+			delivery := q.deliveries[0]
+			q.deliveries = q.deliveries[1:]
+			remaining := len(q.deliveries)
+			q.mu.Unlock()
+
+			// the other consumers of the queue are woken up for the remaining deliveries
+			if remaining > 0 {
+				q.signal()
+			}
+
+			return delivery, true
+		}
+		q.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return Delivery{}, false
+		case <-q.ready:
+		}
+	}
+}
+
+// len returns the number of the waiting deliveries.
+func (q *queue) len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return len(q.deliveries)
+}
+
+// signal wakes up a waiting consumer.
+func (q *queue) signal() {
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
+
+// QueueLength returns the number of the deliveries waiting in a queue, it is 0 for an undeclared queue.
+func (b *Broker) QueueLength(name string) int {
+	q, ok := b.queue(name)
+	if !ok {
+		return 0
+	}
+
+	return q.len()
+}
@@ -0,0 +1,73 @@
+// Package inmemory provides the connection of the in-memory rabbitmq transport.
+package inmemory
+
+import (
+	"emperror.dev/errors"
+
+	amqp091 "github.com/rabbitmq/amqp091-go"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/types"
+)
+
+// ErrNoAmqpChannel is a error that represents an amqp channel that is requested from the in-memory connection.
+var ErrNoAmqpChannel = errors.New("the in-memory rabbitmq connection has no amqp channels")
+
+// connection is the always connected connection of the in-memory transport, it keeps the health checks of the
+// rabbitmq connection working.
+type connection struct {
+	errConnectionChan chan error
+	reconnectedChan   chan struct{}
+}
+
+// NewConnection creates a new in-memory connection.
+func NewConnection() types.IConnection {
+	return &connection{
+		errConnectionChan: make(chan error),
+		reconnectedChan:   make(chan struct{}),
+	}
+}
+
+// IsClosed returns false, the in-memory connection is never closed.
+func (c *connection) IsClosed() bool {
+	return false
+}
+
+// IsConnected returns true, the in-memory connection is always connected.
+func (c *connection) IsConnected() bool {
+	return true
+}
+
+// Channel returns ErrNoAmqpChannel, the in-memory transport doesn't use the amqp channels.
+func (c *connection) Channel() (*amqp091.Channel, error) {
+	return nil, ErrNoAmqpChannel
+}
+
+// Close closes the in-memory connection.
+func (c *connection) Close() error {
+	return nil
+}
+
+// ReConnect reconnects the in-memory connection.
+func (c *connection) ReConnect() error {
+	return nil
+}
+
+// NotifyClose returns the receiver, the in-memory connection is never closed.
+func (c *connection) NotifyClose(receiver chan *amqp091.Error) chan *amqp091.Error {
+	return receiver
+}
+
+// Raw returns nil, the in-memory connection has no amqp connection.
+func (c *connection) Raw() *amqp091.Connection {
+	return nil
+}
+
+// ErrorConnectionChannel returns the error connection channel.
+func (c *connection) ErrorConnectionChannel() chan error {
+	return c.errConnectionChan
+}
+
+// ReconnectedChannel returns the reconnected channel.
+func (c *connection) ReconnectedChannel() chan struct{} {
+	return c.reconnectedChan
+}
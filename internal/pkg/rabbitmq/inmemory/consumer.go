@@ -0,0 +1,364 @@
+// Package inmemory provides the consumer of the in-memory rabbitmq transport.
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"emperror.dev/errors"
+	"go.opentelemetry.io/otel/attribute"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/consumer"
+	messageHeader "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/messageheader"
+	consumertracing "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/otel/tracing/consumer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/pipeline"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/transform"
+	messagingTypes "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/utils"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/metadata"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/versioning"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+	rabbitmqconsumer "github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/consumer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/consumer/configurations"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/consumer/consumercontracts"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/types"
+)
+
+// inMemoryConsumer consumes the messages of a queue of the in-memory broker with the handlers, the pipelines and
+// the retries of the rabbitmq consumer.
+type inMemoryConsumer struct {
+	broker                  *Broker
+	consumerConfiguration   *configurations.RabbitMQConsumerConfiguration
+	messageSerializer       serializer.MessageSerializer
+	logger                  logger.Logger
+	transforms              *transform.Pipeline
+	handlers                []consumer.ConsumerHandler
+	pipelines               []pipeline.ConsumerPipeline
+	isConsumedNotifications []func(message messagingTypes.IMessage)
+	mu                      sync.Mutex
+	cancel                  context.CancelFunc
+	workers                 sync.WaitGroup
+}
+
+// NewInMemoryConsumer creates a new in-memory consumer.
+func NewInMemoryConsumer(
+	broker *Broker,
+	consumerConfiguration *configurations.RabbitMQConsumerConfiguration,
+	messageSerializer serializer.MessageSerializer,
+	logger logger.Logger,
+	transforms *transform.Pipeline,
+	isConsumedNotifications ...func(message messagingTypes.IMessage),
+) (consumer.Consumer, error) {
+	if consumerConfiguration == nil {
+		return nil, errors.New("consumer configuration is required")
+	}
+
+	if consumerConfiguration.ConsumerMessageType == nil {
+		return nil, errors.New(
+			"consumer ConsumerMessageType property is required",
+		)
+	}
+
+	return &inMemoryConsumer{
+		broker:                  broker,
+		consumerConfiguration:   consumerConfiguration,
+		messageSerializer:       messageSerializer,
+		logger:                  logger,
+		transforms:              transforms,
+		handlers:                consumerConfiguration.Handlers,
+		pipelines:               consumerConfiguration.Pipelines,
+		isConsumedNotifications: isConsumedNotifications,
+	}, nil
+}
+
+// IsConsumed adds a new consumed notification.
+func (c *inMemoryConsumer) IsConsumed(h func(message messagingTypes.IMessage)) {
+	c.isConsumedNotifications = append(c.isConsumedNotifications, h)
+}
+
+// ConnectHandler adds a new consumer handler.
+func (c *inMemoryConsumer) ConnectHandler(handler consumer.ConsumerHandler) {
+	c.handlers = append(c.handlers, handler)
+}
+
+// GetName returns the name of the consumer.
+func (c *inMemoryConsumer) GetName() string {
+	return c.consumerConfiguration.Name
+}
+
+// Start declares the exchange, the queue and the binding of the consumer and starts its workers, the number of the
+// workers is the concurrency limit of the consumer.
+func (c *inMemoryConsumer) Start(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.cancel != nil {
+		return nil
+	}
+
+	exchange, routingKey, queueName := c.topology()
+
+	if err := c.broker.DeclareExchange(exchange, c.consumerConfiguration.ExchangeOptions.Type); err != nil {
+		return err
+	}
+	c.broker.DeclareQueue(queueName)
+	if err := c.broker.BindQueue(queueName, routingKey, exchange); err != nil {
+		return err
+	}
+
+	// the exchanges of the bound message types deliver their messages to the same queue
+	for _, messageType := range c.consumerConfiguration.BoundMessageTypes {
+		boundExchange := utils.GetTopicOrExchangeNameFromType(messageType)
+		if err := c.broker.DeclareExchange(boundExchange, c.consumerConfiguration.ExchangeOptions.Type); err != nil {
+			return err
+		}
+		if err := c.broker.BindQueue(queueName, utils.GetRoutingKeyFromType(messageType), boundExchange); err != nil {
+			return err
+		}
+	}
+
+	q, _ := c.broker.queue(queueName)
+
+	workersCtx, cancel := context.WithCancel(ctx)
+	c.cancel = cancel
+
+	concurrencyLimit := max(c.consumerConfiguration.ConcurrencyLimit, 1)
+	if c.consumerConfiguration.OrderByPartitionKey && concurrencyLimit > 1 {
+		c.workers.Add(1)
+		go func() {
+			defer c.workers.Done()
+			c.handleOrdered(workersCtx, q, concurrencyLimit)
+		}()
+
+		return nil
+	}
+
+	for i := 0; i < concurrencyLimit; i++ {
+		c.workers.Add(1)
+		go func() {
+			defer c.workers.Done()
+
+			for {
+				delivery, ok := q.dequeue(workersCtx)
+				if !ok {
+					return
+				}
+				c.handleReceived(workersCtx, q, delivery)
+			}
+		}()
+	}
+
+	return nil
+}
+
+// handleOrdered dispatches the deliveries of the queue to the lanes of their partition keys, like the rabbitmq
+// consumer does for the ordering by partition key.
+func (c *inMemoryConsumer) handleOrdered(ctx context.Context, q *queue, lanesCount int) {
+	lanes := consumer.NewPartitionLanes(
+		ctx,
+		lanesCount,
+		c.consumerConfiguration.PrefetchCount,
+		func(ctx context.Context, delivery Delivery) {
+			c.handleReceived(ctx, q, delivery)
+		},
+	)
+	// the deliveries that aren't handled before the shutdown stay in the queue for the next start of the consumer
+	defer func() {
+		for _, delivery := range lanes.Close() {
+			q.requeue(delivery)
+		}
+	}()
+
+	for {
+		delivery, ok := q.dequeue(ctx)
+		if !ok {
+			return
+		}
+
+		partitionKey, _ := delivery.Headers[messageHeader.PartitionKey].(string)
+		if partitionKey == "" {
+			partitionKey = delivery.MessageId
+		}
+
+		if !lanes.Dispatch(ctx, partitionKey, delivery) {
+			q.requeue(delivery)
+
+			return
+		}
+	}
+}
+
+// Stop stops the workers after their in flight deliveries.
+func (c *inMemoryConsumer) Stop() error {
+	c.mu.Lock()
+	cancel := c.cancel
+	c.cancel = nil
+	c.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+	c.workers.Wait()
+
+	return nil
+}
+
+// topology returns the exchange, the routing key and the queue of the consumer, with the defaults of the rabbitmq
+// consumer for the missing names.
+func (c *inMemoryConsumer) topology() (string, string, string) {
+	messageType := c.consumerConfiguration.ConsumerMessageType
+
+	exchange := c.consumerConfiguration.ExchangeOptions.Name
+	if exchange == "" {
+		exchange = utils.GetTopicOrExchangeNameFromType(messageType)
+	}
+
+	routingKey := c.consumerConfiguration.BindingOptions.RoutingKey
+	if routingKey == "" {
+		routingKey = utils.GetRoutingKeyFromType(messageType)
+	}
+
+	queueName := c.consumerConfiguration.QueueOptions.Name
+	if queueName == "" {
+		queueName = utils.GetQueueNameFromType(messageType)
+	}
+
+	return exchange, routingKey, queueName
+}
+
+// handleReceived handles a delivery, a failed or an interrupted delivery is requeued like the rabbitmq consumer nacks
+// it unless the consumer auto acks.
+func (c *inMemoryConsumer) handleReceived(ctx context.Context, q *queue, delivery Delivery) {
+	meta := metadata.MapToMetadata(delivery.Headers)
+	if meta == nil {
+		meta = metadata.Metadata{}
+	}
+
+	// the reply address of a request is a property of the delivery, the responders read it from the metadata
+	if delivery.ReplyTo != "" {
+		messageHeader.SetReplyTo(meta, delivery.ReplyTo)
+	}
+
+	ctx, span := consumertracing.StartConsumerSpan(
+		ctx,
+		&meta,
+		string(delivery.Body),
+		&consumertracing.ConsumerTracingOptions{
+			MessagingSystem: "rabbitmq",
+			DestinationKind: "queue",
+			Destination:     q.name,
+			OtherAttributes: []attribute.KeyValue{
+				semconv.MessagingRabbitmqDestinationRoutingKey(delivery.RoutingKey),
+			},
+		},
+	)
+
+	contentType := delivery.ContentType
+	if contentType == "" {
+		contentType = rabbitmqconsumer.ContentType
+	}
+
+	message, err := consumer.DeserializeMessage(
+		c.messageSerializer,
+		c.transforms,
+		contentType,
+		delivery.ContentEncoding,
+		delivery.Type,
+		versioning.GetSchemaVersion(meta),
+		delivery.Body,
+	)
+	if err != nil {
+		// a delivery that can't be deserialized is dropped instead of being redelivered forever
+		c.logger.Errorf(fmt.Sprintf("[inMemoryConsumer.handleReceived] dropping a delivery: %v", err))
+		if err := consumertracing.FinishConsumerSpan(span, err); err != nil {
+			c.logger.Error("error in finishing consumer span: %v", err)
+		}
+
+		return
+	}
+
+	consumeContext := messagingTypes.NewMessageConsumeContext(
+		message,
+		meta,
+		contentType,
+		delivery.Type,
+		delivery.Timestamp,
+		delivery.DeliveryTag,
+		delivery.MessageId,
+		delivery.CorrelationId,
+	)
+
+	for _, handler := range c.handlers {
+		err = consumer.HandleWithRetry(ctx, c.pipelines, handler, consumeContext)
+		if err != nil {
+			break
+		}
+	}
+
+	if err != nil && !c.consumerConfiguration.AutoAck {
+		c.logger.Error(
+			"[inMemoryConsumer.handleReceived] error in handling consume message, requeueing the message",
+		)
+		q.requeue(delivery)
+	}
+
+	if err := consumertracing.FinishConsumerSpan(span, nil); err != nil {
+		c.logger.Error("error in finishing consumer span: %v", err)
+	}
+
+	for _, notification := range c.isConsumedNotifications {
+		if notification != nil {
+			notification(message)
+		}
+	}
+}
+
+// consumerFactory creates the in-memory consumers.
+type consumerFactory struct {
+	broker            *Broker
+	connection        types.IConnection
+	messageSerializer serializer.MessageSerializer
+	logger            logger.Logger
+	transforms        *transform.Pipeline
+}
+
+// NewConsumerFactory creates a new in-memory consumer factory.
+func NewConsumerFactory(
+	broker *Broker,
+	connection types.IConnection,
+	messageSerializer serializer.MessageSerializer,
+	logger logger.Logger,
+	transforms *transform.Pipeline,
+) consumercontracts.ConsumerFactory {
+	return &consumerFactory{
+		broker:            broker,
+		connection:        connection,
+		messageSerializer: messageSerializer,
+		logger:            logger,
+		transforms:        transforms,
+	}
+}
+
+// CreateConsumer creates a new in-memory consumer.
+func (f *consumerFactory) CreateConsumer(
+	consumerConfiguration *configurations.RabbitMQConsumerConfiguration,
+	isConsumedNotifications ...func(message messagingTypes.IMessage),
+) (consumer.Consumer, error) {
+	return NewInMemoryConsumer(
+		f.broker,
+		consumerConfiguration,
+		f.messageSerializer,
+		f.logger,
+		f.transforms,
+		isConsumedNotifications...,
+	)
+}
+
+// Connection returns the in-memory connection.
+func (f *consumerFactory) Connection() types.IConnection {
+	return f.connection
+}
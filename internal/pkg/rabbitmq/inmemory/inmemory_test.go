@@ -0,0 +1,600 @@
+//go:build unit
+// +build unit
+
+// Package inmemory provides the tests of the in-memory rabbitmq transport.
+package inmemory
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	uuid "github.com/satori/go.uuid"
+
+	messageConsumer "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/consumer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/pipeline"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/requestreply"
+	messagingTypes "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/utils"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/json"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger/defaultlogger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/bus"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/configurations"
+	consumerConfigurations "github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/consumer/configurations"
+	producerConfigurations "github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/producer/configurations"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/types"
+	typeMapper "github.com/raphaeldiscky/go-food-micro/internal/pkg/reflection/typemapper"
+)
+
+// InMemoryTestMessage is the message of the in-memory transport tests.
+type InMemoryTestMessage struct {
+	messagingTypes.Message
+	Key  string
+	Data string
+}
+
+// GetMessageTypeName returns the type name of the message.
+func (m *InMemoryTestMessage) GetMessageTypeName() string {
+	return typeMapper.GetTypeName(m)
+}
+
+// GetMessageFullTypeName returns the full type name of the message.
+func (m *InMemoryTestMessage) GetMessageFullTypeName() string {
+	return typeMapper.GetFullTypeName(m)
+}
+
+// GetPartitionKey returns the partition key of the message.
+func (m *InMemoryTestMessage) GetPartitionKey() string {
+	return m.Key
+}
+
+// newInMemoryTestMessage creates a new in-memory test message.
+func newInMemoryTestMessage(data string) *InMemoryTestMessage {
+	return &InMemoryTestMessage{
+		Message: *messagingTypes.NewMessage(uuid.NewV4().String()),
+		Data:    data,
+	}
+}
+
+// recordingHandler records the handled messages and fails the first failures calls.
+type recordingHandler struct {
+	mu       sync.Mutex
+	data     []string
+	calls    atomic.Int32
+	failures int32
+}
+
+// Handle handles a message.
+func (h *recordingHandler) Handle(_ context.Context, consumeContext messagingTypes.MessageConsumeContext) error {
+	if h.calls.Add(1) <= h.failures {
+		return errors.New("handler failed")
+	}
+
+	message, ok := consumeContext.Message().(*InMemoryTestMessage)
+	if !ok {
+		return errors.New("unexpected message type")
+	}
+
+	h.mu.Lock()
+	h.data = append(h.data, message.Data)
+	h.mu.Unlock()
+
+	return nil
+}
+
+// handled returns the data of the handled messages.
+func (h *recordingHandler) handled() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return append([]string(nil), h.data...)
+}
+
+// recordingPipeline records the messages that passed the pipeline.
+type recordingPipeline struct {
+	calls atomic.Int32
+}
+
+// Handle handles a message.
+func (p *recordingPipeline) Handle(
+	ctx context.Context,
+	_ messagingTypes.MessageConsumeContext,
+	next pipeline.ConsumerHandlerFunc,
+) error {
+	p.calls.Add(1)
+
+	return next(ctx)
+}
+
+// newTestBus creates a bus on a new in-memory broker with a consumer of the test message.
+func newTestBus(
+	t *testing.T,
+	consumerBuilder func(builder consumerConfigurations.RabbitMQConsumerConfigurationBuilder),
+) (bus.RabbitmqBus, *Broker) {
+	t.Helper()
+
+	logger := defaultlogger.GetLogger()
+	serializer := json.NewDefaultMessageJsonSerializer(json.NewDefaultJsonSerializer())
+	broker := NewBroker()
+
+	b, err := bus.NewRabbitmqBus(
+		logger,
+		NewConsumerFactory(broker, NewConnection(), serializer, logger, nil),
+		NewProducerFactory(broker, serializer, logger, nil),
+		func(builder configurations.RabbitMQConfigurationBuilder) {
+			builder.AddProducer(
+				&InMemoryTestMessage{},
+				func(_ producerConfigurations.RabbitMQProducerConfigurationBuilder) {},
+			)
+			builder.AddConsumer(&InMemoryTestMessage{}, consumerBuilder)
+		},
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, b.Start(context.Background()))
+	t.Cleanup(func() {
+		_ = b.Stop()
+	})
+
+	return b, broker
+}
+
+// TestTopicMatches tests the topic exchange routing key patterns.
+func TestTopicMatches(t *testing.T) {
+	cases := []struct {
+		pattern    string
+		routingKey string
+		matches    bool
+	}{
+		{"orders.created", "orders.created", true},
+		{"orders.*", "orders.created", true},
+		{"orders.*", "orders.created.v1", false},
+		{"orders.#", "orders.created.v1", true},
+		{"orders.#", "orders", true},
+		{"#.v1", "orders.created.v1", true},
+		{"*.created", "products.created", true},
+		{"*.created", "products.updated", false},
+		{"#", "anything.at.all", true},
+	}
+
+	for _, c := range cases {
+		assert.Equal(
+			t,
+			c.matches,
+			topicMatches(strings.Split(c.pattern, "."), strings.Split(c.routingKey, ".")),
+			"pattern `%s` with routing key `%s`",
+			c.pattern,
+			c.routingKey,
+		)
+	}
+}
+
+// TestBrokerRouting tests the routing of the exchange types to the bound queues.
+func TestBrokerRouting(t *testing.T) {
+	broker := NewBroker()
+
+	require.NoError(t, broker.DeclareExchange("direct", types.ExchangeDirect))
+	require.NoError(t, broker.DeclareExchange("fanout", types.ExchangeFanout))
+	broker.DeclareQueue("a")
+	broker.DeclareQueue("b")
+	require.NoError(t, broker.BindQueue("a", "key-a", "direct"))
+	require.NoError(t, broker.BindQueue("b", "key-b", "direct"))
+	require.NoError(t, broker.BindQueue("a", "", "fanout"))
+	require.NoError(t, broker.BindQueue("b", "", "fanout"))
+
+	require.NoError(t, broker.Publish("direct", "key-a", Delivery{}))
+	assert.Equal(t, 1, broker.QueueLength("a"))
+	assert.Equal(t, 0, broker.QueueLength("b"))
+
+	require.NoError(t, broker.Publish("fanout", "ignored", Delivery{}))
+	assert.Equal(t, 2, broker.QueueLength("a"))
+	assert.Equal(t, 1, broker.QueueLength("b"))
+
+	// a redeclared exchange keeps its type
+	assert.Error(t, broker.DeclareExchange("direct", types.ExchangeFanout))
+}
+
+// TestPublishAndConsume tests a message published and consumed through the bus with the pipelines and the
+// notifications.
+func TestPublishAndConsume(t *testing.T) {
+	handler := &recordingHandler{}
+	consumerPipeline := &recordingPipeline{}
+
+	b, _ := newTestBus(t, func(builder consumerConfigurations.RabbitMQConsumerConfigurationBuilder) {
+		builder.WIthPipelines(func(builder pipeline.ConsumerPipelineConfigurationBuilder) {
+			builder.AddPipeline(consumerPipeline)
+		})
+		builder.WithHandlers(func(builder messageConsumer.ConsumerHandlerConfigurationBuilder) {
+			builder.AddHandler(handler)
+		})
+	})
+
+	var produced, consumed atomic.Int32
+	b.IsProduced(func(_ messagingTypes.IMessage) { produced.Add(1) })
+	b.IsConsumed(func(_ messagingTypes.IMessage) { consumed.Add(1) })
+
+	err := b.PublishMessage(context.Background(), newInMemoryTestMessage("hello"), nil)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return consumed.Load() == 1
+	}, 10*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, []string{"hello"}, handler.handled())
+	assert.Equal(t, int32(1), consumerPipeline.calls.Load())
+	assert.Equal(t, int32(1), produced.Load())
+}
+
+// TestFailedMessageIsRequeued tests a message is redelivered after its handler retries are exhausted.
+func TestFailedMessageIsRequeued(t *testing.T) {
+	// the handler fails all the retries of the first delivery
+	handler := &recordingHandler{failures: 3}
+
+	b, _ := newTestBus(t, func(builder consumerConfigurations.RabbitMQConsumerConfigurationBuilder) {
+		builder.WithHandlers(func(builder messageConsumer.ConsumerHandlerConfigurationBuilder) {
+			builder.AddHandler(handler)
+		})
+	})
+
+	err := b.PublishMessage(context.Background(), newInMemoryTestMessage("retried"), nil)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return len(handler.handled()) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, []string{"retried"}, handler.handled())
+	assert.Equal(t, int32(4), handler.calls.Load())
+}
+
+// TestCompetingConsumers tests the workers of a consumer share the messages of its queue.
+func TestCompetingConsumers(t *testing.T) {
+	handler := &recordingHandler{}
+
+	b, broker := newTestBus(t, func(builder consumerConfigurations.RabbitMQConsumerConfigurationBuilder) {
+		builder.WithConcurrencyLimit(4)
+		builder.WithHandlers(func(builder messageConsumer.ConsumerHandlerConfigurationBuilder) {
+			builder.AddHandler(handler)
+		})
+	})
+
+	const count = 8
+	for i := 0; i < count; i++ {
+		err := b.PublishMessage(context.Background(), newInMemoryTestMessage("message"), nil)
+		require.NoError(t, err)
+	}
+
+	require.Eventually(t, func() bool {
+		return len(handler.handled()) == count
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// every message is handled once
+	assert.Equal(t, int32(count), handler.calls.Load())
+	assert.Equal(t, 0, broker.QueueLength(utils.GetQueueNameFromType(reflect.TypeOf(&InMemoryTestMessage{}))))
+}
+
+// TestOrderByPartitionKey tests the messages of the same partition key are handled one after another in their
+// publishing order by a consumer with many workers.
+func TestOrderByPartitionKey(t *testing.T) {
+	var mu sync.Mutex
+	handled := map[string][]string{}
+	active := map[string]bool{}
+
+	handler := consumerHandlerFunc(
+		func(_ context.Context, consumeContext messagingTypes.MessageConsumeContext) error {
+			message, ok := consumeContext.Message().(*InMemoryTestMessage)
+			if !ok {
+				return errors.New("unexpected message type")
+			}
+
+			mu.Lock()
+			assert.False(t, active[message.Key], "messages of the key `%s` are handled concurrently", message.Key)
+			active[message.Key] = true
+			mu.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			mu.Lock()
+			active[message.Key] = false
+			handled[message.Key] = append(handled[message.Key], message.Data)
+			mu.Unlock()
+
+			return nil
+		},
+	)
+
+	b, _ := newTestBus(t, func(builder consumerConfigurations.RabbitMQConsumerConfigurationBuilder) {
+		builder.WithConcurrencyLimit(4)
+		builder.WithOrderByPartitionKey(true)
+		builder.WithHandlers(func(builder messageConsumer.ConsumerHandlerConfigurationBuilder) {
+			builder.AddHandler(handler)
+		})
+	})
+
+	var consumed atomic.Int32
+	b.IsConsumed(func(_ messagingTypes.IMessage) { consumed.Add(1) })
+
+	events := []string{"created", "updated", "deleted"}
+	for _, event := range events {
+		for _, key := range []string{"product-1", "product-2"} {
+			err := b.PublishMessage(context.Background(), &InMemoryTestMessage{
+				Message: *messagingTypes.NewMessage(uuid.NewV4().String()),
+				Key:     key,
+				Data:    event,
+			}, nil)
+			require.NoError(t, err)
+		}
+	}
+
+	require.Eventually(t, func() bool {
+		return consumed.Load() == 6
+	}, 20*time.Second, 10*time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, events, handled["product-1"])
+	assert.Equal(t, events, handled["product-2"])
+}
+
+// InMemoryTestUpdatedMessage is the second message type of the in-memory transport tests.
+type InMemoryTestUpdatedMessage struct {
+	messagingTypes.Message
+	Key  string
+	Data string
+}
+
+// GetMessageTypeName returns the type name of the message.
+func (m *InMemoryTestUpdatedMessage) GetMessageTypeName() string {
+	return typeMapper.GetTypeName(m)
+}
+
+// GetMessageFullTypeName returns the full type name of the message.
+func (m *InMemoryTestUpdatedMessage) GetMessageFullTypeName() string {
+	return typeMapper.GetFullTypeName(m)
+}
+
+// GetPartitionKey returns the partition key of the message.
+func (m *InMemoryTestUpdatedMessage) GetPartitionKey() string {
+	return m.Key
+}
+
+// TestOrderByPartitionKeyAcrossBoundMessages tests a created and an updated message of the same partition key are
+// handled in their publishing order by a consumer whose queue is bound to both message types.
+func TestOrderByPartitionKeyAcrossBoundMessages(t *testing.T) {
+	var mu sync.Mutex
+	handled := map[string][]string{}
+
+	handler := consumerHandlerFunc(
+		func(_ context.Context, consumeContext messagingTypes.MessageConsumeContext) error {
+			var key, event string
+			switch message := consumeContext.Message().(type) {
+			case *InMemoryTestMessage:
+				// the created message is handled slower, an unordered worker would handle the updated one first
+				time.Sleep(50 * time.Millisecond)
+				key, event = message.Key, message.Data
+			case *InMemoryTestUpdatedMessage:
+				key, event = message.Key, message.Data
+			default:
+				return errors.New("unexpected message type")
+			}
+
+			mu.Lock()
+			handled[key] = append(handled[key], event)
+			mu.Unlock()
+
+			return nil
+		},
+	)
+
+	logger := defaultlogger.GetLogger()
+	serializer := json.NewDefaultMessageJsonSerializer(json.NewDefaultJsonSerializer())
+	broker := NewBroker()
+
+	b, err := bus.NewRabbitmqBus(
+		logger,
+		NewConsumerFactory(broker, NewConnection(), serializer, logger, nil),
+		NewProducerFactory(broker, serializer, logger, nil),
+		func(builder configurations.RabbitMQConfigurationBuilder) {
+			builder.AddConsumer(
+				&InMemoryTestMessage{},
+				func(builder consumerConfigurations.RabbitMQConsumerConfigurationBuilder) {
+					builder.WithBoundMessages(&InMemoryTestUpdatedMessage{})
+					builder.WithConcurrencyLimit(4)
+					builder.WithOrderByPartitionKey(true)
+					builder.WithHandlers(func(builder messageConsumer.ConsumerHandlerConfigurationBuilder) {
+						builder.AddHandler(handler)
+					})
+				},
+			)
+		},
+	)
+	require.NoError(t, err)
+
+	// the handlers of a bound message type are connected to the consumer of the shared queue
+	var updatedHandled atomic.Int32
+	err = b.ConnectConsumerHandler(&InMemoryTestUpdatedMessage{}, consumerHandlerFunc(
+		func(_ context.Context, consumeContext messagingTypes.MessageConsumeContext) error {
+			if _, ok := consumeContext.Message().(*InMemoryTestUpdatedMessage); ok {
+				updatedHandled.Add(1)
+			}
+
+			return nil
+		},
+	))
+	require.NoError(t, err)
+
+	var consumed atomic.Int32
+	b.IsConsumed(func(_ messagingTypes.IMessage) { consumed.Add(1) })
+
+	require.NoError(t, b.Start(context.Background()))
+	t.Cleanup(func() {
+		_ = b.Stop()
+	})
+
+	keys := []string{"product-1", "product-2"}
+	for _, key := range keys {
+		err := b.PublishMessage(context.Background(), &InMemoryTestMessage{
+			Message: *messagingTypes.NewMessage(uuid.NewV4().String()),
+			Key:     key,
+			Data:    "created",
+		}, nil)
+		require.NoError(t, err)
+	}
+	for _, key := range keys {
+		err := b.PublishMessage(context.Background(), &InMemoryTestUpdatedMessage{
+			Message: *messagingTypes.NewMessage(uuid.NewV4().String()),
+			Key:     key,
+			Data:    "updated",
+		}, nil)
+		require.NoError(t, err)
+	}
+
+	require.Eventually(t, func() bool {
+		return consumed.Load() == 4
+	}, 20*time.Second, 10*time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	for _, key := range keys {
+		assert.Equal(t, []string{"created", "updated"}, handled[key])
+	}
+	assert.Equal(t, int32(2), updatedHandled.Load())
+}
+
+// consumerHandlerFunc is a consumer handler function.
+type consumerHandlerFunc func(ctx context.Context, consumeContext messagingTypes.MessageConsumeContext) error
+
+// Handle handles a message.
+func (f consumerHandlerFunc) Handle(ctx context.Context, consumeContext messagingTypes.MessageConsumeContext) error {
+	return f(ctx, consumeContext)
+}
+
+// PriceRequest is the request of the in-memory request tests.
+type PriceRequest struct {
+	messagingTypes.Message
+	ProductID string
+}
+
+// GetMessageTypeName returns the type name of the message.
+func (m *PriceRequest) GetMessageTypeName() string {
+	return typeMapper.GetTypeName(m)
+}
+
+// GetMessageFullTypeName returns the full type name of the message.
+func (m *PriceRequest) GetMessageFullTypeName() string {
+	return typeMapper.GetFullTypeName(m)
+}
+
+// PriceReply is the reply of the in-memory request tests.
+type PriceReply struct {
+	messagingTypes.Message
+	ProductID string
+	Price     float64
+}
+
+// GetMessageTypeName returns the type name of the message.
+func (m *PriceReply) GetMessageTypeName() string {
+	return typeMapper.GetTypeName(m)
+}
+
+// GetMessageFullTypeName returns the full type name of the message.
+func (m *PriceReply) GetMessageFullTypeName() string {
+	return typeMapper.GetFullTypeName(m)
+}
+
+// newPriceRequest creates a new price request.
+func newPriceRequest(productID string) *PriceRequest {
+	return &PriceRequest{
+		Message:   *messagingTypes.NewMessage(uuid.NewV4().String()),
+		ProductID: productID,
+	}
+}
+
+// newRequestTestBus creates and starts a bus on a new in-memory broker with a responder of the price requests, the
+// bus is started without a responder for a nil handler.
+func newRequestTestBus(t *testing.T, handler requestreply.RequestHandler) bus.RabbitmqBus {
+	t.Helper()
+
+	logger := defaultlogger.GetLogger()
+	serializer := json.NewDefaultMessageJsonSerializer(json.NewDefaultJsonSerializer())
+	broker := NewBroker()
+
+	b, err := bus.NewRabbitmqBus(
+		logger,
+		NewConsumerFactory(broker, NewConnection(), serializer, logger, nil),
+		NewProducerFactory(broker, serializer, logger, nil),
+		nil,
+	)
+	require.NoError(t, err)
+
+	if handler != nil {
+		require.NoError(t, b.ConnectResponder(&PriceRequest{}, handler))
+	}
+
+	require.NoError(t, b.Start(context.Background()))
+	t.Cleanup(func() {
+		_ = b.Stop()
+	})
+
+	return b
+}
+
+// TestRequestReply tests a typed request is answered with the reply of its responder and the correlation id of the
+// request.
+func TestRequestReply(t *testing.T) {
+	b := newRequestTestBus(t, requestreply.NewRequestHandler(
+		func(_ context.Context, request *PriceRequest) (*PriceReply, error) {
+			return &PriceReply{
+				Message:   *messagingTypes.NewMessage(uuid.NewV4().String()),
+				ProductID: request.ProductID,
+				Price:     9.5,
+			}, nil
+		},
+	))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	reply, err := requestreply.Request[*PriceRequest, *PriceReply](ctx, b, newPriceRequest("product-1"), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "product-1", reply.ProductID)
+	assert.Equal(t, 9.5, reply.Price)
+}
+
+// TestRequestErrorReply tests the error of a responder is returned as the error of the request.
+func TestRequestErrorReply(t *testing.T) {
+	b := newRequestTestBus(t, requestreply.NewRequestHandler(
+		func(_ context.Context, _ *PriceRequest) (*PriceReply, error) {
+			return nil, errors.New("product not found")
+		},
+	))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := requestreply.Request[*PriceRequest, *PriceReply](ctx, b, newPriceRequest("product-1"), nil)
+
+	var replyErr *requestreply.ReplyError
+	require.ErrorAs(t, err, &replyErr)
+	assert.Equal(t, "product not found", replyErr.Message)
+}
+
+// TestRequestWithoutResponder tests a request without a responder ends with its context.
+func TestRequestWithoutResponder(t *testing.T) {
+	b := newRequestTestBus(t, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err := requestreply.Request[*PriceRequest, *PriceReply](ctx, b, newPriceRequest("product-1"), nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
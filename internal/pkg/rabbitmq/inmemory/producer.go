@@ -0,0 +1,270 @@
+// Package inmemory provides the producer of the in-memory rabbitmq transport.
+package inmemory
+
+import (
+	"context"
+	"time"
+
+	"emperror.dev/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+
+	messageHeader "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/messageheader"
+	producertracing "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/otel/tracing/producer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/producer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/transform"
+	messagingTypes "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/utils"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/metadata"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/versioning"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+	rabbitmqproducer "github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/producer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/producer/configurations"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/producer/producercontracts"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/types"
+)
+
+// inMemoryProducer publishes the messages to the exchanges of the in-memory broker, the messages are serialized
+// like the rabbitmq producer serializes them.
+type inMemoryProducer struct {
+	broker                  *Broker
+	logger                  logger.Logger
+	messageSerializer       serializer.MessageSerializer
+	producersConfigurations map[string]*configurations.RabbitMQProducerConfiguration
+	transforms              *transform.Pipeline
+	isProducedNotifications []func(message messagingTypes.IMessage)
+	replies                 *replyQueue
+}
+
+// NewInMemoryProducer creates a new in-memory producer.
+func NewInMemoryProducer(
+	broker *Broker,
+	producersConfigurations map[string]*configurations.RabbitMQProducerConfiguration,
+	logger logger.Logger,
+	messageSerializer serializer.MessageSerializer,
+	transforms *transform.Pipeline,
+	isProducedNotifications ...func(message messagingTypes.IMessage),
+) producer.BatchProducer {
+	return &inMemoryProducer{
+		broker:                  broker,
+		logger:                  logger,
+		messageSerializer:       messageSerializer,
+		producersConfigurations: producersConfigurations,
+		transforms:              transforms,
+		isProducedNotifications: isProducedNotifications,
+		replies:                 newReplyQueue(broker, logger),
+	}
+}
+
+// IsProduced adds a new produced notification.
+func (p *inMemoryProducer) IsProduced(h func(message messagingTypes.IMessage)) {
+	p.isProducedNotifications = append(p.isProducedNotifications, h)
+}
+
+// PublishMessage publishes a message to the exchange of its producer configuration.
+func (p *inMemoryProducer) PublishMessage(
+	ctx context.Context,
+	message messagingTypes.IMessage,
+	meta metadata.Metadata,
+) error {
+	return p.PublishMessageWithTopicName(ctx, message, meta, "")
+}
+
+// PublishMessages publishes a batch of messages, the returned error combines the errors of the failed messages.
+func (p *inMemoryProducer) PublishMessages(
+	ctx context.Context,
+	messages []messagingTypes.IMessage,
+	meta metadata.Metadata,
+) error {
+	var errs []error
+	for _, message := range messages {
+		if err := p.PublishMessage(ctx, message, meta); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Combine(errs...)
+}
+
+// PublishMessageWithTopicName publishes a message to an exchange.
+func (p *inMemoryProducer) PublishMessageWithTopicName(
+	ctx context.Context,
+	message messagingTypes.IMessage,
+	meta metadata.Metadata,
+	topicOrExchangeName string,
+) error {
+	pub, err := p.preparePublishing(ctx, message, meta, topicOrExchangeName, "")
+	if err != nil {
+		return err
+	}
+
+	return p.publish(pub)
+}
+
+// publishing is a serialized message with its producer span, ready to be published to an exchange.
+type publishing struct {
+	message    messagingTypes.IMessage
+	exchange   string
+	kind       types.ExchangeType
+	routingKey string
+	delivery   Delivery
+	span       trace.Span
+}
+
+// preparePublishing serializes a message and starts its producer span, a reply address routes the message to its
+// queue with the default exchange instead of the exchange of its producer configuration.
+func (p *inMemoryProducer) preparePublishing(
+	ctx context.Context,
+	message messagingTypes.IMessage,
+	meta metadata.Metadata,
+	topicOrExchangeName string,
+	replyTo string,
+) (*publishing, error) {
+	producerConfiguration := p.producersConfigurations[utils.GetMessageBaseReflectType(message).String()]
+	if producerConfiguration == nil {
+		producerConfiguration = configurations.NewDefaultRabbitMQProducerConfiguration(message)
+	}
+
+	messageSerializer := p.messageSerializer
+	if producerConfiguration.MessageSerializer != nil {
+		messageSerializer = producerConfiguration.MessageSerializer
+	}
+
+	exchange, routingKey := rabbitmqproducer.ExchangeAndRoutingKey(
+		message,
+		producerConfiguration,
+		topicOrExchangeName,
+	)
+	destination := exchange
+	if replyTo != "" {
+		exchange, routingKey, destination = "", replyTo, replyTo
+	}
+	meta = producer.PublishingMetadata(message, meta, messageSerializer)
+
+	serializedObj, err := p.serialize(message, messageSerializer)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &transform.Payload{Data: serializedObj.Data, ContentEncoding: producerConfiguration.ContentEncoding}
+	if err := p.transforms.EncodePayload(payload); err != nil {
+		return nil, err
+	}
+
+	_, span := producertracing.StartProducerSpan(
+		ctx,
+		message,
+		&meta,
+		string(serializedObj.Data),
+		&producertracing.ProducerTracingOptions{
+			MessagingSystem: "rabbitmq",
+			DestinationKind: "exchange",
+			Destination:     destination,
+			OtherAttributes: []attribute.KeyValue{
+				semconv.MessagingRabbitmqDestinationRoutingKey(routingKey),
+			},
+		},
+	)
+
+	return &publishing{
+		message:    message,
+		exchange:   exchange,
+		kind:       producerConfiguration.ExchangeOptions.Type,
+		routingKey: routingKey,
+		delivery: Delivery{
+			MessageId:       message.GeMessageId(),
+			CorrelationId:   messageHeader.GetCorrelationId(meta),
+			Type:            messageHeader.GetMessageType(meta),
+			ContentType:     serializedObj.ContentType,
+			ContentEncoding: payload.ContentEncoding,
+			Headers:         metadata.MetadataToMap(meta),
+			Body:            payload.Data,
+			Timestamp:       time.Now(),
+		},
+		span: span,
+	}, nil
+}
+
+// publish publishes a prepared message, runs the produced notifications and finishes its span.
+func (p *inMemoryProducer) publish(pub *publishing) error {
+	var err error
+
+	// the producer declares its exchange like the rabbitmq producer does, the default exchange is always there
+	if pub.exchange != "" {
+		err = p.broker.DeclareExchange(pub.exchange, pub.kind)
+	}
+	if err == nil {
+		err = p.broker.Publish(pub.exchange, pub.routingKey, pub.delivery)
+	}
+
+	if err != nil {
+		return producertracing.FinishProducerSpan(pub.span, err)
+	}
+
+	for _, notification := range p.isProducedNotifications {
+		if notification != nil {
+			notification(pub.message)
+		}
+	}
+
+	return producertracing.FinishProducerSpan(pub.span, nil)
+}
+
+// serialize runs the message transforms on a copy of the message and serializes the copy.
+func (p *inMemoryProducer) serialize(
+	message messagingTypes.IMessage,
+	messageSerializer serializer.MessageSerializer,
+) (*serializer.EventSerializationResult, error) {
+	encoded, err := p.transforms.EncodeMessage(message)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedMessage, ok := encoded.(messagingTypes.IMessage)
+	if !ok {
+		return nil, errors.Errorf("transformed message of `%s` is not a message", versioning.TypeName(message))
+	}
+
+	return messageSerializer.Serialize(encodedMessage)
+}
+
+// producerFactory creates the in-memory producers.
+type producerFactory struct {
+	broker            *Broker
+	messageSerializer serializer.MessageSerializer
+	logger            logger.Logger
+	transforms        *transform.Pipeline
+}
+
+// NewProducerFactory creates a new in-memory producer factory.
+func NewProducerFactory(
+	broker *Broker,
+	messageSerializer serializer.MessageSerializer,
+	logger logger.Logger,
+	transforms *transform.Pipeline,
+) producercontracts.ProducerFactory {
+	return &producerFactory{
+		broker:            broker,
+		messageSerializer: messageSerializer,
+		logger:            logger,
+		transforms:        transforms,
+	}
+}
+
+// CreateProducer creates a new in-memory producer.
+func (f *producerFactory) CreateProducer(
+	rabbitmqProducersConfiguration map[string]*configurations.RabbitMQProducerConfiguration,
+	isProducedNotifications ...func(message messagingTypes.IMessage),
+) (producer.Producer, error) {
+	return NewInMemoryProducer(
+		f.broker,
+		rabbitmqProducersConfiguration,
+		f.logger,
+		f.messageSerializer,
+		f.transforms,
+		isProducedNotifications...,
+	), nil
+}
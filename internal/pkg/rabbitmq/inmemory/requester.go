@@ -0,0 +1,148 @@
+// Package inmemory provides the requests and the replies of the in-memory rabbitmq transport.
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"emperror.dev/errors"
+
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/consumer"
+	messageHeader "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/messageheader"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/requestreply"
+	messagingTypes "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/metadata"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/versioning"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+	rabbitmqconsumer "github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/consumer"
+	rabbitmqproducer "github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/producer"
+)
+
+// replyQueue is the reply queue of the requests of a producer, like the direct reply-to queue of rabbitmq it is
+// private to the producer and it is declared with the first request. It lives as long as the broker.
+type replyQueue struct {
+	broker          *Broker
+	logger          logger.Logger
+	pendingRequests *requestreply.PendingRequests[Delivery]
+	once            sync.Once
+	name            string
+}
+
+// newReplyQueue creates a new reply queue.
+func newReplyQueue(broker *Broker, logger logger.Logger) *replyQueue {
+	return &replyQueue{
+		broker:          broker,
+		logger:          logger,
+		pendingRequests: requestreply.NewPendingRequests[Delivery](),
+	}
+}
+
+// address declares the reply queue on its first use and returns its name.
+func (q *replyQueue) address() string {
+	q.once.Do(func() {
+		q.name = fmt.Sprintf("%s.%s", rabbitmqproducer.DirectReplyTo, uuid.NewV4().String())
+		q.broker.DeclareQueue(q.name)
+		replies, _ := q.broker.queue(q.name)
+
+		go func() {
+			for {
+				reply, _ := replies.dequeue(context.Background())
+				if !q.pendingRequests.Resolve(reply.CorrelationId, reply) {
+					q.logger.Infof(
+						"dropping the reply of the request with correlation id `%s`, the request doesn't wait anymore",
+						reply.CorrelationId,
+					)
+				}
+			}
+		}()
+	})
+
+	return q.name
+}
+
+// SendRequest publishes a request with a new correlation id and the reply queue of the producer and waits for its
+// reply until the context is done or the request timeout is reached.
+func (p *inMemoryProducer) SendRequest(
+	ctx context.Context,
+	request messagingTypes.IMessage,
+	meta metadata.Metadata,
+) (messagingTypes.IMessage, error) {
+	ctx, cancel := requestreply.WithRequestTimeout(ctx, requestreply.DefaultRequestTimeout)
+	defer cancel()
+
+	// the reply is matched to its request with the correlation id, so every request gets its own
+	correlationId := uuid.NewV4().String()
+	meta = metadata.FromMetadata(meta)
+	messageHeader.SetCorrelationId(meta, correlationId)
+
+	pub, err := p.preparePublishing(ctx, request, meta, "", "")
+	if err != nil {
+		return nil, err
+	}
+	pub.delivery.ReplyTo = p.replies.address()
+
+	replies := p.replies.pendingRequests.Add(correlationId)
+	defer p.replies.pendingRequests.Remove(correlationId)
+
+	if err := p.publish(pub); err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, errors.WrapIff(
+			ctx.Err(),
+			"no reply for the request `%s` with correlation id `%s`",
+			request.GetMessageTypeName(),
+			correlationId,
+		)
+	case reply := <-replies:
+		return p.replyMessage(reply)
+	}
+}
+
+// PublishReply publishes the reply of a request to the reply queue of the request with the correlation id of the
+// request.
+func (p *inMemoryProducer) PublishReply(
+	ctx context.Context,
+	replyTo string,
+	correlationId string,
+	reply messagingTypes.IMessage,
+	meta metadata.Metadata,
+) error {
+	meta = metadata.FromMetadata(meta)
+	messageHeader.SetCorrelationId(meta, correlationId)
+
+	pub, err := p.preparePublishing(ctx, reply, meta, "", replyTo)
+	if err != nil {
+		return err
+	}
+
+	return p.publish(pub)
+}
+
+// replyMessage deserializes a reply, an error reply is returned as the error of the request.
+func (p *inMemoryProducer) replyMessage(reply Delivery) (messagingTypes.IMessage, error) {
+	contentType := reply.ContentType
+	if contentType == "" {
+		contentType = rabbitmqconsumer.ContentType
+	}
+
+	message, err := consumer.DeserializeMessage(
+		p.messageSerializer,
+		p.transforms,
+		contentType,
+		reply.ContentEncoding,
+		reply.Type,
+		versioning.GetSchemaVersion(metadata.MapToMetadata(reply.Headers)),
+		reply.Body,
+	)
+	if err != nil {
+		return nil, errors.WrapIf(err, "error in deserializing the reply")
+	}
+
+	return requestreply.ReplyResult(message)
+}
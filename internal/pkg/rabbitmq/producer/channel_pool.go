@@ -0,0 +1,225 @@
+// Package producer provides the channel pool of the rabbitmq producer.
+package producer
+
+import (
+	"context"
+	"sync"
+
+	"emperror.dev/errors"
+
+	amqp091 "github.com/rabbitmq/amqp091-go"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/producer/configurations"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/rabbitmqerrors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/types"
+)
+
+// notificationsBufferSize is the buffer of the confirms and returns notifications of a channel, so the connection
+// reader isn't blocked by the confirms of a pipelined batch.
+const notificationsBufferSize = 256
+
+// pooledChannel is a confirm mode channel of the pool with its confirms tracker and declared exchanges.
+type pooledChannel struct {
+	channel           *amqp091.Channel
+	tracker           *confirmTracker
+	declaredExchanges map[string]struct{}
+}
+
+// isClosed checks if the channel can't be used for publishing anymore.
+func (c *pooledChannel) isClosed() bool {
+	return c.channel.IsClosed() || c.tracker.isClosed()
+}
+
+// ensureExchange declares the exchange once per channel.
+func (c *pooledChannel) ensureExchange(
+	producerConfiguration *configurations.RabbitMQProducerConfiguration,
+	exchangeName string,
+) error {
+	// the default exchange routes to the queues by their names and can't be declared
+	if exchangeName == "" {
+		return nil
+	}
+
+	if _, ok := c.declaredExchanges[exchangeName]; ok {
+		return nil
+	}
+
+	err := c.channel.ExchangeDeclare(
+		exchangeName,
+		string(producerConfiguration.ExchangeOptions.Type),
+		producerConfiguration.ExchangeOptions.Durable,
+		producerConfiguration.ExchangeOptions.AutoDelete,
+		false,
+		false,
+		producerConfiguration.ExchangeOptions.Args,
+	)
+	if err != nil {
+		return err
+	}
+
+	c.declaredExchanges[exchangeName] = struct{}{}
+
+	return nil
+}
+
+// publish publishes the message on the channel and returns the future of its confirm.
+func (c *pooledChannel) publish(
+	ctx context.Context,
+	exchange string,
+	routingKey string,
+	props amqp091.Publishing,
+) (uint64, *PublishFuture, error) {
+	deliveryTag := c.channel.GetNextPublishSeqNo()
+	future := c.tracker.track(deliveryTag, props.MessageId)
+
+	// mandatory, so the unroutable messages come back as basic.return instead of being dropped
+	if err := c.channel.PublishWithContext(ctx, exchange, routingKey, true, false, props); err != nil {
+		c.tracker.untrack(deliveryTag)
+
+		return 0, nil, err
+	}
+
+	return deliveryTag, future, nil
+}
+
+// channelPool is a pool of the confirm mode channels, a channel is borrowed only for the publish call so many
+// messages can wait for their confirms on the same channel.
+type channelPool struct {
+	connection types.IConnection
+	logger     logger.Logger
+	size       int
+	idle       chan *pooledChannel
+	mu         sync.Mutex
+	opened     int
+}
+
+// newChannelPool creates a new channel pool, the channels are opened on demand.
+func newChannelPool(connection types.IConnection, size int, logger logger.Logger) *channelPool {
+	return &channelPool{
+		connection: connection,
+		logger:     logger,
+		size:       size,
+		idle:       make(chan *pooledChannel, size),
+	}
+}
+
+// acquire borrows a channel from the pool, it opens a new one while the pool is not full, otherwise it waits.
+func (p *channelPool) acquire(ctx context.Context) (*pooledChannel, error) {
+	for {
+		select {
+		case ch := <-p.idle:
+			if ch.isClosed() {
+				p.discard(ch)
+
+				continue
+			}
+
+			return ch, nil
+		default:
+		}
+
+		if p.reserve() {
+			ch, err := p.open()
+			if err != nil {
+				p.unreserve()
+
+				return nil, err
+			}
+
+			return ch, nil
+		}
+
+		select {
+		case ch := <-p.idle:
+			if ch.isClosed() {
+				p.discard(ch)
+
+				continue
+			}
+
+			return ch, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// release gives the channel back to the pool, the closed channels are replaced on the next acquire.
+func (p *channelPool) release(ch *pooledChannel) {
+	if ch.isClosed() {
+		p.discard(ch)
+
+		return
+	}
+
+	p.idle <- ch
+}
+
+// discard closes the channel and frees its place in the pool.
+func (p *channelPool) discard(ch *pooledChannel) {
+	if !ch.channel.IsClosed() {
+		if err := ch.channel.Close(); err != nil {
+			p.logger.Errorf("Error closing pooled channel: %v", err)
+		}
+	}
+	ch.tracker.close(errors.WithStackIf(rabbitmqerrors.ErrChannelClosed))
+	p.unreserve()
+}
+
+// reserve reserves a place for a new channel if the pool is not full.
+func (p *channelPool) reserve() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.opened >= p.size {
+		return false
+	}
+	p.opened++
+
+	return true
+}
+
+// unreserve frees a place of the pool.
+func (p *channelPool) unreserve() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.opened--
+}
+
+// open opens a new channel in confirm mode and starts tracking its confirms and returns.
+func (p *channelPool) open() (*pooledChannel, error) {
+	if p.connection == nil {
+		return nil, errors.New("connection is nil")
+	}
+
+	if p.connection.IsClosed() {
+		return nil, errors.New("connection is closed, wait for connection alive")
+	}
+
+	channel, err := p.connection.Channel()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := channel.Confirm(false); err != nil {
+		if closeErr := channel.Close(); closeErr != nil {
+			p.logger.Errorf("Error closing channel after confirm error: %v", closeErr)
+		}
+
+		return nil, err
+	}
+
+	confirms := channel.NotifyPublish(make(chan amqp091.Confirmation, notificationsBufferSize))
+	returns := channel.NotifyReturn(make(chan amqp091.Return, notificationsBufferSize))
+
+	tracker := newConfirmTracker()
+	go tracker.listen(confirms, returns)
+
+	return &pooledChannel{
+		channel:           channel,
+		tracker:           tracker,
+		declaredExchanges: make(map[string]struct{}),
+	}, nil
+}
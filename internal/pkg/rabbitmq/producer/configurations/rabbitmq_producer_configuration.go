@@ -6,6 +6,7 @@ import (
 
 	types2 "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/utils"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/producer/options"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/types"
 )
@@ -21,6 +22,8 @@ type RabbitMQProducerConfiguration struct {
 	Expiration          string
 	ReplyTo             string
 	ContentEncoding     string
+	// MessageSerializer serializes the messages of the producer, nil for the default message serializer
+	MessageSerializer serializer.MessageSerializer
 }
 
 // NewDefaultRabbitMQProducerConfiguration creates a new default rabbitmq producer configuration.
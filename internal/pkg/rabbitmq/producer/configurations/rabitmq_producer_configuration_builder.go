@@ -3,6 +3,7 @@ package configurations
 
 import (
 	types2 "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/types"
 )
 
@@ -20,6 +21,7 @@ type RabbitMQProducerConfigurationBuilder interface {
 	WithExpiration(expiration string) RabbitMQProducerConfigurationBuilder
 	WithReplyTo(replyTo string) RabbitMQProducerConfigurationBuilder
 	WithContentEncoding(contentEncoding string) RabbitMQProducerConfigurationBuilder
+	WithMessageSerializer(messageSerializer serializer.MessageSerializer) RabbitMQProducerConfigurationBuilder
 	Build() *RabbitMQProducerConfiguration
 }
 
@@ -144,6 +146,15 @@ func (b *rabbitMQProducerConfigurationBuilder) WithContentEncoding(
 	return b
 }
 
+// WithMessageSerializer sets the message serializer of the producer, instead of the default message serializer.
+func (b *rabbitMQProducerConfigurationBuilder) WithMessageSerializer(
+	messageSerializer serializer.MessageSerializer,
+) RabbitMQProducerConfigurationBuilder {
+	b.rabbitmqProducerOptions.MessageSerializer = messageSerializer
+
+	return b
+}
+
 // Build builds the rabbitmq producer configuration.
 func (b *rabbitMQProducerConfigurationBuilder) Build() *RabbitMQProducerConfiguration {
 	return b.rabbitmqProducerOptions
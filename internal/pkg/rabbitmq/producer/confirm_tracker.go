@@ -0,0 +1,231 @@
+// Package producer provides the publisher confirms tracking of the rabbitmq producer.
+package producer
+
+import (
+	"context"
+	"sync"
+
+	"emperror.dev/errors"
+
+	amqp091 "github.com/rabbitmq/amqp091-go"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/rabbitmqerrors"
+)
+
+// PublishFuture is the pending result of a published message, it completes when the broker confirms the message.
+type PublishFuture struct {
+	done chan struct{}
+	err  error
+}
+
+// newPublishFuture creates a new pending publish future.
+func newPublishFuture() *PublishFuture {
+	return &PublishFuture{done: make(chan struct{})}
+}
+
+// newCompletedPublishFuture creates a publish future that is already completed with the error.
+func newCompletedPublishFuture(err error) *PublishFuture {
+	f := newPublishFuture()
+	f.complete(err)
+
+	return f
+}
+
+// complete completes the future with the error, a nil error means the broker acked the message.
+func (f *PublishFuture) complete(err error) {
+	f.err = err
+	close(f.done)
+}
+
+// Done returns a channel that is closed when the future is completed.
+func (f *PublishFuture) Done() <-chan struct{} {
+	return f.done
+}
+
+// Wait waits for the broker confirm of the message or for the context to be done.
+func (f *PublishFuture) Wait(ctx context.Context) error {
+	select {
+	case <-f.done:
+		return f.err
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return errors.WithStackIf(rabbitmqerrors.ErrConfirmTimeout)
+		}
+
+		return ctx.Err()
+	}
+}
+
+// pendingConfirm is a published message that is waiting for its broker confirm.
+type pendingConfirm struct {
+	messageID string
+	// future is nil when the caller stopped waiting, the entry is kept to clean up its return on the confirm.
+	future *PublishFuture
+}
+
+// confirmTracker tracks the publisher confirms of a confirm mode channel by delivery tag. amqp091 resequences the
+// confirms and splits the multiple acks, so every delivery tag gets its own confirmation in publishing order.
+type confirmTracker struct {
+	mu      sync.Mutex
+	pending map[uint64]*pendingConfirm
+	// returns are the basic.return of the mandatory messages by message id, the broker sends the return of a
+	// message before its confirm.
+	returns   map[string]amqp091.Return
+	closedErr error
+}
+
+// newConfirmTracker creates a new confirm tracker.
+func newConfirmTracker() *confirmTracker {
+	return &confirmTracker{
+		pending: make(map[uint64]*pendingConfirm),
+		returns: make(map[string]amqp091.Return),
+	}
+}
+
+// track registers the message published with the delivery tag and returns its future.
+func (t *confirmTracker) track(deliveryTag uint64, messageID string) *PublishFuture {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.closedErr != nil {
+		return newCompletedPublishFuture(t.closedErr)
+	}
+
+	future := newPublishFuture()
+	t.pending[deliveryTag] = &pendingConfirm{messageID: messageID, future: future}
+
+	return future
+}
+
+// untrack removes the delivery tag of a message that failed to publish, it is not completed.
+func (t *confirmTracker) untrack(deliveryTag uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.pending, deliveryTag)
+}
+
+// abandon stops tracking the future of the delivery tag, its confirm is still consumed when it arrives.
+func (t *confirmTracker) abandon(deliveryTag uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if pending, ok := t.pending[deliveryTag]; ok {
+		pending.future = nil
+	}
+}
+
+// returned records the basic.return of an unroutable mandatory message.
+func (t *confirmTracker) returned(ret amqp091.Return) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.returns[ret.MessageId] = ret
+}
+
+// confirm completes the future of the confirmed delivery tag.
+func (t *confirmTracker) confirm(confirmation amqp091.Confirmation) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	pending, ok := t.pending[confirmation.DeliveryTag]
+	if !ok {
+		return
+	}
+	delete(t.pending, confirmation.DeliveryTag)
+
+	ret, isReturned := t.returns[pending.messageID]
+	if isReturned {
+		delete(t.returns, pending.messageID)
+	}
+
+	if pending.future == nil {
+		return
+	}
+
+	switch {
+	case !confirmation.Ack:
+		pending.future.complete(errors.WithStackIf(rabbitmqerrors.ErrMessageNacked))
+	case isReturned:
+		pending.future.complete(errors.Wrapf(
+			rabbitmqerrors.ErrUnroutableMessage,
+			"message %s to exchange %s with routing key %s returned with %d %s",
+			ret.MessageId,
+			ret.Exchange,
+			ret.RoutingKey,
+			ret.ReplyCode,
+			ret.ReplyText,
+		))
+	default:
+		pending.future.complete(nil)
+	}
+}
+
+// close completes all the pending futures with the error, the futures tracked after it complete immediately.
+func (t *confirmTracker) close(err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.closedErr != nil {
+		return
+	}
+	t.closedErr = err
+
+	for deliveryTag, pending := range t.pending {
+		if pending.future != nil {
+			pending.future.complete(err)
+		}
+		delete(t.pending, deliveryTag)
+	}
+	t.returns = make(map[string]amqp091.Return)
+}
+
+// isClosed checks if the tracker is closed.
+func (t *confirmTracker) isClosed() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.closedErr != nil
+}
+
+// listen consumes the confirms and the returns of the channel until the channel is closed.
+func (t *confirmTracker) listen(
+	confirms <-chan amqp091.Confirmation,
+	returns <-chan amqp091.Return,
+) {
+	for {
+		select {
+		case ret, ok := <-returns:
+			if !ok {
+				returns = nil
+
+				continue
+			}
+			t.returned(ret)
+		case confirmation, ok := <-confirms:
+			if !ok {
+				t.close(errors.WithStackIf(rabbitmqerrors.ErrChannelClosed))
+
+				return
+			}
+			// the return of a message is dispatched before its confirm, drain it so the confirm sees it
+			t.drainReturns(returns)
+			t.confirm(confirmation)
+		}
+	}
+}
+
+// drainReturns records the returns that are already dispatched without blocking.
+func (t *confirmTracker) drainReturns(returns <-chan amqp091.Return) {
+	for {
+		select {
+		case ret, ok := <-returns:
+			if !ok {
+				return
+			}
+			t.returned(ret)
+		default:
+			return
+		}
+	}
+}
@@ -0,0 +1,104 @@
+//go:build unit
+// +build unit
+
+// Package producer provides the confirm tracker tests.
+package producer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	amqp091 "github.com/rabbitmq/amqp091-go"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/rabbitmqerrors"
+)
+
+func Test_Confirm_Completes_The_Future_Of_The_Delivery_Tag(t *testing.T) {
+	tracker := newConfirmTracker()
+	first := tracker.track(1, "message-1")
+	second := tracker.track(2, "message-2")
+
+	tracker.confirm(amqp091.Confirmation{DeliveryTag: 2, Ack: true})
+
+	require.NoError(t, second.Wait(context.Background()))
+	select {
+	case <-first.Done():
+		t.Fatal("the future of an unconfirmed delivery tag is completed")
+	default:
+	}
+}
+
+func Test_Nack_Fails_The_Future(t *testing.T) {
+	tracker := newConfirmTracker()
+	future := tracker.track(1, "message-1")
+
+	tracker.confirm(amqp091.Confirmation{DeliveryTag: 1, Ack: false})
+
+	assert.True(t, errors.Is(future.Wait(context.Background()), rabbitmqerrors.ErrMessageNacked))
+}
+
+func Test_Returned_Message_Fails_The_Future_As_Unroutable(t *testing.T) {
+	tracker := newConfirmTracker()
+	returned := tracker.track(1, "message-1")
+	routed := tracker.track(2, "message-2")
+
+	tracker.returned(amqp091.Return{MessageId: "message-1", ReplyCode: 312, ReplyText: "NO_ROUTE"})
+	tracker.confirm(amqp091.Confirmation{DeliveryTag: 1, Ack: true})
+	tracker.confirm(amqp091.Confirmation{DeliveryTag: 2, Ack: true})
+
+	err := returned.Wait(context.Background())
+	assert.True(t, errors.Is(err, rabbitmqerrors.ErrUnroutableMessage))
+	assert.Contains(t, err.Error(), "NO_ROUTE")
+	assert.NoError(t, routed.Wait(context.Background()))
+}
+
+func Test_Listen_Sees_The_Return_Dispatched_Before_The_Confirm(t *testing.T) {
+	tracker := newConfirmTracker()
+	confirms := make(chan amqp091.Confirmation, 1)
+	returns := make(chan amqp091.Return, 1)
+	future := tracker.track(1, "message-1")
+
+	returns <- amqp091.Return{MessageId: "message-1"}
+	confirms <- amqp091.Confirmation{DeliveryTag: 1, Ack: true}
+	go tracker.listen(confirms, returns)
+
+	assert.True(t, errors.Is(future.Wait(context.Background()), rabbitmqerrors.ErrUnroutableMessage))
+}
+
+func Test_Closed_Channel_Fails_The_Pending_Futures(t *testing.T) {
+	tracker := newConfirmTracker()
+	confirms := make(chan amqp091.Confirmation)
+	returns := make(chan amqp091.Return)
+	future := tracker.track(1, "message-1")
+
+	go tracker.listen(confirms, returns)
+	close(returns)
+	close(confirms)
+
+	assert.True(t, errors.Is(future.Wait(context.Background()), rabbitmqerrors.ErrChannelClosed))
+	assert.True(t, tracker.isClosed())
+	assert.True(
+		t,
+		errors.Is(tracker.track(2, "message-2").Wait(context.Background()), rabbitmqerrors.ErrChannelClosed),
+	)
+}
+
+func Test_Wait_Returns_Confirm_Timeout(t *testing.T) {
+	tracker := newConfirmTracker()
+	future := tracker.track(1, "message-1")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assert.True(t, errors.Is(future.Wait(ctx), rabbitmqerrors.ErrConfirmTimeout))
+
+	// the late confirm of an abandoned delivery tag is consumed without completing the future
+	tracker.abandon(1)
+	tracker.confirm(amqp091.Confirmation{DeliveryTag: 1, Ack: true})
+	assert.Empty(t, tracker.pending)
+}
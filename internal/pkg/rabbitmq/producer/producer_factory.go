@@ -3,6 +3,7 @@ package producer
 
 import (
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/producer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/transform"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
 	serializer "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
@@ -18,6 +19,7 @@ type producerFactory struct {
 	logger          logger.Logger
 	eventSerializer serializer.MessageSerializer
 	rabbitmqOptions *config.RabbitmqOptions
+	transforms      *transform.Pipeline
 }
 
 // NewProducerFactory creates a new producer factory.
@@ -26,12 +28,14 @@ func NewProducerFactory(
 	connection types2.IConnection,
 	eventSerializer serializer.MessageSerializer,
 	l logger.Logger,
+	transforms *transform.Pipeline,
 ) producercontracts.ProducerFactory {
 	return &producerFactory{
 		rabbitmqOptions: rabbitmqOptions,
 		logger:          l,
 		connection:      connection,
 		eventSerializer: eventSerializer,
+		transforms:      transforms,
 	}
 }
 
@@ -46,5 +50,6 @@ func (p *producerFactory) CreateProducer(
 		rabbitmqProducersConfiguration,
 		p.logger,
 		p.eventSerializer,
+		p.transforms,
 		isProducedNotifications...)
 }
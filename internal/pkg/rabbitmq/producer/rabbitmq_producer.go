@@ -7,18 +7,21 @@ import (
 
 	"emperror.dev/errors"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	amqp091 "github.com/rabbitmq/amqp091-go"
-	uuid "github.com/satori/go.uuid"
 	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
 
 	messageHeader "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/messageheader"
 	producer3 "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/otel/tracing/producer"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/producer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/transform"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/transform/compression"
 	types2 "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/utils"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/metadata"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/versioning"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/config"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/producer/configurations"
@@ -33,6 +36,10 @@ type rabbitMQProducer struct {
 	messageSerializer       serializer.MessageSerializer
 	producersConfigurations map[string]*configurations.RabbitMQProducerConfiguration
 	isProducedNotifications []func(message types2.IMessage)
+	channelPool             *channelPool
+	confirmTimeout          time.Duration
+	transforms              *transform.Pipeline
+	requests                *requestChannel
 }
 
 // NewRabbitMQProducer creates a new rabbitmq producer.
@@ -42,15 +49,41 @@ func NewRabbitMQProducer(
 	rabbitmqProducersConfiguration map[string]*configurations.RabbitMQProducerConfiguration,
 	logger logger.Logger,
 	eventSerializer serializer.MessageSerializer,
+	transforms *transform.Pipeline,
 	isProducedNotifications ...func(message types2.IMessage),
-) (producer.Producer, error) {
+) (producer.BatchProducer, error) {
+	producerOptions := cfg.GetProducerOptions()
+
+	// the replies are decompressed whatever the compression of the responder is
+	replyCompressionTransform, err := compression.NewCompressionTransform("", 0)
+	if err != nil {
+		return nil, err
+	}
+	replyTransforms := transforms.With(replyCompressionTransform)
+
+	// the payloads are compressed after the other payload transforms
+	if producerOptions.Compression != "" {
+		compressionTransform, err := compression.NewCompressionTransform(
+			producerOptions.Compression,
+			producerOptions.CompressionMinSize,
+		)
+		if err != nil {
+			return nil, err
+		}
+		transforms = transforms.With(compressionTransform)
+	}
+
 	p := &rabbitMQProducer{
 		logger:                  logger,
 		rabbitmqOptions:         cfg,
 		connection:              connection,
 		messageSerializer:       eventSerializer,
 		producersConfigurations: rabbitmqProducersConfiguration,
+		channelPool:             newChannelPool(connection, producerOptions.ChannelPoolSize, logger),
+		confirmTimeout:          producerOptions.ConfirmTimeout,
+		transforms:              transforms,
 	}
+	p.requests = newRequestChannel(p.channelPool, replyTransforms, producerOptions.RequestTimeout, logger)
 
 	p.isProducedNotifications = isProducedNotifications
 
@@ -80,8 +113,9 @@ func (r *rabbitMQProducer) getProducerConfigurationByMessage(
 	return r.producersConfigurations[messageType.String()]
 }
 
-// getExchangeAndRoutingKey determines the exchange and routing key for message publishing.
-func (r *rabbitMQProducer) getExchangeAndRoutingKey(
+// ExchangeAndRoutingKey determines the exchange and routing key for message publishing, it is shared by the
+// producers of the rabbitmq transports.
+func ExchangeAndRoutingKey(
 	message types2.IMessage,
 	producerConfiguration *configurations.RabbitMQProducerConfiguration,
 	topicOrExchangeName string,
@@ -105,100 +139,164 @@ func (r *rabbitMQProducer) getExchangeAndRoutingKey(
 	return exchange, routingKey
 }
 
-// setupChannel sets up the channel for publishing with confirmation.
-func (r *rabbitMQProducer) setupChannel(
-	producerConfiguration *configurations.RabbitMQProducerConfiguration,
-	exchange string,
-) (*amqp091.Channel, chan amqp091.Confirmation, error) {
-	if r.connection == nil {
-		return nil, nil, errors.New("connection is nil")
+// publishing is a prepared message with its producer span, ready to be published on a pooled channel.
+type publishing struct {
+	message               types2.IMessage
+	producerConfiguration *configurations.RabbitMQProducerConfiguration
+	exchange              string
+	routingKey            string
+	props                 amqp091.Publishing
+	span                  trace.Span
+	channel               *pooledChannel
+	deliveryTag           uint64
+	future                *PublishFuture
+}
+
+// PublishMessageWithTopicName publishes a message to the rabbitmq with topic name.
+func (r *rabbitMQProducer) PublishMessageWithTopicName(
+	ctx context.Context,
+	message types2.IMessage,
+	meta metadata.Metadata,
+	topicOrExchangeName string,
+) error {
+	pub, err := r.preparePublishing(ctx, message, meta, topicOrExchangeName)
+	if err != nil {
+		return err
 	}
 
-	if r.connection.IsClosed() {
-		return nil, nil, errors.New("connection is closed, wait for connection alive")
+	channel, err := r.channelPool.acquire(ctx)
+	if err != nil {
+		return producer3.FinishProducerSpan(pub.span, err)
 	}
 
-	channel, err := r.connection.Channel()
+	err = r.publishToChannel(ctx, channel, pub)
+	r.channelPool.release(channel)
+
 	if err != nil {
-		return nil, nil, err
+		return producer3.FinishProducerSpan(pub.span, err)
 	}
 
-	if err := r.ensureExchange(producerConfiguration, channel, exchange); err != nil {
-		if closeErr := channel.Close(); closeErr != nil {
-			r.logger.Errorf("Error closing channel after ensure exchange error: %v", closeErr)
+	return r.completePublishing(ctx, pub)
+}
+
+// PublishMessages publishes a batch of messages on one pooled channel without waiting between them, then waits
+// for all the broker confirms. The returned error combines the errors of the failed messages.
+func (r *rabbitMQProducer) PublishMessages(
+	ctx context.Context,
+	messages []types2.IMessage,
+	meta metadata.Metadata,
+) error {
+	var errs []error
+
+	publishings := make([]*publishing, 0, len(messages))
+	for _, message := range messages {
+		pub, err := r.preparePublishing(ctx, message, meta, "")
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
 		}
+		publishings = append(publishings, pub)
+	}
 
-		return nil, nil, err
+	if len(publishings) == 0 {
+		return errors.Combine(errs...)
 	}
 
-	if err := channel.Confirm(false); err != nil {
-		if closeErr := channel.Close(); closeErr != nil {
-			r.logger.Errorf("Error closing channel after confirm error: %v", closeErr)
+	channel, err := r.channelPool.acquire(ctx)
+	if err != nil {
+		for _, pub := range publishings {
+			errs = append(errs, producer3.FinishProducerSpan(pub.span, err))
 		}
 
-		return nil, nil, err
+		return errors.Combine(errs...)
 	}
 
-	confirms := make(chan amqp091.Confirmation)
-	channel.NotifyPublish(confirms)
+	published := make([]*publishing, 0, len(publishings))
+	for _, pub := range publishings {
+		if err := r.publishToChannel(ctx, channel, pub); err != nil {
+			errs = append(errs, producer3.FinishProducerSpan(pub.span, err))
 
-	return channel, confirms, nil
-}
-
-// publishMessageToChannel publishes a message to the channel and handles confirmation.
-func (r *rabbitMQProducer) publishMessageToChannel(
-	ctx context.Context,
-	channel *amqp091.Channel,
-	confirms chan amqp091.Confirmation,
-	exchange string,
-	routingKey string,
-	props amqp091.Publishing,
-) error {
-	if err := channel.PublishWithContext(ctx, exchange, routingKey, true, false, props); err != nil {
-		return err
+			continue
+		}
+		published = append(published, pub)
 	}
+	r.channelPool.release(channel)
 
-	if confirmed := <-confirms; !confirmed.Ack {
-		return errors.New("ack not confirmed")
+	for _, pub := range published {
+		if err := r.completePublishing(ctx, pub); err != nil {
+			errs = append(errs, err)
+		}
 	}
 
-	return nil
+	return errors.Combine(errs...)
 }
 
-// PublishMessageWithTopicName publishes a message to the rabbitmq with topic name.
-func (r *rabbitMQProducer) PublishMessageWithTopicName(
+// preparePublishing serializes the message, starts its producer span and builds its amqp publishing.
+func (r *rabbitMQProducer) preparePublishing(
 	ctx context.Context,
 	message types2.IMessage,
 	meta metadata.Metadata,
 	topicOrExchangeName string,
-) error {
+) (*publishing, error) {
 	producerConfiguration := r.getProducerConfigurationByMessage(message)
 	if producerConfiguration == nil {
 		producerConfiguration = configurations.NewDefaultRabbitMQProducerConfiguration(message)
 	}
 
-	exchange, routingKey := r.getExchangeAndRoutingKey(
+	messageSerializer := r.messageSerializer
+	if producerConfiguration.MessageSerializer != nil {
+		messageSerializer = producerConfiguration.MessageSerializer
+	}
+
+	exchange, routingKey := ExchangeAndRoutingKey(
 		message,
 		producerConfiguration,
 		topicOrExchangeName,
 	)
-	meta = r.getMetadata(message, meta)
+
+	return r.newPublishing(ctx, message, meta, producerConfiguration, messageSerializer, exchange, routingKey)
+}
+
+// newPublishing serializes the message, starts its producer span and builds its amqp publishing to an exchange.
+func (r *rabbitMQProducer) newPublishing(
+	ctx context.Context,
+	message types2.IMessage,
+	meta metadata.Metadata,
+	producerConfiguration *configurations.RabbitMQProducerConfiguration,
+	messageSerializer serializer.MessageSerializer,
+	exchange string,
+	routingKey string,
+) (*publishing, error) {
+	meta = producer.PublishingMetadata(message, meta, messageSerializer)
+
+	// the default exchange routes to the queue of the routing key
+	destination := exchange
+	if destination == "" {
+		destination = routingKey
+	}
 
 	producerOptions := &producer3.ProducerTracingOptions{
 		MessagingSystem: "rabbitmq",
 		DestinationKind: "exchange",
-		Destination:     exchange,
+		Destination:     destination,
 		OtherAttributes: []attribute.KeyValue{
 			semconv.MessagingRabbitmqDestinationRoutingKey(routingKey),
 		},
 	}
 
-	serializedObj, err := r.messageSerializer.Serialize(message)
+	serializedObj, err := r.serialize(message, messageSerializer)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	// the content encoding of the producer configuration marks a payload that is already encoded
+	payload := &transform.Payload{Data: serializedObj.Data, ContentEncoding: producerConfiguration.ContentEncoding}
+	if err := r.transforms.EncodePayload(payload); err != nil {
+		return nil, err
 	}
 
-	ctx, beforeProduceSpan := producer3.StartProducerSpan(
+	_, beforeProduceSpan := producer3.StartProducerSpan(
 		ctx,
 		message,
 		&meta,
@@ -206,91 +304,91 @@ func (r *rabbitMQProducer) PublishMessageWithTopicName(
 		producerOptions,
 	)
 
-	channel, confirms, err := r.setupChannel(producerConfiguration, exchange)
-	if err != nil {
-		return producer3.FinishProducerSpan(beforeProduceSpan, err)
-	}
-	defer func() {
-		if err := channel.Close(); err != nil {
-			r.logger.Errorf("Error closing channel: %v", err)
-		}
-	}()
-
 	props := amqp091.Publishing{
 		CorrelationId:   messageHeader.GetCorrelationId(meta),
 		MessageId:       message.GeMessageId(),
 		Timestamp:       time.Now(),
 		Headers:         metadata.MetadataToMap(meta),
-		Type:            message.GetMessageTypeName(),
+		Type:            messageHeader.GetMessageType(meta),
 		ContentType:     serializedObj.ContentType,
-		Body:            serializedObj.Data,
+		Body:            payload.Data,
 		DeliveryMode:    producerConfiguration.DeliveryMode,
 		Expiration:      producerConfiguration.Expiration,
 		AppId:           producerConfiguration.AppId,
 		Priority:        producerConfiguration.Priority,
 		ReplyTo:         producerConfiguration.ReplyTo,
-		ContentEncoding: producerConfiguration.ContentEncoding,
-	}
-
-	if err := r.publishMessageToChannel(ctx, channel, confirms, exchange, routingKey, props); err != nil {
-		return producer3.FinishProducerSpan(beforeProduceSpan, err)
-	}
-
-	for _, notification := range r.isProducedNotifications {
-		if notification != nil {
-			notification(message)
-		}
+		ContentEncoding: payload.ContentEncoding,
 	}
 
-	return producer3.FinishProducerSpan(beforeProduceSpan, nil)
+	return &publishing{
+		message:               message,
+		producerConfiguration: producerConfiguration,
+		exchange:              exchange,
+		routingKey:            routingKey,
+		props:                 props,
+		span:                  beforeProduceSpan,
+	}, nil
 }
 
-// getMetadata gets the metadata.
-func (r *rabbitMQProducer) getMetadata(
+// serialize runs the message transforms on a copy of the message, like the encryption of its pii fields, and
+// serializes the copy.
+func (r *rabbitMQProducer) serialize(
 	message types2.IMessage,
-	meta metadata.Metadata,
-) metadata.Metadata {
-	meta = metadata.FromMetadata(meta)
-
-	// just message type name not full type name because in other side package name for type could be different
-	messageHeader.SetMessageType(meta, message.GetMessageTypeName())
-	messageHeader.SetMessageContentType(meta, r.messageSerializer.ContentType())
-
-	if messageHeader.GetMessageId(meta) == "" {
-		messageHeader.SetMessageId(meta, message.GeMessageId())
-	}
-
-	if messageHeader.GetMessageCreated(meta).Equal(*new(time.Time)) {
-		messageHeader.SetMessageCreated(meta, message.GetCreated())
+	messageSerializer serializer.MessageSerializer,
+) (*serializer.EventSerializationResult, error) {
+	encoded, err := r.transforms.EncodeMessage(message)
+	if err != nil {
+		return nil, err
 	}
 
-	if messageHeader.GetCorrelationId(meta) == "" {
-		cid := uuid.NewV4().String()
-		messageHeader.SetCorrelationId(meta, cid)
+	encodedMessage, ok := encoded.(types2.IMessage)
+	if !ok {
+		return nil, errors.Errorf("transformed message of `%s` is not a message", versioning.TypeName(message))
 	}
-	messageHeader.SetMessageName(meta, utils.GetMessageName(message))
 
-	return meta
+	return messageSerializer.Serialize(encodedMessage)
 }
 
-// ensureExchange ensures the exchange.
-func (r *rabbitMQProducer) ensureExchange(
-	producersConfigurations *configurations.RabbitMQProducerConfiguration,
-	channel *amqp091.Channel,
-	exchangeName string,
+// publishToChannel declares the exchange if the channel didn't declare it yet and publishes the message, the
+// broker confirm is tracked by the future of the publishing.
+func (r *rabbitMQProducer) publishToChannel(
+	ctx context.Context,
+	channel *pooledChannel,
+	pub *publishing,
 ) error {
-	err := channel.ExchangeDeclare(
-		exchangeName,
-		string(producersConfigurations.ExchangeOptions.Type),
-		producersConfigurations.ExchangeOptions.Durable,
-		producersConfigurations.ExchangeOptions.AutoDelete,
-		false,
-		false,
-		producersConfigurations.ExchangeOptions.Args,
-	)
+	if err := channel.ensureExchange(pub.producerConfiguration, pub.exchange); err != nil {
+		return err
+	}
+
+	deliveryTag, future, err := channel.publish(ctx, pub.exchange, pub.routingKey, pub.props)
 	if err != nil {
 		return err
 	}
 
+	pub.channel = channel
+	pub.deliveryTag = deliveryTag
+	pub.future = future
+
 	return nil
 }
+
+// completePublishing waits for the broker confirm of the publishing, runs the produced notifications and
+// finishes its span.
+func (r *rabbitMQProducer) completePublishing(ctx context.Context, pub *publishing) error {
+	confirmCtx, cancel := context.WithTimeout(ctx, r.confirmTimeout)
+	defer cancel()
+
+	if err := pub.future.Wait(confirmCtx); err != nil {
+		pub.channel.tracker.abandon(pub.deliveryTag)
+
+		return producer3.FinishProducerSpan(pub.span, err)
+	}
+
+	for _, notification := range r.isProducedNotifications {
+		if notification != nil {
+			notification(pub.message)
+		}
+	}
+
+	return producer3.FinishProducerSpan(pub.span, nil)
+}
@@ -77,6 +77,7 @@ func TestPublishMessage(t *testing.T) {
 		conn,
 		eventSerializer,
 		defaultLogger.GetLogger(),
+		nil,
 	)
 
 	rabbitmqProducer, err := producerFactory.CreateProducer(nil)
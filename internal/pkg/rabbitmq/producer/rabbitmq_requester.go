@@ -0,0 +1,232 @@
+// Package producer provides the requests and the replies of the rabbitmq producer.
+package producer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+
+	amqp091 "github.com/rabbitmq/amqp091-go"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/consumer"
+	messageHeader "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/messageheader"
+	producer3 "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/otel/tracing/producer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/requestreply"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/transform"
+	types2 "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/metadata"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/versioning"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/producer/configurations"
+)
+
+// DirectReplyTo is the pseudo queue of the rabbitmq direct reply-to, the replies that are published to it are
+// delivered to the consumer of the channel that published the request, without declaring a reply queue.
+const DirectReplyTo = "amq.rabbitmq.reply-to"
+
+// requestChannel is the channel of the requests of a producer, it consumes the direct reply-to replies of the
+// requests it published. The direct reply-to address of a request is bound to its channel, so the requests that
+// wait on a closed channel get no reply and end with their timeout.
+type requestChannel struct {
+	channelPool     *channelPool
+	transforms      *transform.Pipeline
+	requestTimeout  time.Duration
+	logger          logger.Logger
+	pendingRequests *requestreply.PendingRequests[amqp091.Delivery]
+	mu              sync.Mutex
+	channel         *pooledChannel
+}
+
+// newRequestChannel creates a new request channel, the channel is opened with the first request.
+func newRequestChannel(
+	channelPool *channelPool,
+	transforms *transform.Pipeline,
+	requestTimeout time.Duration,
+	logger logger.Logger,
+) *requestChannel {
+	return &requestChannel{
+		channelPool:     channelPool,
+		transforms:      transforms,
+		requestTimeout:  requestTimeout,
+		logger:          logger,
+		pendingRequests: requestreply.NewPendingRequests[amqp091.Delivery](),
+	}
+}
+
+// publish publishes a request on the channel, a closed channel is replaced by a new one.
+func (c *requestChannel) publish(ctx context.Context, pub *publishing) (*pooledChannel, uint64, *PublishFuture, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.channel == nil || c.channel.isClosed() {
+		channel, err := c.open()
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		c.channel = channel
+	}
+
+	if err := c.channel.ensureExchange(pub.producerConfiguration, pub.exchange); err != nil {
+		return nil, 0, nil, err
+	}
+
+	deliveryTag, future, err := c.channel.publish(ctx, pub.exchange, pub.routingKey, pub.props)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	return c.channel, deliveryTag, future, nil
+}
+
+// open opens a confirm mode channel that consumes the direct reply-to replies, the direct reply-to consumer must be
+// started before the first request is published and it must not ack the replies.
+func (c *requestChannel) open() (*pooledChannel, error) {
+	channel, err := c.channelPool.open()
+	if err != nil {
+		return nil, err
+	}
+
+	replies, err := channel.channel.Consume(DirectReplyTo, "", true, false, false, false, nil)
+	if err != nil {
+		if closeErr := channel.channel.Close(); closeErr != nil {
+			c.logger.Errorf("Error closing request channel after consume error: %v", closeErr)
+		}
+
+		return nil, errors.WrapIf(err, "error in consuming the direct reply-to replies")
+	}
+
+	go func() {
+		for reply := range replies {
+			if !c.pendingRequests.Resolve(reply.CorrelationId, reply) {
+				c.logger.Infof(
+					"dropping the reply of the request with correlation id `%s`, the request doesn't wait anymore",
+					reply.CorrelationId,
+				)
+			}
+		}
+	}()
+
+	return channel, nil
+}
+
+// SendRequest publishes a request with a new correlation id and the direct reply-to address and waits for its reply
+// until the context is done or the request timeout is reached.
+func (r *rabbitMQProducer) SendRequest(
+	ctx context.Context,
+	request types2.IMessage,
+	meta metadata.Metadata,
+) (types2.IMessage, error) {
+	ctx, cancel := requestreply.WithRequestTimeout(ctx, r.requests.requestTimeout)
+	defer cancel()
+
+	// the reply is matched to its request with the correlation id, so every request gets its own
+	correlationId := uuid.NewV4().String()
+	meta = metadata.FromMetadata(meta)
+	messageHeader.SetCorrelationId(meta, correlationId)
+
+	pub, err := r.preparePublishing(ctx, request, meta, "")
+	if err != nil {
+		return nil, err
+	}
+	pub.props.ReplyTo = DirectReplyTo
+
+	replies := r.requests.pendingRequests.Add(correlationId)
+	defer r.requests.pendingRequests.Remove(correlationId)
+
+	channel, deliveryTag, future, err := r.requests.publish(ctx, pub)
+	if err != nil {
+		return nil, producer3.FinishProducerSpan(pub.span, err)
+	}
+	pub.channel = channel
+	pub.deliveryTag = deliveryTag
+	pub.future = future
+
+	if err := r.completePublishing(ctx, pub); err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, errors.WrapIff(
+			ctx.Err(),
+			"no reply for the request `%s` with correlation id `%s`",
+			request.GetMessageTypeName(),
+			correlationId,
+		)
+	case reply := <-replies:
+		return r.replyMessage(reply)
+	}
+}
+
+// PublishReply publishes the reply of a request to the reply address of the request with the correlation id of the
+// request, the default exchange routes the reply to the reply address.
+func (r *rabbitMQProducer) PublishReply(
+	ctx context.Context,
+	replyTo string,
+	correlationId string,
+	reply types2.IMessage,
+	meta metadata.Metadata,
+) error {
+	producerConfiguration := r.getProducerConfigurationByMessage(reply)
+	if producerConfiguration == nil {
+		producerConfiguration = configurations.NewDefaultRabbitMQProducerConfiguration(reply)
+	}
+
+	messageSerializer := r.messageSerializer
+	if producerConfiguration.MessageSerializer != nil {
+		messageSerializer = producerConfiguration.MessageSerializer
+	}
+
+	meta = metadata.FromMetadata(meta)
+	messageHeader.SetCorrelationId(meta, correlationId)
+
+	pub, err := r.newPublishing(ctx, reply, meta, producerConfiguration, messageSerializer, "", replyTo)
+	if err != nil {
+		return err
+	}
+
+	channel, err := r.channelPool.acquire(ctx)
+	if err != nil {
+		return producer3.FinishProducerSpan(pub.span, err)
+	}
+
+	err = r.publishToChannel(ctx, channel, pub)
+	r.channelPool.release(channel)
+
+	if err != nil {
+		return producer3.FinishProducerSpan(pub.span, err)
+	}
+
+	return r.completePublishing(ctx, pub)
+}
+
+// replyMessage deserializes a reply, an error reply is returned as the error of the request.
+func (r *rabbitMQProducer) replyMessage(reply amqp091.Delivery) (types2.IMessage, error) {
+	var meta metadata.Metadata
+	if reply.Headers != nil {
+		meta = metadata.MapToMetadata(reply.Headers)
+	}
+
+	contentType := reply.ContentType
+	if contentType == "" {
+		contentType = r.messageSerializer.ContentType()
+	}
+
+	message, err := consumer.DeserializeMessage(
+		r.messageSerializer,
+		r.requests.transforms,
+		contentType,
+		reply.ContentEncoding,
+		reply.Type,
+		versioning.GetSchemaVersion(meta),
+		reply.Body,
+	)
+	if err != nil {
+		return nil, errors.WrapIf(err, "error in deserializing the reply")
+	}
+
+	return requestreply.ReplyResult(message)
+}
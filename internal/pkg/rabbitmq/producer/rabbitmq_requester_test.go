@@ -0,0 +1,248 @@
+//go:build integration
+// +build integration
+
+// Package producer provides the rabbitmq requester tests.
+package producer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	amqp091 "github.com/rabbitmq/amqp091-go"
+	uuid "github.com/satori/go.uuid"
+
+	types2 "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/utils"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/json"
+	defaultLogger "github.com/raphaeldiscky/go-food-micro/internal/pkg/logger/defaultlogger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/config"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/types"
+	typeMapper "github.com/raphaeldiscky/go-food-micro/internal/pkg/reflection/typemapper"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/test/containers/testcontainer/rabbitmq"
+	testUtils "github.com/raphaeldiscky/go-food-micro/internal/pkg/test/utils"
+)
+
+// TestSendRequest tests the requests and the direct reply-to replies of the producer on a rabbitmq server.
+func TestSendRequest(t *testing.T) {
+	testUtils.SkipCI(t)
+
+	rabbitmqHostOption, err := rabbitmq.NewRabbitMQTestContainers(defaultLogger.GetLogger()).
+		PopulateContainerOptions(context.Background(), t)
+	require.NoError(t, err)
+
+	t.Run("the reply of the responder is returned", func(t *testing.T) {
+		rabbitmqProducer := newRequestTestProducer(t, rabbitmqHostOption, 10*time.Second, replyToRequest)
+
+		reply, err := rabbitmqProducer.SendRequest(context.Background(), NewRequesterTestRequest("price"), nil)
+		require.NoError(t, err)
+
+		requesterReply, ok := reply.(*RequesterTestReply)
+		require.True(t, ok)
+		assert.Equal(t, "reply to price", requesterReply.Data)
+		assert.Zero(t, rabbitmqProducer.requests.pendingRequests.Len())
+	})
+
+	t.Run("a request without a reply ends with the request timeout", func(t *testing.T) {
+		requestTimeout := 500 * time.Millisecond
+		rabbitmqProducer := newRequestTestProducer(t, rabbitmqHostOption, requestTimeout, nil)
+
+		start := time.Now()
+		_, err := rabbitmqProducer.SendRequest(context.Background(), NewRequesterTestRequest("price"), nil)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		assert.GreaterOrEqual(t, time.Since(start), requestTimeout)
+		assert.Less(t, time.Since(start), 10*time.Second)
+		assert.Zero(t, rabbitmqProducer.requests.pendingRequests.Len())
+	})
+
+	t.Run("a canceled request ends with its context", func(t *testing.T) {
+		rabbitmqProducer := newRequestTestProducer(t, rabbitmqHostOption, 30*time.Second, nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(200*time.Millisecond, cancel)
+
+		start := time.Now()
+		_, err := rabbitmqProducer.SendRequest(ctx, NewRequesterTestRequest("price"), nil)
+		require.ErrorIs(t, err, context.Canceled)
+
+		assert.Less(t, time.Since(start), 10*time.Second)
+		assert.Zero(t, rabbitmqProducer.requests.pendingRequests.Len())
+	})
+
+	t.Run("a late reply is dropped and the next request gets its own reply", func(t *testing.T) {
+		timedOut := make(chan struct{})
+		lateReplied := make(chan struct{})
+
+		rabbitmqProducer := newRequestTestProducer(
+			t,
+			rabbitmqHostOption,
+			500*time.Millisecond,
+			func(p *rabbitMQProducer, delivery amqp091.Delivery, request *RequesterTestRequest) {
+				if request.Data != "late" {
+					replyToRequest(p, delivery, request)
+
+					return
+				}
+
+				// the late request is answered after its requester stopped waiting
+				<-timedOut
+				replyToRequest(p, delivery, request)
+				close(lateReplied)
+			},
+		)
+
+		_, err := rabbitmqProducer.SendRequest(context.Background(), NewRequesterTestRequest("late"), nil)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		close(timedOut)
+		select {
+		case <-lateReplied:
+		case <-time.After(10 * time.Second):
+			require.FailNow(t, "the late request was not answered")
+		}
+
+		reply, err := rabbitmqProducer.SendRequest(context.Background(), NewRequesterTestRequest("on time"), nil)
+		require.NoError(t, err)
+
+		requesterReply, ok := reply.(*RequesterTestReply)
+		require.True(t, ok)
+		assert.Equal(t, "reply to on time", requesterReply.Data)
+		assert.Zero(t, rabbitmqProducer.requests.pendingRequests.Len())
+	})
+}
+
+// requestTestResponder answers a request that was consumed from the request exchange.
+type requestTestResponder func(p *rabbitMQProducer, delivery amqp091.Delivery, request *RequesterTestRequest)
+
+// replyToRequest publishes the reply of a request to its direct reply-to address.
+func replyToRequest(p *rabbitMQProducer, delivery amqp091.Delivery, request *RequesterTestRequest) {
+	_ = p.PublishReply(
+		context.Background(),
+		delivery.ReplyTo,
+		delivery.CorrelationId,
+		NewRequesterTestReply("reply to "+request.Data),
+		nil,
+	)
+}
+
+// newRequestTestProducer creates a producer with the request timeout on its own connection and a responder that
+// consumes the requests from a queue of the request exchange, the requests are not answered for a nil responder.
+func newRequestTestProducer(
+	t *testing.T,
+	hostOptions *config.RabbitmqHostOptions,
+	requestTimeout time.Duration,
+	respond requestTestResponder,
+) *rabbitMQProducer {
+	t.Helper()
+
+	options := &config.RabbitmqOptions{
+		RabbitmqHostOptions: hostOptions,
+		ProducerOptions:     &config.RabbitmqProducerOptions{RequestTimeout: requestTimeout},
+	}
+
+	conn, err := types.NewRabbitMQConnection(options)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	messageSerializer := json.NewDefaultMessageJsonSerializer(json.NewDefaultJsonSerializer())
+
+	producer, err := NewProducerFactory(
+		options,
+		conn,
+		messageSerializer,
+		defaultLogger.GetLogger(),
+		nil,
+	).CreateProducer(nil)
+	require.NoError(t, err)
+
+	rabbitmqProducer, ok := producer.(*rabbitMQProducer)
+	require.True(t, ok)
+
+	channel, err := conn.Channel()
+	require.NoError(t, err)
+
+	request := &RequesterTestRequest{}
+	exchange := utils.GetTopicOrExchangeName(request)
+
+	// the exchange is declared like the default producer configuration declares it
+	err = channel.ExchangeDeclare(exchange, amqp091.ExchangeTopic, true, false, false, false, nil)
+	require.NoError(t, err)
+
+	queue, err := channel.QueueDeclare("", false, true, true, false, nil)
+	require.NoError(t, err)
+
+	err = channel.QueueBind(queue.Name, utils.GetRoutingKey(request), exchange, false, nil)
+	require.NoError(t, err)
+
+	deliveries, err := channel.Consume(queue.Name, "", true, true, false, false, nil)
+	require.NoError(t, err)
+
+	go func() {
+		for delivery := range deliveries {
+			message, err := messageSerializer.Deserialize(delivery.Body, delivery.Type, delivery.ContentType)
+			if err != nil || respond == nil {
+				continue
+			}
+
+			if request, ok := message.(*RequesterTestRequest); ok {
+				go respond(rabbitmqProducer, delivery, request)
+			}
+		}
+	}()
+
+	return rabbitmqProducer
+}
+
+// RequesterTestRequest is the request of the requester tests.
+type RequesterTestRequest struct {
+	*types2.Message
+	Data string
+}
+
+// NewRequesterTestRequest creates a new requester test request.
+func NewRequesterTestRequest(data string) *RequesterTestRequest {
+	return &RequesterTestRequest{
+		Data:    data,
+		Message: types2.NewMessage(uuid.NewV4().String()),
+	}
+}
+
+// GetMessageTypeName returns the type name of the message.
+func (m *RequesterTestRequest) GetMessageTypeName() string {
+	return typeMapper.GetTypeName(m)
+}
+
+// GetMessageFullTypeName returns the full type name of the message.
+func (m *RequesterTestRequest) GetMessageFullTypeName() string {
+	return typeMapper.GetFullTypeName(m)
+}
+
+// RequesterTestReply is the reply of the requester tests.
+type RequesterTestReply struct {
+	*types2.Message
+	Data string
+}
+
+// NewRequesterTestReply creates a new requester test reply.
+func NewRequesterTestReply(data string) *RequesterTestReply {
+	return &RequesterTestReply{
+		Data:    data,
+		Message: types2.NewMessage(uuid.NewV4().String()),
+	}
+}
+
+// GetMessageTypeName returns the type name of the message.
+func (m *RequesterTestReply) GetMessageTypeName() string {
+	return typeMapper.GetTypeName(m)
+}
+
+// GetMessageFullTypeName returns the full type name of the message.
+func (m *RequesterTestReply) GetMessageFullTypeName() string {
+	return typeMapper.GetFullTypeName(m)
+}
@@ -10,13 +10,12 @@ import (
 
 	bus2 "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/bus"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/producer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/requestreply"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/health/contracts"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/bus"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/config"
-	rabbitmqconsumer "github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/consumer"
-	rabbitmqproducer "github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/producer"
-	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/inmemory"
 )
 
 var (
@@ -36,16 +35,26 @@ var (
 	// - execute its func only if it requested.
 	rabbitmqProviders = fx.Options(
 		fx.Provide(config.ProvideConfig),
-		fx.Provide(types.NewRabbitMQConnection),
+		fx.Provide(newConnection),
+		fx.Provide(inmemory.NewBroker),
 		fx.Provide(fx.Annotate(
 			bus.NewRabbitmqBus,
 			fx.ParamTags(``, ``, ``, `optional:"true"`),
 			fx.As(new(producer.Producer)),
+			fx.As(new(producer.BatchProducer)),
 			fx.As(new(bus2.Bus)),
 			fx.As(new(bus.RabbitmqBus)),
+			fx.As(new(requestreply.Requester)),
+			fx.As(new(requestreply.Responder)),
+		)),
+		fx.Provide(fx.Annotate(
+			newConsumerFactory,
+			fx.ParamTags(``, ``, ``, ``, ``, `optional:"true"`),
+		)),
+		fx.Provide(fx.Annotate(
+			newProducerFactory,
+			fx.ParamTags(``, ``, ``, ``, ``, `optional:"true"`),
 		)),
-		fx.Provide(rabbitmqconsumer.NewConsumerFactory),
-		fx.Provide(rabbitmqproducer.NewProducerFactory),
 		fx.Provide(fx.Annotate(
 			NewRabbitMQHealthChecker,
 			fx.As(new(contracts.Health)),
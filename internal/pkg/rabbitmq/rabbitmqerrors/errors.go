@@ -7,3 +7,15 @@ import (
 
 // ErrDisconnected is a error that represents a disconnected from rabbitmq, trying to reconnect.
 var ErrDisconnected = errors.New("disconnected from rabbitmq, trying to reconnect")
+
+// ErrMessageNacked is a error that represents a published message that was negatively acknowledged by the broker.
+var ErrMessageNacked = errors.New("message was nacked by the rabbitmq broker")
+
+// ErrUnroutableMessage is a error that represents a mandatory message that the broker could not route to any queue.
+var ErrUnroutableMessage = errors.New("message was returned by the rabbitmq broker as unroutable")
+
+// ErrChannelClosed is a error that represents a publishing channel that was closed before the message was confirmed.
+var ErrChannelClosed = errors.New("rabbitmq channel was closed before the message was confirmed")
+
+// ErrConfirmTimeout is a error that represents a published message that was not confirmed in the confirm timeout.
+var ErrConfirmTimeout = errors.New("timed out waiting for the rabbitmq publisher confirm")
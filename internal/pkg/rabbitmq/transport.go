@@ -0,0 +1,57 @@
+// Package rabbitmq provides the selection of the rabbitmq transport.
+package rabbitmq
+
+import (
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/transform"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/config"
+	rabbitmqconsumer "github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/consumer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/consumer/consumercontracts"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/inmemory"
+	rabbitmqproducer "github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/producer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/producer/producercontracts"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/types"
+)
+
+// newConnection creates the connection of the configured transport, the in-memory transport doesn't dial a
+// rabbitmq server.
+func newConnection(rabbitmqOptions *config.RabbitmqOptions) (types.IConnection, error) {
+	if rabbitmqOptions.InMemory {
+		return inmemory.NewConnection(), nil
+	}
+
+	return types.NewRabbitMQConnection(rabbitmqOptions)
+}
+
+// newConsumerFactory creates the consumer factory of the configured transport.
+func newConsumerFactory(
+	rabbitmqOptions *config.RabbitmqOptions,
+	connection types.IConnection,
+	broker *inmemory.Broker,
+	messageSerializer serializer.MessageSerializer,
+	logger logger.Logger,
+	transforms *transform.Pipeline,
+) consumercontracts.ConsumerFactory {
+	if rabbitmqOptions.InMemory {
+		return inmemory.NewConsumerFactory(broker, connection, messageSerializer, logger, transforms)
+	}
+
+	return rabbitmqconsumer.NewConsumerFactory(rabbitmqOptions, connection, messageSerializer, logger, transforms)
+}
+
+// newProducerFactory creates the producer factory of the configured transport.
+func newProducerFactory(
+	rabbitmqOptions *config.RabbitmqOptions,
+	connection types.IConnection,
+	broker *inmemory.Broker,
+	messageSerializer serializer.MessageSerializer,
+	logger logger.Logger,
+	transforms *transform.Pipeline,
+) producercontracts.ProducerFactory {
+	if rabbitmqOptions.InMemory {
+		return inmemory.NewProducerFactory(broker, messageSerializer, logger, transforms)
+	}
+
+	return rabbitmqproducer.NewProducerFactory(rabbitmqOptions, connection, messageSerializer, logger, transforms)
+}
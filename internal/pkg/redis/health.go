@@ -28,3 +28,9 @@ func (healthChecker *RedisHealthChecker) CheckHealth(ctx context.Context) error
 func (healthChecker *RedisHealthChecker) GetHealthName() string {
 	return "redis"
 }
+
+// GetCriticality returns the criticality of the redis health checker, redis is used as a cache,
+// so the service stays ready when it is down.
+func (healthChecker *RedisHealthChecker) GetCriticality() contracts.Criticality {
+	return contracts.CriticalityNonCritical
+}
@@ -0,0 +1,208 @@
+// Package circuitbreaker provides a circuit breaker that stops calling a failing dependency for a while.
+package circuitbreaker
+
+import (
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+)
+
+// ErrOpenState is returned when the circuit is open or the half-open trial calls are exhausted.
+var ErrOpenState = errors.New("circuit breaker is open")
+
+// State is the state of the circuit.
+type State int
+
+const (
+	// StateClosed lets all the calls through.
+	StateClosed State = iota
+	// StateHalfOpen lets a limited number of trial calls through.
+	StateHalfOpen
+	// StateOpen rejects all the calls.
+	StateOpen
+)
+
+// String returns the name of the state.
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateHalfOpen:
+		return "half-open"
+	case StateOpen:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+// CircuitBreaker is a consecutive failures circuit breaker.
+type CircuitBreaker struct {
+	name          string
+	options       Options
+	isFailure     func(err error) bool
+	onStateChange func(name string, from State, to State)
+	now           func() time.Time
+
+	mu               sync.Mutex
+	state            State
+	failures         uint32
+	halfOpenRequests uint32
+	halfOpenSuccess  uint32
+	openedAt         time.Time
+	// generation changes on every state change, so the results of the calls allowed before it are ignored.
+	generation uint64
+}
+
+// NewCircuitBreaker creates a new circuit breaker.
+func NewCircuitBreaker(name string, options *Options, opts ...Option) *CircuitBreaker {
+	cb := &CircuitBreaker{
+		name:      name,
+		isFailure: func(err error) bool { return err != nil },
+		now:       time.Now,
+	}
+
+	if options != nil {
+		cb.options = *options
+	}
+
+	if cb.options.FailureThreshold == 0 {
+		cb.options.FailureThreshold = 5
+	}
+
+	if cb.options.OpenTimeout <= 0 {
+		cb.options.OpenTimeout = 30 * time.Second
+	}
+
+	if cb.options.HalfOpenMaxRequests == 0 {
+		cb.options.HalfOpenMaxRequests = 1
+	}
+
+	for _, opt := range opts {
+		opt(cb)
+	}
+
+	return cb
+}
+
+// Name returns the name of the circuit breaker.
+func (cb *CircuitBreaker) Name() string {
+	return cb.name
+}
+
+// State returns the current state of the circuit.
+func (cb *CircuitBreaker) State() State {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	return cb.currentState()
+}
+
+// Execute runs fn when the circuit allows it and records its result.
+func (cb *CircuitBreaker) Execute(fn func() error) error {
+	done, err := cb.Allow()
+	if err != nil {
+		return err
+	}
+
+	err = fn()
+	done(err)
+
+	return err
+}
+
+// Allow checks whether a call is allowed, the returned function must be called with the result of the call,
+// the result of a call that ends after the state changed is ignored.
+func (cb *CircuitBreaker) Allow() (func(err error), error) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.currentState() {
+	case StateOpen:
+		return nil, errors.WithMessagef(ErrOpenState, "circuit breaker %s", cb.name)
+	case StateHalfOpen:
+		if cb.halfOpenRequests >= cb.options.HalfOpenMaxRequests {
+			return nil, errors.WithMessagef(ErrOpenState, "circuit breaker %s", cb.name)
+		}
+
+		cb.halfOpenRequests++
+	case StateClosed:
+	}
+
+	generation := cb.generation
+
+	return func(err error) {
+		cb.done(generation, err)
+	}, nil
+}
+
+// done records the result of a call allowed in the generation.
+func (cb *CircuitBreaker) done(generation uint64, err error) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	state := cb.currentState()
+	if generation != cb.generation {
+		return
+	}
+
+	failed := err != nil && cb.isFailure(err)
+
+	switch state {
+	case StateClosed:
+		if !failed {
+			cb.failures = 0
+
+			return
+		}
+
+		cb.failures++
+		if cb.failures >= cb.options.FailureThreshold {
+			cb.setState(StateOpen)
+		}
+	case StateHalfOpen:
+		if failed {
+			cb.setState(StateOpen)
+
+			return
+		}
+
+		cb.halfOpenSuccess++
+		if cb.halfOpenSuccess >= cb.options.HalfOpenMaxRequests {
+			cb.setState(StateClosed)
+		}
+	case StateOpen:
+	}
+}
+
+// currentState returns the state, an open circuit turns half-open after the open timeout.
+func (cb *CircuitBreaker) currentState() State {
+	if cb.state == StateOpen && cb.now().Sub(cb.openedAt) >= cb.options.OpenTimeout {
+		cb.setState(StateHalfOpen)
+	}
+
+	return cb.state
+}
+
+// setState changes the state and resets the counters of the new state.
+func (cb *CircuitBreaker) setState(state State) {
+	if cb.state == state {
+		return
+	}
+
+	from := cb.state
+	cb.state = state
+	cb.generation++
+	cb.failures = 0
+	cb.halfOpenRequests = 0
+	cb.halfOpenSuccess = 0
+
+	if state == StateOpen {
+		cb.openedAt = cb.now()
+	}
+
+	if cb.onStateChange != nil {
+		cb.onStateChange(cb.name, from, state)
+	}
+}
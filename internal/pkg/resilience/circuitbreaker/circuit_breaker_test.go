@@ -0,0 +1,141 @@
+//go:build unit
+// +build unit
+
+// Package circuitbreaker provides the circuit breaker tests.
+package circuitbreaker
+
+import (
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errDependency = errors.New("dependency failed")
+
+func newTestCircuitBreaker(now *time.Time, opts ...Option) *CircuitBreaker {
+	cb := NewCircuitBreaker(
+		"test",
+		&Options{FailureThreshold: 2, OpenTimeout: time.Minute, HalfOpenMaxRequests: 1},
+		opts...,
+	)
+	cb.now = func() time.Time { return *now }
+
+	return cb
+}
+
+// TestOpensAfterConsecutiveFailures tests the circuit opens after the failure threshold and rejects the calls.
+func TestOpensAfterConsecutiveFailures(t *testing.T) {
+	now := time.Now()
+	cb := newTestCircuitBreaker(&now)
+
+	assert.ErrorIs(t, cb.Execute(func() error { return errDependency }), errDependency)
+	assert.Equal(t, StateClosed, cb.State())
+	assert.ErrorIs(t, cb.Execute(func() error { return errDependency }), errDependency)
+	assert.Equal(t, StateOpen, cb.State())
+
+	called := false
+	err := cb.Execute(func() error {
+		called = true
+
+		return nil
+	})
+
+	assert.ErrorIs(t, err, ErrOpenState)
+	assert.False(t, called)
+}
+
+// TestSuccessResetsFailures tests a success resets the consecutive failures.
+func TestSuccessResetsFailures(t *testing.T) {
+	now := time.Now()
+	cb := newTestCircuitBreaker(&now)
+
+	_ = cb.Execute(func() error { return errDependency })
+	_ = cb.Execute(func() error { return nil })
+	_ = cb.Execute(func() error { return errDependency })
+
+	assert.Equal(t, StateClosed, cb.State())
+}
+
+// TestHalfOpenClosesOnSuccess tests the circuit closes when the trial call succeeds after the open timeout.
+func TestHalfOpenClosesOnSuccess(t *testing.T) {
+	now := time.Now()
+	cb := newTestCircuitBreaker(&now)
+
+	_ = cb.Execute(func() error { return errDependency })
+	_ = cb.Execute(func() error { return errDependency })
+
+	now = now.Add(time.Minute)
+	assert.Equal(t, StateHalfOpen, cb.State())
+
+	done, err := cb.Allow()
+	require.NoError(t, err)
+
+	// only one trial call is allowed while half-open
+	_, err = cb.Allow()
+	assert.ErrorIs(t, err, ErrOpenState)
+
+	done(nil)
+	assert.Equal(t, StateClosed, cb.State())
+}
+
+// TestHalfOpenReopensOnFailure tests the circuit opens again when the trial call fails.
+func TestHalfOpenReopensOnFailure(t *testing.T) {
+	now := time.Now()
+	var transitions []State
+	cb := newTestCircuitBreaker(&now, WithOnStateChange(func(_ string, _ State, to State) {
+		transitions = append(transitions, to)
+	}))
+
+	_ = cb.Execute(func() error { return errDependency })
+	_ = cb.Execute(func() error { return errDependency })
+
+	now = now.Add(time.Minute)
+	_ = cb.Execute(func() error { return errDependency })
+
+	assert.Equal(t, StateOpen, cb.State())
+	assert.Equal(t, []State{StateOpen, StateHalfOpen, StateOpen}, transitions)
+}
+
+// TestIsFailureIgnoresErrors tests the errors that are not failures don't open the circuit.
+func TestIsFailureIgnoresErrors(t *testing.T) {
+	now := time.Now()
+	cb := newTestCircuitBreaker(&now, WithIsFailure(func(err error) bool {
+		return !errors.Is(err, errDependency)
+	}))
+
+	_ = cb.Execute(func() error { return errDependency })
+	_ = cb.Execute(func() error { return errDependency })
+
+	assert.Equal(t, StateClosed, cb.State())
+}
+
+// TestIgnoresResultsOfCallsAllowedBeforeStateChange tests a slow call allowed before the circuit changed state
+// doesn't count in the new state.
+func TestIgnoresResultsOfCallsAllowedBeforeStateChange(t *testing.T) {
+	now := time.Now()
+	cb := newTestCircuitBreaker(&now)
+
+	slowDone, err := cb.Allow()
+	require.NoError(t, err)
+
+	_ = cb.Execute(func() error { return errDependency })
+	_ = cb.Execute(func() error { return errDependency })
+	require.Equal(t, StateOpen, cb.State())
+
+	now = now.Add(time.Minute)
+	trialDone, err := cb.Allow()
+	require.NoError(t, err)
+	require.Equal(t, StateHalfOpen, cb.State())
+
+	// the slow call of the closed circuit neither closes nor reopens the half-open circuit
+	slowDone(nil)
+	assert.Equal(t, StateHalfOpen, cb.State())
+	slowDone(errDependency)
+	assert.Equal(t, StateHalfOpen, cb.State())
+
+	trialDone(nil)
+	assert.Equal(t, StateClosed, cb.State())
+}
@@ -0,0 +1,32 @@
+// Package circuitbreaker provides a circuit breaker that stops calling a failing dependency for a while.
+package circuitbreaker
+
+import "time"
+
+// Options is a struct that contains the options of the circuit breaker.
+type Options struct {
+	Enabled bool `mapstructure:"enabled" default:"true"`
+	// FailureThreshold is the number of consecutive failures that opens the circuit.
+	FailureThreshold uint32 `mapstructure:"failureThreshold" default:"5"`
+	// OpenTimeout is the duration the circuit stays open before letting trial calls through.
+	OpenTimeout time.Duration `mapstructure:"openTimeout" default:"30s"`
+	// HalfOpenMaxRequests is the number of trial calls that close the circuit when they all succeed.
+	HalfOpenMaxRequests uint32 `mapstructure:"halfOpenMaxRequests" default:"1"`
+}
+
+// Option is a function that configures the circuit breaker.
+type Option func(cb *CircuitBreaker)
+
+// WithIsFailure sets the function that decides whether an error counts as a failure, by default every error does.
+func WithIsFailure(isFailure func(err error) bool) Option {
+	return func(cb *CircuitBreaker) {
+		cb.isFailure = isFailure
+	}
+}
+
+// WithOnStateChange sets the function that is called when the state of the circuit changes.
+func WithOnStateChange(onStateChange func(name string, from State, to State)) Option {
+	return func(cb *CircuitBreaker) {
+		cb.onStateChange = onStateChange
+	}
+}
@@ -0,0 +1,195 @@
+// Package schemaregistry provides the compatibility checks of the json schemas.
+package schemaregistry
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+)
+
+// Compatibility is the compatibility mode of a new schema version with the previous versions.
+type Compatibility string
+
+const (
+	// CompatibilityNone accepts any new schema version.
+	CompatibilityNone Compatibility = "NONE"
+	// CompatibilityBackward checks the consumers of the new schema can read the messages of the previous schema.
+	CompatibilityBackward Compatibility = "BACKWARD"
+	// CompatibilityForward checks the consumers of the previous schema can read the messages of the new schema.
+	CompatibilityForward Compatibility = "FORWARD"
+	// CompatibilityFull checks both the backward and the forward compatibility.
+	CompatibilityFull Compatibility = "FULL"
+)
+
+// Incompatibility is a breaking difference between a reader and a writer schema.
+type Incompatibility struct {
+	Path   string
+	Reason string
+}
+
+// String returns the incompatibility description.
+func (i Incompatibility) String() string {
+	return fmt.Sprintf("%s: %s", i.Path, i.Reason)
+}
+
+// Check returns the incompatibilities of the new schema with the previous schema.
+func (c Compatibility) Check(newSchema *Schema, previousSchema *Schema) ([]Incompatibility, error) {
+	switch c {
+	case CompatibilityNone:
+		return nil, nil
+	case CompatibilityBackward:
+		return CheckReader(newSchema, previousSchema), nil
+	case CompatibilityForward:
+		return CheckReader(previousSchema, newSchema), nil
+	case CompatibilityFull:
+		return append(CheckReader(newSchema, previousSchema), CheckReader(previousSchema, newSchema)...), nil
+	default:
+		return nil, errors.Errorf("compatibility `%s` is not supported", c)
+	}
+}
+
+// CheckReader returns the incompatibilities of a reader schema with the messages of a writer schema. The readers
+// ignore the unknown properties, so a property is breaking only when the reader requires a property the writer may
+// omit or when the types of a property differ.
+func CheckReader(reader *Schema, writer *Schema) []Incompatibility {
+	var incompatibilities []Incompatibility
+	checkReader(reader, writer, "$", &incompatibilities)
+
+	return incompatibilities
+}
+
+// checkReader checks a reader schema with a writer schema at a path.
+func checkReader(reader *Schema, writer *Schema, path string, incompatibilities *[]Incompatibility) {
+	// an empty type accepts any value
+	if len(reader.Type) == 0 {
+		return
+	}
+
+	if len(writer.Type) == 0 {
+		*incompatibilities = append(*incompatibilities, Incompatibility{
+			Path:   path,
+			Reason: fmt.Sprintf("writer type is any value, reader type is %s", typesString(reader.Type)),
+		})
+
+		return
+	}
+
+	for _, writerType := range writer.Type {
+		if !acceptsType(reader.Type, writerType) {
+			*incompatibilities = append(*incompatibilities, Incompatibility{
+				Path: path,
+				Reason: fmt.Sprintf(
+					"writer type %s is not accepted by reader type %s",
+					typesString(writer.Type),
+					typesString(reader.Type),
+				),
+			})
+
+			return
+		}
+	}
+
+	if reader.Format != "" && reader.Format != writer.Format {
+		*incompatibilities = append(*incompatibilities, Incompatibility{
+			Path:   path,
+			Reason: fmt.Sprintf("writer format `%s` is not the reader format `%s`", writer.Format, reader.Format),
+		})
+	}
+
+	for _, required := range reader.Required {
+		if !writer.IsRequired(required) {
+			*incompatibilities = append(*incompatibilities, Incompatibility{
+				Path:   fmt.Sprintf("%s.%s", path, required),
+				Reason: "required by the reader, but optional or missing in the writer",
+			})
+		}
+	}
+
+	for _, name := range sortedProperties(reader.Properties) {
+		if writerProperty, ok := writer.Properties[name]; ok {
+			checkReader(reader.Properties[name], writerProperty, fmt.Sprintf("%s.%s", path, name), incompatibilities)
+		}
+	}
+
+	if reader.Items != nil && writer.Items != nil {
+		checkReader(reader.Items, writer.Items, path+"[]", incompatibilities)
+	}
+
+	if reader.AdditionalProperties != nil && writer.AdditionalProperties != nil {
+		checkReader(reader.AdditionalProperties, writer.AdditionalProperties, path+"{}", incompatibilities)
+	}
+}
+
+// CheckUnwritten returns the properties of a reader schema that a writer schema never writes, they are always the
+// zero values for the reader, like a renamed property.
+func CheckUnwritten(reader *Schema, writer *Schema) []Incompatibility {
+	var incompatibilities []Incompatibility
+	checkUnwritten(reader, writer, "$", &incompatibilities)
+
+	return incompatibilities
+}
+
+// checkUnwritten checks the reader properties are written by a writer schema at a path.
+func checkUnwritten(reader *Schema, writer *Schema, path string, incompatibilities *[]Incompatibility) {
+	for _, name := range sortedProperties(reader.Properties) {
+		propertyPath := fmt.Sprintf("%s.%s", path, name)
+
+		writerProperty, ok := writer.Properties[name]
+		if !ok {
+			*incompatibilities = append(*incompatibilities, Incompatibility{
+				Path:   propertyPath,
+				Reason: "read by the reader, but never written by the writer",
+			})
+
+			continue
+		}
+		checkUnwritten(reader.Properties[name], writerProperty, propertyPath, incompatibilities)
+	}
+
+	if reader.Items != nil && writer.Items != nil {
+		checkUnwritten(reader.Items, writer.Items, path+"[]", incompatibilities)
+	}
+}
+
+// acceptsType returns true if the reader types accept a writer type, the integers are also numbers.
+func acceptsType(readerTypes Types, writerType string) bool {
+	return readerTypes.Has(writerType) || (writerType == "integer" && readerTypes.Has("number"))
+}
+
+// typesString returns the description of the types.
+func typesString(types Types) string {
+	if len(types) == 0 {
+		return "any"
+	}
+
+	return strings.Join(types, "|")
+}
+
+// sortedProperties returns the sorted property names, so the incompatibilities have a stable order.
+func sortedProperties(properties map[string]*Schema) []string {
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// incompatibleSchemaError returns the ErrIncompatibleSchema error with the incompatibilities.
+func incompatibleSchemaError(subject string, version int, incompatibilities []Incompatibility) error {
+	reasons := make([]string, 0, len(incompatibilities))
+	for _, incompatibility := range incompatibilities {
+		reasons = append(reasons, incompatibility.String())
+	}
+
+	return errors.WithMessagef(
+		ErrIncompatibleSchema,
+		"`%s` with version %d: %s",
+		subject,
+		version,
+		strings.Join(reasons, "; "),
+	)
+}
@@ -0,0 +1,221 @@
+//go:build unit
+// +build unit
+
+// Package schemaregistry provides the schema generator and compatibility tests.
+package schemaregistry
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+)
+
+type productDto struct {
+	ID        uuid.UUID         `json:"id"`
+	Name      string            `json:"name"`
+	Price     float64           `json:"price"`
+	Stock     int               `json:"stock,omitempty"`
+	Tags      []string          `json:"tags"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	DeletedAt *time.Time        `json:"deletedAt,omitempty"`
+	CreatedAt time.Time         `json:"createdAt"`
+}
+
+type productCreated struct {
+	*types.Message
+	*productDto
+	Name string `json:"productName"`
+	Skip string `json:"-"`
+}
+
+func Test_GenerateSchema_Follows_The_Json_Encoding(t *testing.T) {
+	schema, err := GenerateSchemaByT[*productCreated]()
+	require.NoError(t, err)
+
+	assert.Equal(t, SchemaDraft, schema.Schema)
+	assert.Equal(t, "productCreated", schema.Title)
+	assert.Equal(t, Types{"object"}, schema.Type)
+	assert.ElementsMatch(
+		t,
+		[]string{
+			"messageId", "created", "eventType", "id", "name", "price", "stock", "tags", "labels",
+			"deletedAt", "createdAt", "productName",
+		},
+		keys(schema.Properties),
+	)
+	// the direct fields win over the fields of the embedded structs, the embedded pointers are assumed to be set
+	assert.Equal(
+		t,
+		[]string{"productName", "created", "eventType", "id", "name", "price", "tags", "createdAt"},
+		schema.Required,
+	)
+
+	assert.Equal(t, &Schema{Type: Types{"string"}, Format: "uuid"}, schema.Properties["id"])
+	assert.Equal(t, &Schema{Type: Types{"string"}, Format: "date-time"}, schema.Properties["createdAt"])
+	assert.Equal(t, &Schema{Type: Types{"string", "null"}, Format: "date-time"}, schema.Properties["deletedAt"])
+	assert.Equal(t, &Schema{Type: Types{"integer"}}, schema.Properties["stock"])
+	assert.Equal(
+		t,
+		&Schema{Type: Types{"array", "null"}, Items: &Schema{Type: Types{"string"}}},
+		schema.Properties["tags"],
+	)
+	assert.Equal(
+		t,
+		&Schema{Type: Types{"object", "null"}, AdditionalProperties: &Schema{Type: Types{"string"}}},
+		schema.Properties["labels"],
+	)
+}
+
+func Test_GenerateSchema_Requires_The_Fields_Of_An_Embedded_Struct(t *testing.T) {
+	type event struct {
+		productDto
+	}
+
+	schema, err := GenerateSchemaByT[event]()
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"id", "name", "price", "tags", "createdAt"}, schema.Required)
+}
+
+func Test_GenerateSchema_Rejects_A_Recursive_Type(t *testing.T) {
+	type category struct {
+		Parent *category `json:"parent"`
+	}
+
+	_, err := GenerateSchemaByT[category]()
+	assert.Error(t, err)
+}
+
+func Test_Schema_Survives_The_Serialization(t *testing.T) {
+	schema, err := GenerateSchemaByT[productCreated]()
+	require.NoError(t, err)
+
+	data, err := schema.Bytes()
+	require.NoError(t, err)
+
+	parsed, err := ParseSchema(data)
+	require.NoError(t, err)
+	assert.True(t, schema.Equal(parsed))
+}
+
+func Test_Backward_Compatibility_Accepts_An_Optional_Field_And_Rejects_A_Required_Field(t *testing.T) {
+	type productV1 struct {
+		Name string `json:"name"`
+	}
+	type productV2Optional struct {
+		Name  string `json:"name"`
+		Price int    `json:"price,omitempty"`
+	}
+	type productV2Required struct {
+		Name  string `json:"name"`
+		Price int    `json:"price"`
+	}
+
+	previous := mustGenerate(t, reflect.TypeOf(productV1{}))
+
+	incompatibilities, err := CompatibilityBackward.Check(
+		mustGenerate(t, reflect.TypeOf(productV2Optional{})),
+		previous,
+	)
+	require.NoError(t, err)
+	assert.Empty(t, incompatibilities)
+
+	incompatibilities, err = CompatibilityBackward.Check(
+		mustGenerate(t, reflect.TypeOf(productV2Required{})),
+		previous,
+	)
+	require.NoError(t, err)
+	require.Len(t, incompatibilities, 1)
+	assert.Equal(t, "$.price", incompatibilities[0].Path)
+}
+
+func Test_Forward_Compatibility_Rejects_A_Removed_Required_Field(t *testing.T) {
+	type productV1 struct {
+		Name  string  `json:"name"`
+		Price float64 `json:"price"`
+	}
+	type productV2 struct {
+		Name string `json:"name"`
+	}
+
+	incompatibilities, err := CompatibilityForward.Check(
+		mustGenerate(t, reflect.TypeOf(productV2{})),
+		mustGenerate(t, reflect.TypeOf(productV1{})),
+	)
+	require.NoError(t, err)
+	require.Len(t, incompatibilities, 1)
+	assert.Equal(t, "$.price", incompatibilities[0].Path)
+
+	// the removed field is ignored by the consumers of the new schema
+	incompatibilities, err = CompatibilityBackward.Check(
+		mustGenerate(t, reflect.TypeOf(productV2{})),
+		mustGenerate(t, reflect.TypeOf(productV1{})),
+	)
+	require.NoError(t, err)
+	assert.Empty(t, incompatibilities)
+}
+
+func Test_Full_Compatibility_Rejects_A_Changed_Type_But_Widens_The_Integers(t *testing.T) {
+	type priceV1 struct {
+		Items []struct {
+			Price int `json:"price"`
+		} `json:"items"`
+	}
+	type priceV2 struct {
+		Items []struct {
+			Price string `json:"price"`
+		} `json:"items"`
+	}
+	type priceV3 struct {
+		Items []struct {
+			Price float64 `json:"price"`
+		} `json:"items"`
+	}
+
+	incompatibilities, err := CompatibilityFull.Check(
+		mustGenerate(t, reflect.TypeOf(priceV2{})),
+		mustGenerate(t, reflect.TypeOf(priceV1{})),
+	)
+	require.NoError(t, err)
+	require.Len(t, incompatibilities, 2)
+	assert.Equal(t, "$.items[].price", incompatibilities[0].Path)
+
+	incompatibilities, err = CompatibilityBackward.Check(
+		mustGenerate(t, reflect.TypeOf(priceV3{})),
+		mustGenerate(t, reflect.TypeOf(priceV1{})),
+	)
+	require.NoError(t, err)
+	assert.Empty(t, incompatibilities)
+
+	incompatibilities, err = CompatibilityNone.Check(
+		mustGenerate(t, reflect.TypeOf(priceV2{})),
+		mustGenerate(t, reflect.TypeOf(priceV1{})),
+	)
+	require.NoError(t, err)
+	assert.Empty(t, incompatibilities)
+}
+
+func mustGenerate(t *testing.T, typ reflect.Type) *Schema {
+	t.Helper()
+
+	schema, err := GenerateSchema(typ)
+	require.NoError(t, err)
+
+	return schema
+}
+
+func keys(properties map[string]*Schema) []string {
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+
+	return names
+}
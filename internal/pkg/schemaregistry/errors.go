@@ -0,0 +1,15 @@
+// Package schemaregistry provides the schema registry errors.
+package schemaregistry
+
+import (
+	"emperror.dev/errors"
+)
+
+// ErrIncompatibleSchema is a error that represents a schema that breaks the compatibility with the stored schemas.
+var ErrIncompatibleSchema = errors.New("schema is incompatible with the stored schema")
+
+// ErrSchemaNotStored is a error that represents a registered schema that is missing or outdated in the directory.
+var ErrSchemaNotStored = errors.New("schema is not stored in the schema registry")
+
+// ErrInvalidPayload is a error that represents a message payload that doesn't match its stored schema.
+var ErrInvalidPayload = errors.New("payload doesn't match the stored schema")
@@ -0,0 +1,247 @@
+// Package schemaregistry provides the json schema generator of the go types.
+package schemaregistry
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+
+	googleUuid "github.com/google/uuid"
+	uuid "github.com/satori/go.uuid"
+
+	typeMapper "github.com/raphaeldiscky/go-food-micro/internal/pkg/reflection/typemapper"
+)
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	uuidTypes         = []reflect.Type{reflect.TypeOf(uuid.UUID{}), reflect.TypeOf(googleUuid.UUID{})}
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// GenerateSchemaByT generates the json schema of the type T.
+func GenerateSchemaByT[T any]() (*Schema, error) {
+	return GenerateSchema(typeMapper.GetGenericTypeByT[T]())
+}
+
+// GenerateSchema generates the json schema of the json encoding of a type, it follows the json tags and flattens
+// the embedded structs like encoding/json.
+func GenerateSchema(typ reflect.Type) (*Schema, error) {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	schema, err := newGenerator().generate(typ)
+	if err != nil {
+		return nil, errors.WrapIff(err, "error in generating the json schema of `%s`", typ.String())
+	}
+	schema.Schema = SchemaDraft
+	schema.Title = typ.Name()
+
+	return schema, nil
+}
+
+// generator generates the json schemas and detects the recursive types.
+type generator struct {
+	visiting map[reflect.Type]bool
+}
+
+// newGenerator creates a new generator.
+func newGenerator() *generator {
+	return &generator{visiting: make(map[reflect.Type]bool)}
+}
+
+// generate generates the json schema of a type.
+func (g *generator) generate(typ reflect.Type) (*Schema, error) {
+	if typ.Kind() == reflect.Ptr {
+		schema, err := g.generate(typ.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		return nullable(schema), nil
+	}
+
+	if typ == timeType {
+		return &Schema{Type: Types{"string"}, Format: "date-time"}, nil
+	}
+
+	for _, uuidType := range uuidTypes {
+		if typ == uuidType {
+			return &Schema{Type: Types{"string"}, Format: "uuid"}, nil
+		}
+	}
+
+	// a custom json encoding can't be described from the type, so it accepts any value
+	if typ.Implements(jsonMarshalerType) || reflect.PointerTo(typ).Implements(jsonMarshalerType) {
+		return &Schema{}, nil
+	}
+
+	if typ.Implements(textMarshalerType) || reflect.PointerTo(typ).Implements(textMarshalerType) {
+		return &Schema{Type: Types{"string"}}, nil
+	}
+
+	switch typ.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: Types{"boolean"}}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: Types{"integer"}}, nil
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: Types{"number"}}, nil
+	case reflect.String:
+		return &Schema{Type: Types{"string"}}, nil
+	case reflect.Interface:
+		return &Schema{}, nil
+	case reflect.Slice:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: Types{"string", "null"}, Format: "byte"}, nil
+		}
+
+		items, err := g.generate(typ.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		return &Schema{Type: Types{"array", "null"}, Items: items}, nil
+	case reflect.Array:
+		items, err := g.generate(typ.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		return &Schema{Type: Types{"array"}, Items: items}, nil
+	case reflect.Map:
+		if typ.Key().Kind() != reflect.String {
+			return nil, errors.Errorf("map key of `%s` should be a string", typ.String())
+		}
+
+		values, err := g.generate(typ.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		return &Schema{Type: Types{"object", "null"}, AdditionalProperties: values}, nil
+	case reflect.Struct:
+		return g.generateStruct(typ)
+	default:
+		return nil, errors.Errorf("type `%s` has no json encoding", typ.String())
+	}
+}
+
+// generateStruct generates the json schema of a struct.
+func (g *generator) generateStruct(typ reflect.Type) (*Schema, error) {
+	if g.visiting[typ] {
+		return nil, errors.Errorf("recursive type `%s` is not supported", typ.String())
+	}
+	g.visiting[typ] = true
+	defer delete(g.visiting, typ)
+
+	schema := &Schema{Type: Types{"object"}, Properties: make(map[string]*Schema)}
+	if err := g.addFields(schema, typ); err != nil {
+		return nil, err
+	}
+
+	return schema, nil
+}
+
+// addFields adds the json fields of a struct to the schema, the fields of the embedded structs are added after the
+// direct fields so the direct fields win like encoding/json. The embedded pointers, like the message and the dto of
+// an event, are assumed to be set.
+func (g *generator) addFields(schema *Schema, typ reflect.Type) error {
+	var embedded []reflect.StructField
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+		fieldType := field.Type
+		if field.Anonymous && name == "" {
+			for fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+
+			if fieldType.Kind() == reflect.Struct {
+				embedded = append(embedded, field)
+
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		if _, ok := schema.Properties[name]; ok {
+			continue
+		}
+
+		property, err := g.generate(field.Type)
+		if err != nil {
+			return errors.WrapIff(err, "field `%s`", field.Name)
+		}
+
+		if hasOption(options, "string") {
+			property = &Schema{Type: Types{"string"}}
+		}
+
+		schema.Properties[name] = property
+		if !hasOption(options, "omitempty") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+
+	for _, field := range embedded {
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+
+		if g.visiting[fieldType] {
+			return errors.Errorf("recursive type `%s` is not supported", fieldType.String())
+		}
+		g.visiting[fieldType] = true
+
+		err := g.addFields(schema, fieldType)
+		delete(g.visiting, fieldType)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// nullable adds the null type to a schema.
+func nullable(schema *Schema) *Schema {
+	if len(schema.Type) == 0 || schema.Type.Has("null") {
+		return schema
+	}
+	schema.Type = append(schema.Type, "null")
+
+	return schema
+}
+
+// hasOption returns true if the json tag options have the option.
+func hasOption(options string, option string) bool {
+	for _, item := range strings.Split(options, ",") {
+		if item == option {
+			return true
+		}
+	}
+
+	return false
+}
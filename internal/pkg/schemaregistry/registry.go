@@ -0,0 +1,374 @@
+// Package schemaregistry provides a file based schema registry of the integration events, the schema versions are
+// stored in the repository as `<directory>/<subject>/v<version>.json`.
+package schemaregistry
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"emperror.dev/errors"
+	"github.com/xeipuuv/gojsonschema"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/versioning"
+)
+
+// Registry is a file based registry of the json schemas of the messages.
+type Registry struct {
+	directory     string
+	compatibility Compatibility
+	mu            sync.RWMutex
+	types         map[string]reflect.Type
+	// validators are the compiled latest schemas of the subjects, a nil validator is a subject without schema
+	validators map[string]*gojsonschema.Schema
+}
+
+// NewRegistry creates a new schema registry of a directory.
+func NewRegistry(directory string, compatibility Compatibility) *Registry {
+	if compatibility == "" {
+		compatibility = CompatibilityBackward
+	}
+
+	return &Registry{
+		directory:     directory,
+		compatibility: compatibility,
+		types:         make(map[string]reflect.Type),
+		validators:    make(map[string]*gojsonschema.Schema),
+	}
+}
+
+// Subject returns the subject of a message or a message type, it is the type alias of the versioning registry or
+// the go type name.
+func Subject(obj interface{}) string {
+	if typ, ok := obj.(reflect.Type); ok {
+		obj = reflect.Zero(typ).Interface()
+	}
+
+	return SubjectOfTypeName(versioning.TypeName(obj))
+}
+
+// SubjectOfTypeName returns the subject of a message type name, like the type name header of a message.
+func SubjectOfTypeName(typeName string) string {
+	return strings.TrimPrefix(typeName, "*")
+}
+
+// Register registers the messages or the message types whose schemas are generated and checked by the registry.
+func (r *Registry) Register(objs ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, obj := range objs {
+		typ, ok := obj.(reflect.Type)
+		if !ok {
+			typ = reflect.TypeOf(obj)
+		}
+		r.types[Subject(typ)] = typ
+	}
+}
+
+// Subjects returns the sorted subjects of the registered types.
+func (r *Registry) Subjects() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	subjects := make([]string, 0, len(r.types))
+	for subject := range r.types {
+		subjects = append(subjects, subject)
+	}
+	sort.Strings(subjects)
+
+	return subjects
+}
+
+// Versions returns the sorted stored schema versions of a subject.
+func (r *Registry) Versions(subject string) ([]int, error) {
+	entries, err := os.ReadDir(filepath.Join(r.directory, subject))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, errors.WrapIff(err, "error in reading the schemas of `%s`", subject)
+	}
+
+	var versions []int
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, "v") || filepath.Ext(name) != ".json" {
+			continue
+		}
+
+		version, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(name, "v"), ".json"))
+		if err != nil {
+			continue
+		}
+		versions = append(versions, version)
+	}
+	sort.Ints(versions)
+
+	return versions, nil
+}
+
+// Schema returns a stored schema version of a subject.
+func (r *Registry) Schema(subject string, version int) (*Schema, error) {
+	data, err := os.ReadFile(r.schemaPath(subject, version))
+	if err != nil {
+		return nil, errors.WrapIff(err, "error in reading the schema version %d of `%s`", version, subject)
+	}
+
+	return ParseSchema(data)
+}
+
+// Latest returns the latest stored schema of a subject and its version, the version is zero for the subjects
+// without stored schemas.
+func (r *Registry) Latest(subject string) (*Schema, int, error) {
+	versions, err := r.Versions(subject)
+	if err != nil || len(versions) == 0 {
+		return nil, 0, err
+	}
+
+	version := versions[len(versions)-1]
+	schema, err := r.Schema(subject, version)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return schema, version, nil
+}
+
+// Check checks the generated schemas of the registered types are compatible with their latest stored schemas and
+// are stored, a changed schema should be stored with Update.
+func (r *Registry) Check() error {
+	var errs []error
+
+	for _, subject := range r.Subjects() {
+		schema, latest, version, err := r.compare(subject)
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		if latest == nil {
+			errs = append(errs, errors.WithMessagef(ErrSchemaNotStored, "`%s` has no stored schema", subject))
+
+			continue
+		}
+
+		if !schema.Equal(latest) {
+			errs = append(errs, errors.WithMessagef(
+				ErrSchemaNotStored,
+				"`%s` changed since the stored version %d",
+				subject,
+				version,
+			))
+		}
+	}
+
+	return errors.Combine(errs...)
+}
+
+// Update stores the changed schemas of the registered types as their next version, the schemas that break the
+// compatibility with their latest version are not stored.
+func (r *Registry) Update() error {
+	var errs []error
+
+	for _, subject := range r.Subjects() {
+		schema, latest, version, err := r.compare(subject)
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		if latest != nil && schema.Equal(latest) {
+			continue
+		}
+
+		if err := r.store(subject, version+1, schema); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Combine(errs...)
+}
+
+// CheckReader checks a consumer type can read the messages of all the stored schema versions of its subject, the
+// older versions may still be in the queues, and that the latest version writes all the consumer properties.
+func (r *Registry) CheckReader(obj interface{}) error {
+	typ, ok := obj.(reflect.Type)
+	if !ok {
+		typ = reflect.TypeOf(obj)
+	}
+	subject := Subject(typ)
+
+	reader, err := GenerateSchema(typ)
+	if err != nil {
+		return err
+	}
+
+	versions, err := r.Versions(subject)
+	if err != nil {
+		return err
+	}
+
+	if len(versions) == 0 {
+		return errors.WithMessagef(ErrSchemaNotStored, "`%s` has no stored schema", subject)
+	}
+
+	var errs []error
+	for _, version := range versions {
+		writer, err := r.Schema(subject, version)
+		if err != nil {
+			return err
+		}
+
+		incompatibilities := CheckReader(reader, writer)
+		if version == versions[len(versions)-1] {
+			incompatibilities = append(incompatibilities, CheckUnwritten(reader, writer)...)
+		}
+
+		if len(incompatibilities) > 0 {
+			errs = append(errs, incompatibleSchemaError(subject, version, incompatibilities))
+		}
+	}
+
+	return errors.Combine(errs...)
+}
+
+// CheckReaders checks the registered types are consumers that can read the stored schemas of their subjects, the
+// registry of a consumer has the directory of the producer schemas.
+func (r *Registry) CheckReaders() error {
+	var errs []error
+
+	for _, subject := range r.Subjects() {
+		r.mu.RLock()
+		typ := r.types[subject]
+		r.mu.RUnlock()
+
+		if err := r.CheckReader(typ); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Combine(errs...)
+}
+
+// Validate validates a json payload of a subject with its latest stored schema, the subjects without stored
+// schemas are not validated.
+func (r *Registry) Validate(subject string, data []byte) error {
+	validator, err := r.validator(subject)
+	if err != nil || validator == nil {
+		return err
+	}
+
+	result, err := validator.Validate(gojsonschema.NewBytesLoader(data))
+	if err != nil {
+		return errors.WrapIff(err, "error in validating the payload of `%s`", subject)
+	}
+
+	if result.Valid() {
+		return nil
+	}
+
+	reasons := make([]string, 0, len(result.Errors()))
+	for _, resultErr := range result.Errors() {
+		reasons = append(reasons, resultErr.String())
+	}
+
+	return errors.WithMessagef(ErrInvalidPayload, "`%s`: %s", subject, strings.Join(reasons, "; "))
+}
+
+// compare generates the schema of a registered subject and checks its compatibility with the latest stored schema.
+func (r *Registry) compare(subject string) (*Schema, *Schema, int, error) {
+	r.mu.RLock()
+	typ := r.types[subject]
+	r.mu.RUnlock()
+
+	schema, err := GenerateSchema(typ)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	latest, version, err := r.Latest(subject)
+	if err != nil || latest == nil {
+		return schema, nil, 0, err
+	}
+
+	incompatibilities, err := r.compatibility.Check(schema, latest)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	if len(incompatibilities) > 0 {
+		return nil, nil, 0, incompatibleSchemaError(subject, version, incompatibilities)
+	}
+
+	return schema, latest, version, nil
+}
+
+// store writes a schema version of a subject.
+func (r *Registry) store(subject string, version int, schema *Schema) error {
+	data, err := schema.Bytes()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Join(r.directory, subject), 0o755); err != nil {
+		return errors.WrapIff(err, "error in creating the schemas directory of `%s`", subject)
+	}
+
+	if err := os.WriteFile(r.schemaPath(subject, version), data, 0o644); err != nil { //nolint:gosec
+		return errors.WrapIff(err, "error in writing the schema version %d of `%s`", version, subject)
+	}
+
+	r.mu.Lock()
+	delete(r.validators, subject)
+	r.mu.Unlock()
+
+	return nil
+}
+
+// validator returns the compiled latest schema of a subject.
+func (r *Registry) validator(subject string) (*gojsonschema.Schema, error) {
+	r.mu.RLock()
+	validator, ok := r.validators[subject]
+	r.mu.RUnlock()
+
+	if ok {
+		return validator, nil
+	}
+
+	latest, _, err := r.Latest(subject)
+	if err != nil {
+		return nil, err
+	}
+
+	if latest != nil {
+		data, err := latest.Bytes()
+		if err != nil {
+			return nil, err
+		}
+
+		validator, err = gojsonschema.NewSchema(gojsonschema.NewBytesLoader(data))
+		if err != nil {
+			return nil, errors.WrapIff(err, "error in compiling the schema of `%s`", subject)
+		}
+	}
+
+	r.mu.Lock()
+	r.validators[subject] = validator
+	r.mu.Unlock()
+
+	return validator, nil
+}
+
+// schemaPath returns the file path of a schema version.
+func (r *Registry) schemaPath(subject string, version int) string {
+	return filepath.Join(r.directory, subject, fmt.Sprintf("v%d.json", version))
+}
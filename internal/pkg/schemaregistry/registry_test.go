@@ -0,0 +1,224 @@
+//go:build unit
+// +build unit
+
+// Package schemaregistry provides the schema registry tests.
+package schemaregistry
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/json"
+	typeMapper "github.com/raphaeldiscky/go-food-micro/internal/pkg/reflection/typemapper"
+)
+
+// orderPlacedV1 is the first version of the order placed event.
+type orderPlacedV1 struct {
+	*types.Message
+	OrderID string  `json:"orderId"`
+	Total   float64 `json:"total"`
+}
+
+// orderPlacedV2 adds an optional currency, it is registered with the same subject as the first version.
+type orderPlacedV2 struct {
+	*types.Message
+	OrderID  string  `json:"orderId"`
+	Total    float64 `json:"total"`
+	Currency string  `json:"currency,omitempty"`
+}
+
+// orderPlacedBreaking requires a new customer id.
+type orderPlacedBreaking struct {
+	*types.Message
+	OrderID    string  `json:"orderId"`
+	Total      float64 `json:"total"`
+	CustomerID string  `json:"customerId"`
+}
+
+// orderPlacedConsumer is the order placed event of a consumer, it requires the order id.
+type orderPlacedConsumer struct {
+	*types.Message
+	OrderID string `json:"orderId"`
+}
+
+func Test_Update_Stores_The_Schema_Versions_And_Check_Detects_The_Changes(t *testing.T) {
+	directory := t.TempDir()
+
+	registry := NewRegistry(directory, CompatibilityBackward)
+	registry.Register(&orderPlacedV1{})
+
+	assert.True(t, errors.Is(registry.Check(), ErrSchemaNotStored))
+	require.NoError(t, registry.Update())
+	require.NoError(t, registry.Check())
+	assert.FileExists(t, filepath.Join(directory, "orderPlacedV1", "v1.json"))
+
+	// an unchanged schema is not stored again
+	require.NoError(t, registry.Update())
+	versions, err := registry.Versions("orderPlacedV1")
+	require.NoError(t, err)
+	assert.Equal(t, []int{1}, versions)
+
+	// a compatible change is stored as the next version
+	renameSubject(t, directory, "orderPlacedV1", "orderPlacedV2")
+	registry = NewRegistry(directory, CompatibilityBackward)
+	registry.Register(reflect.TypeOf(&orderPlacedV2{}))
+
+	assert.True(t, errors.Is(registry.Check(), ErrSchemaNotStored))
+	require.NoError(t, registry.Update())
+	require.NoError(t, registry.Check())
+
+	latest, version, err := registry.Latest("orderPlacedV2")
+	require.NoError(t, err)
+	assert.Equal(t, 2, version)
+	assert.Contains(t, latest.Properties, "currency")
+}
+
+func Test_Update_Rejects_An_Incompatible_Schema(t *testing.T) {
+	directory := t.TempDir()
+
+	registry := NewRegistry(directory, CompatibilityBackward)
+	registry.Register(&orderPlacedV1{})
+	require.NoError(t, registry.Update())
+
+	renameSubject(t, directory, "orderPlacedV1", "orderPlacedBreaking")
+	registry = NewRegistry(directory, CompatibilityBackward)
+	registry.Register(&orderPlacedBreaking{})
+
+	err := registry.Update()
+	assert.True(t, errors.Is(err, ErrIncompatibleSchema))
+	assert.Contains(t, err.Error(), "$.customerId")
+	assert.True(t, errors.Is(registry.Check(), ErrIncompatibleSchema))
+
+	versions, err := registry.Versions("orderPlacedBreaking")
+	require.NoError(t, err)
+	assert.Equal(t, []int{1}, versions)
+}
+
+func Test_CheckReader_Checks_All_The_Stored_Versions(t *testing.T) {
+	directory := t.TempDir()
+
+	registry := NewRegistry(directory, CompatibilityNone)
+	registry.Register(&orderPlacedConsumer{})
+	assert.True(t, errors.Is(registry.CheckReader(&orderPlacedConsumer{}), ErrSchemaNotStored))
+
+	// the first version of the producer has the order id, the second one made it optional
+	writeSchema(t, directory, "orderPlacedConsumer", 1, reflect.TypeOf(orderPlacedV1{}))
+	require.NoError(t, registry.CheckReader(&orderPlacedConsumer{}))
+
+	type orderPlacedOptionalID struct {
+		OrderID string `json:"orderId,omitempty"`
+	}
+	writeSchema(t, directory, "orderPlacedConsumer", 2, reflect.TypeOf(orderPlacedOptionalID{}))
+
+	err := registry.CheckReader(reflect.TypeOf(&orderPlacedConsumer{}))
+	assert.True(t, errors.Is(err, ErrIncompatibleSchema))
+	assert.Contains(t, err.Error(), "version 2")
+	assert.True(t, errors.Is(registry.CheckReaders(), ErrIncompatibleSchema))
+}
+
+func Test_CheckReader_Rejects_A_Property_The_Latest_Version_Never_Writes(t *testing.T) {
+	type orderPlacedRenamedID struct {
+		ID    string  `json:"id"`
+		Total float64 `json:"total"`
+	}
+
+	directory := t.TempDir()
+	writeSchema(t, directory, "orderPlacedConsumer", 1, reflect.TypeOf(orderPlacedV1{}))
+	writeSchema(t, directory, "orderPlacedConsumer", 2, reflect.TypeOf(orderPlacedRenamedID{}))
+
+	err := NewRegistry(directory, CompatibilityNone).CheckReader(&orderPlacedConsumer{})
+	assert.True(t, errors.Is(err, ErrIncompatibleSchema))
+	assert.Contains(t, err.Error(), "$.orderId: read by the reader, but never written by the writer")
+}
+
+func Test_ValidatingMessageSerializer_Validates_The_Json_Payloads(t *testing.T) {
+	directory := t.TempDir()
+
+	registry := NewRegistry(directory, CompatibilityBackward)
+	registry.Register(&orderPlacedV1{})
+	require.NoError(t, registry.Update())
+
+	typeMapper.RegisterType(reflect.TypeOf(&orderPlacedV1{}))
+	messageSerializer := NewValidatingMessageSerializer(
+		json.NewDefaultMessageJsonSerializer(json.NewDefaultJsonSerializer()),
+		registry,
+		true,
+		true,
+	)
+
+	message := &orderPlacedV1{
+		Message: types.NewMessage(uuid.NewV4().String()),
+		OrderID: uuid.NewV4().String(),
+		Total:   10,
+	}
+	result, err := messageSerializer.Serialize(message)
+	require.NoError(t, err)
+
+	_, err = messageSerializer.DeserializeType(result.Data, reflect.TypeOf(message), json.ContentType)
+	require.NoError(t, err)
+
+	_, err = messageSerializer.DeserializeType(
+		[]byte(`{"orderId":"1","total":"ten"}`),
+		reflect.TypeOf(message),
+		json.ContentType,
+	)
+	assert.True(t, errors.Is(err, ErrInvalidPayload))
+	assert.Contains(t, err.Error(), "total")
+
+	_, err = messageSerializer.DeserializeObject([]byte(`{"total":10}`), "*orderPlacedV1", "")
+	assert.True(t, errors.Is(err, ErrInvalidPayload))
+
+	// the subjects without a stored schema are not validated
+	type untracked struct {
+		*types.Message
+		Total float64 `json:"total"`
+	}
+	_, err = messageSerializer.Serialize(&untracked{Message: types.NewMessage(uuid.NewV4().String()), Total: 1})
+	assert.NoError(t, err)
+}
+
+func Test_Registry_Validate_Checks_The_Formats(t *testing.T) {
+	type paymentReceived struct {
+		PaymentID uuid.UUID `json:"paymentId"`
+		PaidAt    time.Time `json:"paidAt"`
+	}
+
+	directory := t.TempDir()
+	writeSchema(t, directory, "paymentReceived", 1, reflect.TypeOf(paymentReceived{}))
+	registry := NewRegistry(directory, CompatibilityBackward)
+
+	assert.NoError(t, registry.Validate(
+		"paymentReceived",
+		[]byte(`{"paymentId":"5f1b2d4c-8f0e-4a57-9a9e-8b1c2c3d4e5f","paidAt":"2024-01-01T00:00:00Z"}`),
+	))
+	assert.True(t, errors.Is(
+		registry.Validate("paymentReceived", []byte(`{"paymentId":"1","paidAt":"yesterday"}`)),
+		ErrInvalidPayload,
+	))
+}
+
+func renameSubject(t *testing.T, directory string, subject string, newSubject string) {
+	t.Helper()
+
+	require.NoError(t, os.Rename(filepath.Join(directory, subject), filepath.Join(directory, newSubject)))
+}
+
+func writeSchema(t *testing.T, directory string, subject string, version int, typ reflect.Type) {
+	t.Helper()
+
+	schema, err := GenerateSchema(typ)
+	require.NoError(t, err)
+
+	registry := NewRegistry(directory, CompatibilityNone)
+	require.NoError(t, registry.store(subject, version, schema))
+}
@@ -0,0 +1,111 @@
+// Package schemaregistry provides the json schema of the messages.
+package schemaregistry
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"emperror.dev/errors"
+)
+
+// SchemaDraft is the json schema draft of the generated schemas.
+const SchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+// Types is the list of the json types of a schema, it is serialized as a single type when it has one type.
+type Types []string
+
+// MarshalJSON marshals the types.
+func (t Types) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+
+	return json.Marshal([]string(t))
+}
+
+// UnmarshalJSON unmarshals a single type or a list of types.
+func (t *Types) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = Types{single}
+
+		return nil
+	}
+
+	var types []string
+	if err := json.Unmarshal(data, &types); err != nil {
+		return errors.WrapIf(err, "schema type should be a string or a list of strings")
+	}
+	*t = types
+
+	return nil
+}
+
+// Has returns true if the types contain the json type.
+func (t Types) Has(typ string) bool {
+	for _, item := range t {
+		if item == typ {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Schema is the subset of the json schema that is generated for the messages.
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Type                 Types              `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+}
+
+// ParseSchema parses a json schema.
+func ParseSchema(data []byte) (*Schema, error) {
+	schema := &Schema{}
+	if err := json.Unmarshal(data, schema); err != nil {
+		return nil, errors.WrapIf(err, "error in parsing the json schema")
+	}
+
+	return schema, nil
+}
+
+// Bytes returns the indented json of the schema, the properties are sorted so equal schemas have equal bytes.
+func (s *Schema) Bytes() ([]byte, error) {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return nil, errors.WrapIf(err, "error in marshaling the json schema")
+	}
+
+	return append(data, '\n'), nil
+}
+
+// Equal returns true if the schemas are the same.
+func (s *Schema) Equal(other *Schema) bool {
+	left, err := s.Bytes()
+	if err != nil {
+		return false
+	}
+
+	right, err := other.Bytes()
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(left, right)
+}
+
+// IsRequired returns true if the property is required.
+func (s *Schema) IsRequired(property string) bool {
+	for _, required := range s.Required {
+		if required == property {
+			return true
+		}
+	}
+
+	return false
+}
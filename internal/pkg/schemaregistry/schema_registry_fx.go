@@ -0,0 +1,16 @@
+// Package schemaregistry provides the schema registry fx module.
+package schemaregistry
+
+import (
+	"go.uber.org/fx"
+)
+
+// Module provided to fxlog, the core module validates the message payloads with the registry when a validation is
+// enabled in the options.
+var Module = fx.Module(
+	"schemaregistryfx",
+	fx.Provide(
+		ProvideConfig,
+		NewRegistryFromOptions,
+	),
+)
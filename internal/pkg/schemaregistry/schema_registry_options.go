@@ -0,0 +1,48 @@
+// Package schemaregistry provides the schema registry options.
+package schemaregistry
+
+import (
+	"path/filepath"
+
+	"github.com/iancoleman/strcase"
+	"github.com/spf13/viper"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/config"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/config/environment"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/constants"
+	typeMapper "github.com/raphaeldiscky/go-food-micro/internal/pkg/reflection/typemapper"
+)
+
+// optionName is the name of the option for the schema registry.
+var optionName = strcase.ToLowerCamel(typeMapper.GetGenericTypeNameByT[SchemaRegistryOptions]())
+
+// SchemaRegistryOptions is a struct that contains the options for the schema registry.
+type SchemaRegistryOptions struct {
+	// Directory is the directory of the stored schemas, a relative directory is relative to the project root.
+	Directory string `mapstructure:"directory"`
+	// Compatibility is the compatibility mode of the new schema versions.
+	Compatibility Compatibility `mapstructure:"compatibility"     default:"BACKWARD"`
+	// ValidateOnPublish validates the published json payloads with their stored schemas.
+	ValidateOnPublish bool `mapstructure:"validateOnPublish"`
+	// ValidateOnConsume validates the consumed json payloads with their stored schemas.
+	ValidateOnConsume bool `mapstructure:"validateOnConsume"`
+}
+
+// ProvideConfig provides the config for the schema registry.
+func ProvideConfig(environment environment.Environment) (*SchemaRegistryOptions, error) {
+	return config.BindConfigKey[*SchemaRegistryOptions](optionName, environment)
+}
+
+// NewRegistryFromOptions creates a new schema registry of the configured directory.
+func NewRegistryFromOptions(options *SchemaRegistryOptions) *Registry {
+	directory := options.Directory
+	if !filepath.IsAbs(directory) {
+		rootPath := viper.GetString(constants.AppRootPath)
+		if rootPath == "" {
+			rootPath = environment.GetProjectRootWorkingDirectory()
+		}
+		directory = filepath.Join(rootPath, directory)
+	}
+
+	return NewRegistry(directory, options.Compatibility)
+}
@@ -0,0 +1,128 @@
+// Package schemaregistry provides a message serializer that validates the json payloads with the stored schemas.
+package schemaregistry
+
+import (
+	"reflect"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/json"
+)
+
+// ValidatingMessageSerializer is a message serializer that validates the serialized and the deserialized json
+// payloads with their latest stored schemas, the other content types have their own contracts.
+type ValidatingMessageSerializer struct {
+	serializer.MessageSerializer
+	registry            *Registry
+	validateSerialize   bool
+	validateDeserialize bool
+}
+
+// NewValidatingMessageSerializer creates a new validating message serializer.
+func NewValidatingMessageSerializer(
+	messageSerializer serializer.MessageSerializer,
+	registry *Registry,
+	validateSerialize bool,
+	validateDeserialize bool,
+) *ValidatingMessageSerializer {
+	return &ValidatingMessageSerializer{
+		MessageSerializer:   messageSerializer,
+		registry:            registry,
+		validateSerialize:   validateSerialize,
+		validateDeserialize: validateDeserialize,
+	}
+}
+
+// Serialize serializes a message and validates its payload.
+func (s *ValidatingMessageSerializer) Serialize(message types.IMessage) (*serializer.EventSerializationResult, error) {
+	result, err := s.MessageSerializer.Serialize(message)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateSerialized(message, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// SerializeObject serializes an object and validates its payload.
+func (s *ValidatingMessageSerializer) SerializeObject(
+	message interface{},
+) (*serializer.EventSerializationResult, error) {
+	result, err := s.MessageSerializer.SerializeObject(message)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateSerialized(message, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Deserialize validates the payload of a message type and deserializes it.
+func (s *ValidatingMessageSerializer) Deserialize(
+	data []byte,
+	messageType string,
+	contentType string,
+) (types.IMessage, error) {
+	if err := s.validateDeserialized(SubjectOfTypeName(messageType), data, contentType); err != nil {
+		return nil, err
+	}
+
+	return s.MessageSerializer.Deserialize(data, messageType, contentType)
+}
+
+// DeserializeObject validates the payload of a message type and deserializes it.
+func (s *ValidatingMessageSerializer) DeserializeObject(
+	data []byte,
+	messageType string,
+	contentType string,
+) (interface{}, error) {
+	if err := s.validateDeserialized(SubjectOfTypeName(messageType), data, contentType); err != nil {
+		return nil, err
+	}
+
+	return s.MessageSerializer.DeserializeObject(data, messageType, contentType)
+}
+
+// DeserializeType validates the payload of a message type and deserializes it.
+func (s *ValidatingMessageSerializer) DeserializeType(
+	data []byte,
+	messageType reflect.Type,
+	contentType string,
+) (types.IMessage, error) {
+	if err := s.validateDeserialized(Subject(messageType), data, contentType); err != nil {
+		return nil, err
+	}
+
+	return s.MessageSerializer.DeserializeType(data, messageType, contentType)
+}
+
+// validateSerialized validates a serialized json payload.
+func (s *ValidatingMessageSerializer) validateSerialized(
+	message interface{},
+	result *serializer.EventSerializationResult,
+) error {
+	if !s.validateSerialize || result.ContentType != json.ContentType {
+		return nil
+	}
+
+	return s.registry.Validate(Subject(message), result.Data)
+}
+
+// validateDeserialized validates a consumed json payload, a message without a content type has the default one.
+func (s *ValidatingMessageSerializer) validateDeserialized(subject string, data []byte, contentType string) error {
+	if contentType == "" {
+		contentType = s.ContentType()
+	}
+
+	if !s.validateDeserialize || contentType != json.ContentType {
+		return nil
+	}
+
+	return s.registry.Validate(subject, data)
+}
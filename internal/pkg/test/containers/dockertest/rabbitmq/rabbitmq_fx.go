@@ -14,6 +14,11 @@ var RabbitmqDockerTestContainerOptionsDecorator = func(t *testing.T, ctx context
 	t.Helper()
 
 	return func(c *config.RabbitmqOptions, logger logger.Logger) (*config.RabbitmqOptions, error) {
+		// the in-memory bus doesn't need a rabbitmq container
+		if c.InMemory {
+			return c, nil
+		}
+
 		rabbitmqHostOptions, err := NewRabbitMQDockerTest(logger).PopulateContainerOptions(ctx, t)
 		c.RabbitmqHostOptions = rabbitmqHostOptions
 
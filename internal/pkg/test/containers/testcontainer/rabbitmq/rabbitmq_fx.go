@@ -14,6 +14,28 @@ var RabbitmqContainerOptionsDecorator = func(t *testing.T, ctx context.Context)
 	t.Helper()
 
 	return func(c *config.RabbitmqOptions, logger logger.Logger) (*config.RabbitmqOptions, error) {
+		// the in-memory bus doesn't need a rabbitmq container
+		if c.InMemory {
+			return c, nil
+		}
+
+		rabbitmqHostOptions, err := NewRabbitMQTestContainers(
+			logger,
+		).PopulateContainerOptions(ctx, t)
+		c.RabbitmqHostOptions = rabbitmqHostOptions
+
+		return c, err
+	}
+}
+
+// RabbitmqServerContainerOptionsDecorator is a decorator for the rabbitmq container options that runs the bus on a
+// rabbitmq container, even when the configuration enables the in-memory bus.
+var RabbitmqServerContainerOptionsDecorator = func(t *testing.T, ctx context.Context) interface{} {
+	t.Helper()
+
+	return func(c *config.RabbitmqOptions, logger logger.Logger) (*config.RabbitmqOptions, error) {
+		c.InMemory = false
+
 		rabbitmqHostOptions, err := NewRabbitMQTestContainers(
 			logger,
 		).PopulateContainerOptions(ctx, t)
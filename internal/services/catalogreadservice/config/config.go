@@ -4,13 +4,17 @@ package config
 import (
 	"strings"
 
+	"emperror.dev/errors"
+
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/config"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/config/environment"
 )
 
 // Config is a struct that contains the config for the catalog read service.
 type Config struct {
-	AppOptions AppOptions `mapstructure:"appOptions" env:"AppOptions"`
+	AppOptions       AppOptions       `mapstructure:"appOptions"       env:"AppOptions"`
+	ReadModelOptions ReadModelOptions `mapstructure:"readModelOptions" env:"ReadModelOptions"`
+	ElasticIndexes   ElasticIndexes   `mapstructure:"elasticIndexes"   env:"ElasticIndexes"`
 }
 
 // NewConfig creates a new Config.
@@ -20,6 +24,10 @@ func NewConfig(env environment.Environment) (*Config, error) {
 		return nil, err
 	}
 
+	if err := cfg.ReadModelOptions.Validate(); err != nil {
+		return nil, err
+	}
+
 	return cfg, nil
 }
 
@@ -38,3 +46,44 @@ func (cfg *AppOptions) GetMicroserviceNameUpper() string {
 func (cfg *AppOptions) GetMicroserviceName() string {
 	return cfg.ServiceName
 }
+
+const (
+	// MongoSearchStore serves the products search from the mongo read model.
+	MongoSearchStore = "mongo"
+	// ElasticSearchStore serves the products search from the elastic read model.
+	ElasticSearchStore = "elastic"
+)
+
+// ReadModelOptions is a struct that contains the products read model options.
+type ReadModelOptions struct {
+	// SearchStore is the read model store serving the products search, `mongo` or `elastic`.
+	SearchStore string `mapstructure:"searchStore"       env:"SearchStore"`
+	// ElasticProjection enables projecting the products into the elastic read model.
+	ElasticProjection bool `mapstructure:"elasticProjection" env:"ElasticProjection"`
+}
+
+// UseElasticSearch returns true if the products search is served by the elastic read model.
+func (cfg *ReadModelOptions) UseElasticSearch() bool {
+	return strings.EqualFold(cfg.SearchStore, ElasticSearchStore)
+}
+
+// Validate validates the read model options.
+func (cfg *ReadModelOptions) Validate() error {
+	switch strings.ToLower(cfg.SearchStore) {
+	case "", MongoSearchStore:
+		return nil
+	case ElasticSearchStore:
+		if !cfg.ElasticProjection {
+			return errors.New("elastic search store requires the elastic projection to be enabled")
+		}
+
+		return nil
+	default:
+		return errors.Errorf("unknown search store %s", cfg.SearchStore)
+	}
+}
+
+// ElasticIndexes is a struct that contains the elastic index names.
+type ElasticIndexes struct {
+	Products string `mapstructure:"products" env:"Products"`
+}
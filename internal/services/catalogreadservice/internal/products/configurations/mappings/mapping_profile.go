@@ -20,5 +20,27 @@ func ConfigureProductsMappings() error {
 		return err
 	}
 
+	err = mapper.CreateCustomMap[*models.ProductSearchHit, *dto.ProductSearchHitDto](
+		func(hit *models.ProductSearchHit) (*dto.ProductSearchHitDto, error) {
+			if hit == nil {
+				return nil, nil
+			}
+
+			productDto, err := mapper.Map[*dto.ProductDto](&hit.Product)
+			if err != nil {
+				return nil, err
+			}
+
+			return &dto.ProductSearchHitDto{
+				ProductDto: *productDto,
+				Score:      hit.Score,
+				Highlights: hit.Highlights,
+			}, nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
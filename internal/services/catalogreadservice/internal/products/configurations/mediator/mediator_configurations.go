@@ -25,6 +25,7 @@ import (
 func ConfigProductsMediator(
 	log logger.Logger,
 	mongoProductRepository data.ProductRepository,
+	searchProductRepository data.ProductRepository,
 	cacheProductRepository data.ProductCacheRepository,
 	tracer tracing.AppTracer,
 ) error {
@@ -79,7 +80,7 @@ func ConfigProductsMediator(
 	err = mediatr.RegisterRequestHandler[*searchProductsQueryV1.SearchProducts, *searchProductsDtosV1.SearchProductsResponseDto](
 		searchProductsQueryV1.NewSearchProductsHandler(
 			log,
-			mongoProductRepository,
+			searchProductRepository,
 			tracer,
 		),
 	)
@@ -8,6 +8,7 @@ import (
 
 	logger2 "github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
 
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/config"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/configurations/mappings"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/configurations/mediator"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/contracts/data"
@@ -30,11 +31,25 @@ func NewProductsModuleConfigurator(
 // ConfigureProductsModule is a method that configures the products module.
 func (c *ProductsModuleConfigurator) ConfigureProductsModule() {
 	c.ResolveFunc(
-		func(logger logger2.Logger, mongoRepository data.ProductRepository, cacheRepository data.ProductCacheRepository, tracer tracing.AppTracer) error {
+		func(
+			logger logger2.Logger,
+			cfg *config.Config,
+			mongoRepository data.ProductRepository,
+			elasticRepository data.ProductElasticRepository,
+			cacheRepository data.ProductCacheRepository,
+			tracer tracing.AppTracer,
+		) error {
+			// the mongo read model is always the primary store, the search can be served by the elastic read model
+			var searchRepository data.ProductRepository = mongoRepository
+			if cfg.ReadModelOptions.UseElasticSearch() {
+				searchRepository = elasticRepository
+			}
+
 			// config Products Mediators
 			err := mediator.ConfigProductsMediator(
 				logger,
 				mongoRepository,
+				searchRepository,
 				cacheRepository,
 				tracer,
 			)
@@ -2,6 +2,10 @@
 package rabbitmq
 
 import (
+	"context"
+	"fmt"
+	"reflect"
+
 	"github.com/go-playground/validator"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/consumer"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
@@ -12,9 +16,22 @@ import (
 	rabbitmqConfigurations "github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/configurations"
 	consumerConfigurations "github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/consumer/configurations"
 
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/config"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/contracts/data"
 	createProductExternalEventV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/creatingproduct/v1/events/integrationevents/externalevents"
 	deleteProductExternalEventV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/deletingproducts/v1/events/integrationevents/externalevents"
+	purgeProductExternalEventV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/purgingproducts/v1/events/integrationevents/externalevents"
+	restoreProductExternalEventV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/restoringproducts/v1/events/integrationevents/externalevents"
 	updateProductExternalEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/updatingproducts/v1/events/integrationevents/externalevents"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/projections"
+)
+
+const (
+	// productEventsQueueName is the queue of all the product events
+	productEventsQueueName  = "product_events"
+	elasticProjectionSuffix = "elastic_projection"
+	// productConsumersConcurrencyLimit is the number of the products whose events are handled concurrently
+	productConsumersConcurrencyLimit = 4
 )
 
 // ConfigProductsRabbitMQ configures the rabbitmq for the products.
@@ -23,71 +40,121 @@ func ConfigProductsRabbitMQ(
 	log logger.Logger,
 	val *validator.Validate,
 	tracer tracing.AppTracer,
+	readModelOptions *config.ReadModelOptions,
+	elasticRepository data.ProductElasticRepository,
 ) {
 	// Create message instances
 	productCreatedMsg := &createProductExternalEventV1.ProductCreatedV1{}
 	productDeletedMsg := &deleteProductExternalEventV1.ProductDeletedV1{}
 	productUpdatedMsg := &updateProductExternalEventsV1.ProductUpdatedV1{}
+	productRestoredMsg := &restoreProductExternalEventV1.ProductRestoredV1{}
+	productPurgedMsg := &purgeProductExternalEventV1.ProductPurgedV1{}
 
 	// Register message types using the standard utility function
 	messageTypesMap := map[string]types.IMessage{
-		productCreatedMsg.GetMessageTypeName(): productCreatedMsg,
-		productDeletedMsg.GetMessageTypeName(): productDeletedMsg,
-		productUpdatedMsg.GetMessageTypeName(): productUpdatedMsg,
+		productCreatedMsg.GetMessageTypeName():  productCreatedMsg,
+		productDeletedMsg.GetMessageTypeName():  productDeletedMsg,
+		productUpdatedMsg.GetMessageTypeName():  productUpdatedMsg,
+		productRestoredMsg.GetMessageTypeName(): productRestoredMsg,
+		productPurgedMsg.GetMessageTypeName():   productPurgedMsg,
 	}
 
 	utils.RegisterCustomMessageTypesToRegistry(messageTypesMap)
 
 	log.Infow("Registered message types for products using standard utility", logger.Fields{
-		"productCreated": productCreatedMsg.GetMessageTypeName(),
-		"productDeleted": productDeletedMsg.GetMessageTypeName(),
-		"productUpdated": productUpdatedMsg.GetMessageTypeName(),
+		"productCreated":  productCreatedMsg.GetMessageTypeName(),
+		"productDeleted":  productDeletedMsg.GetMessageTypeName(),
+		"productUpdated":  productUpdatedMsg.GetMessageTypeName(),
+		"productRestored": productRestoredMsg.GetMessageTypeName(),
+		"productPurged":   productPurgedMsg.GetMessageTypeName(),
 	})
 
-	builder.
-		AddConsumer(
-			productCreatedMsg,
-			func(builder consumerConfigurations.RabbitMQConsumerConfigurationBuilder) {
-				builder.WithHandlers(
-					func(handlersBuilder consumer.ConsumerHandlerConfigurationBuilder) {
-						handlersBuilder.AddHandler(
-							createProductExternalEventV1.NewProductCreatedConsumer(
-								log,
-								val,
-								tracer,
-							),
-						)
-					},
-				)
-			}).
-		AddConsumer(
-			productDeletedMsg,
-			func(builder consumerConfigurations.RabbitMQConsumerConfigurationBuilder) {
-				builder.WithHandlers(
+	// all the product events share one queue, so the events of a product are handled in their order across the
+	// event types
+	productEventsHandler := productEventsHandler{
+		utils.GetMessageBaseReflectType(productCreatedMsg): createProductExternalEventV1.NewProductCreatedConsumer(
+			log,
+			val,
+			tracer,
+		),
+		utils.GetMessageBaseReflectType(productUpdatedMsg): updateProductExternalEventsV1.NewProductUpdatedConsumer(
+			log,
+			val,
+			tracer,
+		),
+		utils.GetMessageBaseReflectType(productDeletedMsg): deleteProductExternalEventV1.NewProductDeletedConsumer(
+			log,
+			val,
+			tracer,
+		),
+		utils.GetMessageBaseReflectType(productRestoredMsg): restoreProductExternalEventV1.NewProductRestoredConsumer(
+			log,
+			val,
+			tracer,
+		),
+		utils.GetMessageBaseReflectType(productPurgedMsg): purgeProductExternalEventV1.NewProductPurgedConsumer(
+			log,
+			val,
+			tracer,
+		),
+	}
+	builder.AddConsumer(
+		productCreatedMsg,
+		func(builder consumerConfigurations.RabbitMQConsumerConfigurationBuilder) {
+			orderedByProduct(builder, productUpdatedMsg, productDeletedMsg, productRestoredMsg, productPurgedMsg).
+				WithName(productEventsQueueName).
+				WithQueueName(productEventsQueueName).
+				WithHandlers(
 					func(handlersBuilder consumer.ConsumerHandlerConfigurationBuilder) {
-						handlersBuilder.AddHandler(
-							deleteProductExternalEventV1.NewProductDeletedConsumer(
-								log,
-								val,
-								tracer,
-							),
-						)
+						handlersBuilder.AddHandler(productEventsHandler)
 					},
 				)
-			}).
-		AddConsumer(
-			productUpdatedMsg,
-			func(builder consumerConfigurations.RabbitMQConsumerConfigurationBuilder) {
-				builder.WithHandlers(
+		})
+
+	if !readModelOptions.ElasticProjection {
+		return
+	}
+
+	// second pipeline with its own queue, so the elastic read model is projected independently of the mongo read model
+	elasticProjection := projections.NewElasticProductProjection(elasticRepository, val, log, tracer)
+	builder.AddConsumer(
+		productCreatedMsg,
+		func(builder consumerConfigurations.RabbitMQConsumerConfigurationBuilder) {
+			orderedByProduct(builder, productUpdatedMsg, productDeletedMsg, productRestoredMsg, productPurgedMsg).
+				WithName(fmt.Sprintf("%s_%s", productEventsQueueName, elasticProjectionSuffix)).
+				WithQueueName(fmt.Sprintf("%s_%s", productEventsQueueName, elasticProjectionSuffix)).
+				WithHandlers(
 					func(handlersBuilder consumer.ConsumerHandlerConfigurationBuilder) {
-						handlersBuilder.AddHandler(
-							updateProductExternalEventsV1.NewProductUpdatedConsumer(
-								log,
-								val,
-								tracer,
-							),
-						)
+						handlersBuilder.AddHandler(elasticProjection)
 					},
 				)
-			})
+		})
+}
+
+// orderedByProduct binds the exchanges of the other product events to the queue of the consumer and handles the
+// events of the different products concurrently and the events of the same product in their delivery order, so an
+// update of a product isn't applied before its creation or its previous update. The order is kept within one instance
+// of the service only, the other instances consuming the queue get the other events of the product, and an event
+// that is nacked and requeued is redelivered after the later events of its product.
+func orderedByProduct(
+	builder consumerConfigurations.RabbitMQConsumerConfigurationBuilder,
+	boundMessages ...types.IMessage,
+) consumerConfigurations.RabbitMQConsumerConfigurationBuilder {
+	return builder.
+		WithBoundMessages(boundMessages...).
+		WithConcurrencyLimit(productConsumersConcurrencyLimit).
+		WithOrderByPartitionKey(true)
+}
+
+// productEventsHandler handles the product events of the shared queue with the consumer of their message type.
+type productEventsHandler map[reflect.Type]consumer.ConsumerHandler
+
+// Handle handles a product event with the consumer of its message type, the events without a consumer are ignored.
+func (h productEventsHandler) Handle(ctx context.Context, consumeContext types.MessageConsumeContext) error {
+	handler, ok := h[utils.GetMessageBaseReflectType(consumeContext.Message())]
+	if !ok {
+		return nil
+	}
+
+	return handler.Handle(ctx, consumeContext)
 }
@@ -0,0 +1,24 @@
+// Package schemas contains the schema registry configurations of the consumed products integration events.
+package schemas
+
+import (
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/schemaregistry"
+
+	createProductExternalEventV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/creatingproduct/v1/events/integrationevents/externalevents"
+	deleteProductExternalEventV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/deletingproducts/v1/events/integrationevents/externalevents"
+	purgeProductExternalEventV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/purgingproducts/v1/events/integrationevents/externalevents"
+	restoreProductExternalEventV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/restoringproducts/v1/events/integrationevents/externalevents"
+	updateProductExternalEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/updatingproducts/v1/events/integrationevents/externalevents"
+)
+
+// ConfigProductsSchemas registers the consumed products external events, they should read the schemas of the
+// catalogs write service in api/jsonschema/catalogwriteservice.
+func ConfigProductsSchemas(registry *schemaregistry.Registry) {
+	registry.Register(
+		&createProductExternalEventV1.ProductCreatedV1{},
+		&updateProductExternalEventsV1.ProductUpdatedV1{},
+		&deleteProductExternalEventV1.ProductDeletedV1{},
+		&restoreProductExternalEventV1.ProductRestoredV1{},
+		&purgeProductExternalEventV1.ProductPurgedV1{},
+	)
+}
@@ -16,12 +16,19 @@ type ProductRepository interface {
 	) (*utils.ListResult[*models.Product], error)
 	SearchProducts(
 		ctx context.Context,
-		searchText string,
+		criteria *models.ProductSearchCriteria,
 		listQuery *utils.ListQuery,
-	) (*utils.ListResult[*models.Product], error)
+	) (*utils.ListResult[*models.ProductSearchHit], error)
 	GetProductByID(ctx context.Context, uuid string) (*models.Product, error)
 	GetProductByProductID(ctx context.Context, uuid string) (*models.Product, error)
 	CreateProduct(ctx context.Context, product *models.Product) (*models.Product, error)
 	UpdateProduct(ctx context.Context, product *models.Product) (*models.Product, error)
 	DeleteProductByID(ctx context.Context, uuid string) error
 }
+
+// ProductElasticRepository is a contract for the elastic product read model repository.
+type ProductElasticRepository interface {
+	ProductRepository
+	// EnsureIndex creates the products index template and index if they don't exist.
+	EnsureIndex(ctx context.Context) error
+}
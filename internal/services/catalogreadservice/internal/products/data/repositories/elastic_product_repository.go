@@ -0,0 +1,497 @@
+// Package repositories contains the elastic product repository.
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing/attribute"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/utils"
+
+	elasticsearch "github.com/elastic/go-elasticsearch/v8"
+	elastic "github.com/raphaeldiscky/go-food-micro/internal/pkg/elasticsearch"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+	utils2 "github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing/utils"
+	attribute2 "go.opentelemetry.io/otel/attribute"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/config"
+	data2 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/contracts/data"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/models"
+)
+
+const (
+	defaultProductIndex        = "products"
+	productIndexTemplateSuffix = "_template"
+)
+
+// elasticSearchResult is the elastic search response for the products index.
+type elasticSearchResult struct {
+	Hits struct {
+		Total struct {
+			Value int64 `json:"value"`
+		} `json:"total"`
+		Hits []struct {
+			Score     float64             `json:"_score"`
+			Source    models.Product      `json:"_source"`
+			Highlight map[string][]string `json:"highlight"`
+		} `json:"hits"`
+	} `json:"hits"`
+}
+
+// elasticProductRepository is a struct that contains the elastic product repository.
+type elasticProductRepository struct {
+	log           logger.Logger
+	elasticClient *elasticsearch.Client
+	index         string
+	tracer        tracing.AppTracer
+}
+
+// NewElasticProductRepository creates a new ElasticProductRepository.
+func NewElasticProductRepository(
+	log logger.Logger,
+	elasticClient *elasticsearch.Client,
+	cfg *config.Config,
+	tracer tracing.AppTracer,
+) data2.ProductElasticRepository {
+	index := cfg.ElasticIndexes.Products
+	if index == "" {
+		index = defaultProductIndex
+	}
+
+	return &elasticProductRepository{
+		log:           log,
+		elasticClient: elasticClient,
+		index:         index,
+		tracer:        tracer,
+	}
+}
+
+// EnsureIndex creates the products index template and index if they don't exist.
+func (e *elasticProductRepository) EnsureIndex(ctx context.Context) error {
+	ctx, span := e.tracer.Start(ctx, "elasticProductRepository.EnsureIndex")
+	defer span.End()
+
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/mapping-types.html
+	template := &elastic.IndexTemplate{
+		IndexPatterns: []string{e.index + "*"},
+		Template: elastic.IndexTemplateSettings{
+			Mappings: map[string]interface{}{
+				"dynamic": "strict",
+				"properties": map[string]interface{}{
+					"id":        map[string]interface{}{"type": "keyword"},
+					"productID": map[string]interface{}{"type": "keyword"},
+					"name": map[string]interface{}{
+						"type":   "text",
+						"fields": map[string]interface{}{"keyword": map[string]interface{}{"type": "keyword"}},
+					},
+					"description": map[string]interface{}{"type": "text"},
+					"price":       map[string]interface{}{"type": "double"},
+					"createdAt":   map[string]interface{}{"type": "date"},
+					"updatedAt":   map[string]interface{}{"type": "date"},
+				},
+			},
+		},
+	}
+
+	err := elastic.PutIndexTemplate(ctx, e.elasticClient, e.index+productIndexTemplateSuffix, template)
+	if err != nil {
+		return utils2.TraceErrStatusFromSpan(span, err)
+	}
+
+	err = elastic.EnsureIndex(ctx, e.elasticClient, e.index)
+	if err != nil {
+		return utils2.TraceErrStatusFromSpan(span, err)
+	}
+
+	e.log.Infow(
+		fmt.Sprintf("products index %s ensured", e.index),
+		logger.Fields{"Index": e.index},
+	)
+
+	return nil
+}
+
+// GetAllProducts gets all products from the elastic index.
+func (e *elasticProductRepository) GetAllProducts(
+	ctx context.Context,
+	listQuery *utils.ListQuery,
+) (*utils.ListResult[*models.Product], error) {
+	ctx, span := e.tracer.Start(ctx, "elasticProductRepository.GetAllProducts")
+	defer span.End()
+
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"match_all": map[string]interface{}{},
+		},
+		"from": listQuery.GetOffset(),
+		"size": listQuery.GetLimit(),
+		"sort": []map[string]interface{}{
+			{"createdAt": "desc"},
+		},
+	}
+
+	result, err := e.search(ctx, query)
+	if err != nil {
+		return nil, utils2.TraceErrStatusFromSpan(span, err)
+	}
+
+	products := make([]*models.Product, len(result.Hits.Hits))
+	for i := range result.Hits.Hits {
+		products[i] = &result.Hits.Hits[i].Source
+	}
+
+	listResult := utils.NewListResult[*models.Product](
+		products,
+		listQuery.GetSize(),
+		listQuery.GetPage(),
+		result.Hits.Total.Value,
+	)
+
+	span.SetAttributes(attribute.Object("ProductsResult", listResult))
+
+	return listResult, nil
+}
+
+// SearchProducts searches for products in the elastic index with a fuzzy full-text search ranked by relevance.
+func (e *elasticProductRepository) SearchProducts(
+	ctx context.Context,
+	criteria *models.ProductSearchCriteria,
+	listQuery *utils.ListQuery,
+) (*utils.ListResult[*models.ProductSearchHit], error) {
+	ctx, span := e.tracer.Start(ctx, "elasticProductRepository.SearchProducts")
+	span.SetAttributes(attribute2.String("SearchText", criteria.SearchText))
+	span.SetAttributes(attribute2.Bool("Fuzzy", criteria.Fuzzy))
+	defer span.End()
+
+	// the search text is passed as a match query text, so it is analyzed and never interpreted as a query syntax
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-multi-match-query.html
+	multiMatch := map[string]interface{}{
+		"query":  criteria.SearchText,
+		"fields": []string{"name^5", "description"},
+		"type":   "best_fields",
+	}
+	if criteria.Fuzzy {
+		multiMatch["fuzziness"] = "AUTO"
+	}
+
+	filters := []map[string]interface{}{}
+	priceRange := map[string]interface{}{}
+	if criteria.MinPrice != nil {
+		priceRange["gte"] = *criteria.MinPrice
+	}
+	if criteria.MaxPrice != nil {
+		priceRange["lte"] = *criteria.MaxPrice
+	}
+	if len(priceRange) > 0 {
+		filters = append(filters, map[string]interface{}{
+			"range": map[string]interface{}{"price": priceRange},
+		})
+	}
+
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must":   []map[string]interface{}{{"multi_match": multiMatch}},
+				"filter": filters,
+			},
+		},
+		"from": listQuery.GetOffset(),
+		"size": listQuery.GetLimit(),
+	}
+	if criteria.Highlight {
+		query["highlight"] = map[string]interface{}{
+			"pre_tags":  []string{highlightPreTag},
+			"post_tags": []string{highlightPostTag},
+			"fields": map[string]interface{}{
+				"name":        map[string]interface{}{"number_of_fragments": 0},
+				"description": map[string]interface{}{},
+			},
+		}
+	}
+
+	result, err := e.search(ctx, query)
+	if err != nil {
+		return nil, utils2.TraceErrStatusFromSpan(span, err)
+	}
+
+	hits := make([]*models.ProductSearchHit, len(result.Hits.Hits))
+	for i, hit := range result.Hits.Hits {
+		hits[i] = &models.ProductSearchHit{
+			Product:    hit.Source,
+			Score:      hit.Score,
+			Highlights: hit.Highlight,
+		}
+	}
+
+	listResult := utils.NewListResult[*models.ProductSearchHit](
+		hits,
+		listQuery.GetSize(),
+		listQuery.GetPage(),
+		result.Hits.Total.Value,
+	)
+
+	e.log.Infow(
+		fmt.Sprintf(
+			"products loaded for search term '%s'",
+			criteria.SearchText,
+		),
+		logger.Fields{"ProductsResult": listResult},
+	)
+
+	span.SetAttributes(attribute.Object("ProductsResult", listResult))
+
+	return listResult, nil
+}
+
+// GetProductByID gets a product by id from the elastic index.
+func (e *elasticProductRepository) GetProductByID(
+	ctx context.Context,
+	uuid string,
+) (*models.Product, error) {
+	ctx, span := e.tracer.Start(ctx, "elasticProductRepository.GetProductByID")
+	span.SetAttributes(attribute2.String("ID", uuid))
+	defer span.End()
+
+	res, err := e.elasticClient.Get(
+		e.index,
+		uuid,
+		e.elasticClient.Get.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, utils2.TraceErrStatusFromSpan(
+			span,
+			errors.WrapIf(err, "failed to get product"),
+		)
+	}
+	defer e.closeBody(res.Body)
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, utils2.TraceStatusFromSpan(
+			span,
+			customErrors.NewNotFoundError(
+				fmt.Sprintf(
+					"can't find the product with id %s into the elastic index.",
+					uuid,
+				),
+			),
+		)
+	}
+
+	if res.IsError() {
+		return nil, utils2.TraceErrStatusFromSpan(
+			span,
+			errors.Errorf("get error: %s", res.String()),
+		)
+	}
+
+	var result struct {
+		Source models.Product `json:"_source"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return nil, utils2.TraceErrStatusFromSpan(
+			span,
+			errors.WrapIf(err, "failed to decode get response"),
+		)
+	}
+
+	span.SetAttributes(attribute.Object("Product", result.Source))
+
+	e.log.Infow(
+		fmt.Sprintf("product with id %s loaded", uuid),
+		logger.Fields{"Product": result.Source, "ID": uuid},
+	)
+
+	return &result.Source, nil
+}
+
+// GetProductByProductID gets a product by product id from the elastic index.
+func (e *elasticProductRepository) GetProductByProductID(
+	ctx context.Context,
+	uuid string,
+) (*models.Product, error) {
+	ctx, span := e.tracer.Start(ctx, "elasticProductRepository.GetProductByProductID")
+	span.SetAttributes(attribute2.String("ProductID", uuid))
+	defer span.End()
+
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"term": map[string]interface{}{
+				"productID": uuid,
+			},
+		},
+		"size": 1,
+	}
+
+	result, err := e.search(ctx, query)
+	if err != nil {
+		return nil, utils2.TraceErrStatusFromSpan(span, err)
+	}
+
+	if len(result.Hits.Hits) == 0 {
+		return nil, nil
+	}
+
+	product := result.Hits.Hits[0].Source
+	span.SetAttributes(attribute.Object("Product", product))
+
+	e.log.Infow(
+		fmt.Sprintf("product with productID %s loaded", uuid),
+		logger.Fields{"Product": product, "ProductID": uuid},
+	)
+
+	return &product, nil
+}
+
+// CreateProduct indexes a new product in the elastic index.
+func (e *elasticProductRepository) CreateProduct(
+	ctx context.Context,
+	product *models.Product,
+) (*models.Product, error) {
+	return e.indexProduct(ctx, product, "CreateProduct")
+}
+
+// UpdateProduct re-indexes an existing product in the elastic index.
+func (e *elasticProductRepository) UpdateProduct(
+	ctx context.Context,
+	updateProduct *models.Product,
+) (*models.Product, error) {
+	return e.indexProduct(ctx, updateProduct, "UpdateProduct")
+}
+
+// DeleteProductByID deletes a product by id from the elastic index.
+func (e *elasticProductRepository) DeleteProductByID(
+	ctx context.Context,
+	uuid string,
+) error {
+	ctx, span := e.tracer.Start(ctx, "elasticProductRepository.DeleteProductByID")
+	span.SetAttributes(attribute2.String("ID", uuid))
+	defer span.End()
+
+	res, err := e.elasticClient.Delete(
+		e.index,
+		uuid,
+		e.elasticClient.Delete.WithContext(ctx),
+		e.elasticClient.Delete.WithRefresh("true"),
+	)
+	if err != nil {
+		return utils2.TraceErrStatusFromSpan(
+			span,
+			errors.WrapIf(err, fmt.Sprintf(
+				"error in deleting product with id %s from the elastic index.",
+				uuid,
+			)),
+		)
+	}
+	defer e.closeBody(res.Body)
+
+	if res.IsError() && res.StatusCode != http.StatusNotFound {
+		return utils2.TraceErrStatusFromSpan(
+			span,
+			errors.Errorf("delete error: %s", res.String()),
+		)
+	}
+
+	e.log.Infow(
+		fmt.Sprintf("product with id %s deleted", uuid),
+		logger.Fields{"Product": uuid},
+	)
+
+	return nil
+}
+
+// indexProduct indexes a product document with its read model id.
+func (e *elasticProductRepository) indexProduct(
+	ctx context.Context,
+	product *models.Product,
+	operation string,
+) (*models.Product, error) {
+	ctx, span := e.tracer.Start(ctx, fmt.Sprintf("elasticProductRepository.%s", operation))
+	span.SetAttributes(attribute.Object("Product", product))
+	defer span.End()
+
+	productJSON, err := json.Marshal(product)
+	if err != nil {
+		return nil, utils2.TraceErrStatusFromSpan(
+			span,
+			errors.WrapIf(err, "failed to marshal product"),
+		)
+	}
+
+	res, err := e.elasticClient.Index(
+		e.index,
+		strings.NewReader(string(productJSON)),
+		e.elasticClient.Index.WithContext(ctx),
+		e.elasticClient.Index.WithDocumentID(product.ID),
+		e.elasticClient.Index.WithRefresh("true"),
+	)
+	if err != nil {
+		return nil, utils2.TraceErrStatusFromSpan(
+			span,
+			errors.WrapIf(
+				err,
+				fmt.Sprintf("error in indexing product with id %s into the elastic index.", product.ID),
+			),
+		)
+	}
+	defer e.closeBody(res.Body)
+
+	if res.IsError() {
+		return nil, utils2.TraceErrStatusFromSpan(
+			span,
+			errors.Errorf("index error: %s", res.String()),
+		)
+	}
+
+	e.log.Infow(
+		fmt.Sprintf("product with id '%s' indexed", product.ProductID),
+		logger.Fields{"Product": product, "ID": product.ID},
+	)
+
+	return product, nil
+}
+
+// search executes a search request against the products index.
+func (e *elasticProductRepository) search(
+	ctx context.Context,
+	query map[string]interface{},
+) (*elasticSearchResult, error) {
+	queryJSON, err := json.Marshal(query)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to marshal search query")
+	}
+
+	res, err := e.elasticClient.Search(
+		e.elasticClient.Search.WithContext(ctx),
+		e.elasticClient.Search.WithIndex(e.index),
+		e.elasticClient.Search.WithBody(strings.NewReader(string(queryJSON))),
+	)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to execute search")
+	}
+	defer e.closeBody(res.Body)
+
+	if res.IsError() {
+		return nil, errors.Errorf("search error: %s", res.String())
+	}
+
+	var result elasticSearchResult
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return nil, errors.WrapIf(err, "failed to decode search response")
+	}
+
+	return &result, nil
+}
+
+// closeBody closes the response body and logs any errors.
+func (e *elasticProductRepository) closeBody(body io.ReadCloser) {
+	if err := body.Close(); err != nil {
+		e.log.Error(errors.WrapIf(err, "failed to close response body"))
+	}
+}
@@ -20,7 +20,9 @@ import (
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing/attribute"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/utils"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	utils2 "github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing/utils"
 	uuid2 "github.com/satori/go.uuid"
@@ -38,6 +40,7 @@ const (
 type mongoProductRepository struct {
 	log                    logger.Logger
 	mongoGenericRepository data.GenericRepository[*models.Product]
+	collection             *mongo.Collection
 	tracer                 tracing.AppTracer
 }
 
@@ -57,6 +60,7 @@ func NewMongoProductRepository(
 	return &mongoProductRepository{
 		log:                    log,
 		mongoGenericRepository: mongoRepo,
+		collection:             db.Database(mongoOptions.Database).Collection(productCollection),
 		tracer:                 tracer,
 	}
 }
@@ -91,17 +95,39 @@ func (p *mongoProductRepository) GetAllProducts(
 	return result, nil
 }
 
-// SearchProducts searches for products in the database.
+// SearchProducts searches for products in the database with a full-text search ranked by relevance.
 func (p *mongoProductRepository) SearchProducts(
 	ctx context.Context,
-	searchText string,
+	criteria *models.ProductSearchCriteria,
 	listQuery *utils.ListQuery,
-) (*utils.ListResult[*models.Product], error) {
+) (*utils.ListResult[*models.ProductSearchHit], error) {
 	ctx, span := p.tracer.Start(ctx, "mongoProductRepository.SearchProducts")
-	span.SetAttributes(attribute2.String("SearchText", searchText))
+	span.SetAttributes(attribute2.String("SearchText", criteria.SearchText))
+	span.SetAttributes(attribute2.Bool("Fuzzy", criteria.Fuzzy))
 	defer span.End()
 
-	result, err := p.mongoGenericRepository.Search(ctx, searchText, listQuery)
+	terms := mongodb.TextSearchTerms(criteria.SearchText)
+
+	// https://www.mongodb.com/docs/manual/core/link-text-indexes/
+	textFilter := append(
+		priceRangeFilter(criteria),
+		bson.E{
+			Key:   "$text",
+			Value: bson.D{{Key: "$search", Value: mongodb.EscapeTextSearch(criteria.SearchText)}},
+		},
+	)
+	score := bson.D{{Key: "$meta", Value: "textScore"}}
+	findOptions := options.Find().
+		SetProjection(bson.D{{Key: "score", Value: score}}).
+		SetSort(bson.D{{Key: "score", Value: score}})
+
+	result, err := mongodb.PaginateWithOptions[*models.ProductSearchHit](
+		ctx,
+		listQuery,
+		p.collection,
+		textFilter,
+		findOptions,
+	)
 	if err != nil {
 		return nil, utils2.TraceErrStatusFromSpan(
 			span,
@@ -112,10 +138,35 @@ func (p *mongoProductRepository) SearchProducts(
 		)
 	}
 
+	if result.TotalItems == 0 && criteria.Fuzzy && len(terms) > 0 {
+		// text indexes only match whole (stemmed) words, so fall back to partial matching of each term
+		result, err = mongodb.Paginate[*models.ProductSearchHit](
+			ctx,
+			listQuery,
+			p.collection,
+			append(priceRangeFilter(criteria), bson.E{Key: "$or", Value: partialTermsFilter(terms)}),
+		)
+		if err != nil {
+			return nil, utils2.TraceErrStatusFromSpan(
+				span,
+				errors.WrapIf(
+					err,
+					"error in the paginate",
+				),
+			)
+		}
+	}
+
+	if criteria.Highlight {
+		for _, hit := range result.Items {
+			hit.Highlights = highlightProduct(&hit.Product, terms)
+		}
+	}
+
 	p.log.Infow(
 		fmt.Sprintf(
 			"products loaded for search term '%s'",
-			searchText,
+			criteria.SearchText,
 		),
 		logger.Fields{"ProductsResult": result},
 	)
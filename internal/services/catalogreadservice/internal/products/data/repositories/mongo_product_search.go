@@ -0,0 +1,108 @@
+package repositories
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/models"
+)
+
+const (
+	productTextIndexName = "products_text_search"
+	highlightPreTag      = "<em>"
+	highlightPostTag     = "</em>"
+)
+
+// EnsureMongoProductIndexes creates the indexes required by the mongo product repository, like the full-text search index.
+func EnsureMongoProductIndexes(
+	ctx context.Context,
+	db *mongo.Client,
+	mongoOptions *mongodb.MongoDbOptions,
+) error {
+	collection := db.Database(mongoOptions.Database).Collection(productCollection)
+
+	// https://www.mongodb.com/docs/manual/core/indexes/index-types/index-text/control-text-search-results/
+	_, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "name", Value: "text"},
+				{Key: "description", Value: "text"},
+			},
+			Options: options.Index().
+				SetName(productTextIndexName).
+				SetWeights(bson.D{{Key: "name", Value: 10}, {Key: "description", Value: 2}}),
+		},
+		{
+			Keys: bson.D{{Key: "price", Value: 1}},
+		},
+	})
+	if err != nil {
+		return errors.WrapIf(err, "error in creating products indexes")
+	}
+
+	return nil
+}
+
+// priceRangeFilter creates the price range part of a search filter.
+func priceRangeFilter(criteria *models.ProductSearchCriteria) bson.D {
+	priceRange := bson.D{}
+	if criteria.MinPrice != nil {
+		priceRange = append(priceRange, bson.E{Key: "$gte", Value: *criteria.MinPrice})
+	}
+	if criteria.MaxPrice != nil {
+		priceRange = append(priceRange, bson.E{Key: "$lte", Value: *criteria.MaxPrice})
+	}
+	if len(priceRange) == 0 {
+		return bson.D{}
+	}
+
+	return bson.D{{Key: "price", Value: priceRange}}
+}
+
+// partialTermsFilter creates a filter matching any of the terms partially in the searchable fields.
+func partialTermsFilter(terms []string) bson.A {
+	var filters bson.A
+	for _, term := range terms {
+		filters = append(
+			filters,
+			bson.D{{Key: "name", Value: mongodb.ContainsRegex(term)}},
+			bson.D{{Key: "description", Value: mongodb.ContainsRegex(term)}},
+		)
+	}
+
+	return filters
+}
+
+// highlightProduct marks the matched terms in the searchable fields of a product.
+func highlightProduct(product *models.Product, terms []string) map[string][]string {
+	if len(terms) == 0 {
+		return nil
+	}
+
+	escaped := make([]string, 0, len(terms))
+	for _, term := range terms {
+		escaped = append(escaped, mongodb.EscapeRegex(term))
+	}
+	matcher := regexp.MustCompile("(?i)" + strings.Join(escaped, "|"))
+
+	highlights := map[string][]string{}
+	for field, value := range map[string]string{"name": product.Name, "description": product.Description} {
+		if !matcher.MatchString(value) {
+			continue
+		}
+		highlights[field] = []string{
+			matcher.ReplaceAllStringFunc(value, func(match string) string {
+				return highlightPreTag + match + highlightPostTag
+			}),
+		}
+	}
+
+	return highlights
+}
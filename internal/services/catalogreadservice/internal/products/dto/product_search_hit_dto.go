@@ -0,0 +1,8 @@
+package dto
+
+// ProductSearchHitDto is a struct that contains a searched product with its relevance score and highlights.
+type ProductSearchHitDto struct {
+	ProductDto
+	Score      float64             `json:"score"`
+	Highlights map[string][]string `json:"highlights,omitempty"`
+}
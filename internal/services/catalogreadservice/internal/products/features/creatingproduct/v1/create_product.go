@@ -5,7 +5,8 @@ import (
 	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation"
-	uuid "github.com/satori/go.uuid"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/models"
 )
 
 // CreateProduct is a struct that contains the create product command.
@@ -28,7 +29,7 @@ func NewCreateProduct(
 	createdAt time.Time,
 ) (*CreateProduct, error) {
 	command := &CreateProduct{
-		ID:          uuid.NewV4().String(),
+		ID:          models.NewProductReadModelID(productID),
 		ProductID:   productID,
 		Name:        name,
 		Description: description,
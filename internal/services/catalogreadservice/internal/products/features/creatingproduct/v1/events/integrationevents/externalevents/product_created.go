@@ -10,7 +10,7 @@ import (
 // ProductCreatedV1 is a struct that contains the product created event.
 type ProductCreatedV1 struct {
 	*types.Message
-	ProductID   string    `json:"productID,omitempty"`
+	ProductID   string    `json:"id,omitempty"`
 	Name        string    `json:"name,omitempty"`
 	Description string    `json:"description,omitempty"`
 	Price       float64   `json:"price,omitempty"`
@@ -21,3 +21,8 @@ type ProductCreatedV1 struct {
 func (p *ProductCreatedV1) GetMessageTypeName() string {
 	return "ProductCreatedV1"
 }
+
+// GetPartitionKey returns the id of the product, the events of a product are handled in their order.
+func (p *ProductCreatedV1) GetPartitionKey() string {
+	return p.ProductID
+}
@@ -15,3 +15,8 @@ type ProductDeletedV1 struct {
 func (p *ProductDeletedV1) GetMessageTypeName() string {
 	return "ProductDeletedV1"
 }
+
+// GetPartitionKey returns the id of the product, the events of a product are handled in their order.
+func (p *ProductDeletedV1) GetPartitionKey() string {
+	return p.ProductID
+}
@@ -0,0 +1,22 @@
+// Package externalevents contains the product purged event.
+package externalevents
+
+import (
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+)
+
+// ProductPurgedV1 is a struct that contains the product purged event, a product that is permanently deleted.
+type ProductPurgedV1 struct {
+	*types.Message
+	ProductID string `json:"productID,omitempty"`
+}
+
+// GetMessageTypeName returns the message type name.
+func (p *ProductPurgedV1) GetMessageTypeName() string {
+	return "ProductPurgedV1"
+}
+
+// GetPartitionKey returns the id of the product, the events of a product are handled in their order.
+func (p *ProductPurgedV1) GetPartitionKey() string {
+	return p.ProductID
+}
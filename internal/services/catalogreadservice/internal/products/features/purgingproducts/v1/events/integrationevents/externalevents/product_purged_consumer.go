@@ -0,0 +1,131 @@
+package externalevents
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/go-playground/validator"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/consumer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing/utils"
+	"go.opentelemetry.io/otel/attribute"
+
+	mediatr "github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/deletingproducts/v1/commands"
+)
+
+// ProductPurgedConsumer is a struct that contains the product purged consumer.
+type ProductPurgedConsumer struct {
+	logger    logger.Logger
+	validator *validator.Validate
+	tracer    tracing.AppTracer
+}
+
+// NewProductPurgedConsumer creates a new ProductPurgedConsumer.
+func NewProductPurgedConsumer(
+	log logger.Logger,
+	val *validator.Validate,
+	tracer tracing.AppTracer,
+) consumer.ConsumerHandler {
+	return &ProductPurgedConsumer{
+		logger:    log,
+		validator: val,
+		tracer:    tracer,
+	}
+}
+
+// Handle is a method that handles the product purged consumer, the read model of a purged product is
+// usually already deleted by its ProductDeleted event, so a missing read model is not an error.
+func (c *ProductPurgedConsumer) Handle(
+	ctx context.Context,
+	consumeContext types.MessageConsumeContext,
+) error {
+	ctx, span := c.tracer.Start(ctx, "productPurgedConsumer.Handle")
+	defer span.End()
+
+	message, ok := consumeContext.Message().(*ProductPurgedV1)
+	if !ok {
+		err := errors.New("error in casting message to ProductPurgedV1")
+		span.RecordError(err)
+
+		return err
+	}
+
+	if err := c.validator.Struct(message); err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(
+			err,
+			"message validation failed",
+		)
+		span.RecordError(validationErr)
+
+		return validationErr
+	}
+
+	span.SetAttributes(attribute.String("productId", message.ProductID))
+
+	productUUID, err := uuid.FromString(message.ProductID)
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(
+			err,
+			"[purgeProductConsumer_Consume.uuid.FromString] error in converting uuid",
+		)
+		c.logger.Errorf(
+			fmt.Sprintf(
+				"[purgeProductConsumer_Consume.uuid.FromString] err: %v",
+				utils.TraceErrStatusFromSpan(span, badRequestErr),
+			),
+		)
+
+		return badRequestErr
+	}
+
+	command, err := commands.NewDeleteProduct(productUUID)
+	if err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(
+			err,
+			"[purgeProductConsumer_Consume.NewDeleteProduct] command validation failed",
+		)
+		span.RecordError(validationErr)
+
+		return validationErr
+	}
+
+	_, err = mediatr.Send[*commands.DeleteProduct, *mediatr.Unit](ctx, command)
+	if customErrors.IsNotFoundError(err) {
+		c.logger.Infow(
+			fmt.Sprintf("read model of the purged product with id: {%s} is already deleted", command.ProductID),
+			logger.Fields{"productId": command.ProductID},
+		)
+
+		return nil
+	}
+
+	if err != nil {
+		err = errors.WithMessage(
+			err,
+			fmt.Sprintf(
+				"[purgeProductConsumer_Consume.Send] error in sending DeleteProduct with id: {%s}",
+				command.ProductID,
+			),
+		)
+		span.RecordError(err)
+
+		return err
+	}
+
+	c.logger.Infow(
+		"Product purged consumer handled successfully",
+		logger.Fields{
+			"productId": command.ProductID,
+			"traceId":   span.SpanContext().TraceID().String(),
+		},
+	)
+
+	return nil
+}
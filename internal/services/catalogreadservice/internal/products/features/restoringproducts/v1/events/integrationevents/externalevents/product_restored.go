@@ -0,0 +1,28 @@
+// Package externalevents contains the product restored event.
+package externalevents
+
+import (
+	"time"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+)
+
+// ProductRestoredV1 is a struct that contains the product restored event, a deleted product that is back in the catalog.
+type ProductRestoredV1 struct {
+	*types.Message
+	ProductID   string    `json:"id,omitempty"`
+	Name        string    `json:"name,omitempty"`
+	Description string    `json:"description,omitempty"`
+	Price       float64   `json:"price,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// GetMessageTypeName is a method that returns the message type name.
+func (p *ProductRestoredV1) GetMessageTypeName() string {
+	return "ProductRestoredV1"
+}
+
+// GetPartitionKey returns the id of the product, the events of a product are handled in their order.
+func (p *ProductRestoredV1) GetPartitionKey() string {
+	return p.ProductID
+}
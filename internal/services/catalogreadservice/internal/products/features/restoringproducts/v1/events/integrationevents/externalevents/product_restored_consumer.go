@@ -0,0 +1,126 @@
+package externalevents
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/go-playground/validator"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/consumer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing/utils"
+	"go.opentelemetry.io/otel/attribute"
+
+	mediatr "github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+
+	createProductV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/creatingproduct/v1"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/creatingproduct/v1/dtos"
+)
+
+// ProductRestoredConsumer is a struct that contains the product restored consumer.
+type ProductRestoredConsumer struct {
+	logger    logger.Logger
+	validator *validator.Validate
+	tracer    tracing.AppTracer
+}
+
+// NewProductRestoredConsumer creates a new ProductRestoredConsumer.
+func NewProductRestoredConsumer(
+	log logger.Logger,
+	val *validator.Validate,
+	tracer tracing.AppTracer,
+) consumer.ConsumerHandler {
+	return &ProductRestoredConsumer{
+		logger:    log,
+		validator: val,
+		tracer:    tracer,
+	}
+}
+
+// Handle is a method that handles the product restored consumer, the deleted read model is created again.
+func (c *ProductRestoredConsumer) Handle(
+	ctx context.Context,
+	consumeContext types.MessageConsumeContext,
+) error {
+	ctx, span := c.tracer.Start(ctx, "productRestoredConsumer.Handle")
+	defer span.End()
+
+	message, ok := consumeContext.Message().(*ProductRestoredV1)
+	if !ok {
+		err := errors.New("error in casting message to ProductRestoredV1")
+		span.RecordError(err)
+
+		return err
+	}
+
+	if err := c.validator.Struct(message); err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(
+			err,
+			"message validation failed",
+		)
+		span.RecordError(validationErr)
+
+		return validationErr
+	}
+
+	span.SetAttributes(attribute.String("productID", message.ProductID))
+
+	command, err := createProductV1.NewCreateProduct(
+		message.ProductID,
+		message.Name,
+		message.Description,
+		message.Price,
+		message.CreatedAt,
+	)
+	if err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(
+			err,
+			"[restoreProductConsumer_Consume.NewCreateProduct] command validation failed",
+		)
+		c.logger.Errorf(
+			fmt.Sprintf(
+				"[restoreProductConsumer_Consume.NewCreateProduct] err: %v",
+				utils.TraceErrStatusFromSpan(span, validationErr),
+			),
+		)
+
+		return validationErr
+	}
+
+	_, err = mediatr.Send[*createProductV1.CreateProduct, *dtos.CreateProductResponseDto](
+		ctx,
+		command,
+	)
+	if err != nil {
+		err = errors.WithMessage(
+			err,
+			fmt.Sprintf(
+				"[restoreProductConsumer_Consume.Send] error in sending CreateProduct with id: {%s}",
+				command.ProductID,
+			),
+		)
+		c.logger.Errorw(
+			"Failed to send CreateProduct command",
+			logger.Fields{
+				"error":     err,
+				"productId": command.ProductID,
+			},
+		)
+		span.RecordError(err)
+
+		return err
+	}
+
+	c.logger.Infow(
+		"Product restored consumer handled successfully",
+		logger.Fields{
+			"productId": command.ProductID,
+			"traceId":   span.SpanContext().TraceID().String(),
+		},
+	)
+
+	return nil
+}
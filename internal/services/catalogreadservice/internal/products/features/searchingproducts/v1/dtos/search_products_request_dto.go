@@ -7,6 +7,10 @@ import (
 
 // SearchProductsRequestDto is a struct that contains the search products request dto.
 type SearchProductsRequestDto struct {
-	SearchText       string `query:"search" json:"search"`
-	*utils.ListQuery `                      json:"listQuery"`
+	SearchText       string   `query:"search"    json:"search"`
+	MinPrice         *float64 `query:"minPrice"  json:"minPrice"`
+	MaxPrice         *float64 `query:"maxPrice"  json:"maxPrice"`
+	Fuzzy            bool     `query:"fuzzy"     json:"fuzzy"`
+	Highlight        bool     `query:"highlight" json:"highlight"`
+	*utils.ListQuery `                           json:"listQuery"`
 }
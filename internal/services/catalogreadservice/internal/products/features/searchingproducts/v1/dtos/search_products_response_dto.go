@@ -9,5 +9,5 @@ import (
 
 // SearchProductsResponseDto is a struct that contains the search products response dto.
 type SearchProductsResponseDto struct {
-	Products *utils.ListResult[*dto.ProductDto]
+	Products *utils.ListResult[*dto.ProductSearchHitDto]
 }
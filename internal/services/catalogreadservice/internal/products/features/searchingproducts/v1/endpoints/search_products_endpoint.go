@@ -39,7 +39,7 @@ func (ep *SearchProductsEndpoint) MapEndpoint() {
 // SearchProducts
 // @Tags Products
 // @Summary Search products
-// @Description Search products
+// @Description Search products with a full-text search ranked by relevance, optionally filtered by a price range
 // @Accept json
 // @Produce json
 // @Param searchProductsRequestDto query dtos.SearchProductsRequestDto false "SearchProductsRequestDto"
@@ -72,6 +72,10 @@ func (ep *SearchProductsEndpoint) handler() echo.HandlerFunc {
 
 		query := &queries.SearchProducts{
 			SearchText: request.SearchText,
+			MinPrice:   request.MinPrice,
+			MaxPrice:   request.MaxPrice,
+			Fuzzy:      request.Fuzzy,
+			Highlight:  request.Highlight,
 			ListQuery:  request.ListQuery,
 		}
 
@@ -10,10 +10,24 @@ import (
 // SearchProducts is a struct that contains the search products query.
 type SearchProducts struct {
 	SearchText string
+	MinPrice   *float64
+	MaxPrice   *float64
+	Fuzzy      bool
+	Highlight  bool
 	*utils.ListQuery
 }
 
 // Validate is a method that validates the search products query.
 func (s *SearchProducts) Validate() error {
-	return validation.ValidateStruct(s, validation.Field(&s.SearchText, validation.Required))
+	maxPriceRules := []validation.Rule{validation.Min(0.0)}
+	if s.MinPrice != nil {
+		maxPriceRules = append(maxPriceRules, validation.Min(*s.MinPrice))
+	}
+
+	return validation.ValidateStruct(
+		s,
+		validation.Field(&s.SearchText, validation.Required),
+		validation.Field(&s.MinPrice, validation.Min(0.0)),
+		validation.Field(&s.MaxPrice, maxPriceRules...),
+	)
 }
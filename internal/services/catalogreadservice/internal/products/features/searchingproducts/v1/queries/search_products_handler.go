@@ -13,6 +13,7 @@ import (
 	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/contracts/data"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/dto"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/searchingproducts/v1/dtos"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/models"
 )
 
 // SearchProductsHandler is a struct that contains the search products handler.
@@ -42,7 +43,13 @@ func (c *SearchProductsHandler) Handle(
 ) (*dtos.SearchProductsResponseDto, error) {
 	products, err := c.mongoRepository.SearchProducts(
 		ctx,
-		query.SearchText,
+		&models.ProductSearchCriteria{
+			SearchText: query.SearchText,
+			MinPrice:   query.MinPrice,
+			MaxPrice:   query.MaxPrice,
+			Fuzzy:      query.Fuzzy,
+			Highlight:  query.Highlight,
+		},
 		query.ListQuery,
 	)
 	if err != nil {
@@ -52,7 +59,7 @@ func (c *SearchProductsHandler) Handle(
 		)
 	}
 
-	listResultDto, err := utils.ListResultToListResultDto[*dto.ProductDto](
+	listResultDto, err := utils.ListResultToListResultDto[*dto.ProductSearchHitDto](
 		products,
 	)
 	if err != nil {
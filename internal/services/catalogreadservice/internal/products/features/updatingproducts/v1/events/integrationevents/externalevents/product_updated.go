@@ -10,10 +10,11 @@ import (
 // ProductUpdatedV1 is a struct that contains the product updated event.
 type ProductUpdatedV1 struct {
 	*types.Message
-	ProductID   string    `json:"productID,omitempty"`
+	ProductID   string    `json:"id,omitempty"`
 	Name        string    `json:"name,omitempty"`
 	Description string    `json:"description,omitempty"`
 	Price       float64   `json:"price,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
 	UpdatedAt   time.Time `json:"updatedAt"`
 }
 
@@ -21,3 +22,8 @@ type ProductUpdatedV1 struct {
 func (p *ProductUpdatedV1) GetMessageTypeName() string {
 	return "ProductUpdatedV1"
 }
+
+// GetPartitionKey returns the id of the product, the events of a product are handled in their order.
+func (p *ProductUpdatedV1) GetPartitionKey() string {
+	return p.ProductID
+}
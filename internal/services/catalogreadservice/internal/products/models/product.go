@@ -3,8 +3,13 @@ package models
 
 import (
 	"time"
+
+	uuid "github.com/satori/go.uuid"
 )
 
+// productReadModelIDNamespace is the namespace of the read model ids derived from the product ids.
+var productReadModelIDNamespace = uuid.NewV5(uuid.NamespaceURL, "catalogreadservice/products")
+
 // Product is a struct that contains the product.
 type Product struct {
 	// we generate id ourselves because auto generate mongo string id column with type _id is not an uuid
@@ -17,6 +22,12 @@ type Product struct {
 	UpdatedAt   time.Time `json:"updatedAt,omitempty"   bson:"updatedAt,omitempty"`
 }
 
+// NewProductReadModelID derives the read model id from the product id, so every read model store
+// (mongo, elastic) projects the same product with the same id and replayed events stay idempotent.
+func NewProductReadModelID(productID string) string {
+	return uuid.NewV5(productReadModelIDNamespace, productID).String()
+}
+
 // ProductsList is a struct that contains the products list.
 type ProductsList struct {
 	TotalCount int64      `json:"totalCount" bson:"totalCount"`
@@ -0,0 +1,19 @@
+package models
+
+// ProductSearchCriteria is a struct that contains the criteria for searching products.
+type ProductSearchCriteria struct {
+	SearchText string
+	MinPrice   *float64
+	MaxPrice   *float64
+	// Fuzzy falls back to case-insensitive partial matching of each term when the full-text search has no hits.
+	Fuzzy bool
+	// Highlight marks the matched terms in the returned fields.
+	Highlight bool
+}
+
+// ProductSearchHit is a struct that contains a product matched by a search with its relevance.
+type ProductSearchHit struct {
+	Product    `bson:",inline"`
+	Score      float64             `json:"score"                bson:"score,omitempty"`
+	Highlights map[string][]string `json:"highlights,omitempty" bson:"-"`
+}
@@ -4,10 +4,15 @@ package products
 import (
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/web/route"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/http/customecho/contracts"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing"
 	"go.uber.org/fx"
 
+	elasticsearch "github.com/elastic/go-elasticsearch/v8"
 	echo "github.com/labstack/echo/v4"
 
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/config"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/contracts/data"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/data/repositories"
 	getProductByIdV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/getproductbyid/v1/endpoints"
 	getProductsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/gettingproducts/v1/endpoints"
@@ -22,6 +27,7 @@ func NewModule() fx.Option {
 		// Other provides
 		fx.Provide(repositories.NewRedisProductRepository),
 		fx.Provide(repositories.NewMongoProductRepository),
+		fx.Provide(newElasticProductRepository),
 
 		fx.Provide(fx.Annotate(func(catalogsServer contracts.EchoHTTPServer) *echo.Group {
 			var g *echo.Group
@@ -40,3 +46,17 @@ func NewModule() fx.Option {
 		),
 	)
 }
+
+// newElasticProductRepository creates the elastic read model repository, it is nil without the elastic projection.
+func newElasticProductRepository(
+	log logger.Logger,
+	elasticClient *elasticsearch.Client,
+	cfg *config.Config,
+	tracer tracing.AppTracer,
+) data.ProductElasticRepository {
+	if !cfg.ReadModelOptions.ElasticProjection {
+		return nil
+	}
+
+	return repositories.NewElasticProductRepository(log, elasticClient, cfg, tracer)
+}
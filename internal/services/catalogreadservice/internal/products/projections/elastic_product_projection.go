@@ -0,0 +1,281 @@
+// Package projections contains the elastic product projection.
+package projections
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/go-playground/validator"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/consumer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing/utils"
+	"go.opentelemetry.io/otel/attribute"
+
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/contracts/data"
+	createProductExternalEventV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/creatingproduct/v1/events/integrationevents/externalevents"
+	deleteProductExternalEventV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/deletingproducts/v1/events/integrationevents/externalevents"
+	purgeProductExternalEventV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/purgingproducts/v1/events/integrationevents/externalevents"
+	restoreProductExternalEventV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/restoringproducts/v1/events/integrationevents/externalevents"
+	updateProductExternalEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/updatingproducts/v1/events/integrationevents/externalevents"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/models"
+)
+
+// elasticProductProjection projects the product integration events into the elastic read model.
+type elasticProductProjection struct {
+	elasticRepository data.ProductElasticRepository
+	validator         *validator.Validate
+	log               logger.Logger
+	tracer            tracing.AppTracer
+}
+
+// NewElasticProductProjection creates a new elastic product projection consumer handler.
+func NewElasticProductProjection(
+	elasticRepository data.ProductElasticRepository,
+	val *validator.Validate,
+	log logger.Logger,
+	tracer tracing.AppTracer,
+) consumer.ConsumerHandler {
+	return &elasticProductProjection{
+		elasticRepository: elasticRepository,
+		validator:         val,
+		log:               log,
+		tracer:            tracer,
+	}
+}
+
+// Handle handles the product integration events.
+func (e *elasticProductProjection) Handle(
+	ctx context.Context,
+	consumeContext types.MessageConsumeContext,
+) error {
+	if err := e.validator.Struct(consumeContext.Message()); err != nil {
+		return customErrors.NewValidationErrorWrap(
+			err,
+			"message validation failed",
+		)
+	}
+
+	switch evt := consumeContext.Message().(type) {
+	case *createProductExternalEventV1.ProductCreatedV1:
+		return e.onProductCreated(ctx, evt)
+	case *updateProductExternalEventsV1.ProductUpdatedV1:
+		return e.onProductUpdated(ctx, evt)
+	case *deleteProductExternalEventV1.ProductDeletedV1:
+		return e.onProductDeleted(ctx, evt)
+	case *restoreProductExternalEventV1.ProductRestoredV1:
+		return e.onProductRestored(ctx, evt)
+	case *purgeProductExternalEventV1.ProductPurgedV1:
+		return e.onProductPurged(ctx, evt)
+	default:
+		return nil
+	}
+}
+
+// onProductCreated indexes the created product, a product that an earlier consumed update already indexed only gets
+// its creation time, so the late creation doesn't overwrite the newer fields of the update.
+func (e *elasticProductProjection) onProductCreated(
+	ctx context.Context,
+	evt *createProductExternalEventV1.ProductCreatedV1,
+) error {
+	ctx, span := e.tracer.Start(ctx, "elasticProductProjection.onProductCreated")
+	span.SetAttributes(attribute.String("ProductID", evt.ProductID))
+	defer span.End()
+
+	product, err := e.elasticRepository.GetProductByProductID(ctx, evt.ProductID)
+	if err != nil {
+		return utils.TraceErrStatusFromSpan(
+			span,
+			errors.WithMessage(
+				err,
+				fmt.Sprintf("error in fetching product with productID %s", evt.ProductID),
+			),
+		)
+	}
+
+	if product != nil {
+		if !product.CreatedAt.IsZero() {
+			return nil
+		}
+
+		product.CreatedAt = evt.CreatedAt
+		_, err = e.elasticRepository.UpdateProduct(ctx, product)
+	} else {
+		_, err = e.elasticRepository.CreateProduct(ctx, &models.Product{
+			ID:          models.NewProductReadModelID(evt.ProductID),
+			ProductID:   evt.ProductID,
+			Name:        evt.Name,
+			Description: evt.Description,
+			Price:       evt.Price,
+			CreatedAt:   evt.CreatedAt,
+		})
+	}
+	if err != nil {
+		return utils.TraceErrStatusFromSpan(
+			span,
+			errors.WithMessage(
+				err,
+				fmt.Sprintf("error in projecting created product with productID %s", evt.ProductID),
+			),
+		)
+	}
+
+	e.log.Infow(
+		fmt.Sprintf("product with productID %s projected to elastic", evt.ProductID),
+		logger.Fields{"ProductID": evt.ProductID, "MessageID": evt.MessageId},
+	)
+
+	return nil
+}
+
+// onProductUpdated re-indexes the updated product, keeping the fields that are not part of the event.
+func (e *elasticProductProjection) onProductUpdated(
+	ctx context.Context,
+	evt *updateProductExternalEventsV1.ProductUpdatedV1,
+) error {
+	ctx, span := e.tracer.Start(ctx, "elasticProductProjection.onProductUpdated")
+	span.SetAttributes(attribute.String("ProductID", evt.ProductID))
+	defer span.End()
+
+	product, err := e.elasticRepository.GetProductByProductID(ctx, evt.ProductID)
+	if err != nil {
+		return utils.TraceErrStatusFromSpan(
+			span,
+			errors.WithMessage(
+				err,
+				fmt.Sprintf("error in fetching product with productID %s", evt.ProductID),
+			),
+		)
+	}
+
+	// the update can be consumed before the creation, so we upsert the product
+	if product == nil {
+		product = &models.Product{
+			ID:        models.NewProductReadModelID(evt.ProductID),
+			ProductID: evt.ProductID,
+		}
+	}
+
+	if product.CreatedAt.IsZero() {
+		product.CreatedAt = evt.CreatedAt
+	}
+	product.Name = evt.Name
+	product.Description = evt.Description
+	product.Price = evt.Price
+	product.UpdatedAt = evt.UpdatedAt
+
+	_, err = e.elasticRepository.UpdateProduct(ctx, product)
+	if err != nil {
+		return utils.TraceErrStatusFromSpan(
+			span,
+			errors.WithMessage(
+				err,
+				fmt.Sprintf("error in projecting updated product with productID %s", evt.ProductID),
+			),
+		)
+	}
+
+	e.log.Infow(
+		fmt.Sprintf("updated product with productID %s projected to elastic", evt.ProductID),
+		logger.Fields{"ProductID": evt.ProductID, "MessageID": evt.MessageId},
+	)
+
+	return nil
+}
+
+// onProductDeleted removes the deleted product from the index.
+func (e *elasticProductProjection) onProductDeleted(
+	ctx context.Context,
+	evt *deleteProductExternalEventV1.ProductDeletedV1,
+) error {
+	ctx, span := e.tracer.Start(ctx, "elasticProductProjection.onProductDeleted")
+	span.SetAttributes(attribute.String("ProductID", evt.ProductID))
+	defer span.End()
+
+	err := e.elasticRepository.DeleteProductByID(ctx, models.NewProductReadModelID(evt.ProductID))
+	if err != nil {
+		return utils.TraceErrStatusFromSpan(
+			span,
+			errors.WithMessage(
+				err,
+				fmt.Sprintf("error in projecting deleted product with productID %s", evt.ProductID),
+			),
+		)
+	}
+
+	e.log.Infow(
+		fmt.Sprintf("deleted product with productID %s projected to elastic", evt.ProductID),
+		logger.Fields{"ProductID": evt.ProductID, "MessageID": evt.MessageId},
+	)
+
+	return nil
+}
+
+// onProductRestored indexes the restored product again.
+func (e *elasticProductProjection) onProductRestored(
+	ctx context.Context,
+	evt *restoreProductExternalEventV1.ProductRestoredV1,
+) error {
+	ctx, span := e.tracer.Start(ctx, "elasticProductProjection.onProductRestored")
+	span.SetAttributes(attribute.String("ProductID", evt.ProductID))
+	defer span.End()
+
+	product := &models.Product{
+		ID:          models.NewProductReadModelID(evt.ProductID),
+		ProductID:   evt.ProductID,
+		Name:        evt.Name,
+		Description: evt.Description,
+		Price:       evt.Price,
+		CreatedAt:   evt.CreatedAt,
+	}
+
+	_, err := e.elasticRepository.CreateProduct(ctx, product)
+	if err != nil {
+		return utils.TraceErrStatusFromSpan(
+			span,
+			errors.WithMessage(
+				err,
+				fmt.Sprintf("error in projecting restored product with productID %s", evt.ProductID),
+			),
+		)
+	}
+
+	e.log.Infow(
+		fmt.Sprintf("restored product with productID %s projected to elastic", evt.ProductID),
+		logger.Fields{"ProductID": evt.ProductID, "MessageID": evt.MessageId},
+	)
+
+	return nil
+}
+
+// onProductPurged removes the purged product from the index, it is usually already removed by the delete event.
+func (e *elasticProductProjection) onProductPurged(
+	ctx context.Context,
+	evt *purgeProductExternalEventV1.ProductPurgedV1,
+) error {
+	ctx, span := e.tracer.Start(ctx, "elasticProductProjection.onProductPurged")
+	span.SetAttributes(attribute.String("ProductID", evt.ProductID))
+	defer span.End()
+
+	err := e.elasticRepository.DeleteProductByID(ctx, models.NewProductReadModelID(evt.ProductID))
+	if err != nil {
+		return utils.TraceErrStatusFromSpan(
+			span,
+			errors.WithMessage(
+				err,
+				fmt.Sprintf("error in projecting purged product with productID %s", evt.ProductID),
+			),
+		)
+	}
+
+	e.log.Infow(
+		fmt.Sprintf("purged product with productID %s projected to elastic", evt.ProductID),
+		logger.Fields{"ProductID": evt.ProductID, "MessageID": evt.MessageId},
+	)
+
+	return nil
+}
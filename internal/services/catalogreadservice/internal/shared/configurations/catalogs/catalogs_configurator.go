@@ -6,12 +6,15 @@ import (
 	"net/http"
 
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/fxapp/contracts"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	echo "github.com/labstack/echo/v4"
 	echocontracts "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/customecho/contracts"
 
 	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/config"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/configurations"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/contracts/data"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/shared/configurations/catalogs/infrastructure"
 )
 
@@ -37,6 +40,19 @@ func NewCatalogReadServiceConfigurator(app contracts.Application) *CatalogReadSe
 // ConfigureCatalogs is a method that configures the catalogs.
 func (ic *CatalogReadServiceConfigurator) ConfigureCatalogs() {
 	ic.infrastructureConfigurator.CatalogReadConfigInfra()
+
+	// Catalogs configurations
+	ic.ResolveFunc(
+		func(
+			cfg *config.Config,
+			db *mongo.Client,
+			mongoOptions *mongodb.MongoDbOptions,
+			elasticRepository data.ProductElasticRepository,
+		) error {
+			return ic.ensureCatalogsIndexes(cfg, db, mongoOptions, elasticRepository)
+		},
+	)
+
 	ic.productsModuleConfigurator.ConfigureProductsModule()
 }
 
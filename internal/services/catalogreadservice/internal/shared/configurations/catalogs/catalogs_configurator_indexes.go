@@ -0,0 +1,33 @@
+package catalogs
+
+import (
+	"context"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/config"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/contracts/data"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/data/repositories"
+)
+
+func (ic *CatalogReadServiceConfigurator) ensureCatalogsIndexes(
+	cfg *config.Config,
+	db *mongo.Client,
+	mongoOptions *mongodb.MongoDbOptions,
+	elasticRepository data.ProductElasticRepository,
+) error {
+	ctx := context.Background()
+
+	// creating indexes is idempotent, so they can be ensured on every startup
+	err := repositories.EnsureMongoProductIndexes(ctx, db, mongoOptions)
+	if err != nil {
+		return err
+	}
+
+	if cfg.ReadModelOptions.ElasticProjection {
+		return elasticRepository.EnsureIndex(ctx)
+	}
+
+	return nil
+}
@@ -2,7 +2,9 @@ package infrastructure
 
 import (
 	"github.com/go-playground/validator"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/config/environment"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/elasticsearch"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/grpc"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/health"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
@@ -12,11 +14,15 @@ import (
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/configurations"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/redis"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/schemaregistry"
 	"go.uber.org/fx"
 
+	elasticsearchClient "github.com/elastic/go-elasticsearch/v8"
 	customEcho "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/customecho"
 
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/config"
 	rabbitmq2 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/configurations/rabbitmq"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/contracts/data"
 )
 
 // https://pmihaylov.com/shared-components-go-microservices/
@@ -27,14 +33,30 @@ func NewModule() fx.Option {
 		"infrastructurefx",
 		// Modules
 		core.Module,
+		schemaregistry.Module,
 		customEcho.Module,
 		grpc.Module,
 		mongodb.Module,
+		// the elastic read model is optional, so elasticsearch is only used with the elastic projection
+		fx.Provide(newElasticClient),
 		redis.Module,
 		rabbitmq.ModuleFunc(
-			func(v *validator.Validate, l logger.Logger, tracer tracing.AppTracer) configurations.RabbitMQConfigurationBuilderFuc {
+			func(
+				v *validator.Validate,
+				l logger.Logger,
+				tracer tracing.AppTracer,
+				cfg *config.Config,
+				elasticRepository data.ProductElasticRepository,
+			) configurations.RabbitMQConfigurationBuilderFuc {
 				return func(builder configurations.RabbitMQConfigurationBuilder) {
-					rabbitmq2.ConfigProductsRabbitMQ(builder, l, v, tracer)
+					rabbitmq2.ConfigProductsRabbitMQ(
+						builder,
+						l,
+						v,
+						tracer,
+						&cfg.ReadModelOptions,
+						elasticRepository,
+					)
 				}
 			},
 		),
@@ -46,3 +68,18 @@ func NewModule() fx.Option {
 		fx.Provide(validator.New),
 	)
 }
+
+// newElasticClient creates the elasticsearch client of the elastic read model, the client is nil and the elastic
+// options aren't required without the elastic projection.
+func newElasticClient(cfg *config.Config, env environment.Environment) (*elasticsearchClient.Client, error) {
+	if !cfg.ReadModelOptions.ElasticProjection {
+		return nil, nil
+	}
+
+	elasticOptions, err := elasticsearch.ProvideConfig(env)
+	if err != nil {
+		return nil, err
+	}
+
+	return elasticsearch.NewElasticClient(elasticOptions)
+}
@@ -32,6 +32,12 @@ import (
 	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/shared/app/test"
 )
 
+// SeededMinPrice and SeededMaxPrice are the price range of the seeded products.
+const (
+	SeededMinPrice = 100.0
+	SeededMaxPrice = 1000.0
+)
+
 // CatalogReadIntegrationTestSharedFixture is a shared fixture for integration tests.
 type CatalogReadIntegrationTestSharedFixture struct {
 	Cfg                    *config.Config
@@ -230,7 +236,7 @@ func seedData(
 			Name:        gofakeit.Name(),
 			CreatedAt:   time.Now(),
 			Description: gofakeit.AdjectiveDescriptive(),
-			Price:       gofakeit.Price(100, 1000),
+			Price:       gofakeit.Price(SeededMinPrice, SeededMaxPrice),
 		},
 		{
 			ID:          uuid.NewV4().String(),
@@ -238,7 +244,7 @@ func seedData(
 			Name:        gofakeit.Name(),
 			CreatedAt:   time.Now(),
 			Description: gofakeit.AdjectiveDescriptive(),
-			Price:       gofakeit.Price(100, 1000),
+			Price:       gofakeit.Price(SeededMinPrice, SeededMaxPrice),
 		},
 	}
 
@@ -281,6 +287,11 @@ func seedData(
 
 // cleanupRabbitmqData cleans up the rabbitmq data.
 func (i *CatalogReadIntegrationTestSharedFixture) cleanupRabbitmqData() error {
+	// the in-memory bus has no rabbitmq server to clean up
+	if i.rabbitmqOptions.InMemory {
+		return nil
+	}
+
 	// https://github.com/michaelklishin/rabbit-hole
 	// Get all queues
 	queues, err := i.RabbitmqCleaner.ListQueuesIn(
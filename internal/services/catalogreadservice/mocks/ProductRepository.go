@@ -308,29 +308,29 @@ func (_c *ProductRepository_GetProductByProductID_Call) RunAndReturn(run func(co
 	return _c
 }
 
-// SearchProducts provides a mock function with given fields: ctx, searchText, listQuery
-func (_m *ProductRepository) SearchProducts(ctx context.Context, searchText string, listQuery *utils.ListQuery) (*utils.ListResult[*models.Product], error) {
-	ret := _m.Called(ctx, searchText, listQuery)
+// SearchProducts provides a mock function with given fields: ctx, criteria, listQuery
+func (_m *ProductRepository) SearchProducts(ctx context.Context, criteria *models.ProductSearchCriteria, listQuery *utils.ListQuery) (*utils.ListResult[*models.ProductSearchHit], error) {
+	ret := _m.Called(ctx, criteria, listQuery)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SearchProducts")
 	}
 
-	var r0 *utils.ListResult[*models.Product]
+	var r0 *utils.ListResult[*models.ProductSearchHit]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, *utils.ListQuery) (*utils.ListResult[*models.Product], error)); ok {
-		return rf(ctx, searchText, listQuery)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ProductSearchCriteria, *utils.ListQuery) (*utils.ListResult[*models.ProductSearchHit], error)); ok {
+		return rf(ctx, criteria, listQuery)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, *utils.ListQuery) *utils.ListResult[*models.Product]); ok {
-		r0 = rf(ctx, searchText, listQuery)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ProductSearchCriteria, *utils.ListQuery) *utils.ListResult[*models.ProductSearchHit]); ok {
+		r0 = rf(ctx, criteria, listQuery)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*utils.ListResult[*models.Product])
+			r0 = ret.Get(0).(*utils.ListResult[*models.ProductSearchHit])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, *utils.ListQuery) error); ok {
-		r1 = rf(ctx, searchText, listQuery)
+	if rf, ok := ret.Get(1).(func(context.Context, *models.ProductSearchCriteria, *utils.ListQuery) error); ok {
+		r1 = rf(ctx, criteria, listQuery)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -345,25 +345,25 @@ type ProductRepository_SearchProducts_Call struct {
 
 // SearchProducts is a helper method to define mock.On call
 //   - ctx context.Context
-//   - searchText string
+//   - criteria *models.ProductSearchCriteria
 //   - listQuery *utils.ListQuery
-func (_e *ProductRepository_Expecter) SearchProducts(ctx interface{}, searchText interface{}, listQuery interface{}) *ProductRepository_SearchProducts_Call {
-	return &ProductRepository_SearchProducts_Call{Call: _e.mock.On("SearchProducts", ctx, searchText, listQuery)}
+func (_e *ProductRepository_Expecter) SearchProducts(ctx interface{}, criteria interface{}, listQuery interface{}) *ProductRepository_SearchProducts_Call {
+	return &ProductRepository_SearchProducts_Call{Call: _e.mock.On("SearchProducts", ctx, criteria, listQuery)}
 }
 
-func (_c *ProductRepository_SearchProducts_Call) Run(run func(ctx context.Context, searchText string, listQuery *utils.ListQuery)) *ProductRepository_SearchProducts_Call {
+func (_c *ProductRepository_SearchProducts_Call) Run(run func(ctx context.Context, criteria *models.ProductSearchCriteria, listQuery *utils.ListQuery)) *ProductRepository_SearchProducts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(*utils.ListQuery))
+		run(args[0].(context.Context), args[1].(*models.ProductSearchCriteria), args[2].(*utils.ListQuery))
 	})
 	return _c
 }
 
-func (_c *ProductRepository_SearchProducts_Call) Return(_a0 *utils.ListResult[*models.Product], _a1 error) *ProductRepository_SearchProducts_Call {
+func (_c *ProductRepository_SearchProducts_Call) Return(_a0 *utils.ListResult[*models.ProductSearchHit], _a1 error) *ProductRepository_SearchProducts_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ProductRepository_SearchProducts_Call) RunAndReturn(run func(context.Context, string, *utils.ListQuery) (*utils.ListResult[*models.Product], error)) *ProductRepository_SearchProducts_Call {
+func (_c *ProductRepository_SearchProducts_Call) RunAndReturn(run func(context.Context, *models.ProductSearchCriteria, *utils.ListQuery) (*utils.ListResult[*models.ProductSearchHit], error)) *ProductRepository_SearchProducts_Call {
 	_c.Call.Return(run)
 	return _c
 }
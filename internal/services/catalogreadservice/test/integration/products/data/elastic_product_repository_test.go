@@ -0,0 +1,125 @@
+//go:build integration
+// +build integration
+
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/elasticsearch"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger/defaultlogger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/utils"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+	elasticsearchContainer "github.com/raphaeldiscky/go-food-micro/internal/pkg/test/containers/testcontainer/elasticsearch"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/config"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/data/repositories"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/models"
+)
+
+func TestProductElasticRepository(t *testing.T) {
+	ctx := context.Background()
+	log := defaultlogger.GetLogger()
+
+	elasticOptions, err := elasticsearchContainer.NewElasticsearchTestContainers(log).
+		PopulateContainerOptions(ctx, t)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := elasticsearch.NewElasticClient(elasticOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repository := repositories.NewElasticProductRepository(
+		log,
+		client,
+		&config.Config{ElasticIndexes: config.ElasticIndexes{Products: "products-" + uuid.NewV4().String()}},
+		tracing.NewAppTracer("test"),
+	)
+	if err := repository.EnsureIndex(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	productID := uuid.NewV4().String()
+	product := &models.Product{
+		ID:          models.NewProductReadModelID(productID),
+		ProductID:   productID,
+		Name:        "arabica coffee",
+		Description: "a roasted arabica coffee",
+		Price:       120,
+		CreatedAt:   time.Now(),
+	}
+
+	// scenario
+	Convey("Elasticsearch Product Repository", t, func() {
+		Convey("When we index a new product", func() {
+			_, err := repository.CreateProduct(ctx, product)
+			So(err, ShouldBeNil)
+
+			Convey("Then we should be able to retrieve the product by ID and product ID", func() {
+				byID, err := repository.GetProductByID(ctx, product.ID)
+				So(err, ShouldBeNil)
+				So(byID.Name, ShouldEqual, product.Name)
+
+				byProductID, err := repository.GetProductByProductID(ctx, productID)
+				So(err, ShouldBeNil)
+				So(byProductID, ShouldNotBeNil)
+				So(byProductID.ID, ShouldEqual, product.ID)
+			})
+
+			Convey("Then the search should match the product only within its price range", func() {
+				inRange, outOfRange := 100.0, 110.0
+				found, err := repository.SearchProducts(
+					ctx,
+					&models.ProductSearchCriteria{SearchText: "arabica", MinPrice: &inRange},
+					utils.NewListQuery(10, 1),
+				)
+				So(err, ShouldBeNil)
+				So(found.Items, ShouldHaveLength, 1)
+				So(found.Items[0].ProductID, ShouldEqual, productID)
+
+				excluded, err := repository.SearchProducts(
+					ctx,
+					&models.ProductSearchCriteria{SearchText: "arabica", MaxPrice: &outOfRange},
+					utils.NewListQuery(10, 1),
+				)
+				So(err, ShouldBeNil)
+				So(excluded.Items, ShouldBeEmpty)
+			})
+
+			Convey("Then updating the product should re-index its fields", func() {
+				updated := *product
+				updated.Name = "robusta coffee"
+				_, err := repository.UpdateProduct(ctx, &updated)
+				So(err, ShouldBeNil)
+
+				byID, err := repository.GetProductByID(ctx, product.ID)
+				So(err, ShouldBeNil)
+				So(byID.Name, ShouldEqual, updated.Name)
+			})
+		})
+
+		Convey("When we delete the product", func() {
+			So(repository.DeleteProductByID(ctx, product.ID), ShouldBeNil)
+
+			Convey("Then retrieving the product should fail with a not found error", func() {
+				deleted, err := repository.GetProductByID(ctx, product.ID)
+				So(customErrors.IsNotFoundError(err), ShouldBeTrue)
+				So(deleted, ShouldBeNil)
+
+				byProductID, err := repository.GetProductByProductID(ctx, productID)
+				So(err, ShouldBeNil)
+				So(byProductID, ShouldBeNil)
+			})
+		})
+	})
+}
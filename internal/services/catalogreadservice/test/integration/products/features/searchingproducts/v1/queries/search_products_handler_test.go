@@ -0,0 +1,106 @@
+//go:build integration
+// +build integration
+
+package queries
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/utils"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	mediatr "github.com/mehdihadeli/go-mediatr"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/searchingproducts/v1/dtos"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/searchingproducts/v1/queries"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/shared/testfixture/integration"
+)
+
+func TestSearchProducts(t *testing.T) {
+	integrationTestSharedFixture := integration.NewCatalogReadIntegrationTestSharedFixture(t)
+
+	Convey("Search Products Feature", t, func() {
+		ctx := context.Background()
+		integrationTestSharedFixture.SetupTest(t)
+
+		// https://specflow.org/learn/gherkin/#learn-gherkin
+		// scenario
+		Convey("Searching existing products by a term of their name", func() {
+			Convey("Given an existing product in the system", func() {
+				product := integrationTestSharedFixture.Items[0]
+				term := strings.Fields(product.Name)[0]
+
+				Convey("When SearchProducts query executed with highlighting", func() {
+					query := &queries.SearchProducts{
+						SearchText: term,
+						Highlight:  true,
+						ListQuery:  utils.NewListQuery(10, 1),
+					}
+
+					queryResult, err := mediatr.Send[*queries.SearchProducts, *dtos.SearchProductsResponseDto](
+						ctx,
+						query,
+					)
+
+					Convey("Then the matched products should be ranked and highlighted", func() {
+						So(err, ShouldBeNil)
+						So(queryResult, ShouldNotBeNil)
+						So(queryResult.Products.Items, ShouldNotBeEmpty)
+
+						hit := queryResult.Products.Items[0]
+						So(hit.Score, ShouldBeGreaterThan, 0.0)
+						So(hit.Highlights["name"], ShouldNotBeEmpty)
+						So(hit.Highlights["name"][0], ShouldContainSubstring, "<em>")
+					})
+				})
+
+				Convey("When SearchProducts query executed with a price range excluding the product", func() {
+					// a range below the seeded prices excludes every seeded product
+					minPrice := 0.0
+					maxPrice := integration.SeededMinPrice / 2
+					query := &queries.SearchProducts{
+						SearchText: product.Name,
+						MinPrice:   &minPrice,
+						MaxPrice:   &maxPrice,
+						ListQuery:  utils.NewListQuery(10, 1),
+					}
+
+					queryResult, err := mediatr.Send[*queries.SearchProducts, *dtos.SearchProductsResponseDto](
+						ctx,
+						query,
+					)
+
+					Convey("Then the product should not be returned", func() {
+						So(err, ShouldBeNil)
+						for _, hit := range queryResult.Products.Items {
+							So(hit.ProductID, ShouldNotEqual, product.ProductID)
+						}
+					})
+				})
+
+				Convey("When SearchProducts query executed with regex syntax in the search text", func() {
+					query := &queries.SearchProducts{
+						SearchText: ".*",
+						Fuzzy:      true,
+						ListQuery:  utils.NewListQuery(10, 1),
+					}
+
+					queryResult, err := mediatr.Send[*queries.SearchProducts, *dtos.SearchProductsResponseDto](
+						ctx,
+						query,
+					)
+
+					Convey("Then the search text should be matched literally", func() {
+						So(err, ShouldBeNil)
+						So(queryResult.Products.Items, ShouldBeEmpty)
+					})
+				})
+			})
+		})
+
+		integrationTestSharedFixture.TearDownTest()
+	})
+}
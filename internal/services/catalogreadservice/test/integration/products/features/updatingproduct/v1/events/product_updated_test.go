@@ -17,6 +17,7 @@ import (
 	testutils "github.com/raphaeldiscky/go-food-micro/internal/pkg/test/utils"
 	uuid "github.com/satori/go.uuid"
 
+	createExternalEvents "github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/creatingproduct/v1/events/integrationevents/externalevents"
 	externalEvents "github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/updatingproducts/v1/events/integrationevents/externalevents"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/models"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/shared/testfixture/integration"
@@ -143,6 +144,58 @@ func TestProductUpdatedConsumer(t *testing.T) {
 			},
 		)
 
+		// https://specflow.org/learn/gherkin/#learn-gherkin
+		// scenario
+		Convey(
+			"Update a new product in mongo database when its ProductUpdated event is published right after its ProductCreated event",
+			func() {
+				productCreated := &createExternalEvents.ProductCreatedV1{
+					Message:     types.NewMessage(uuid.NewV4().String()),
+					ProductID:   uuid.NewV4().String(),
+					Name:        gofakeit.Name(),
+					Price:       gofakeit.Price(100, 1000),
+					Description: gofakeit.EmojiDescription(),
+					CreatedAt:   time.Now(),
+				}
+				productUpdated := &externalEvents.ProductUpdatedV1{
+					Message:     types.NewMessage(uuid.NewV4().String()),
+					ProductID:   productCreated.ProductID,
+					Name:        gofakeit.Name(),
+					Price:       gofakeit.Price(100, 1000),
+					Description: gofakeit.EmojiDescription(),
+					UpdatedAt:   time.Now(),
+				}
+
+				Convey("When the ProductCreated and the ProductUpdated events consumed", func() {
+					err := integrationTestSharedFixture.Bus.PublishMessage(ctx, productCreated, nil)
+					So(err, ShouldBeNil)
+					err = integrationTestSharedFixture.Bus.PublishMessage(ctx, productUpdated, nil)
+					So(err, ShouldBeNil)
+
+					Convey(
+						"Then It should apply the ProductUpdated event after the ProductCreated event",
+						func() {
+							var product *models.Product
+
+							err = testutils.WaitUntilConditionMet(func() bool {
+								product, err = integrationTestSharedFixture.ProductRepository.GetProductByProductID(
+									ctx,
+									productCreated.ProductID,
+								)
+
+								return product != nil &&
+									product.Name == productUpdated.Name
+							})
+
+							So(err, ShouldBeNil)
+							So(product, ShouldNotBeNil)
+							So(product.Price, ShouldEqual, productUpdated.Price)
+						},
+					)
+				})
+			},
+		)
+
 		integrationTestSharedFixture.TearDownTest()
 	})
 
@@ -0,0 +1,205 @@
+//go:build unit
+// +build unit
+
+package projections
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/go-playground/validator"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/consumer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger/defaultlogger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	uuid "github.com/satori/go.uuid"
+
+	createProductExternalEventV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/creatingproduct/v1/events/integrationevents/externalevents"
+	deleteProductExternalEventV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/deletingproducts/v1/events/integrationevents/externalevents"
+	updateProductExternalEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/features/updatingproducts/v1/events/integrationevents/externalevents"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/models"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/projections"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/mocks"
+)
+
+// elasticRepositoryMock is the product repository mock with the elastic index operations.
+type elasticRepositoryMock struct {
+	*mocks.ProductRepository
+}
+
+// EnsureIndex ensures the products index.
+func (m *elasticRepositoryMock) EnsureIndex(_ context.Context) error {
+	return nil
+}
+
+type elasticProductProjectionUnitTests struct {
+	suite.Suite
+	repository *mocks.ProductRepository
+	projection consumer.ConsumerHandler
+}
+
+func TestElasticProductProjectionUnit(t *testing.T) {
+	suite.Run(t, &elasticProductProjectionUnitTests{})
+}
+
+func (s *elasticProductProjectionUnitTests) SetupTest() {
+	s.repository = mocks.NewProductRepository(s.T())
+	s.projection = projections.NewElasticProductProjection(
+		&elasticRepositoryMock{ProductRepository: s.repository},
+		validator.New(),
+		defaultlogger.GetLogger(),
+		tracing.NewAppTracer("test"),
+	)
+}
+
+func (s *elasticProductProjectionUnitTests) handle(message types.IMessage) error {
+	return s.projection.Handle(
+		context.Background(),
+		types.NewMessageConsumeContext(message, nil, "", "", time.Now(), 0, "", ""),
+	)
+}
+
+func (s *elasticProductProjectionUnitTests) Test_Product_Created_Should_Index_The_Product() {
+	event := &createProductExternalEventV1.ProductCreatedV1{
+		Message:   types.NewMessage(uuid.NewV4().String()),
+		ProductID: uuid.NewV4().String(),
+		Name:      "coffee",
+		Price:     120,
+		CreatedAt: time.Now(),
+	}
+
+	s.repository.EXPECT().GetProductByProductID(mock.Anything, event.ProductID).Return(nil, nil)
+	s.repository.EXPECT().
+		CreateProduct(mock.Anything, mock.MatchedBy(func(product *models.Product) bool {
+			return product.ID == models.NewProductReadModelID(event.ProductID) &&
+				product.ProductID == event.ProductID &&
+				product.Name == event.Name &&
+				product.Price == event.Price
+		})).
+		Return(&models.Product{}, nil)
+
+	s.Require().NoError(s.handle(event))
+}
+
+func (s *elasticProductProjectionUnitTests) Test_Product_Created_After_Updated_Should_Only_Set_The_Creation_Time() {
+	event := &createProductExternalEventV1.ProductCreatedV1{
+		Message:   types.NewMessage(uuid.NewV4().String()),
+		ProductID: uuid.NewV4().String(),
+		Name:      "coffee",
+		Price:     120,
+		CreatedAt: time.Now().Add(-time.Hour),
+	}
+	updated := &models.Product{
+		ID:        models.NewProductReadModelID(event.ProductID),
+		ProductID: event.ProductID,
+		Name:      "tea",
+		Price:     150,
+	}
+
+	s.repository.EXPECT().GetProductByProductID(mock.Anything, event.ProductID).Return(updated, nil)
+	s.repository.EXPECT().
+		UpdateProduct(mock.Anything, mock.MatchedBy(func(product *models.Product) bool {
+			return product.Name == "tea" && product.Price == 150 && product.CreatedAt.Equal(event.CreatedAt)
+		})).
+		Return(updated, nil)
+
+	s.Require().NoError(s.handle(event))
+}
+
+func (s *elasticProductProjectionUnitTests) Test_Product_Created_Again_Should_Be_Skipped() {
+	event := &createProductExternalEventV1.ProductCreatedV1{
+		Message:   types.NewMessage(uuid.NewV4().String()),
+		ProductID: uuid.NewV4().String(),
+		Name:      "coffee",
+		Price:     120,
+		CreatedAt: time.Now(),
+	}
+	existing := &models.Product{
+		ID:        models.NewProductReadModelID(event.ProductID),
+		ProductID: event.ProductID,
+		Name:      "tea",
+		CreatedAt: event.CreatedAt,
+	}
+
+	s.repository.EXPECT().GetProductByProductID(mock.Anything, event.ProductID).Return(existing, nil)
+
+	s.Require().NoError(s.handle(event))
+}
+
+func (s *elasticProductProjectionUnitTests) Test_Product_Updated_Should_Keep_The_Fields_Not_In_The_Event() {
+	createdAt := time.Now().Add(-time.Hour)
+	event := &updateProductExternalEventsV1.ProductUpdatedV1{
+		Message:   types.NewMessage(uuid.NewV4().String()),
+		ProductID: uuid.NewV4().String(),
+		Name:      "tea",
+		Price:     150,
+		UpdatedAt: time.Now(),
+	}
+	existing := &models.Product{
+		ID:        models.NewProductReadModelID(event.ProductID),
+		ProductID: event.ProductID,
+		Name:      "coffee",
+		CreatedAt: createdAt,
+	}
+
+	s.repository.EXPECT().GetProductByProductID(mock.Anything, event.ProductID).Return(existing, nil)
+	s.repository.EXPECT().
+		UpdateProduct(mock.Anything, mock.MatchedBy(func(product *models.Product) bool {
+			return product.Name == event.Name && product.Price == event.Price && product.CreatedAt.Equal(createdAt)
+		})).
+		Return(existing, nil)
+
+	s.Require().NoError(s.handle(event))
+}
+
+func (s *elasticProductProjectionUnitTests) Test_Product_Updated_Before_Created_Should_Upsert_The_Product() {
+	event := &updateProductExternalEventsV1.ProductUpdatedV1{
+		Message:   types.NewMessage(uuid.NewV4().String()),
+		ProductID: uuid.NewV4().String(),
+		Name:      "tea",
+		Price:     150,
+		CreatedAt: time.Now().Add(-time.Hour),
+	}
+
+	s.repository.EXPECT().GetProductByProductID(mock.Anything, event.ProductID).Return(nil, nil)
+	s.repository.EXPECT().
+		UpdateProduct(mock.Anything, mock.MatchedBy(func(product *models.Product) bool {
+			return product.ID == models.NewProductReadModelID(event.ProductID) &&
+				product.Name == event.Name &&
+				product.CreatedAt.Equal(event.CreatedAt)
+		})).
+		Return(&models.Product{}, nil)
+
+	s.Require().NoError(s.handle(event))
+}
+
+func (s *elasticProductProjectionUnitTests) Test_Product_Deleted_Should_Remove_The_Product() {
+	event := &deleteProductExternalEventV1.ProductDeletedV1{
+		Message:   types.NewMessage(uuid.NewV4().String()),
+		ProductID: uuid.NewV4().String(),
+	}
+
+	s.repository.EXPECT().
+		DeleteProductByID(mock.Anything, models.NewProductReadModelID(event.ProductID)).
+		Return(nil)
+
+	s.Require().NoError(s.handle(event))
+}
+
+func (s *elasticProductProjectionUnitTests) Test_Repository_Error_Should_Fail_The_Projection() {
+	event := &deleteProductExternalEventV1.ProductDeletedV1{
+		Message:   types.NewMessage(uuid.NewV4().String()),
+		ProductID: uuid.NewV4().String(),
+	}
+
+	s.repository.EXPECT().
+		DeleteProductByID(mock.Anything, mock.Anything).
+		Return(errors.New("index unavailable"))
+
+	s.Require().ErrorContains(s.handle(event), "index unavailable")
+}
@@ -0,0 +1,37 @@
+//go:build unit
+// +build unit
+
+package schemas
+
+import (
+	"testing"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/constants"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/schemaregistry"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogreadservice/internal/products/configurations/schemas"
+)
+
+type externalEventsSchemasUnitTests struct {
+	suite.Suite
+	registry *schemaregistry.Registry
+}
+
+func TestExternalEventsSchemasUnit(t *testing.T) {
+	suite.Run(t, &externalEventsSchemasUnitTests{})
+}
+
+func (s *externalEventsSchemasUnitTests) SetupSuite() {
+	options, err := schemaregistry.ProvideConfig(constants.Test)
+	s.Require().NoError(err)
+
+	s.registry = schemaregistry.NewRegistryFromOptions(options)
+	schemas.ConfigProductsSchemas(s.registry)
+}
+
+// TestConsumersShouldReadTheProducerSchemas tests the external events can read all the stored schema versions of
+// the catalogs write service.
+func (s *externalEventsSchemasUnitTests) TestConsumersShouldReadTheProducerSchemas() {
+	s.Require().NoError(s.registry.CheckReaders())
+}
@@ -14,6 +14,7 @@ func NewModule() fx.Option {
 		// - execute its func only if it requested
 		fx.Provide(
 			NewAppOptions,
+			NewPriceSchedulerOptions,
 		),
 	)
 }
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"time"
+
+	"github.com/iancoleman/strcase"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/config"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/config/environment"
+
+	typeMapper "github.com/raphaeldiscky/go-food-micro/internal/pkg/reflection/typemapper"
+)
+
+const (
+	defaultPriceSchedulerPollInterval = 10 * time.Second
+	defaultPriceSchedulerBatchSize    = 100
+)
+
+// PriceSchedulerOptions is a struct that contains the scheduled product price changes worker options.
+type PriceSchedulerOptions struct {
+	Enabled             bool `mapstructure:"enabled"             env:"Enabled"`
+	PollIntervalSeconds int  `mapstructure:"pollIntervalSeconds" env:"PollIntervalSeconds"`
+	BatchSize           int  `mapstructure:"batchSize"           env:"BatchSize"`
+}
+
+// NewPriceSchedulerOptions is a constructor for the PriceSchedulerOptions.
+func NewPriceSchedulerOptions(env environment.Environment) (*PriceSchedulerOptions, error) {
+	optionName := strcase.ToLowerCamel(typeMapper.GetGenericTypeNameByT[PriceSchedulerOptions]())
+	cfg, err := config.BindConfigKey[*PriceSchedulerOptions](optionName, env)
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// PollInterval returns the interval between checks for due price changes.
+func (o *PriceSchedulerOptions) PollInterval() time.Duration {
+	if o.PollIntervalSeconds <= 0 {
+		return defaultPriceSchedulerPollInterval
+	}
+
+	return time.Duration(o.PollIntervalSeconds) * time.Second
+}
+
+// GetBatchSize returns the maximum number of due price changes applied in one poll.
+func (o *PriceSchedulerOptions) GetBatchSize() int {
+	if o.BatchSize <= 0 {
+		return defaultPriceSchedulerBatchSize
+	}
+
+	return o.BatchSize
+}
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS scheduled_product_price_changes;
+DROP TABLE IF EXISTS product_price_histories;
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS product_price_histories
+(
+    id          uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    product_id  uuid NOT NULL REFERENCES products (id),
+    old_price   numeric,
+    new_price   numeric NOT NULL,
+    reason      text,
+    scheduled_price_change_id uuid,
+    changed_at  timestamp with time zone NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_price_histories_product_id_changed_at
+    ON product_price_histories (product_id, changed_at DESC);
+
+CREATE TABLE IF NOT EXISTS scheduled_product_price_changes
+(
+    id           uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    product_id   uuid NOT NULL REFERENCES products (id),
+    new_price    numeric NOT NULL,
+    effective_at timestamp with time zone NOT NULL,
+    status       text NOT NULL,
+    error        text,
+    applied_at   timestamp with time zone,
+    created_at   timestamp with time zone,
+    updated_at   timestamp with time zone
+);
+
+CREATE INDEX IF NOT EXISTS idx_scheduled_product_price_changes_status_effective_at
+    ON scheduled_product_price_changes (status, effective_at);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS audit_logs
+(
+    id          uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    table_name  text NOT NULL,
+    record_id   text NOT NULL,
+    action      text NOT NULL,
+    actor       text NOT NULL,
+    before      jsonb,
+    after       jsonb,
+    changed_at  timestamp with time zone NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_table_name_record_id_changed_at
+    ON audit_logs (table_name, record_id, changed_at DESC);
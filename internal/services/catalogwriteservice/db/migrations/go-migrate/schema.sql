@@ -10,5 +10,48 @@ CREATE TABLE "public"."products" (
   "price" numeric NULL,
   "created_at" timestamptz NULL,
   "updated_at" timestamptz NULL,
+  "version" bigint NOT NULL DEFAULT 1,
   PRIMARY KEY ("product_id")
 );
+-- Create "product_price_histories" table
+CREATE TABLE "public"."product_price_histories" (
+  "id" uuid NOT NULL DEFAULT uuid_generate_v4(),
+  "product_id" uuid NOT NULL,
+  "old_price" numeric NULL,
+  "new_price" numeric NOT NULL,
+  "reason" text NULL,
+  "scheduled_price_change_id" uuid NULL,
+  "changed_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_product_price_histories_product_id_changed_at" to table: "product_price_histories"
+CREATE INDEX "idx_product_price_histories_product_id_changed_at" ON "public"."product_price_histories" ("product_id", "changed_at" DESC);
+-- Create "scheduled_product_price_changes" table
+CREATE TABLE "public"."scheduled_product_price_changes" (
+  "id" uuid NOT NULL DEFAULT uuid_generate_v4(),
+  "product_id" uuid NOT NULL,
+  "new_price" numeric NOT NULL,
+  "effective_at" timestamptz NOT NULL,
+  "status" text NOT NULL,
+  "error" text NULL,
+  "applied_at" timestamptz NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_scheduled_product_price_changes_status_effective_at" to table: "scheduled_product_price_changes"
+CREATE INDEX "idx_scheduled_product_price_changes_status_effective_at" ON "public"."scheduled_product_price_changes" ("status", "effective_at");
+-- Create "audit_logs" table
+CREATE TABLE "public"."audit_logs" (
+  "id" uuid NOT NULL DEFAULT uuid_generate_v4(),
+  "table_name" text NOT NULL,
+  "record_id" text NOT NULL,
+  "action" text NOT NULL,
+  "actor" text NOT NULL,
+  "before" jsonb NULL,
+  "after" jsonb NULL,
+  "changed_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_audit_logs_table_name_record_id_changed_at" to table: "audit_logs"
+CREATE INDEX "idx_audit_logs_table_name_record_id_changed_at" ON "public"."audit_logs" ("table_name", "record_id", "changed_at" DESC);
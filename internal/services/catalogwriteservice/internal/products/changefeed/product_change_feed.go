@@ -0,0 +1,168 @@
+// Package changefeed contains the in-process feed of the product changes.
+package changefeed
+
+import (
+	"sync"
+	"time"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/producer"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+
+	dtoV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1"
+	changingProductPriceEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/changingproductprice/v1/events/integrationevents"
+	creatingProductEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/creatingproduct/v1/events/integrationevents"
+	deletingProductEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/deletingproduct/v1/events/integrationevents"
+	purgingProductEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/purgingproduct/v1/events/integrationevents"
+	restoringProductEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/restoringproduct/v1/events/integrationevents"
+	updatingProductEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/updatingproduct/v1/events/integrationevents"
+)
+
+// DefaultBufferSize is the number of changes a subscriber can fall behind before it is dropped.
+const DefaultBufferSize = 64
+
+// ChangeType is the kind of change applied to a product.
+type ChangeType string
+
+// the change types of the product integration events.
+const (
+	ChangeTypeCreated      ChangeType = "created"
+	ChangeTypeUpdated      ChangeType = "updated"
+	ChangeTypeDeleted      ChangeType = "deleted"
+	ChangeTypeRestored     ChangeType = "restored"
+	ChangeTypePurged       ChangeType = "purged"
+	ChangeTypePriceChanged ChangeType = "price_changed"
+)
+
+// ProductChange is a change of a product, the product is only set for the created, updated and restored changes.
+type ProductChange struct {
+	MessageID  string
+	ChangeType ChangeType
+	ProductID  string
+	Product    *dtoV1.ProductDto
+	OccurredAt time.Time
+}
+
+// Subscription receives the product changes published after it was created.
+type Subscription struct {
+	changes chan *ProductChange
+	lagged  bool
+}
+
+// Changes returns the channel of the changes, it is closed when the subscription is canceled or dropped.
+func (s *Subscription) Changes() <-chan *ProductChange {
+	return s.changes
+}
+
+// Lagged reports whether the subscription was dropped because it didn't keep up with the changes,
+// it is only meaningful after the changes channel is closed.
+func (s *Subscription) Lagged() bool {
+	return s.lagged
+}
+
+// ProductChangeFeed fans the product integration events published by this instance out to its subscribers.
+type ProductChangeFeed struct {
+	mu          sync.Mutex
+	subscribers map[*Subscription]struct{}
+	bufferSize  int
+}
+
+// NewProductChangeFeed is a constructor for the ProductChangeFeed, it listens to the messages produced by the producer.
+func NewProductChangeFeed(rabbitmqProducer producer.Producer) *ProductChangeFeed {
+	feed := newProductChangeFeed(DefaultBufferSize)
+	rabbitmqProducer.IsProduced(feed.onProduced)
+
+	return feed
+}
+
+// newProductChangeFeed creates a feed with the given subscriber buffer size.
+func newProductChangeFeed(bufferSize int) *ProductChangeFeed {
+	return &ProductChangeFeed{
+		subscribers: make(map[*Subscription]struct{}),
+		bufferSize:  bufferSize,
+	}
+}
+
+// Subscribe creates a subscription, the returned cancel func must be called when the subscriber is done.
+func (f *ProductChangeFeed) Subscribe() (*Subscription, func()) {
+	sub := &Subscription{changes: make(chan *ProductChange, f.bufferSize)}
+
+	f.mu.Lock()
+	f.subscribers[sub] = struct{}{}
+	f.mu.Unlock()
+
+	return sub, func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		f.remove(sub)
+	}
+}
+
+// Publish sends the change to all subscribers without blocking, a subscriber with a full buffer is dropped.
+func (f *ProductChangeFeed) Publish(change *ProductChange) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for sub := range f.subscribers {
+		select {
+		case sub.changes <- change:
+		default:
+			sub.lagged = true
+			f.remove(sub)
+		}
+	}
+}
+
+// remove closes and removes a subscription, the lock must be held.
+func (f *ProductChangeFeed) remove(sub *Subscription) {
+	if _, ok := f.subscribers[sub]; !ok {
+		return
+	}
+
+	delete(f.subscribers, sub)
+	close(sub.changes)
+}
+
+// onProduced publishes the product integration events, the other messages are ignored.
+func (f *ProductChangeFeed) onProduced(message types.IMessage) {
+	if change := toProductChange(message); change != nil {
+		f.Publish(change)
+	}
+}
+
+// toProductChange maps a product integration event to a change.
+func toProductChange(message types.IMessage) *ProductChange {
+	change := &ProductChange{
+		MessageID:  message.GeMessageId(),
+		OccurredAt: message.GetCreated(),
+	}
+
+	switch event := message.(type) {
+	case *creatingProductEventsV1.ProductCreatedV1:
+		change.ChangeType = ChangeTypeCreated
+		change.Product = event.ProductDto
+	case *updatingProductEventsV1.ProductUpdatedV1:
+		change.ChangeType = ChangeTypeUpdated
+		change.Product = event.ProductDto
+	case *restoringProductEventsV1.ProductRestoredV1:
+		change.ChangeType = ChangeTypeRestored
+		change.Product = event.ProductDto
+	case *deletingProductEventsV1.ProductDeletedV1:
+		change.ChangeType = ChangeTypeDeleted
+		change.ProductID = event.ProductID
+	case *purgingProductEventsV1.ProductPurgedV1:
+		change.ChangeType = ChangeTypePurged
+		change.ProductID = event.ProductID
+	case *changingProductPriceEventsV1.ProductPriceChangedV1:
+		change.ChangeType = ChangeTypePriceChanged
+		change.ProductID = event.ProductID.String()
+	default:
+		return nil
+	}
+
+	if change.Product != nil {
+		change.ProductID = change.Product.ID.String()
+	}
+
+	return change
+}
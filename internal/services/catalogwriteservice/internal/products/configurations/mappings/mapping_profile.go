@@ -64,6 +64,36 @@ func configureModelMappings() error {
 		return err
 	}
 
+	return configurePriceMappings()
+}
+
+// configurePriceMappings sets up the product price history and scheduled price change mappings.
+func configurePriceMappings() error {
+	// ProductPriceHistory to ProductPriceHistoryDataModel
+	if err := mapper.CreateMap[*models.ProductPriceHistory, *datamodel.ProductPriceHistoryDataModel](); err != nil {
+		return err
+	}
+
+	// ProductPriceHistoryDataModel to ProductPriceHistory
+	if err := mapper.CreateMap[*datamodel.ProductPriceHistoryDataModel, *models.ProductPriceHistory](); err != nil {
+		return err
+	}
+
+	// ProductPriceHistory to ProductPriceHistoryDto
+	if err := mapper.CreateMap[*models.ProductPriceHistory, *dtoV1.ProductPriceHistoryDto](); err != nil {
+		return err
+	}
+
+	// ScheduledPriceChange to ScheduledPriceChangeDataModel
+	if err := mapper.CreateMap[*models.ScheduledPriceChange, *datamodel.ScheduledPriceChangeDataModel](); err != nil {
+		return err
+	}
+
+	// ScheduledPriceChangeDataModel to ScheduledPriceChange
+	if err := mapper.CreateMap[*datamodel.ScheduledPriceChangeDataModel, *models.ScheduledPriceChange](); err != nil {
+		return err
+	}
+
 	return nil
 }
 
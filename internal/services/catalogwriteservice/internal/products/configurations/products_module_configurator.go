@@ -2,8 +2,12 @@
 package configurations
 
 import (
+	"context"
+
 	fxcontracts "github.com/raphaeldiscky/go-food-micro/internal/pkg/fxapp/contracts"
 	grpcServer "github.com/raphaeldiscky/go-food-micro/internal/pkg/grpc"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/grpc/gateway"
+	echocontracts "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/customecho/contracts"
 	googleGrpc "google.golang.org/grpc"
 
 	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/configurations/endpoints"
@@ -67,5 +71,20 @@ func (c *ProductsModuleConfigurator) MapProductsEndpoints() error {
 		},
 	)
 
+	// config Products Grpc Gateway Endpoints, the crud rest endpoints are the http annotations of the proto
+	c.ResolveFunc(
+		func(echoServer echocontracts.EchoHTTPServer, grpcClient grpcServer.GrpcClient) error {
+			return gateway.MapGateway(
+				context.Background(),
+				echoServer.RouteBuilder(),
+				grpcClient.GetGrpcConnection(),
+				gateway.Service{
+					Descriptor: productsservice.File_products_proto.Services().ByName("ProductsService"),
+					Register:   productsservice.RegisterProductsServiceHandler,
+				},
+			)
+		},
+	)
+
 	return nil
 }
@@ -0,0 +1,26 @@
+// Package schemas contains the schema registry configurations of the products integration events.
+package schemas
+
+import (
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/schemaregistry"
+
+	changeProductPriceIntegrationEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/changingproductprice/v1/events/integrationevents"
+	createProductIntegrationEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/creatingproduct/v1/events/integrationevents"
+	deleteProductIntegrationEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/deletingproduct/v1/events/integrationevents"
+	purgeProductIntegrationEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/purgingproduct/v1/events/integrationevents"
+	restoreProductIntegrationEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/restoringproduct/v1/events/integrationevents"
+	updateProductIntegrationEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/updatingproduct/v1/events/integrationevents"
+)
+
+// ConfigProductsSchemas registers the products integration events, they are the contracts with the consumers
+// of the catalogs read service and their schemas are stored in api/jsonschema/catalogwriteservice.
+func ConfigProductsSchemas(registry *schemaregistry.Registry) {
+	registry.Register(
+		&createProductIntegrationEventsV1.ProductCreatedV1{},
+		&updateProductIntegrationEventsV1.ProductUpdatedV1{},
+		&deleteProductIntegrationEventsV1.ProductDeletedV1{},
+		&restoreProductIntegrationEventsV1.ProductRestoredV1{},
+		&purgeProductIntegrationEventsV1.ProductPurgedV1{},
+		&changeProductPriceIntegrationEventsV1.ProductPriceChangedV1{},
+	)
+}
@@ -26,4 +26,12 @@ type ProductRepository interface {
 	CreateProduct(ctx context.Context, product *models.Product) (*models.Product, error)
 	UpdateProduct(ctx context.Context, product *models.Product) (*models.Product, error)
 	DeleteProductByID(ctx context.Context, uuid uuid.UUID) error
+	// DeleteProductByIDWithVersion deletes the product only when it still has the expected version.
+	DeleteProductByIDWithVersion(ctx context.Context, uuid uuid.UUID, expectedVersion int64) error
+	// GetProductsByIDs returns the existing products with the given ids, inside the current transaction if there is one.
+	GetProductsByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.Product, error)
+	// GetDeletedProductIDs returns the ids of the soft deleted products among the given ids, inside the current transaction if there is one.
+	GetDeletedProductIDs(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error)
+	// UpsertProducts inserts the products or updates the existing ones with the same id, inside the current transaction if there is one.
+	UpsertProducts(ctx context.Context, products []*models.Product) error
 }
@@ -19,6 +19,7 @@ type ProductDataModel struct {
 	Name        string
 	Description string
 	Price       float64
+	Version     int64     `gorm:"not null;default:1"`
 	CreatedAt   time.Time `gorm:"default:current_timestamp"`
 	UpdatedAt   time.Time
 	// for soft delete - https://gorm.io/docs/delete.html#Soft-Delete
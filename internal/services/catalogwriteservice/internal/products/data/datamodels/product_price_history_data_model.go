@@ -0,0 +1,34 @@
+package datamodels
+
+import (
+	"time"
+
+	json "github.com/goccy/go-json"
+	uuid "github.com/satori/go.uuid"
+)
+
+// ProductPriceHistoryDataModel is a struct that contains the product price history data model.
+type ProductPriceHistoryDataModel struct {
+	ID                     uuid.UUID `gorm:"primaryKey"`
+	ProductID              uuid.UUID
+	OldPrice               float64
+	NewPrice               float64
+	Reason                 string
+	ScheduledPriceChangeID *uuid.UUID
+	ChangedAt              time.Time
+}
+
+// TableName overrides the table name used by ProductPriceHistoryDataModel to `product_price_histories`.
+func (p *ProductPriceHistoryDataModel) TableName() string {
+	return "product_price_histories"
+}
+
+// String is a method that returns the string representation of the product price history data model.
+func (p *ProductPriceHistoryDataModel) String() string {
+	j, err := json.Marshal(p)
+	if err != nil {
+		return ""
+	}
+
+	return string(j)
+}
@@ -0,0 +1,36 @@
+package datamodels
+
+import (
+	"time"
+
+	json "github.com/goccy/go-json"
+	uuid "github.com/satori/go.uuid"
+)
+
+// ScheduledPriceChangeDataModel is a struct that contains the scheduled product price change data model.
+type ScheduledPriceChangeDataModel struct {
+	ID          uuid.UUID `gorm:"primaryKey"`
+	ProductID   uuid.UUID
+	NewPrice    float64
+	EffectiveAt time.Time
+	Status      string
+	Error       string
+	AppliedAt   *time.Time
+	CreatedAt   time.Time `gorm:"default:current_timestamp"`
+	UpdatedAt   time.Time
+}
+
+// TableName overrides the table name used by ScheduledPriceChangeDataModel to `scheduled_product_price_changes`.
+func (s *ScheduledPriceChangeDataModel) TableName() string {
+	return "scheduled_product_price_changes"
+}
+
+// String is a method that returns the string representation of the scheduled price change data model.
+func (s *ScheduledPriceChangeDataModel) String() string {
+	j, err := json.Marshal(s)
+	if err != nil {
+		return ""
+	}
+
+	return string(j)
+}
@@ -9,17 +9,21 @@ import (
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/data"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/mapper"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing/attribute"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/postgresgorm/helpers/gormextensions"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/postgresgorm/repository"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/utils"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	utils2 "github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing/utils"
 	goUuid "github.com/satori/go.uuid"
 	attribute2 "go.opentelemetry.io/otel/attribute"
 
 	data2 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/contracts"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/data/datamodels"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/models"
 )
 
@@ -28,6 +32,7 @@ type PostgresProductRepository struct {
 	Log                   logger.Logger
 	GormGenericRepository data.GenericRepository[*models.Product]
 	Tracer                tracing.AppTracer
+	db                    *gorm.DB
 }
 
 // NewPostgresProductRepository is a constructor for the PostgresProductRepository.
@@ -42,6 +47,7 @@ func NewPostgresProductRepository(
 		Log:                   log,
 		GormGenericRepository: gormRepository,
 		Tracer:                tracer,
+		db:                    db,
 	}
 }
 
@@ -272,3 +278,190 @@ func (p *PostgresProductRepository) DeleteProductByID(
 
 	return nil
 }
+
+// DeleteProductByIDWithVersion soft deletes a product from the database when it still has the expected version.
+// If the product doesn't exist, it returns a NotFound error.
+// If the product has another version, it returns a PreconditionFailed error.
+func (p *PostgresProductRepository) DeleteProductByIDWithVersion(
+	ctx context.Context,
+	uuid goUuid.UUID,
+	expectedVersion int64,
+) error {
+	ctx, span := p.Tracer.Start(ctx, "postgresProductRepository.DeleteProductByIDWithVersion")
+	span.SetAttributes(attribute2.String("ID", uuid.String()))
+	span.SetAttributes(attribute2.Int64("ExpectedVersion", expectedVersion))
+	defer span.End()
+
+	dataModel := &datamodels.ProductDataModel{}
+	err := p.dbWithTx(ctx).Where("id = ?", uuid).First(dataModel).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return customerrors.NewNotFoundError(
+			fmt.Sprintf("product with id `%s` not found in the database", uuid),
+		)
+	}
+	if err != nil {
+		return utils2.TraceStatusFromSpan(
+			span,
+			errors.WrapIf(err, fmt.Sprintf("error in finding product with id `%s`", uuid)),
+		)
+	}
+
+	if dataModel.Version != expectedVersion {
+		return newVersionMismatchError(uuid, expectedVersion)
+	}
+
+	// the version condition keeps a concurrent update between the read and the delete
+	result := p.dbWithTx(ctx).
+		Where("version = ?", expectedVersion).
+		Delete(dataModel)
+	err = utils2.TraceStatusFromSpan(span, errors.WrapIf(result.Error, fmt.Sprintf(
+		"error in deleting product with id `%s` from the database",
+		uuid,
+	)))
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected == 0 {
+		return newVersionMismatchError(uuid, expectedVersion)
+	}
+
+	p.Log.Infow(
+		fmt.Sprintf(
+			"product with id `%s` and version %d deleted",
+			uuid,
+			expectedVersion,
+		),
+		logger.Fields{"Product": uuid, "Version": expectedVersion},
+	)
+
+	return nil
+}
+
+// newVersionMismatchError returns the error of a product that changed since the expected version.
+func newVersionMismatchError(uuid goUuid.UUID, expectedVersion int64) error {
+	return customerrors.NewPreconditionFailedError(
+		fmt.Sprintf(
+			"product with id `%s` has changed since version %d",
+			uuid,
+			expectedVersion,
+		),
+	)
+}
+
+// GetProductsByIDs gets the existing products with the given ids.
+func (p *PostgresProductRepository) GetProductsByIDs(
+	ctx context.Context,
+	ids []goUuid.UUID,
+) ([]*models.Product, error) {
+	ctx, span := p.Tracer.Start(ctx, "postgresProductRepository.GetProductsByIDs")
+	span.SetAttributes(attribute2.Int("Count", len(ids)))
+	defer span.End()
+
+	if len(ids) == 0 {
+		return []*models.Product{}, nil
+	}
+
+	var dataModels []*datamodels.ProductDataModel
+
+	err := p.dbWithTx(ctx).Where("id IN ?", ids).Find(&dataModels).Error
+	err = utils2.TraceStatusFromSpan(
+		span,
+		errors.WrapIf(err, "error in getting products by ids from the database."),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	products, err := mapper.Map[[]*models.Product](dataModels)
+	if err != nil {
+		return nil, errors.WrapIf(err, "error in the mapping products")
+	}
+
+	return products, nil
+}
+
+// GetDeletedProductIDs gets the ids of the soft deleted products among the given ids.
+func (p *PostgresProductRepository) GetDeletedProductIDs(
+	ctx context.Context,
+	ids []goUuid.UUID,
+) ([]goUuid.UUID, error) {
+	ctx, span := p.Tracer.Start(ctx, "postgresProductRepository.GetDeletedProductIDs")
+	span.SetAttributes(attribute2.Int("Count", len(ids)))
+	defer span.End()
+
+	deletedIDs := []goUuid.UUID{}
+	if len(ids) == 0 {
+		return deletedIDs, nil
+	}
+
+	err := p.dbWithTx(ctx).
+		Unscoped().
+		Model(&datamodels.ProductDataModel{}).
+		Where("id IN ? AND deleted_at IS NOT NULL", ids).
+		Pluck("id", &deletedIDs).Error
+
+	return deletedIDs, utils2.TraceStatusFromSpan(
+		span,
+		errors.WrapIf(err, "error in getting the deleted products by ids from the database."),
+	)
+}
+
+// UpsertProducts inserts the products in one statement, the products with an existing id are updated instead,
+// the update keeps the deletion of a soft deleted product, which is only restored by the restore product command.
+func (p *PostgresProductRepository) UpsertProducts(
+	ctx context.Context,
+	products []*models.Product,
+) error {
+	ctx, span := p.Tracer.Start(ctx, "postgresProductRepository.UpsertProducts")
+	span.SetAttributes(attribute2.Int("Count", len(products)))
+	defer span.End()
+
+	if len(products) == 0 {
+		return nil
+	}
+
+	dataModels, err := mapper.Map[[]*datamodels.ProductDataModel](products)
+	if err != nil {
+		return errors.WrapIf(err, "error in the mapping products")
+	}
+
+	// https://gorm.io/docs/create.html#Upsert-On-Conflict
+	err = p.dbWithTx(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "id"}},
+			DoUpdates: append(
+				clause.AssignmentColumns(
+					[]string{"name", "description", "price", "updated_at"},
+				),
+				clause.Assignment{
+					Column: clause.Column{Name: "version"},
+					Value:  gorm.Expr("products.version + 1"),
+				},
+			),
+		}).
+		Create(&dataModels).Error
+	err = utils2.TraceStatusFromSpan(
+		span,
+		errors.WrapIf(err, "error in upserting products into the database."),
+	)
+	if err != nil {
+		return err
+	}
+
+	p.Log.Infow(
+		fmt.Sprintf("%d products upserted", len(products)),
+		logger.Fields{"Count": len(products)},
+	)
+
+	return nil
+}
+
+// dbWithTx returns the transaction of the context if there is one, otherwise the database.
+func (p *PostgresProductRepository) dbWithTx(ctx context.Context) *gorm.DB {
+	if tx := gormextensions.GetTxFromContextIfExists(ctx); tx != nil {
+		return tx.WithContext(ctx)
+	}
+
+	return p.db.WithContext(ctx)
+}
@@ -0,0 +1,18 @@
+package v1
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// ProductPriceHistoryDto is a struct that contains the product price history dto.
+type ProductPriceHistoryDto struct {
+	ID                     uuid.UUID  `json:"id"`
+	ProductID              uuid.UUID  `json:"productId"`
+	OldPrice               float64    `json:"oldPrice"`
+	NewPrice               float64    `json:"newPrice"`
+	Reason                 string     `json:"reason"`
+	ScheduledPriceChangeID *uuid.UUID `json:"scheduledPriceChangeId,omitempty"`
+	ChangedAt              time.Time  `json:"changedAt"`
+}
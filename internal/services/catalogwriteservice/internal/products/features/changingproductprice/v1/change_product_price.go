@@ -0,0 +1,75 @@
+// Package v1 contains the change product price command.
+package v1
+
+import (
+	"time"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/cqrs"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/models"
+)
+
+// ChangeProductPrice is an internal command that changes the price of a product and records it in the price history,
+// it is sent by the scheduled price change worker and has no endpoint.
+type ChangeProductPrice struct {
+	cqrs.TxCommand
+	ProductID              uuid.UUID
+	NewPrice               float64
+	Reason                 string
+	ScheduledPriceChangeID *uuid.UUID
+	ChangedAt              time.Time
+}
+
+// NewChangeProductPrice is a constructor for the ChangeProductPrice.
+func NewChangeProductPrice(
+	productID uuid.UUID,
+	newPrice float64,
+	scheduledPriceChangeID *uuid.UUID,
+) *ChangeProductPrice {
+	command := &ChangeProductPrice{
+		TxCommand:              cqrs.NewTxCommandByT[ChangeProductPrice](),
+		ProductID:              productID,
+		NewPrice:               newPrice,
+		Reason:                 models.PriceChangeReasonScheduled,
+		ScheduledPriceChangeID: scheduledPriceChangeID,
+		ChangedAt:              time.Now(),
+	}
+
+	return command
+}
+
+// NewChangeProductPriceWithValidation is a constructor for the ChangeProductPrice with validation.
+func NewChangeProductPriceWithValidation(
+	productID uuid.UUID,
+	newPrice float64,
+	scheduledPriceChangeID *uuid.UUID,
+) (*ChangeProductPrice, error) {
+	command := NewChangeProductPrice(productID, newPrice, scheduledPriceChangeID)
+	err := command.Validate()
+
+	return command, err
+}
+
+// Validate is a method that validates the change product price command.
+func (c *ChangeProductPrice) Validate() error {
+	err := validation.ValidateStruct(
+		c,
+		validation.Field(&c.ProductID, validation.Required),
+		validation.Field(
+			&c.NewPrice,
+			validation.Required,
+			validation.Min(0.0).Exclusive(),
+		),
+		validation.Field(&c.Reason, validation.Required),
+		validation.Field(&c.ChangedAt, validation.Required),
+	)
+	if err != nil {
+		return customErrors.NewValidationErrorWrap(err, "validation error")
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/cqrs"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/mapper"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/postgresgorm/gormdbcontext"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/postgresgorm/helpers/gormextensions"
+	"gorm.io/gorm"
+
+	mediatr "github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/data/datamodels"
+	dto "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1/fxparams"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/changingproductprice/v1/events/integrationevents"
+	updatedevents "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/updatingproduct/v1/events/integrationevents"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/models"
+)
+
+// changeProductPriceHandler is a struct that contains the change product price handler.
+type changeProductPriceHandler struct {
+	fxparams.ProductHandlerParams
+}
+
+// NewChangeProductPriceHandler is a constructor for the changeProductPriceHandler.
+func NewChangeProductPriceHandler(
+	params fxparams.ProductHandlerParams,
+) cqrs.RequestHandlerWithRegisterer[*ChangeProductPrice, *mediatr.Unit] {
+	return &changeProductPriceHandler{
+		ProductHandlerParams: params,
+	}
+}
+
+// RegisterHandler is a method that registers the change product price handler.
+func (c *changeProductPriceHandler) RegisterHandler() error {
+	return mediatr.RegisterRequestHandler[*ChangeProductPrice, *mediatr.Unit](
+		c,
+	)
+}
+
+// Handle is a method that handles the change product price command.
+func (c *changeProductPriceHandler) Handle(
+	ctx context.Context,
+	command *ChangeProductPrice,
+) (*mediatr.Unit, error) {
+	product, err := gormdbcontext.FindModelByID[*datamodels.ProductDataModel, *models.Product](
+		ctx,
+		c.CatalogsDBContext,
+		command.ProductID,
+	)
+	if err != nil {
+		return nil, customErrors.NewApplicationErrorWrapWithCode(
+			err,
+			http.StatusNotFound,
+			fmt.Sprintf(
+				"product with id `%s` not found",
+				command.ProductID,
+			),
+		)
+	}
+
+	oldPrice := product.Price
+	if oldPrice == command.NewPrice {
+		c.Log.Infow(
+			fmt.Sprintf(
+				"product with id '%s' already has the price %v",
+				command.ProductID,
+				command.NewPrice,
+			),
+			logger.Fields{"ID": command.ProductID},
+		)
+
+		return &mediatr.Unit{}, nil
+	}
+
+	// a map is used, so the update is applied even for zero values
+	err = c.CatalogsDBContext.WithTxIfExists(ctx).DB().
+		WithContext(ctx).
+		Model(&datamodels.ProductDataModel{}).
+		Where("id = ?", command.ProductID).
+		Updates(map[string]interface{}{
+			"price":      command.NewPrice,
+			"updated_at": command.ChangedAt,
+			"version":    gorm.Expr("version + 1"),
+		}).Error
+	if err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"error in updating product price in the repository",
+		)
+	}
+
+	product.Price = command.NewPrice
+	product.UpdatedAt = command.ChangedAt
+	product.Version++
+
+	_, err = gormdbcontext.AddModel[*datamodels.ProductPriceHistoryDataModel, *models.ProductPriceHistory](
+		ctx,
+		c.CatalogsDBContext,
+		&models.ProductPriceHistory{
+			ID:                     uuid.NewV4(),
+			ProductID:              command.ProductID,
+			OldPrice:               oldPrice,
+			NewPrice:               command.NewPrice,
+			Reason:                 command.Reason,
+			ScheduledPriceChangeID: command.ScheduledPriceChangeID,
+			ChangedAt:              command.ChangedAt,
+		},
+	)
+	if err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"error in adding product price history",
+		)
+	}
+
+	productDto, err := mapper.Map[*dto.ProductDto](product)
+	if err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"error in the mapping ProductDto",
+		)
+	}
+
+	priceChanged := integrationevents.NewProductPriceChangedV1(
+		command.ProductID,
+		oldPrice,
+		command.NewPrice,
+		command.Reason,
+		command.ScheduledPriceChangeID,
+		command.ChangedAt,
+	)
+	// keeps the existing consumers of product updates, like the read models, in sync
+	productUpdated := updatedevents.NewProductUpdatedV1(productDto)
+
+	// the events are published once the price change is committed, a rolled back change publishes nothing
+	err = gormextensions.AfterCommit(ctx, func(ctx context.Context) error {
+		if err := c.RabbitmqProducer.PublishMessage(ctx, priceChanged, nil); err != nil {
+			return customErrors.NewApplicationErrorWrap(
+				err,
+				"error in publishing 'ProductPriceChanged' message",
+			)
+		}
+
+		if err := c.RabbitmqProducer.PublishMessage(ctx, productUpdated, nil); err != nil {
+			return customErrors.NewApplicationErrorWrap(
+				err,
+				"error in publishing 'ProductUpdated' message",
+			)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.Log.Infow(
+		fmt.Sprintf(
+			"price of product with id '%s' changed from %v to %v",
+			command.ProductID,
+			oldPrice,
+			command.NewPrice,
+		),
+		logger.Fields{
+			"ID":        command.ProductID,
+			"MessageId": priceChanged.MessageId,
+		},
+	)
+
+	return &mediatr.Unit{}, nil
+}
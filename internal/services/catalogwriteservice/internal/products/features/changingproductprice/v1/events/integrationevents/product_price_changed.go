@@ -0,0 +1,46 @@
+// Package integrationevents contains the product price changed v1.
+package integrationevents
+
+import (
+	"time"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// ProductPriceChangedV1 is a struct that contains the product price changed v1.
+type ProductPriceChangedV1 struct {
+	*types.Message
+	ProductID              uuid.UUID  `json:"productId"`
+	OldPrice               float64    `json:"oldPrice"`
+	NewPrice               float64    `json:"newPrice"`
+	Reason                 string     `json:"reason"`
+	ScheduledPriceChangeID *uuid.UUID `json:"scheduledPriceChangeId,omitempty"`
+	ChangedAt              time.Time  `json:"changedAt"`
+}
+
+// NewProductPriceChangedV1 is a constructor for the ProductPriceChangedV1.
+func NewProductPriceChangedV1(
+	productID uuid.UUID,
+	oldPrice float64,
+	newPrice float64,
+	reason string,
+	scheduledPriceChangeID *uuid.UUID,
+	changedAt time.Time,
+) *ProductPriceChangedV1 {
+	return &ProductPriceChangedV1{
+		Message:                types.NewMessage(uuid.NewV4().String()),
+		ProductID:              productID,
+		OldPrice:               oldPrice,
+		NewPrice:               newPrice,
+		Reason:                 reason,
+		ScheduledPriceChangeID: scheduledPriceChangeID,
+		ChangedAt:              changedAt,
+	}
+}
+
+// GetPartitionKey returns the id of the product, the consumers handle the events of a product in their order.
+func (p *ProductPriceChangedV1) GetPartitionKey() string {
+	return p.ProductID.String()
+}
@@ -49,6 +49,7 @@ func (c *createProductHandler) Handle(
 		Name:        command.Name,
 		Description: command.Description,
 		Price:       command.Price,
+		Version:     1,
 		CreatedAt:   command.CreatedAt,
 	}
 
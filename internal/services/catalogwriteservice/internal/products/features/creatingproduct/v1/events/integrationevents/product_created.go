@@ -22,3 +22,12 @@ func NewProductCreatedV1(productDto *dtoV1.ProductDto) *ProductCreatedV1 {
 		Message:    types.NewMessage(uuid.NewV4().String()),
 	}
 }
+
+// GetPartitionKey returns the id of the product, the consumers handle the events of a product in their order.
+func (p *ProductCreatedV1) GetPartitionKey() string {
+	if p.ProductDto == nil {
+		return ""
+	}
+
+	return p.ID.String()
+}
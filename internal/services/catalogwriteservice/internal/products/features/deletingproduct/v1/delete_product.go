@@ -12,6 +12,8 @@ import (
 // DeleteProduct is a struct that contains the delete product command.
 type DeleteProduct struct {
 	ProductID uuid.UUID
+	// ExpectedVersion is the version the client read, the delete fails when the product changed since, 0 skips the check
+	ExpectedVersion int64
 }
 
 // NewDeleteProduct is a constructor for the DeleteProduct.
@@ -35,6 +37,7 @@ func (c *DeleteProduct) Validate() error {
 		c,
 		validation.Field(&c.ProductID, validation.Required),
 		validation.Field(&c.ProductID, is.UUIDv4),
+		validation.Field(&c.ExpectedVersion, validation.Min(int64(0))),
 	)
 	if err != nil {
 		return customErrors.NewValidationErrorWrap(err, "validation error")
@@ -40,7 +40,16 @@ func (c *deleteProductHandler) Handle(
 	ctx context.Context,
 	command *DeleteProduct,
 ) (*mediatr.Unit, error) {
-	err := c.ProductRepository.DeleteProductByID(ctx, command.ProductID)
+	var err error
+	if command.ExpectedVersion != 0 {
+		err = c.ProductRepository.DeleteProductByIDWithVersion(
+			ctx,
+			command.ProductID,
+			command.ExpectedVersion,
+		)
+	} else {
+		err = c.ProductRepository.DeleteProductByID(ctx, command.ProductID)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -17,3 +17,8 @@ type ProductDeletedV1 struct {
 func NewProductDeletedV1(productID string) *ProductDeletedV1 {
 	return &ProductDeletedV1{ProductID: productID, Message: types.NewMessage(uuid.NewV4().String())}
 }
+
+// GetPartitionKey returns the id of the product, the consumers handle the events of a product in their order.
+func (p *ProductDeletedV1) GetPartitionKey() string {
+	return p.ProductID
+}
@@ -0,0 +1,13 @@
+// Package dtos contains the export products response dto.
+package dtos
+
+import (
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/utils"
+
+	dtoV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1"
+)
+
+// ExportProductsResponseDto is a struct that contains one page of a product export.
+type ExportProductsResponseDto struct {
+	Products *utils.ListResult[*dtoV1.ProductDto]
+}
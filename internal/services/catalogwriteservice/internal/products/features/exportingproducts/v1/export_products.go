@@ -0,0 +1,52 @@
+// Package v1 contains the export products query.
+package v1
+
+import (
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/cqrs"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/utils"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+)
+
+// MaxExportPageSize is the maximum number of products in one export page.
+const MaxExportPageSize = 1000
+
+// ExportProducts is a struct that contains the export products query.
+type ExportProducts struct {
+	cqrs.Query
+	*utils.ListQuery
+}
+
+// NewExportProducts is a constructor for the ExportProducts.
+func NewExportProducts(listQuery *utils.ListQuery) *ExportProducts {
+	if listQuery == nil {
+		listQuery = utils.NewListQueryFromQueryParams("", "")
+	}
+
+	return &ExportProducts{
+		Query:     cqrs.NewQueryByT[ExportProducts](),
+		ListQuery: listQuery,
+	}
+}
+
+// NewExportProductsWithValidation is a constructor for the ExportProducts with validation.
+func NewExportProductsWithValidation(listQuery *utils.ListQuery) (*ExportProducts, error) {
+	query := NewExportProducts(listQuery)
+	err := query.Validate()
+
+	return query, err
+}
+
+// Validate is a method that validates the export products query.
+func (q *ExportProducts) Validate() error {
+	err := validation.ValidateStruct(
+		q.ListQuery,
+		validation.Field(&q.ListQuery.Size, validation.Max(MaxExportPageSize)),
+	)
+	if err != nil {
+		return customErrors.NewValidationErrorWrap(err, "validation error")
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package v1
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/web/route"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/utils"
+
+	echo "github.com/labstack/echo/v4"
+	mediatr "github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+
+	dtosv1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1/fxparams"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/exportingproducts/v1/dtos"
+)
+
+// exportProductsEndpoint is a struct that contains the export products endpoint.
+type exportProductsEndpoint struct {
+	fxparams.ProductRouteParams
+}
+
+// NewExportProductsEndpoint is a constructor for the exportProductsEndpoint.
+func NewExportProductsEndpoint(
+	params fxparams.ProductRouteParams,
+) route.Endpoint {
+	return &exportProductsEndpoint{ProductRouteParams: params}
+}
+
+// MapEndpoint is a method that maps the endpoint.
+func (ep *exportProductsEndpoint) MapEndpoint() {
+	ep.ProductsGroup.GET("/export", ep.handler())
+}
+
+// ExportProducts
+// @Tags Products
+// @Summary Export products
+// @Description Export a page of products as csv or json, in the same shape the import accepts. The pagination is in the X-Page, X-Page-Size, X-Total-Items and X-Total-Pages headers
+// @Produce json,text/csv
+// @Param format query string false "Export format, `csv` or `json` (default)"
+// @Param page query int false "Page number"
+// @Param size query int false "Page size"
+// @Success 200 {array} dtosv1.ProductDto
+// @Router /api/v1/products/export [get].
+func (ep *exportProductsEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		format := strings.ToLower(c.QueryParam("format"))
+		if format == "" {
+			format = "json"
+		}
+
+		if format != "csv" && format != "json" {
+			return customErrors.NewBadRequestError(
+				"the export format must be `csv` or `json`",
+			)
+		}
+
+		listQuery, err := utils.GetListQueryFromCtx(c)
+		if err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(
+				err,
+				"error in getting data from query string",
+			)
+
+			return badRequestErr
+		}
+
+		query, err := NewExportProductsWithValidation(listQuery)
+		if err != nil {
+			return err
+		}
+
+		queryResult, err := mediatr.Send[*ExportProducts, *dtos.ExportProductsResponseDto](
+			ctx,
+			query,
+		)
+		if err != nil {
+			return errors.WithMessage(
+				err,
+				"error in sending ExportProducts",
+			)
+		}
+
+		page := queryResult.Products
+		header := c.Response().Header()
+		header.Set("X-Page", strconv.Itoa(page.Page))
+		header.Set("X-Page-Size", strconv.Itoa(page.Size))
+		header.Set("X-Total-Items", strconv.FormatInt(page.TotalItems, 10))
+		header.Set("X-Total-Pages", strconv.Itoa(page.TotalPage))
+
+		if format == "csv" {
+			return writeCSV(c, page.Items)
+		}
+
+		return writeJSON(c, page.Items)
+	}
+}
+
+func writeCSV(c echo.Context, products []*dtosv1.ProductDto) error {
+	c.Response().Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+	c.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="products.csv"`)
+	c.Response().WriteHeader(http.StatusOK)
+
+	writer := csv.NewWriter(c.Response())
+
+	err := writer.Write([]string{"id", "name", "description", "price", "createdAt", "updatedAt"})
+	if err != nil {
+		return errors.WrapIf(err, "error in writing the csv header")
+	}
+
+	for _, product := range products {
+		err := writer.Write([]string{
+			product.ID.String(),
+			product.Name,
+			product.Description,
+			strconv.FormatFloat(product.Price, 'f', -1, 64),
+			product.CreatedAt.Format(time.RFC3339),
+			product.UpdatedAt.Format(time.RFC3339),
+		})
+		if err != nil {
+			return errors.WrapIf(err, "error in writing the csv")
+		}
+	}
+
+	writer.Flush()
+
+	return errors.WrapIf(writer.Error(), "error in writing the csv")
+}
+
+// writeJSON streams the products as a json array, one element at a time.
+func writeJSON(c echo.Context, products []*dtosv1.ProductDto) error {
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+	c.Response().WriteHeader(http.StatusOK)
+
+	if _, err := c.Response().Write([]byte("[")); err != nil {
+		return errors.WrapIf(err, "error in writing the json")
+	}
+
+	for i, product := range products {
+		if i > 0 {
+			if _, err := c.Response().Write([]byte(",")); err != nil {
+				return errors.WrapIf(err, "error in writing the json")
+			}
+		}
+
+		b, err := json.Marshal(product)
+		if err != nil {
+			return errors.WrapIf(err, "error in marshaling the product")
+		}
+
+		if _, err := c.Response().Write(b); err != nil {
+			return errors.WrapIf(err, "error in writing the json")
+		}
+	}
+
+	_, err := c.Response().Write([]byte("]"))
+
+	return errors.WrapIf(err, "error in writing the json")
+}
@@ -0,0 +1,104 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/cqrs"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/mapper"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/utils"
+
+	mediatr "github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/data/datamodels"
+	dtosv1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1/fxparams"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/exportingproducts/v1/dtos"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/models"
+)
+
+// exportProductsHandler is a struct that contains the export products handler.
+type exportProductsHandler struct {
+	fxparams.ProductHandlerParams
+}
+
+// NewExportProductsHandler is a constructor for the exportProductsHandler.
+func NewExportProductsHandler(
+	params fxparams.ProductHandlerParams,
+) cqrs.RequestHandlerWithRegisterer[*ExportProducts, *dtos.ExportProductsResponseDto] {
+	return &exportProductsHandler{
+		ProductHandlerParams: params,
+	}
+}
+
+// RegisterHandler is a method that registers the export products handler.
+func (c *exportProductsHandler) RegisterHandler() error {
+	return mediatr.RegisterRequestHandler[*ExportProducts, *dtos.ExportProductsResponseDto](
+		c,
+	)
+}
+
+// Handle is a method that handles the export products query, the products are in creation order so the pages are stable.
+func (c *exportProductsHandler) Handle(
+	ctx context.Context,
+	query *ExportProducts,
+) (*dtos.ExportProductsResponseDto, error) {
+	var (
+		items      []*datamodels.ProductDataModel
+		totalItems int64
+	)
+
+	db := c.CatalogsDBContext.DB().
+		WithContext(ctx).
+		Model(&datamodels.ProductDataModel{})
+
+	if err := db.Count(&totalItems).Error; err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"error in counting products",
+		)
+	}
+
+	err := db.Order("created_at asc, id asc").
+		Offset(query.GetOffset()).
+		Limit(query.GetLimit()).
+		Find(&items).Error
+	if err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"error in fetching products",
+		)
+	}
+
+	products, err := mapper.Map[[]*models.Product](items)
+	if err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"error in the mapping Product",
+		)
+	}
+
+	listResultDto, err := utils.ListResultToListResultDto[*dtosv1.ProductDto](
+		utils.NewListResult(
+			products,
+			query.GetSize(),
+			query.GetPage(),
+			totalItems,
+		),
+	)
+	if err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"error in the mapping",
+		)
+	}
+
+	c.Log.Infow(
+		fmt.Sprintf("page %d of the products export fetched", query.GetPage()),
+		logger.Fields{"Page": query.GetPage(), "Size": query.GetSize()},
+	)
+
+	return &dtos.ExportProductsResponseDto{Products: listResultDto}, nil
+}
@@ -0,0 +1,19 @@
+// Package dtos contains the get deleted products dtos.
+package dtos
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// DeletedProductDto is a struct that contains a soft deleted product.
+type DeletedProductDto struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Price       float64   `json:"price"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+	DeletedAt   time.Time `json:"deletedAt"`
+}
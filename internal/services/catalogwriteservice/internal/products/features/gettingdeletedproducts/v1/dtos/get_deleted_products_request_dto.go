@@ -0,0 +1,10 @@
+package dtos
+
+import "github.com/raphaeldiscky/go-food-micro/internal/pkg/utils"
+
+// https://echo.labstack.com/guide/binding/
+
+// GetDeletedProductsRequestDto validation will handle in command level.
+type GetDeletedProductsRequestDto struct {
+	*utils.ListQuery
+}
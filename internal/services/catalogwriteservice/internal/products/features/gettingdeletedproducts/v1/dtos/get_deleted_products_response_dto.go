@@ -0,0 +1,10 @@
+package dtos
+
+import "github.com/raphaeldiscky/go-food-micro/internal/pkg/utils"
+
+// https://echo.labstack.com/guide/response/
+
+// GetDeletedProductsResponseDto is a struct that contains the get deleted products response dto.
+type GetDeletedProductsResponseDto struct {
+	Products *utils.ListResult[*DeletedProductDto]
+}
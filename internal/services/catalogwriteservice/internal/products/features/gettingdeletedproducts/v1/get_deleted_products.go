@@ -0,0 +1,25 @@
+// Package v1 contains the get deleted products query.
+package v1
+
+import (
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/cqrs"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/utils"
+)
+
+// GetDeletedProducts is a struct that contains the get deleted products query.
+type GetDeletedProducts struct {
+	cqrs.Query
+	*utils.ListQuery
+}
+
+// NewGetDeletedProducts is a constructor for the GetDeletedProducts.
+func NewGetDeletedProducts(listQuery *utils.ListQuery) *GetDeletedProducts {
+	if listQuery == nil {
+		listQuery = utils.NewListQueryFromQueryParams("", "")
+	}
+
+	return &GetDeletedProducts{
+		Query:     cqrs.NewQueryByT[GetDeletedProducts](),
+		ListQuery: listQuery,
+	}
+}
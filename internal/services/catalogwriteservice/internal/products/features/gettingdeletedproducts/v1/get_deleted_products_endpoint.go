@@ -0,0 +1,88 @@
+package v1
+
+import (
+	"net/http"
+
+	"emperror.dev/errors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/authentication"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/web/route"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/utils"
+
+	echo "github.com/labstack/echo/v4"
+	mediatr "github.com/mehdihadeli/go-mediatr"
+	authenticationMiddleware "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/customecho/middlewares/authentication"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1/fxparams"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/gettingdeletedproducts/v1/dtos"
+)
+
+// getDeletedProductsEndpoint is a struct that contains the get deleted products endpoint.
+type getDeletedProductsEndpoint struct {
+	fxparams.ProductRouteParams
+}
+
+// NewGetDeletedProductsEndpoint is a constructor for the getDeletedProductsEndpoint.
+func NewGetDeletedProductsEndpoint(
+	params fxparams.ProductRouteParams,
+) route.Endpoint {
+	return &getDeletedProductsEndpoint{ProductRouteParams: params}
+}
+
+// MapEndpoint is a method that maps the endpoint.
+func (ep *getDeletedProductsEndpoint) MapEndpoint() {
+	// only an admin sees, restores and purges the deleted products
+	ep.ProductsGroup.GET(
+		"/deleted",
+		ep.handler(),
+		authenticationMiddleware.RequireRole(authentication.RoleAdmin),
+	)
+}
+
+// GetDeletedProducts
+// @Tags Products
+// @Summary Get deleted products
+// @Description Get the soft deleted products, they can be restored or purged
+// @Accept json
+// @Produce json
+// @Param getDeletedProductsRequestDto query dtos.GetDeletedProductsRequestDto false "GetDeletedProductsRequestDto"
+// @Success 200 {object} dtos.GetDeletedProductsResponseDto
+// @Router /api/v1/products/deleted [get].
+func (ep *getDeletedProductsEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		listQuery, err := utils.GetListQueryFromCtx(c)
+		if err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(
+				err,
+				"error in getting data from query string",
+			)
+
+			return badRequestErr
+		}
+
+		request := &dtos.GetDeletedProductsRequestDto{ListQuery: listQuery}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(
+				err,
+				"error in the binding request",
+			)
+
+			return badRequestErr
+		}
+
+		queryResult, err := mediatr.Send[*GetDeletedProducts, *dtos.GetDeletedProductsResponseDto](
+			ctx,
+			NewGetDeletedProducts(request.ListQuery),
+		)
+		if err != nil {
+			return errors.WithMessage(
+				err,
+				"error in sending GetDeletedProducts",
+			)
+		}
+
+		return c.JSON(http.StatusOK, queryResult)
+	}
+}
@@ -0,0 +1,96 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/cqrs"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/utils"
+
+	mediatr "github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/data/datamodels"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1/fxparams"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/gettingdeletedproducts/v1/dtos"
+)
+
+// getDeletedProductsHandler is a struct that contains the get deleted products handler.
+type getDeletedProductsHandler struct {
+	fxparams.ProductHandlerParams
+}
+
+// NewGetDeletedProductsHandler is a constructor for the getDeletedProductsHandler.
+func NewGetDeletedProductsHandler(
+	params fxparams.ProductHandlerParams,
+) cqrs.RequestHandlerWithRegisterer[*GetDeletedProducts, *dtos.GetDeletedProductsResponseDto] {
+	return &getDeletedProductsHandler{
+		ProductHandlerParams: params,
+	}
+}
+
+// RegisterHandler is a method that registers the get deleted products handler.
+func (c *getDeletedProductsHandler) RegisterHandler() error {
+	return mediatr.RegisterRequestHandler[*GetDeletedProducts, *dtos.GetDeletedProductsResponseDto](
+		c,
+	)
+}
+
+// Handle is a method that handles the get deleted products query, the last deleted products are first.
+func (c *getDeletedProductsHandler) Handle(
+	ctx context.Context,
+	query *GetDeletedProducts,
+) (*dtos.GetDeletedProductsResponseDto, error) {
+	var (
+		items      []*datamodels.ProductDataModel
+		totalItems int64
+	)
+
+	db := c.CatalogsDBContext.DB().
+		WithContext(ctx).
+		Unscoped().
+		Model(&datamodels.ProductDataModel{}).
+		Where("deleted_at IS NOT NULL")
+
+	if err := db.Count(&totalItems).Error; err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"error in counting deleted products",
+		)
+	}
+
+	err := db.Order("deleted_at desc").
+		Offset(query.GetOffset()).
+		Limit(query.GetLimit()).
+		Find(&items).Error
+	if err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"error in fetching deleted products",
+		)
+	}
+
+	// the product model has no deleted_at, so the data models are mapped directly
+	products := make([]*dtos.DeletedProductDto, 0, len(items))
+	for _, item := range items {
+		products = append(products, &dtos.DeletedProductDto{
+			ID:          item.ID,
+			Name:        item.Name,
+			Description: item.Description,
+			Price:       item.Price,
+			CreatedAt:   item.CreatedAt,
+			UpdatedAt:   item.UpdatedAt,
+			DeletedAt:   item.DeletedAt.Time,
+		})
+	}
+
+	c.Log.Info("deleted products fetched")
+
+	return &dtos.GetDeletedProductsResponseDto{
+		Products: utils.NewListResult(
+			products,
+			query.GetSize(),
+			query.GetPage(),
+			totalItems,
+		),
+	}, nil
+}
@@ -0,0 +1,16 @@
+// Package dtos contains the get product price history request dto.
+package dtos
+
+import (
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/utils"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// https://echo.labstack.com/guide/binding/
+
+// GetProductPriceHistoryRequestDto validation will handle in query level.
+type GetProductPriceHistoryRequestDto struct {
+	ProductID uuid.UUID `param:"id" json:"-"`
+	*utils.ListQuery
+}
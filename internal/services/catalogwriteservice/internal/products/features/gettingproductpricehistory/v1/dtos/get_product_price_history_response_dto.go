@@ -0,0 +1,14 @@
+package dtos
+
+import (
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/utils"
+
+	dtoV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1"
+)
+
+// https://echo.labstack.com/guide/response/
+
+// GetProductPriceHistoryResponseDto is a struct that contains the get product price history response dto.
+type GetProductPriceHistoryResponseDto struct {
+	PriceHistory *utils.ListResult[*dtoV1.ProductPriceHistoryDto]
+}
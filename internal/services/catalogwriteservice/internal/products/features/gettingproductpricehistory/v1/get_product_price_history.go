@@ -0,0 +1,57 @@
+// Package v1 contains the get product price history query.
+package v1
+
+import (
+	"github.com/go-ozzo/ozzo-validation/is"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/cqrs"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/utils"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+	uuid "github.com/satori/go.uuid"
+)
+
+// GetProductPriceHistory is a struct that contains the get product price history query.
+type GetProductPriceHistory struct {
+	cqrs.Query
+	*utils.ListQuery
+	ProductID uuid.UUID
+}
+
+// NewGetProductPriceHistory is a constructor for the GetProductPriceHistory.
+func NewGetProductPriceHistory(
+	productID uuid.UUID,
+	listQuery *utils.ListQuery,
+) *GetProductPriceHistory {
+	query := &GetProductPriceHistory{
+		Query:     cqrs.NewQueryByT[GetProductPriceHistory](),
+		ListQuery: listQuery,
+		ProductID: productID,
+	}
+
+	return query
+}
+
+// NewGetProductPriceHistoryWithValidation is a constructor for the GetProductPriceHistory with validation.
+func NewGetProductPriceHistoryWithValidation(
+	productID uuid.UUID,
+	listQuery *utils.ListQuery,
+) (*GetProductPriceHistory, error) {
+	query := NewGetProductPriceHistory(productID, listQuery)
+	err := query.Validate()
+
+	return query, err
+}
+
+// Validate is a method that validates the get product price history query.
+func (q *GetProductPriceHistory) Validate() error {
+	err := validation.ValidateStruct(
+		q,
+		validation.Field(&q.ProductID, validation.Required, is.UUIDv4),
+	)
+	if err != nil {
+		return customErrors.NewValidationErrorWrap(err, "validation error")
+	}
+
+	return nil
+}
@@ -1,4 +1,3 @@
-// Package v1 contains the get products endpoint.
 package v1
 
 import (
@@ -13,36 +12,37 @@ import (
 	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
 
 	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1/fxparams"
-	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/gettingproducts/v1/dtos"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/gettingproductpricehistory/v1/dtos"
 )
 
-// getProductsEndpoint is a struct that contains the get products endpoint.
-type getProductsEndpoint struct {
+// getProductPriceHistoryEndpoint is a struct that contains the get product price history endpoint.
+type getProductPriceHistoryEndpoint struct {
 	fxparams.ProductRouteParams
 }
 
-// NewGetProductsEndpoint is a constructor for the getProductsEndpoint.
-func NewGetProductsEndpoint(
+// NewGetProductPriceHistoryEndpoint is a constructor for the getProductPriceHistoryEndpoint.
+func NewGetProductPriceHistoryEndpoint(
 	params fxparams.ProductRouteParams,
 ) route.Endpoint {
-	return &getProductsEndpoint{ProductRouteParams: params}
+	return &getProductPriceHistoryEndpoint{ProductRouteParams: params}
 }
 
 // MapEndpoint is a method that maps the endpoint.
-func (ep *getProductsEndpoint) MapEndpoint() {
-	ep.ProductsGroup.GET("", ep.handler())
+func (ep *getProductPriceHistoryEndpoint) MapEndpoint() {
+	ep.ProductsGroup.GET("/:id/price-history", ep.handler())
 }
 
-// GetAllProducts
+// GetProductPriceHistory
 // @Tags Products
-// @Summary Get all product
-// @Description Get all products
+// @Summary Get product price history
+// @Description Get the price changes of a product, newest first
 // @Accept json
 // @Produce json
-// @Param getProductsRequestDto query dtos.GetProductsRequestDto false "GetProductsRequestDto"
-// @Success 200 {object} dtos.GetProductsResponseDto
-// @Router /api/v1/products [get].
-func (ep *getProductsEndpoint) handler() echo.HandlerFunc {
+// @Param id path string true "Product ID"
+// @Param getProductPriceHistoryRequestDto query dtos.GetProductPriceHistoryRequestDto false "GetProductPriceHistoryRequestDto"
+// @Success 200 {object} dtos.GetProductPriceHistoryResponseDto
+// @Router /api/v1/products/{id}/price-history [get].
+func (ep *getProductPriceHistoryEndpoint) handler() echo.HandlerFunc {
 	return func(c echo.Context) error {
 		ctx := c.Request().Context()
 
@@ -56,7 +56,7 @@ func (ep *getProductsEndpoint) handler() echo.HandlerFunc {
 			return badRequestErr
 		}
 
-		request := &dtos.GetProductsRequestDto{ListQuery: listQuery}
+		request := &dtos.GetProductPriceHistoryRequestDto{ListQuery: listQuery}
 		if err := c.Bind(request); err != nil {
 			badRequestErr := customErrors.NewBadRequestErrorWrap(
 				err,
@@ -66,19 +66,22 @@ func (ep *getProductsEndpoint) handler() echo.HandlerFunc {
 			return badRequestErr
 		}
 
-		query, err := NewGetProducts(request.ListQuery)
+		query, err := NewGetProductPriceHistoryWithValidation(
+			request.ProductID,
+			request.ListQuery,
+		)
 		if err != nil {
 			return err
 		}
 
-		queryResult, err := mediatr.Send[*GetProducts, *dtos.GetProductsResponseDto](
+		queryResult, err := mediatr.Send[*GetProductPriceHistory, *dtos.GetProductPriceHistoryResponseDto](
 			ctx,
 			query,
 		)
 		if err != nil {
 			return errors.WithMessage(
 				err,
-				"error in sending GetProducts",
+				"error in sending GetProductPriceHistory",
 			)
 		}
 
@@ -0,0 +1,125 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/cqrs"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/mapper"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/postgresgorm/gormdbcontext"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/utils"
+
+	mediatr "github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/data/datamodels"
+	dtosv1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1/fxparams"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/gettingproductpricehistory/v1/dtos"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/models"
+)
+
+// getProductPriceHistoryHandler is a struct that contains the get product price history handler.
+type getProductPriceHistoryHandler struct {
+	fxparams.ProductHandlerParams
+}
+
+// NewGetProductPriceHistoryHandler is a constructor for the getProductPriceHistoryHandler.
+func NewGetProductPriceHistoryHandler(
+	params fxparams.ProductHandlerParams,
+) cqrs.RequestHandlerWithRegisterer[*GetProductPriceHistory, *dtos.GetProductPriceHistoryResponseDto] {
+	return &getProductPriceHistoryHandler{
+		ProductHandlerParams: params,
+	}
+}
+
+// RegisterHandler is a method that registers the get product price history handler.
+func (c *getProductPriceHistoryHandler) RegisterHandler() error {
+	return mediatr.RegisterRequestHandler[*GetProductPriceHistory, *dtos.GetProductPriceHistoryResponseDto](
+		c,
+	)
+}
+
+// Handle is a method that handles the get product price history query, the newest changes come first.
+func (c *getProductPriceHistoryHandler) Handle(
+	ctx context.Context,
+	query *GetProductPriceHistory,
+) (*dtos.GetProductPriceHistoryResponseDto, error) {
+	exists := gormdbcontext.Exists[*datamodels.ProductDataModel](
+		ctx,
+		c.CatalogsDBContext,
+		query.ProductID,
+	)
+	if !exists {
+		return nil, customErrors.NewNotFoundError(
+			fmt.Sprintf(
+				"product with id `%s` not found",
+				query.ProductID,
+			),
+		)
+	}
+
+	listQuery := query.ListQuery
+	if listQuery == nil {
+		listQuery = utils.NewListQueryFromQueryParams("", "")
+	}
+
+	var (
+		items      []*datamodels.ProductPriceHistoryDataModel
+		totalItems int64
+	)
+
+	db := c.CatalogsDBContext.DB().
+		WithContext(ctx).
+		Model(&datamodels.ProductPriceHistoryDataModel{}).
+		Where("product_id = ?", query.ProductID)
+
+	if err := db.Count(&totalItems).Error; err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"error in counting product price history",
+		)
+	}
+
+	err := db.Order("changed_at desc").
+		Offset(listQuery.GetOffset()).
+		Limit(listQuery.GetLimit()).
+		Find(&items).Error
+	if err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"error in fetching product price history",
+		)
+	}
+
+	history, err := mapper.Map[[]*models.ProductPriceHistory](items)
+	if err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"error in the mapping ProductPriceHistory",
+		)
+	}
+
+	listResultDto, err := utils.ListResultToListResultDto[*dtosv1.ProductPriceHistoryDto](
+		utils.NewListResult(
+			history,
+			listQuery.GetSize(),
+			listQuery.GetPage(),
+			totalItems,
+		),
+	)
+	if err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"error in the mapping",
+		)
+	}
+
+	c.Log.Infow(
+		fmt.Sprintf("price history of product with id '%s' fetched", query.ProductID),
+		logger.Fields{"ID": query.ProductID},
+	)
+
+	return &dtos.GetProductPriceHistoryResponseDto{PriceHistory: listResultDto}, nil
+}
@@ -0,0 +1,12 @@
+// Package dtos contains the import products dtos.
+package dtos
+
+// ImportProductRowDto is a row of a product import, the rows with an id update the existing product with that id.
+type ImportProductRowDto struct {
+	// Row is the 1-based position of the row in the import, without the csv header
+	Row         int     `json:"-"`
+	ID          string  `json:"id,omitempty"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Price       float64 `json:"price"`
+}
@@ -0,0 +1,68 @@
+package dtos
+
+import (
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/json"
+)
+
+// https://echo.labstack.com/guide/response/
+
+// ImportProductsResponseDto is a struct that contains the report of a product import.
+type ImportProductsResponseDto struct {
+	TotalRows int                  `json:"totalRows"`
+	Created   int                  `json:"created"`
+	Updated   int                  `json:"updated"`
+	Failed    int                  `json:"failed"`
+	Errors    []*ImportRowErrorDto `json:"errors"`
+	// Warnings are the saved rows whose messages were not published
+	Warnings []*ImportRowErrorDto `json:"warnings,omitempty"`
+}
+
+// ImportRowErrorDto is a struct that contains the error of a rejected import row.
+type ImportRowErrorDto struct {
+	Row       int    `json:"row"`
+	ProductID string `json:"productId,omitempty"`
+	Message   string `json:"message"`
+}
+
+// NewImportProductsResponseDto is a constructor for an empty ImportProductsResponseDto.
+func NewImportProductsResponseDto() *ImportProductsResponseDto {
+	return &ImportProductsResponseDto{Errors: []*ImportRowErrorDto{}}
+}
+
+// AddError records a rejected row.
+func (i *ImportProductsResponseDto) AddError(row int, productID string, message string) {
+	i.Failed++
+	i.Errors = append(i.Errors, &ImportRowErrorDto{
+		Row:       row,
+		ProductID: productID,
+		Message:   message,
+	})
+}
+
+// AddWarning records a saved row that has a problem, like its message that was not published.
+func (i *ImportProductsResponseDto) AddWarning(row int, productID string, message string) {
+	i.Warnings = append(i.Warnings, &ImportRowErrorDto{
+		Row:       row,
+		ProductID: productID,
+		Message:   message,
+	})
+}
+
+// Merge adds the counters, the errors and the warnings of another report.
+func (i *ImportProductsResponseDto) Merge(other *ImportProductsResponseDto) {
+	if other == nil {
+		return
+	}
+
+	i.TotalRows += other.TotalRows
+	i.Created += other.Created
+	i.Updated += other.Updated
+	i.Failed += other.Failed
+	i.Errors = append(i.Errors, other.Errors...)
+	i.Warnings = append(i.Warnings, other.Warnings...)
+}
+
+// String is a method that returns the string representation of the import products response dto.
+func (i *ImportProductsResponseDto) String() string {
+	return json.PrettyPrint(i)
+}
@@ -0,0 +1,63 @@
+// Package v1 contains the import products v1.
+package v1
+
+import (
+	"time"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/cqrs"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+	uuid "github.com/satori/go.uuid"
+)
+
+// ImportProductItem is a validated row of a product import.
+type ImportProductItem struct {
+	Row         int
+	ProductID   uuid.UUID
+	Name        string
+	Description string
+	Price       float64
+}
+
+// ImportProducts is a struct that contains the import products command, it saves one batch of an import.
+type ImportProducts struct {
+	cqrs.Command
+	Products   []*ImportProductItem
+	ImportedAt time.Time
+}
+
+// NewImportProducts is a constructor for the ImportProducts.
+func NewImportProducts(products []*ImportProductItem) *ImportProducts {
+	return &ImportProducts{
+		Command:    cqrs.NewCommandByT[ImportProducts](),
+		Products:   products,
+		ImportedAt: time.Now(),
+	}
+}
+
+// NewImportProductsWithValidation is a constructor for the ImportProducts with validation.
+func NewImportProductsWithValidation(products []*ImportProductItem) (*ImportProducts, error) {
+	command := NewImportProducts(products)
+	err := command.Validate()
+
+	return command, err
+}
+
+// Validate is a method that validates the import products command.
+func (c *ImportProducts) Validate() error {
+	err := validation.ValidateStruct(
+		c,
+		validation.Field(
+			&c.Products,
+			validation.Required,
+			validation.Length(1, MaxImportBatchSize),
+		),
+		validation.Field(&c.ImportedAt, validation.Required),
+	)
+	if err != nil {
+		return customErrors.NewValidationErrorWrap(err, "validation error")
+	}
+
+	return nil
+}
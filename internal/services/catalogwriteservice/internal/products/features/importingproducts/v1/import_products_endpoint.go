@@ -0,0 +1,90 @@
+package v1
+
+import (
+	"net/http"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/web/route"
+
+	echo "github.com/labstack/echo/v4"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1/fxparams"
+)
+
+// importProductsEndpoint is a struct that contains the import products endpoint.
+type importProductsEndpoint struct {
+	fxparams.ProductRouteParams
+}
+
+// NewImportProductsEndpoint is a constructor for the importProductsEndpoint.
+func NewImportProductsEndpoint(
+	params fxparams.ProductRouteParams,
+) route.Endpoint {
+	return &importProductsEndpoint{ProductRouteParams: params}
+}
+
+// MapEndpoint is a method that maps the endpoint.
+func (ep *importProductsEndpoint) MapEndpoint() {
+	ep.ProductsGroup.POST("/import", ep.handler())
+}
+
+// ImportProducts
+// @Tags Products
+// @Summary Import products
+// @Description Create or update products from a csv or a json array body, the body is read as a stream and saved in batches
+// @Accept json,text/csv
+// @Produce json
+// @Param format query string false "Body format, `csv` or `json`, the content type is used by default"
+// @Success 200 {object} dtos.ImportProductsResponseDto
+// @Router /api/v1/products/import [post].
+func (ep *importProductsEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		var reader ProductRowReader
+
+		switch importFormat(c) {
+		case "csv":
+			reader = NewCSVProductRowReader(c.Request().Body)
+		case "json":
+			reader = NewJSONProductRowReader(c.Request().Body)
+		default:
+			return customErrors.NewBadRequestError(
+				"the import format must be `csv` or `json`",
+			)
+		}
+
+		result, err := ImportProductRows(ctx, reader)
+		if err != nil {
+			if ctx.Err() != nil {
+				return errors.WithMessage(err, "error in importing products")
+			}
+
+			return customErrors.NewBadRequestErrorWrap(
+				err,
+				"error in reading the import",
+			)
+		}
+
+		return c.JSON(http.StatusOK, result)
+	}
+}
+
+func importFormat(c echo.Context) string {
+	if format := c.QueryParam("format"); format != "" {
+		return strings.ToLower(format)
+	}
+
+	contentType := c.Request().Header.Get(echo.HeaderContentType)
+
+	switch {
+	case strings.HasPrefix(contentType, "text/csv"):
+		return "csv"
+	case strings.HasPrefix(contentType, echo.MIMEApplicationJSON), contentType == "":
+		return "json"
+	default:
+		return contentType
+	}
+}
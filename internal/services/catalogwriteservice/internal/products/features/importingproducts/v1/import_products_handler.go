@@ -0,0 +1,300 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/cqrs"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/mapper"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/postgresgorm/contracts"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/postgresgorm/gormdbcontext"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/reflection/typemapper"
+
+	mediatr "github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/data/datamodels"
+	dtosv1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1/fxparams"
+	pricechangedevents "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/changingproductprice/v1/events/integrationevents"
+	createdevents "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/creatingproduct/v1/events/integrationevents"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/importingproducts/v1/dtos"
+	updatedevents "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/updatingproduct/v1/events/integrationevents"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/models"
+)
+
+// importProductsHandler is a struct that contains the import products handler.
+type importProductsHandler struct {
+	fxparams.ProductHandlerParams
+}
+
+// NewImportProductsHandler is a constructor for the importProductsHandler.
+func NewImportProductsHandler(
+	params fxparams.ProductHandlerParams,
+) cqrs.RequestHandlerWithRegisterer[*ImportProducts, *dtos.ImportProductsResponseDto] {
+	return &importProductsHandler{
+		ProductHandlerParams: params,
+	}
+}
+
+// RegisterHandler is a method that registers the import products handler.
+func (c *importProductsHandler) RegisterHandler() error {
+	return mediatr.RegisterRequestHandler[*ImportProducts, *dtos.ImportProductsResponseDto](
+		c,
+	)
+}
+
+// Handle is a method that handles the import products command, the whole batch is saved in one transaction
+// and its messages are published after the commit.
+func (c *importProductsHandler) Handle(
+	ctx context.Context,
+	command *ImportProducts,
+) (*dtos.ImportProductsResponseDto, error) {
+	result := dtos.NewImportProductsResponseDto()
+	result.TotalRows = len(command.Products)
+
+	var messages []*importedMessage
+
+	err := c.CatalogsDBContext.RunInTx(
+		ctx,
+		func(ctx context.Context, _ contracts.GormDBContext) error {
+			var err error
+			messages, err = c.saveProducts(ctx, command, result)
+
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	c.publishMessages(ctx, messages, result)
+
+	c.Log.Infow(
+		fmt.Sprintf(
+			"%d products imported, %d created, %d updated and %d failed",
+			result.TotalRows,
+			result.Created,
+			result.Updated,
+			result.Failed,
+		),
+		logger.Fields{
+			"Created":  result.Created,
+			"Updated":  result.Updated,
+			"Failed":   result.Failed,
+			"Warnings": len(result.Warnings),
+		},
+	)
+
+	return result, nil
+}
+
+// importedMessage is a message of an imported row, it is published after the batch is committed.
+type importedMessage struct {
+	row       int
+	productID uuid.UUID
+	message   types.IMessage
+}
+
+func (c *importProductsHandler) saveProducts(
+	ctx context.Context,
+	command *ImportProducts,
+	result *dtos.ImportProductsResponseDto,
+) ([]*importedMessage, error) {
+	ids := make([]uuid.UUID, 0, len(command.Products))
+	for _, item := range command.Products {
+		ids = append(ids, item.ProductID)
+	}
+
+	existingProducts, err := c.ProductRepository.GetProductsByIDs(ctx, ids)
+	if err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"error in getting the existing products",
+		)
+	}
+
+	deletedIDs, err := c.ProductRepository.GetDeletedProductIDs(ctx, ids)
+	if err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"error in getting the deleted products",
+		)
+	}
+
+	existing := make(map[uuid.UUID]*models.Product, len(existingProducts))
+	for _, product := range existingProducts {
+		existing[product.ID] = product
+	}
+
+	deleted := make(map[uuid.UUID]bool, len(deletedIDs))
+	for _, id := range deletedIDs {
+		deleted[id] = true
+	}
+
+	items := make([]*ImportProductItem, 0, len(command.Products))
+	products := make([]*models.Product, 0, len(command.Products))
+
+	for _, item := range command.Products {
+		if deleted[item.ProductID] {
+			// the upsert would otherwise revive the deleted row without a restore event
+			result.AddError(
+				item.Row,
+				item.ProductID.String(),
+				"the product is deleted, restore it before importing it",
+			)
+
+			continue
+		}
+
+		product := &models.Product{
+			ID:          item.ProductID,
+			Name:        item.Name,
+			Description: item.Description,
+			Price:       item.Price,
+			Version:     1,
+			CreatedAt:   command.ImportedAt,
+		}
+		if old, ok := existing[item.ProductID]; ok {
+			// the upsert increments the stored version
+			product.CreatedAt = old.CreatedAt
+			product.UpdatedAt = command.ImportedAt
+			product.Version = old.Version + 1
+		}
+
+		items = append(items, item)
+		products = append(products, product)
+	}
+
+	if len(products) == 0 {
+		return nil, nil
+	}
+
+	err = c.ProductRepository.UpsertProducts(ctx, products)
+	if err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"error in upserting the imported products",
+		)
+	}
+
+	messages := make([]*importedMessage, 0, len(products))
+
+	for i, product := range products {
+		old, ok := existing[product.ID]
+		if !ok {
+			result.Created++
+		} else {
+			result.Updated++
+
+			if old.Price != product.Price {
+				priceChanged, err := c.recordPriceChange(ctx, product, old.Price, command)
+				if err != nil {
+					return nil, err
+				}
+
+				messages = append(messages, &importedMessage{
+					row:       items[i].Row,
+					productID: product.ID,
+					message:   priceChanged,
+				})
+			}
+		}
+
+		message, err := c.productEvent(product, ok)
+		if err != nil {
+			return nil, err
+		}
+
+		messages = append(messages, &importedMessage{
+			row:       items[i].Row,
+			productID: product.ID,
+			message:   message,
+		})
+	}
+
+	return messages, nil
+}
+
+func (c *importProductsHandler) recordPriceChange(
+	ctx context.Context,
+	product *models.Product,
+	oldPrice float64,
+	command *ImportProducts,
+) (types.IMessage, error) {
+	_, err := gormdbcontext.AddModel[*datamodels.ProductPriceHistoryDataModel, *models.ProductPriceHistory](
+		ctx,
+		c.CatalogsDBContext,
+		&models.ProductPriceHistory{
+			ID:        uuid.NewV4(),
+			ProductID: product.ID,
+			OldPrice:  oldPrice,
+			NewPrice:  product.Price,
+			Reason:    models.PriceChangeReasonImported,
+			ChangedAt: command.ImportedAt,
+		},
+	)
+	if err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"error in adding product price history",
+		)
+	}
+
+	return pricechangedevents.NewProductPriceChangedV1(
+		product.ID,
+		oldPrice,
+		product.Price,
+		models.PriceChangeReasonImported,
+		nil,
+		command.ImportedAt,
+	), nil
+}
+
+func (c *importProductsHandler) productEvent(
+	product *models.Product,
+	exists bool,
+) (types.IMessage, error) {
+	productDto, err := mapper.Map[*dtosv1.ProductDto](product)
+	if err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"error in the mapping ProductDto",
+		)
+	}
+
+	if exists {
+		return updatedevents.NewProductUpdatedV1(productDto), nil
+	}
+
+	return createdevents.NewProductCreatedV1(productDto), nil
+}
+
+// publishMessages publishes the messages of the committed batch, a failed publish can't undo the saved row,
+// so it is reported as a warning of the row.
+func (c *importProductsHandler) publishMessages(
+	ctx context.Context,
+	messages []*importedMessage,
+	result *dtos.ImportProductsResponseDto,
+) {
+	for _, m := range messages {
+		err := c.RabbitmqProducer.PublishMessage(ctx, m.message, nil)
+		if err == nil {
+			continue
+		}
+
+		messageType := typemapper.GetNonePointerTypeName(m.message)
+		c.Log.Errorw(
+			fmt.Sprintf("error in publishing '%s' message of an imported product", messageType),
+			logger.Fields{"ProductID": m.productID, "Row": m.row, "Error": err},
+		)
+		result.AddWarning(
+			m.row,
+			m.productID.String(),
+			fmt.Sprintf("the product is saved, but its '%s' message was not published: %v", messageType, err),
+		)
+	}
+}
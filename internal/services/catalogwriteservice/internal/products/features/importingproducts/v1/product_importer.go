@@ -0,0 +1,153 @@
+package v1
+
+import (
+	"context"
+	"io"
+
+	"emperror.dev/errors"
+
+	mediatr "github.com/mehdihadeli/go-mediatr"
+	uuid "github.com/satori/go.uuid"
+
+	creatingproductv1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/creatingproduct/v1"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/importingproducts/v1/dtos"
+)
+
+// MaxImportBatchSize is the number of rows saved in one transaction.
+const MaxImportBatchSize = 500
+
+// ImportProductRows validates the rows of the reader with the create product rules and saves the valid ones
+// in batches with the ImportProducts command. The rejected rows and the failed batches are in the report,
+// an error is only returned when the reader can't continue.
+func ImportProductRows(
+	ctx context.Context,
+	reader ProductRowReader,
+) (*dtos.ImportProductsResponseDto, error) {
+	report := dtos.NewImportProductsResponseDto()
+	batch := make([]*ImportProductItem, 0, MaxImportBatchSize)
+	seen := make(map[uuid.UUID]int)
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		err := importBatch(ctx, batch, report)
+		batch = make([]*ImportProductItem, 0, MaxImportBatchSize)
+
+		return err
+	}
+
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		var rowErr *RowError
+		if errors.As(err, &rowErr) {
+			report.TotalRows++
+			report.AddError(rowErr.Row, rowErr.ProductID, rowErr.Err.Error())
+
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		report.TotalRows++
+
+		item, err := newImportProductItem(row)
+		if err != nil {
+			report.AddError(row.Row, row.ID, err.Error())
+
+			continue
+		}
+
+		// the same product can't be upserted twice in one statement, and a later row would silently win
+		if previous, ok := seen[item.ProductID]; ok {
+			report.AddError(
+				row.Row,
+				row.ID,
+				errors.Errorf("duplicate of the product in row %d", previous).Error(),
+			)
+
+			continue
+		}
+
+		seen[item.ProductID] = row.Row
+		batch = append(batch, item)
+
+		if len(batch) == MaxImportBatchSize {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+func newImportProductItem(row *dtos.ImportProductRowDto) (*ImportProductItem, error) {
+	command := creatingproductv1.NewCreateProduct(row.Name, row.Description, row.Price)
+
+	if row.ID != "" {
+		id, err := uuid.FromString(row.ID)
+		if err != nil {
+			return nil, errors.Errorf("id: invalid uuid %q", row.ID)
+		}
+
+		command.ProductID = id
+	}
+
+	if err := command.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &ImportProductItem{
+		Row:         row.Row,
+		ProductID:   command.ProductID,
+		Name:        command.Name,
+		Description: command.Description,
+		Price:       command.Price,
+	}, nil
+}
+
+func importBatch(
+	ctx context.Context,
+	batch []*ImportProductItem,
+	report *dtos.ImportProductsResponseDto,
+) error {
+	command, err := NewImportProductsWithValidation(batch)
+	if err != nil {
+		return err
+	}
+
+	result, err := mediatr.Send[*ImportProducts, *dtos.ImportProductsResponseDto](
+		ctx,
+		command,
+	)
+	if err != nil {
+		if ctx.Err() != nil {
+			return errors.WithMessage(ctx.Err(), "the import was canceled")
+		}
+
+		// the batch was rolled back, so every row of it is reported and can be sent again
+		for _, item := range batch {
+			report.AddError(item.Row, item.ProductID.String(), err.Error())
+		}
+
+		return nil
+	}
+
+	// the rows of the batch are already counted by the importer
+	result.TotalRows = 0
+	report.Merge(result)
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package v1
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"emperror.dev/errors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/importingproducts/v1/dtos"
+)
+
+// ProductRowReader reads the rows of a product import one at a time, so an import is never loaded in memory.
+type ProductRowReader interface {
+	// Read returns the next row and io.EOF after the last one. A *RowError is returned for a row that
+	// can't be parsed, the reading continues with the next row after it, any other error stops the import.
+	Read() (*dtos.ImportProductRowDto, error)
+}
+
+// RowError is an error of a single import row.
+type RowError struct {
+	Row       int
+	ProductID string
+	Err       error
+}
+
+// Error returns the error message of the row.
+func (e *RowError) Error() string {
+	return fmt.Sprintf("row %d: %v", e.Row, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// csvProductRowReader reads the rows of a csv import, the first record is the header.
+type csvProductRowReader struct {
+	reader  *csv.Reader
+	columns map[string]int
+	row     int
+}
+
+// NewCSVProductRowReader creates a ProductRowReader for a csv with a `name`, `description` and `price` header,
+// the `id` column is optional and the other columns are ignored.
+func NewCSVProductRowReader(r io.Reader) ProductRowReader {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	return &csvProductRowReader{reader: reader}
+}
+
+// Read returns the next row of the csv.
+func (c *csvProductRowReader) Read() (*dtos.ImportProductRowDto, error) {
+	if c.columns == nil {
+		if err := c.readHeader(); err != nil {
+			return nil, err
+		}
+	}
+
+	record, err := c.reader.Read()
+	if err == io.EOF {
+		return nil, io.EOF
+	}
+
+	c.row++
+
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return nil, &RowError{Row: c.row, Err: parseErr.Err}
+	}
+
+	if err != nil {
+		return nil, errors.WrapIf(err, "error in reading the csv")
+	}
+
+	row := &dtos.ImportProductRowDto{
+		Row:         c.row,
+		ID:          c.field(record, "id"),
+		Name:        c.field(record, "name"),
+		Description: c.field(record, "description"),
+	}
+
+	if price := c.field(record, "price"); price != "" {
+		row.Price, err = strconv.ParseFloat(price, 64)
+		if err != nil {
+			return nil, &RowError{
+				Row:       c.row,
+				ProductID: row.ID,
+				Err:       errors.Errorf("price: invalid number %q", price),
+			}
+		}
+	}
+
+	return row, nil
+}
+
+func (c *csvProductRowReader) readHeader() error {
+	header, err := c.reader.Read()
+	if err == io.EOF {
+		return errors.New("the csv has no header")
+	}
+
+	if err != nil {
+		return errors.WrapIf(err, "error in reading the csv header")
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+
+	for _, required := range []string{"name", "description", "price"} {
+		if _, ok := columns[required]; !ok {
+			return errors.Errorf("the csv header has no `%s` column", required)
+		}
+	}
+
+	c.columns = columns
+
+	return nil
+}
+
+func (c *csvProductRowReader) field(record []string, column string) string {
+	i, ok := c.columns[column]
+	if !ok || i >= len(record) {
+		return ""
+	}
+
+	return strings.TrimSpace(record[i])
+}
+
+// jsonProductRowReader reads the rows of a json array import.
+type jsonProductRowReader struct {
+	decoder *json.Decoder
+	started bool
+	row     int
+}
+
+// NewJSONProductRowReader creates a ProductRowReader for a json array of products.
+func NewJSONProductRowReader(r io.Reader) ProductRowReader {
+	return &jsonProductRowReader{decoder: json.NewDecoder(r)}
+}
+
+// Read returns the next element of the json array.
+func (j *jsonProductRowReader) Read() (*dtos.ImportProductRowDto, error) {
+	if !j.started {
+		token, err := j.decoder.Token()
+		if err != nil {
+			return nil, errors.WrapIf(err, "error in reading the json")
+		}
+
+		if delim, ok := token.(json.Delim); !ok || delim != '[' {
+			return nil, errors.New("the json import must be an array of products")
+		}
+
+		j.started = true
+	}
+
+	if !j.decoder.More() {
+		return nil, io.EOF
+	}
+
+	j.row++
+
+	row := &dtos.ImportProductRowDto{}
+
+	err := j.decoder.Decode(row)
+
+	// the decoder skips the whole value on a type error, so the next row can still be read
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return nil, &RowError{
+			Row:       j.row,
+			ProductID: row.ID,
+			Err:       errors.Errorf("%s: invalid type %s", typeErr.Field, typeErr.Value),
+		}
+	}
+
+	if err != nil {
+		return nil, errors.WrapIf(err, "error in reading the json")
+	}
+
+	row.Row = j.row
+
+	return row, nil
+}
@@ -0,0 +1,11 @@
+// Package dtos contains the purge product request dto.
+package dtos
+
+import uuid "github.com/satori/go.uuid"
+
+// https://echo.labstack.com/guide/binding/
+
+// PurgeProductRequestDto validation will handle in command level.
+type PurgeProductRequestDto struct {
+	ProductID uuid.UUID `param:"id" json:"-"`
+}
@@ -0,0 +1,24 @@
+// Package integrationevents contains the product purged v1.
+package integrationevents
+
+import (
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// ProductPurgedV1 is a struct that contains the product purged v1.
+type ProductPurgedV1 struct {
+	*types.Message
+	ProductID string `json:"productID,omitempty"`
+}
+
+// NewProductPurgedV1 is a constructor for the ProductPurgedV1.
+func NewProductPurgedV1(productID string) *ProductPurgedV1 {
+	return &ProductPurgedV1{ProductID: productID, Message: types.NewMessage(uuid.NewV4().String())}
+}
+
+// GetPartitionKey returns the id of the product, the consumers handle the events of a product in their order.
+func (p *ProductPurgedV1) GetPartitionKey() string {
+	return p.ProductID
+}
@@ -0,0 +1,47 @@
+// Package v1 contains the purge product command.
+package v1
+
+import (
+	"github.com/go-ozzo/ozzo-validation/is"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/cqrs"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+	uuid "github.com/satori/go.uuid"
+)
+
+// PurgeProduct is a struct that contains the purge product command, it permanently deletes a product
+// and its price history, deleted or not.
+type PurgeProduct struct {
+	cqrs.Command
+	ProductID uuid.UUID
+}
+
+// NewPurgeProduct is a constructor for the PurgeProduct.
+func NewPurgeProduct(productID uuid.UUID) *PurgeProduct {
+	return &PurgeProduct{
+		Command:   cqrs.NewCommandByT[PurgeProduct](),
+		ProductID: productID,
+	}
+}
+
+// NewPurgeProductWithValidation is a constructor for the PurgeProduct with validation.
+func NewPurgeProductWithValidation(productID uuid.UUID) (*PurgeProduct, error) {
+	command := NewPurgeProduct(productID)
+	err := command.Validate()
+
+	return command, err
+}
+
+// Validate is a method that validates the purge product command.
+func (c *PurgeProduct) Validate() error {
+	err := validation.ValidateStruct(
+		c,
+		validation.Field(&c.ProductID, validation.Required, is.UUIDv4),
+	)
+	if err != nil {
+		return customErrors.NewValidationErrorWrap(err, "validation error")
+	}
+
+	return nil
+}
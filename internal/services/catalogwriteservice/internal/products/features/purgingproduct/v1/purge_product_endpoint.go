@@ -0,0 +1,83 @@
+package v1
+
+import (
+	"net/http"
+
+	"emperror.dev/errors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/authentication"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/web/route"
+
+	echo "github.com/labstack/echo/v4"
+	mediatr "github.com/mehdihadeli/go-mediatr"
+	authenticationMiddleware "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/customecho/middlewares/authentication"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1/fxparams"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/purgingproduct/v1/dtos"
+)
+
+// purgeProductEndpoint is a struct that contains the purge product endpoint.
+type purgeProductEndpoint struct {
+	fxparams.ProductRouteParams
+}
+
+// NewPurgeProductEndpoint is a constructor for the purgeProductEndpoint.
+func NewPurgeProductEndpoint(
+	params fxparams.ProductRouteParams,
+) route.Endpoint {
+	return &purgeProductEndpoint{ProductRouteParams: params}
+}
+
+// MapEndpoint is a method that maps the endpoint.
+func (ep *purgeProductEndpoint) MapEndpoint() {
+	// only an admin sees, restores and purges the deleted products
+	ep.ProductsGroup.DELETE(
+		"/:id/purge",
+		ep.handler(),
+		authenticationMiddleware.RequireRole(authentication.RoleAdmin),
+	)
+}
+
+// PurgeProduct
+// @Tags Products
+// @Summary Purge product
+// @Description Permanently delete a deleted product with its price history and its scheduled price changes,
+// @Description the price history is deleted for good and is not kept anywhere else
+// @Accept json
+// @Produce json
+// @Success 204
+// @Param id path string true "Product ID"
+// @Router /api/v1/products/{id}/purge [delete].
+func (ep *purgeProductEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		request := &dtos.PurgeProductRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(
+				err,
+				"error in the binding request",
+			)
+
+			return badRequestErr
+		}
+
+		command, err := NewPurgeProductWithValidation(request.ProductID)
+		if err != nil {
+			return err
+		}
+
+		_, err = mediatr.Send[*PurgeProduct, *mediatr.Unit](
+			ctx,
+			command,
+		)
+		if err != nil {
+			return errors.WithMessage(
+				err,
+				"error in sending PurgeProduct",
+			)
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,132 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/cqrs"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/postgresgorm/contracts"
+	"gorm.io/gorm"
+
+	mediatr "github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/data/datamodels"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1/fxparams"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/purgingproduct/v1/events/integrationevents"
+)
+
+// purgeProductHandler is a struct that contains the purge product handler.
+type purgeProductHandler struct {
+	fxparams.ProductHandlerParams
+}
+
+// NewPurgeProductHandler is a constructor for the purgeProductHandler.
+func NewPurgeProductHandler(
+	params fxparams.ProductHandlerParams,
+) cqrs.RequestHandlerWithRegisterer[*PurgeProduct, *mediatr.Unit] {
+	return &purgeProductHandler{
+		ProductHandlerParams: params,
+	}
+}
+
+// RegisterHandler is a method that registers the purge product handler.
+func (c *purgeProductHandler) RegisterHandler() error {
+	return mediatr.RegisterRequestHandler[*PurgeProduct, *mediatr.Unit](
+		c,
+	)
+}
+
+// Handle is a method that handles the purge product command, the price history and the scheduled price changes of
+// the product are deleted with it for good, the audit log only keeps the snapshots of the product row.
+func (c *purgeProductHandler) Handle(
+	ctx context.Context,
+	command *PurgeProduct,
+) (*mediatr.Unit, error) {
+	productPurged := integrationevents.NewProductPurgedV1(command.ProductID.String())
+
+	err := c.CatalogsDBContext.RunInTx(
+		ctx,
+		func(ctx context.Context, _ contracts.GormDBContext) error {
+			db := c.CatalogsDBContext.WithTxIfExists(ctx).DB().WithContext(ctx)
+
+			dataModel := &datamodels.ProductDataModel{}
+
+			err := db.Unscoped().Where("id = ?", command.ProductID).First(dataModel).Error
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return customErrors.NewNotFoundError(
+					fmt.Sprintf("product with id `%s` not found", command.ProductID),
+				)
+			}
+
+			if err != nil {
+				return customErrors.NewApplicationErrorWrap(
+					err,
+					"error in finding the product",
+				)
+			}
+
+			// only a deleted product is purged, so a product is never lost by a single request
+			if !dataModel.DeletedAt.Valid {
+				return customErrors.NewConflictError(
+					fmt.Sprintf("product with id `%s` is not deleted", command.ProductID),
+				)
+			}
+
+			// the price tables reference the product, so they are deleted first, the price history is lost with them
+			err = db.Where("product_id = ?", command.ProductID).
+				Delete(&datamodels.ProductPriceHistoryDataModel{}).Error
+			if err != nil {
+				return customErrors.NewApplicationErrorWrap(
+					err,
+					"error in purging the product price history",
+				)
+			}
+
+			err = db.Where("product_id = ?", command.ProductID).
+				Delete(&datamodels.ScheduledPriceChangeDataModel{}).Error
+			if err != nil {
+				return customErrors.NewApplicationErrorWrap(
+					err,
+					"error in purging the product scheduled price changes",
+				)
+			}
+
+			err = db.Unscoped().Delete(dataModel).Error
+			if err != nil {
+				return customErrors.NewApplicationErrorWrap(
+					err,
+					"error in purging the product",
+				)
+			}
+
+			err = c.RabbitmqProducer.PublishMessage(ctx, productPurged, nil)
+			if err != nil {
+				return customErrors.NewApplicationErrorWrap(
+					err,
+					"error in publishing 'ProductPurged' message",
+				)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Log.Infow(
+		fmt.Sprintf(
+			"product with id '%s' purged",
+			command.ProductID,
+		),
+		logger.Fields{
+			"ID":        command.ProductID,
+			"MessageId": productPurged.MessageId,
+		},
+	)
+
+	return &mediatr.Unit{}, nil
+}
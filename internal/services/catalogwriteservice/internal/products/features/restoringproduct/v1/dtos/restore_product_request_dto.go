@@ -0,0 +1,11 @@
+// Package dtos contains the restore product request dto.
+package dtos
+
+import uuid "github.com/satori/go.uuid"
+
+// https://echo.labstack.com/guide/binding/
+
+// RestoreProductRequestDto validation will handle in command level.
+type RestoreProductRequestDto struct {
+	ProductID uuid.UUID `param:"id" json:"-"`
+}
@@ -0,0 +1,34 @@
+// Package integrationevents contains the product restored v1.
+package integrationevents
+
+import (
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+
+	uuid "github.com/satori/go.uuid"
+
+	dtoV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1"
+)
+
+// ProductRestoredV1 is a struct that contains the product restored v1, it has the whole product so the
+// read models can add it again.
+type ProductRestoredV1 struct {
+	*types.Message
+	*dtoV1.ProductDto
+}
+
+// NewProductRestoredV1 is a constructor for the ProductRestoredV1.
+func NewProductRestoredV1(productDto *dtoV1.ProductDto) *ProductRestoredV1 {
+	return &ProductRestoredV1{
+		ProductDto: productDto,
+		Message:    types.NewMessage(uuid.NewV4().String()),
+	}
+}
+
+// GetPartitionKey returns the id of the product, the consumers handle the events of a product in their order.
+func (p *ProductRestoredV1) GetPartitionKey() string {
+	if p.ProductDto == nil {
+		return ""
+	}
+
+	return p.ID.String()
+}
@@ -0,0 +1,51 @@
+// Package v1 contains the restore product command.
+package v1
+
+import (
+	"time"
+
+	"github.com/go-ozzo/ozzo-validation/is"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/cqrs"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+	uuid "github.com/satori/go.uuid"
+)
+
+// RestoreProduct is a struct that contains the restore product command, it undoes the soft delete of a product.
+type RestoreProduct struct {
+	cqrs.Command
+	ProductID  uuid.UUID
+	RestoredAt time.Time
+}
+
+// NewRestoreProduct is a constructor for the RestoreProduct.
+func NewRestoreProduct(productID uuid.UUID) *RestoreProduct {
+	return &RestoreProduct{
+		Command:    cqrs.NewCommandByT[RestoreProduct](),
+		ProductID:  productID,
+		RestoredAt: time.Now(),
+	}
+}
+
+// NewRestoreProductWithValidation is a constructor for the RestoreProduct with validation.
+func NewRestoreProductWithValidation(productID uuid.UUID) (*RestoreProduct, error) {
+	command := NewRestoreProduct(productID)
+	err := command.Validate()
+
+	return command, err
+}
+
+// Validate is a method that validates the restore product command.
+func (c *RestoreProduct) Validate() error {
+	err := validation.ValidateStruct(
+		c,
+		validation.Field(&c.ProductID, validation.Required, is.UUIDv4),
+		validation.Field(&c.RestoredAt, validation.Required),
+	)
+	if err != nil {
+		return customErrors.NewValidationErrorWrap(err, "validation error")
+	}
+
+	return nil
+}
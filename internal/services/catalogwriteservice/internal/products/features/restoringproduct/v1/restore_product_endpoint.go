@@ -0,0 +1,82 @@
+package v1
+
+import (
+	"net/http"
+
+	"emperror.dev/errors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/authentication"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/web/route"
+
+	echo "github.com/labstack/echo/v4"
+	mediatr "github.com/mehdihadeli/go-mediatr"
+	authenticationMiddleware "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/customecho/middlewares/authentication"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1/fxparams"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/restoringproduct/v1/dtos"
+)
+
+// restoreProductEndpoint is a struct that contains the restore product endpoint.
+type restoreProductEndpoint struct {
+	fxparams.ProductRouteParams
+}
+
+// NewRestoreProductEndpoint is a constructor for the restoreProductEndpoint.
+func NewRestoreProductEndpoint(
+	params fxparams.ProductRouteParams,
+) route.Endpoint {
+	return &restoreProductEndpoint{ProductRouteParams: params}
+}
+
+// MapEndpoint is a method that maps the endpoint.
+func (ep *restoreProductEndpoint) MapEndpoint() {
+	// only an admin sees, restores and purges the deleted products
+	ep.ProductsGroup.POST(
+		"/:id/restore",
+		ep.handler(),
+		authenticationMiddleware.RequireRole(authentication.RoleAdmin),
+	)
+}
+
+// RestoreProduct
+// @Tags Products
+// @Summary Restore product
+// @Description Restore a deleted product
+// @Accept json
+// @Produce json
+// @Success 204
+// @Param id path string true "Product ID"
+// @Router /api/v1/products/{id}/restore [post].
+func (ep *restoreProductEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		request := &dtos.RestoreProductRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(
+				err,
+				"error in the binding request",
+			)
+
+			return badRequestErr
+		}
+
+		command, err := NewRestoreProductWithValidation(request.ProductID)
+		if err != nil {
+			return err
+		}
+
+		_, err = mediatr.Send[*RestoreProduct, *mediatr.Unit](
+			ctx,
+			command,
+		)
+		if err != nil {
+			return errors.WithMessage(
+				err,
+				"error in sending RestoreProduct",
+			)
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
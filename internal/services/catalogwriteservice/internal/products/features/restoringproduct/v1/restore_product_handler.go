@@ -0,0 +1,142 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/cqrs"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/mapper"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/postgresgorm/contracts"
+	"gorm.io/gorm"
+
+	mediatr "github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/data/datamodels"
+	dtosv1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1/fxparams"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/restoringproduct/v1/events/integrationevents"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/models"
+)
+
+// restoreProductHandler is a struct that contains the restore product handler.
+type restoreProductHandler struct {
+	fxparams.ProductHandlerParams
+}
+
+// NewRestoreProductHandler is a constructor for the restoreProductHandler.
+func NewRestoreProductHandler(
+	params fxparams.ProductHandlerParams,
+) cqrs.RequestHandlerWithRegisterer[*RestoreProduct, *mediatr.Unit] {
+	return &restoreProductHandler{
+		ProductHandlerParams: params,
+	}
+}
+
+// RegisterHandler is a method that registers the restore product handler.
+func (c *restoreProductHandler) RegisterHandler() error {
+	return mediatr.RegisterRequestHandler[*RestoreProduct, *mediatr.Unit](
+		c,
+	)
+}
+
+// Handle is a method that handles the restore product command.
+func (c *restoreProductHandler) Handle(
+	ctx context.Context,
+	command *RestoreProduct,
+) (*mediatr.Unit, error) {
+	var productRestored *integrationevents.ProductRestoredV1
+
+	err := c.CatalogsDBContext.RunInTx(
+		ctx,
+		func(ctx context.Context, _ contracts.GormDBContext) error {
+			db := c.CatalogsDBContext.WithTxIfExists(ctx).DB().WithContext(ctx)
+
+			dataModel := &datamodels.ProductDataModel{}
+
+			err := db.Unscoped().Where("id = ?", command.ProductID).First(dataModel).Error
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return customErrors.NewNotFoundError(
+					fmt.Sprintf("product with id `%s` not found", command.ProductID),
+				)
+			}
+
+			if err != nil {
+				return customErrors.NewApplicationErrorWrap(
+					err,
+					"error in finding the product",
+				)
+			}
+
+			if !dataModel.DeletedAt.Valid {
+				return customErrors.NewConflictError(
+					fmt.Sprintf("product with id `%s` is not deleted", command.ProductID),
+				)
+			}
+
+			// a map is used, so the deleted_at is set to null
+			err = db.Unscoped().
+				Model(dataModel).
+				Updates(map[string]interface{}{
+					"deleted_at": nil,
+					"updated_at": command.RestoredAt,
+					"version":    gorm.Expr("version + 1"),
+				}).Error
+			if err != nil {
+				return customErrors.NewApplicationErrorWrap(
+					err,
+					"error in restoring the product",
+				)
+			}
+
+			dataModel.UpdatedAt = command.RestoredAt
+			dataModel.Version++
+
+			product, err := mapper.Map[*models.Product](dataModel)
+			if err != nil {
+				return customErrors.NewApplicationErrorWrap(
+					err,
+					"error in the mapping Product",
+				)
+			}
+
+			productDto, err := mapper.Map[*dtosv1.ProductDto](product)
+			if err != nil {
+				return customErrors.NewApplicationErrorWrap(
+					err,
+					"error in the mapping ProductDto",
+				)
+			}
+
+			productRestored = integrationevents.NewProductRestoredV1(productDto)
+
+			err = c.RabbitmqProducer.PublishMessage(ctx, productRestored, nil)
+			if err != nil {
+				return customErrors.NewApplicationErrorWrap(
+					err,
+					"error in publishing 'ProductRestored' message",
+				)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Log.Infow(
+		fmt.Sprintf(
+			"product with id '%s' restored",
+			command.ProductID,
+		),
+		logger.Fields{
+			"ID":        command.ProductID,
+			"MessageId": productRestored.MessageId,
+		},
+	)
+
+	return &mediatr.Unit{}, nil
+}
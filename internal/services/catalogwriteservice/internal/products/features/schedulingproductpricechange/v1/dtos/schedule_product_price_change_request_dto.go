@@ -0,0 +1,17 @@
+// Package dtos contains the schedule product price change request dto.
+package dtos
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// https://echo.labstack.com/guide/binding/
+
+// ScheduleProductPriceChangeRequestDto validation will handle in command level.
+type ScheduleProductPriceChangeRequestDto struct {
+	ProductID   uuid.UUID `json:"-"           param:"id"`
+	NewPrice    float64   `json:"newPrice"`
+	EffectiveAt time.Time `json:"effectiveAt"`
+}
@@ -0,0 +1,19 @@
+package dtos
+
+import (
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/json"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// https://echo.labstack.com/guide/response/
+
+// ScheduleProductPriceChangeResponseDto is a struct that contains the schedule product price change response dto.
+type ScheduleProductPriceChangeResponseDto struct {
+	ScheduledPriceChangeID uuid.UUID `json:"scheduledPriceChangeId"`
+}
+
+// String is a method that returns the string representation of the schedule product price change response dto.
+func (s *ScheduleProductPriceChangeResponseDto) String() string {
+	return json.PrettyPrint(s)
+}
@@ -0,0 +1,77 @@
+// Package v1 contains the schedule product price change command.
+package v1
+
+import (
+	"time"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/cqrs"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+	uuid "github.com/satori/go.uuid"
+)
+
+// ScheduleProductPriceChange is a struct that contains the schedule product price change command.
+type ScheduleProductPriceChange struct {
+	cqrs.Command
+	ScheduledPriceChangeID uuid.UUID
+	ProductID              uuid.UUID
+	NewPrice               float64
+	EffectiveAt            time.Time
+	CreatedAt              time.Time
+}
+
+// NewScheduleProductPriceChange is a constructor for the ScheduleProductPriceChange.
+func NewScheduleProductPriceChange(
+	productID uuid.UUID,
+	newPrice float64,
+	effectiveAt time.Time,
+) *ScheduleProductPriceChange {
+	command := &ScheduleProductPriceChange{
+		Command:                cqrs.NewCommandByT[ScheduleProductPriceChange](),
+		ScheduledPriceChangeID: uuid.NewV4(),
+		ProductID:              productID,
+		NewPrice:               newPrice,
+		EffectiveAt:            effectiveAt,
+		CreatedAt:              time.Now(),
+	}
+
+	return command
+}
+
+// NewScheduleProductPriceChangeWithValidation is a constructor for the ScheduleProductPriceChange with validation.
+func NewScheduleProductPriceChangeWithValidation(
+	productID uuid.UUID,
+	newPrice float64,
+	effectiveAt time.Time,
+) (*ScheduleProductPriceChange, error) {
+	command := NewScheduleProductPriceChange(productID, newPrice, effectiveAt)
+	err := command.Validate()
+
+	return command, err
+}
+
+// Validate is a method that validates the schedule product price change command.
+func (c *ScheduleProductPriceChange) Validate() error {
+	err := validation.ValidateStruct(
+		c,
+		validation.Field(&c.ScheduledPriceChangeID, validation.Required),
+		validation.Field(&c.ProductID, validation.Required),
+		validation.Field(
+			&c.NewPrice,
+			validation.Required,
+			validation.Min(0.0).Exclusive(),
+		),
+		validation.Field(
+			&c.EffectiveAt,
+			validation.Required,
+			validation.Min(c.CreatedAt).Exclusive().Error("must be in the future"),
+		),
+		validation.Field(&c.CreatedAt, validation.Required),
+	)
+	if err != nil {
+		return customErrors.NewValidationErrorWrap(err, "validation error")
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package v1
+
+import (
+	"net/http"
+
+	"emperror.dev/errors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/web/route"
+
+	echo "github.com/labstack/echo/v4"
+	mediatr "github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1/fxparams"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/schedulingproductpricechange/v1/dtos"
+)
+
+// scheduleProductPriceChangeEndpoint is a struct that contains the schedule product price change endpoint.
+type scheduleProductPriceChangeEndpoint struct {
+	fxparams.ProductRouteParams
+}
+
+// NewScheduleProductPriceChangeEndpoint is a constructor for the scheduleProductPriceChangeEndpoint.
+func NewScheduleProductPriceChangeEndpoint(
+	params fxparams.ProductRouteParams,
+) route.Endpoint {
+	return &scheduleProductPriceChangeEndpoint{ProductRouteParams: params}
+}
+
+// MapEndpoint is a method that maps the endpoint.
+func (ep *scheduleProductPriceChangeEndpoint) MapEndpoint() {
+	ep.ProductsGroup.POST("/:id/price-changes", ep.handler())
+}
+
+// ScheduleProductPriceChange
+// @Tags Products
+// @Summary Schedule product price change
+// @Description Schedule a price change of a product, which is applied at the effective time
+// @Accept json
+// @Produce json
+// @Param ScheduleProductPriceChangeRequestDto body dtos.ScheduleProductPriceChangeRequestDto true "Price change data"
+// @Param id path string true "Product ID"
+// @Success 201 {object} dtos.ScheduleProductPriceChangeResponseDto
+// @Router /api/v1/products/{id}/price-changes [post].
+func (ep *scheduleProductPriceChangeEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		request := &dtos.ScheduleProductPriceChangeRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(
+				err,
+				"error in the binding request",
+			)
+
+			return badRequestErr
+		}
+
+		command, err := NewScheduleProductPriceChangeWithValidation(
+			request.ProductID,
+			request.NewPrice,
+			request.EffectiveAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		result, err := mediatr.Send[*ScheduleProductPriceChange, *dtos.ScheduleProductPriceChangeResponseDto](
+			ctx,
+			command,
+		)
+		if err != nil {
+			return errors.WithMessage(
+				err,
+				"error in sending ScheduleProductPriceChange",
+			)
+		}
+
+		return c.JSON(http.StatusCreated, result)
+	}
+}
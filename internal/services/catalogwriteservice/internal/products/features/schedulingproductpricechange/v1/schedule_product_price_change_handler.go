@@ -0,0 +1,95 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/cqrs"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/postgresgorm/gormdbcontext"
+
+	mediatr "github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/data/datamodels"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1/fxparams"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/schedulingproductpricechange/v1/dtos"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/models"
+)
+
+// scheduleProductPriceChangeHandler is a struct that contains the schedule product price change handler.
+type scheduleProductPriceChangeHandler struct {
+	fxparams.ProductHandlerParams
+}
+
+// NewScheduleProductPriceChangeHandler is a constructor for the scheduleProductPriceChangeHandler.
+func NewScheduleProductPriceChangeHandler(
+	params fxparams.ProductHandlerParams,
+) cqrs.RequestHandlerWithRegisterer[*ScheduleProductPriceChange, *dtos.ScheduleProductPriceChangeResponseDto] {
+	return &scheduleProductPriceChangeHandler{
+		ProductHandlerParams: params,
+	}
+}
+
+// RegisterHandler is a method that registers the schedule product price change handler.
+func (c *scheduleProductPriceChangeHandler) RegisterHandler() error {
+	return mediatr.RegisterRequestHandler[*ScheduleProductPriceChange, *dtos.ScheduleProductPriceChangeResponseDto](
+		c,
+	)
+}
+
+// Handle is a method that handles the schedule product price change command.
+func (c *scheduleProductPriceChangeHandler) Handle(
+	ctx context.Context,
+	command *ScheduleProductPriceChange,
+) (*dtos.ScheduleProductPriceChangeResponseDto, error) {
+	exists := gormdbcontext.Exists[*datamodels.ProductDataModel](
+		ctx,
+		c.CatalogsDBContext,
+		command.ProductID,
+	)
+	if !exists {
+		return nil, customErrors.NewNotFoundError(
+			fmt.Sprintf(
+				"product with id `%s` not found",
+				command.ProductID,
+			),
+		)
+	}
+
+	scheduledPriceChange, err := gormdbcontext.AddModel[*datamodels.ScheduledPriceChangeDataModel, *models.ScheduledPriceChange](
+		ctx,
+		c.CatalogsDBContext,
+		&models.ScheduledPriceChange{
+			ID:          command.ScheduledPriceChangeID,
+			ProductID:   command.ProductID,
+			NewPrice:    command.NewPrice,
+			EffectiveAt: command.EffectiveAt,
+			Status:      models.ScheduledPriceChangePending,
+			CreatedAt:   command.CreatedAt,
+		},
+	)
+	if err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"error in scheduling product price change",
+		)
+	}
+
+	c.Log.Infow(
+		fmt.Sprintf(
+			"price change of product with id '%s' to %v scheduled at %s",
+			command.ProductID,
+			command.NewPrice,
+			command.EffectiveAt,
+		),
+		logger.Fields{
+			"ID":                     command.ProductID,
+			"ScheduledPriceChangeID": scheduledPriceChange.ID,
+		},
+	)
+
+	return &dtos.ScheduleProductPriceChangeResponseDto{
+		ScheduledPriceChangeID: scheduledPriceChange.ID,
+	}, nil
+}
@@ -22,3 +22,12 @@ func NewProductUpdatedV1(productDto *dto.ProductDto) *ProductUpdatedV1 {
 		ProductDto: productDto,
 	}
 }
+
+// GetPartitionKey returns the id of the product, the consumers handle the events of a product in their order.
+func (p *ProductUpdatedV1) GetPartitionKey() string {
+	if p.ProductDto == nil {
+		return ""
+	}
+
+	return p.ID.String()
+}
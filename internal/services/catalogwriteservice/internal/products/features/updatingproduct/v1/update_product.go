@@ -4,6 +4,8 @@ package v1
 import (
 	"time"
 
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/cqrs"
+
 	validation "github.com/go-ozzo/ozzo-validation"
 	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
 	uuid "github.com/satori/go.uuid"
@@ -11,11 +13,14 @@ import (
 
 // UpdateProduct is a struct that contains the update product command.
 type UpdateProduct struct {
+	cqrs.TxCommand
 	ProductID   uuid.UUID
 	Name        string
 	Description string
 	Price       float64
 	UpdatedAt   time.Time
+	// ExpectedVersion is the version the client read, the update fails when the product changed since, 0 skips the check
+	ExpectedVersion int64
 }
 
 // NewUpdateProduct is a constructor for the UpdateProduct.
@@ -26,6 +31,7 @@ func NewUpdateProduct(
 	price float64,
 ) *UpdateProduct {
 	command := &UpdateProduct{
+		TxCommand:   cqrs.NewTxCommandByT[UpdateProduct](),
 		ProductID:   productID,
 		Name:        name,
 		Description: description,
@@ -66,6 +72,7 @@ func (c *UpdateProduct) Validate() error {
 		),
 		validation.Field(&c.Price, validation.Required, validation.Min(0.0)),
 		validation.Field(&c.UpdatedAt, validation.Required),
+		validation.Field(&c.ExpectedVersion, validation.Min(int64(0))),
 	)
 	if err != nil {
 		return customErrors.NewValidationErrorWrap(err, "validation error")
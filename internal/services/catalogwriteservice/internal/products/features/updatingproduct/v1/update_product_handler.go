@@ -9,13 +9,16 @@ import (
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/mapper"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/postgresgorm/gormdbcontext"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/postgresgorm/helpers/gormextensions"
 
 	mediatr "github.com/mehdihadeli/go-mediatr"
 	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+	uuid "github.com/satori/go.uuid"
 
 	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/data/datamodels"
 	dto "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1/fxparams"
+	pricechangedevents "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/changingproductprice/v1/events/integrationevents"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/updatingproduct/v1/events/integrationevents"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/models"
 )
@@ -63,21 +66,36 @@ func (c *updateProductHandler) Handle(
 		)
 	}
 
+	if command.ExpectedVersion != 0 && product.Version != command.ExpectedVersion {
+		return nil, customErrors.NewPreconditionFailedError(
+			fmt.Sprintf(
+				"product with id `%s` has version %d, expected version %d",
+				command.ProductID,
+				product.Version,
+				command.ExpectedVersion,
+			),
+		)
+	}
+
+	oldPrice := product.Price
+	loadedVersion := product.Version
+
 	product.Name = command.Name
 	product.Price = command.Price
 	product.Description = command.Description
 	product.UpdatedAt = command.UpdatedAt
+	product.Version = loadedVersion + 1
 
-	updatedProduct, err := gormdbcontext.UpdateModel[*datamodels.ProductDataModel, *models.Product](
-		ctx,
-		c.CatalogsDBContext,
-		product,
-	)
+	updatedProduct, err := c.updateProductIfVersion(ctx, command, product, loadedVersion)
 	if err != nil {
-		return nil, customErrors.NewApplicationErrorWrap(
-			err,
-			"error in updating product in the repository",
-		)
+		return nil, err
+	}
+
+	if oldPrice != command.Price {
+		err = c.recordPriceChange(ctx, command, oldPrice)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	productDto, err := mapper.Map[*dto.ProductDto](updatedProduct)
@@ -90,12 +108,27 @@ func (c *updateProductHandler) Handle(
 
 	productUpdated := integrationevents.NewProductUpdatedV1(productDto)
 
-	err = c.RabbitmqProducer.PublishMessage(ctx, productUpdated, nil)
-	if err != nil {
-		return nil, customErrors.NewApplicationErrorWrap(
-			err,
-			"error in publishing 'ProductUpdated' message",
+	// the update runs in the transaction of the mediator pipeline, its event is published once it is committed
+	err = gormextensions.AfterCommit(ctx, func(ctx context.Context) error {
+		if err := c.RabbitmqProducer.PublishMessage(ctx, productUpdated, nil); err != nil {
+			return customErrors.NewApplicationErrorWrap(
+				err,
+				"error in publishing 'ProductUpdated' message",
+			)
+		}
+
+		c.Log.Infow(
+			fmt.Sprintf(
+				"ProductUpdated message with messageId `%s` published to the rabbitmq broker",
+				productUpdated.MessageId,
+			),
+			logger.Fields{"MessageId": productUpdated.MessageId},
 		)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	c.Log.Infow(
@@ -106,13 +139,96 @@ func (c *updateProductHandler) Handle(
 		logger.Fields{"ID": command.ProductID},
 	)
 
-	c.Log.Infow(
-		fmt.Sprintf(
-			"ProductUpdated message with messageId `%s` published to the rabbitmq broker",
-			productUpdated.MessageId,
-		),
-		logger.Fields{"MessageId": productUpdated.MessageId},
+	return &mediatr.Unit{}, nil
+}
+
+// updateProductIfVersion updates the product only when its row still has the loaded version, so a concurrent
+// update between the read and the write is not overwritten.
+func (c *updateProductHandler) updateProductIfVersion(
+	ctx context.Context,
+	command *UpdateProduct,
+	product *models.Product,
+	loadedVersion int64,
+) (*models.Product, error) {
+	dataModel, err := mapper.Map[*datamodels.ProductDataModel](product)
+	if err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"error in the mapping ProductDataModel",
+		)
+	}
+
+	result := c.CatalogsDBContext.WithTxIfExists(ctx).DB().
+		WithContext(ctx).
+		Where("version = ?", loadedVersion).
+		Updates(dataModel)
+	if result.Error != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			result.Error,
+			"error in updating product in the repository",
+		)
+	}
+
+	if result.RowsAffected == 0 {
+		message := fmt.Sprintf(
+			"product with id `%s` was modified concurrently",
+			command.ProductID,
+		)
+
+		// the client only asked for a precondition when it sent the version it read
+		if command.ExpectedVersion != 0 {
+			return nil, customErrors.NewPreconditionFailedError(message)
+		}
+
+		return nil, customErrors.NewConflictError(message)
+	}
+
+	return product, nil
+}
+
+// recordPriceChange adds the price change to the product price history in the transaction of the product update and
+// publishes it once the transaction is committed.
+func (c *updateProductHandler) recordPriceChange(
+	ctx context.Context,
+	command *UpdateProduct,
+	oldPrice float64,
+) error {
+	_, err := gormdbcontext.AddModel[*datamodels.ProductPriceHistoryDataModel, *models.ProductPriceHistory](
+		ctx,
+		c.CatalogsDBContext,
+		&models.ProductPriceHistory{
+			ID:        uuid.NewV4(),
+			ProductID: command.ProductID,
+			OldPrice:  oldPrice,
+			NewPrice:  command.Price,
+			Reason:    models.PriceChangeReasonUpdated,
+			ChangedAt: command.UpdatedAt,
+		},
 	)
+	if err != nil {
+		return customErrors.NewApplicationErrorWrap(
+			err,
+			"error in adding product price history",
+		)
+	}
+
+	priceChanged := pricechangedevents.NewProductPriceChangedV1(
+		command.ProductID,
+		oldPrice,
+		command.Price,
+		models.PriceChangeReasonUpdated,
+		nil,
+		command.UpdatedAt,
+	)
+
+	return gormextensions.AfterCommit(ctx, func(ctx context.Context) error {
+		if err := c.RabbitmqProducer.PublishMessage(ctx, priceChanged, nil); err != nil {
+			return customErrors.NewApplicationErrorWrap(
+				err,
+				"error in publishing 'ProductPriceChanged' message",
+			)
+		}
 
-	return &mediatr.Unit{}, err
+		return nil
+	})
 }
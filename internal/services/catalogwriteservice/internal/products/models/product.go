@@ -13,6 +13,7 @@ type Product struct {
 	Name        string
 	Description string
 	Price       float64
+	Version     int64
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// Price change reasons recorded in the product price history.
+const (
+	PriceChangeReasonCreated   = "created"
+	PriceChangeReasonUpdated   = "updated"
+	PriceChangeReasonScheduled = "scheduled"
+	PriceChangeReasonImported  = "imported"
+)
+
+// ProductPriceHistory is a struct that contains a price change of a product.
+type ProductPriceHistory struct {
+	ID                     uuid.UUID
+	ProductID              uuid.UUID
+	OldPrice               float64
+	NewPrice               float64
+	Reason                 string
+	ScheduledPriceChangeID *uuid.UUID
+	ChangedAt              time.Time
+}
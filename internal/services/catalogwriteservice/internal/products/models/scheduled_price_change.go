@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// Scheduled price change statuses.
+const (
+	ScheduledPriceChangePending = "pending"
+	ScheduledPriceChangeApplied = "applied"
+	ScheduledPriceChangeFailed  = "failed"
+)
+
+// ScheduledPriceChange is a struct that contains a product price change which should be applied at a future time.
+type ScheduledPriceChange struct {
+	ID          uuid.UUID
+	ProductID   uuid.UUID
+	NewPrice    float64
+	EffectiveAt time.Time
+	Status      string
+	Error       string
+	AppliedAt   *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
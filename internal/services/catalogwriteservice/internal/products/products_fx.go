@@ -2,20 +2,35 @@
 package products
 
 import (
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/authentication"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/cqrs"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/web/route"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/http/customecho/contracts"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/http/customecho/middlewares/auditactor"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/idempotency"
 	"go.uber.org/fx"
 
 	echo "github.com/labstack/echo/v4"
+	authenticationMiddleware "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/customecho/middlewares/authentication"
+	idempotencyMiddleware "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/customecho/middlewares/idempotency"
 
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/changefeed"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/data/repositories"
+	changingproductpricev1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/changingproductprice/v1"
 	creatingproductv1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/creatingproduct/v1"
 	deletingproductv1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/deletingproduct/v1"
+	exportingproductsv1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/exportingproducts/v1"
+	gettingdeletedproductsv1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/gettingdeletedproducts/v1"
 	gettingproductbyidv1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/gettingproductbyid/v1"
+	gettingproductpricehistoryv1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/gettingproductpricehistory/v1"
 	gettingproductsv1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/gettingproducts/v1"
+	importingproductsv1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/importingproducts/v1"
+	purgingproductv1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/purgingproduct/v1"
+	restoringproductv1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/restoringproduct/v1"
+	schedulingproductpricechangev1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/schedulingproductpricechange/v1"
 	searchingproductsv1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/searchingproduct/v1"
 	updatingoroductsv1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/updatingproduct/v1"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/workers"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/shared/grpc"
 )
 
@@ -26,14 +41,27 @@ func NewModule() fx.Option {
 
 		// Other provides
 		fx.Provide(repositories.NewPostgresProductRepository),
+		fx.Provide(changefeed.NewProductChangeFeed),
 		fx.Provide(grpc.NewProductGrpcService),
+		fx.Provide(workers.NewScheduledPriceChangeWorker),
 
 		fx.Provide(
-			fx.Annotate(func(catalogsServer contracts.EchoHTTPServer) *echo.Group {
+			fx.Annotate(func(
+				catalogsServer contracts.EchoHTTPServer,
+				idempotencyManager *idempotency.Manager,
+				tokenValidator *authentication.TokenValidator,
+			) *echo.Group {
 				var g *echo.Group
 				catalogsServer.RouteBuilder().
 					RegisterGroupFunc("/api/v1", func(v1 *echo.Group) {
-						group := v1.Group("/products")
+						// the bearer token sets the principal, which is the actor of the audit log entries,
+						// retried commands with the same `Idempotency-Key` get the first response
+						group := v1.Group(
+							"/products",
+							authenticationMiddleware.Authentication(tokenValidator),
+							auditactor.AuditActor(),
+							idempotencyMiddleware.Idempotency(idempotencyManager),
+						)
 						g = group
 					})
 
@@ -67,34 +95,73 @@ func NewModule() fx.Option {
 				updatingoroductsv1.NewUpdateProductHandler,
 				"product-handlers",
 			),
+			cqrs.AsHandler(
+				changingproductpricev1.NewChangeProductPriceHandler,
+				"product-handlers",
+			),
+			cqrs.AsHandler(
+				schedulingproductpricechangev1.NewScheduleProductPriceChangeHandler,
+				"product-handlers",
+			),
+			cqrs.AsHandler(
+				gettingproductpricehistoryv1.NewGetProductPriceHistoryHandler,
+				"product-handlers",
+			),
+			cqrs.AsHandler(
+				importingproductsv1.NewImportProductsHandler,
+				"product-handlers",
+			),
+			cqrs.AsHandler(
+				exportingproductsv1.NewExportProductsHandler,
+				"product-handlers",
+			),
+			cqrs.AsHandler(
+				restoringproductv1.NewRestoreProductHandler,
+				"product-handlers",
+			),
+			cqrs.AsHandler(
+				purgingproductv1.NewPurgeProductHandler,
+				"product-handlers",
+			),
+			cqrs.AsHandler(
+				gettingdeletedproductsv1.NewGetDeletedProductsHandler,
+				"product-handlers",
+			),
 		),
 
-		// add endpoints to DI
+		// add endpoints to DI, the crud endpoints are served by the grpc gateway
 		fx.Provide(
 			route.AsRoute(
-				creatingproductv1.NewCreteProductEndpoint,
+				schedulingproductpricechangev1.NewScheduleProductPriceChangeEndpoint,
 				"product-routes",
 			),
 			route.AsRoute(
-				updatingoroductsv1.NewUpdateProductEndpoint,
+				gettingproductpricehistoryv1.NewGetProductPriceHistoryEndpoint,
 				"product-routes",
 			),
 			route.AsRoute(
-				gettingproductsv1.NewGetProductsEndpoint,
+				importingproductsv1.NewImportProductsEndpoint,
 				"product-routes",
 			),
 			route.AsRoute(
-				searchingproductsv1.NewSearchProductsEndpoint,
+				exportingproductsv1.NewExportProductsEndpoint,
 				"product-routes",
 			),
 			route.AsRoute(
-				gettingproductbyidv1.NewGetProductByIDEndpoint,
+				restoringproductv1.NewRestoreProductEndpoint,
 				"product-routes",
 			),
 			route.AsRoute(
-				deletingproductv1.NewDeleteProductEndpoint,
+				purgingproductv1.NewPurgeProductEndpoint,
+				"product-routes",
+			),
+			route.AsRoute(
+				gettingdeletedproductsv1.NewGetDeletedProductsEndpoint,
 				"product-routes",
 			),
 		),
+
+		// background workers
+		fx.Invoke(workers.RegisterScheduledPriceChangeWorkerHooks),
 	)
 }
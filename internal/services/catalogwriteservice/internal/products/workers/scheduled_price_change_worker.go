@@ -0,0 +1,236 @@
+// Package workers contains the products background workers.
+package workers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/postgresgorm/contracts"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/postgresgorm/helpers/gormextensions"
+	"go.uber.org/fx"
+	"gorm.io/gorm/clause"
+
+	mediatr "github.com/mehdihadeli/go-mediatr"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/config"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/data/datamodels"
+	changingproductpricev1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/changingproductprice/v1"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/models"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/shared/data/dbcontext"
+)
+
+// applyPriceChangeSavePoint isolates the price change from the status update of the scheduled change.
+const applyPriceChangeSavePoint = "apply_scheduled_price_change"
+
+// ScheduledPriceChangeWorker applies the scheduled product price changes once they are due.
+type ScheduledPriceChangeWorker struct {
+	dbContext *dbcontext.CatalogsGormDBContext
+	options   *config.PriceSchedulerOptions
+	log       logger.Logger
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+// NewScheduledPriceChangeWorker is a constructor for the ScheduledPriceChangeWorker.
+func NewScheduledPriceChangeWorker(
+	dbContext *dbcontext.CatalogsGormDBContext,
+	options *config.PriceSchedulerOptions,
+	log logger.Logger,
+) *ScheduledPriceChangeWorker {
+	return &ScheduledPriceChangeWorker{
+		dbContext: dbContext,
+		options:   options,
+		log:       log,
+	}
+}
+
+// RegisterScheduledPriceChangeWorkerHooks runs the worker during the application lifetime, when it is enabled.
+func RegisterScheduledPriceChangeWorkerHooks(
+	lc fx.Lifecycle,
+	worker *ScheduledPriceChangeWorker,
+) {
+	if !worker.options.Enabled {
+		return
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			// the OnStart ctx is only alive during the startup, so the worker gets its own context
+			worker.Start(context.Background())
+
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return worker.Stop(ctx)
+		},
+	})
+}
+
+// Start starts polling for the due price changes in the background.
+func (w *ScheduledPriceChangeWorker) Start(ctx context.Context) {
+	ctx, w.cancel = context.WithCancel(ctx)
+	w.done = make(chan struct{})
+
+	go func() {
+		defer close(w.done)
+
+		ticker := time.NewTicker(w.options.PollInterval())
+		defer ticker.Stop()
+
+		w.log.Infof(
+			"scheduled price change worker started with poll interval %s",
+			w.options.PollInterval(),
+		)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				w.ProcessDueChanges(ctx)
+			}
+		}
+	}()
+}
+
+// Stop stops the worker and waits for the in-progress price change to finish.
+func (w *ScheduledPriceChangeWorker) Stop(ctx context.Context) error {
+	if w.cancel == nil {
+		return nil
+	}
+
+	w.cancel()
+
+	select {
+	case <-w.done:
+		w.log.Info("scheduled price change worker stopped")
+
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// ProcessDueChanges applies the due price changes, up to the configured batch size.
+func (w *ScheduledPriceChangeWorker) ProcessDueChanges(ctx context.Context) {
+	var dueCount int64
+
+	// checking without a transaction first, so idle polls stay cheap
+	err := w.dbContext.DB().
+		WithContext(ctx).
+		Model(&datamodels.ScheduledPriceChangeDataModel{}).
+		Where("status = ? AND effective_at <= ?", models.ScheduledPriceChangePending, time.Now()).
+		Count(&dueCount).Error
+	if err != nil {
+		w.log.Errorf("error in checking due scheduled price changes: %v", err)
+
+		return
+	}
+
+	for i := 0; i < int(dueCount) && i < w.options.GetBatchSize(); i++ {
+		if ctx.Err() != nil {
+			return
+		}
+
+		applied, err := w.applyNextDueChange(ctx)
+		if err != nil {
+			w.log.Errorf("error in applying scheduled price change: %v", err)
+
+			return
+		}
+
+		if !applied {
+			return
+		}
+	}
+}
+
+// applyNextDueChange applies the oldest due price change, other instances skip the locked change. The price change
+// joins the transaction of the status update and its events are published once both are committed.
+// It returns false when there is no due change left.
+func (w *ScheduledPriceChangeWorker) applyNextDueChange(ctx context.Context) (bool, error) {
+	found := false
+
+	err := w.dbContext.RunInTx(ctx, func(ctx context.Context, _ contracts.GormDBContext) error {
+		tx, err := gormextensions.GetTxFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		change := &datamodels.ScheduledPriceChangeDataModel{}
+
+		result := tx.WithContext(ctx).
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND effective_at <= ?", models.ScheduledPriceChangePending, time.Now()).
+			Order("effective_at").
+			Limit(1).
+			Find(change)
+		if result.Error != nil {
+			return errors.WrapIf(result.Error, "error in fetching the due scheduled price change")
+		}
+
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		found = true
+
+		if err := gormextensions.SavePoint(ctx, applyPriceChangeSavePoint); err != nil {
+			return err
+		}
+
+		updates := map[string]interface{}{"updated_at": time.Now()}
+
+		applyErr := w.applyChange(ctx, change)
+		if applyErr != nil {
+			// a failed change shouldn't block the next ones, so we keep it as failed, the events of the failed change
+			// are dropped with its changes
+			if err := gormextensions.RollbackToSavePoint(ctx, applyPriceChangeSavePoint); err != nil {
+				return err
+			}
+
+			updates["status"] = models.ScheduledPriceChangeFailed
+			updates["error"] = applyErr.Error()
+
+			w.log.Errorw(
+				fmt.Sprintf(
+					"scheduled price change with id '%s' for product '%s' failed",
+					change.ID,
+					change.ProductID,
+				),
+				logger.Fields{"ID": change.ID, "ProductID": change.ProductID, "Error": applyErr.Error()},
+			)
+		} else {
+			updates["status"] = models.ScheduledPriceChangeApplied
+			updates["applied_at"] = time.Now()
+		}
+
+		err = tx.WithContext(ctx).Model(change).Updates(updates).Error
+
+		return errors.WrapIf(err, "error in updating the scheduled price change status")
+	})
+
+	return found, err
+}
+
+// applyChange sends the price change through the mediator, so it goes through the same pipelines as other commands.
+func (w *ScheduledPriceChangeWorker) applyChange(
+	ctx context.Context,
+	change *datamodels.ScheduledPriceChangeDataModel,
+) error {
+	command, err := changingproductpricev1.NewChangeProductPriceWithValidation(
+		change.ProductID,
+		change.NewPrice,
+		&change.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = mediatr.Send[*changingproductpricev1.ChangeProductPrice, *mediatr.Unit](ctx, command)
+
+	return err
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/bus"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/test/containers/testcontainer/gorm"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/test/containers/testcontainer/rabbitmq"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/test/containers/testcontainer/redis"
 	"github.com/stretchr/testify/require"
 
 	fxcontracts "github.com/raphaeldiscky/go-food-micro/internal/pkg/fxapp/contracts"
@@ -27,7 +28,9 @@ import (
 )
 
 // CatalogWriteTestApp is a struct that contains the test app.
-type CatalogWriteTestApp struct{}
+type CatalogWriteTestApp struct {
+	rabbitmqServer bool
+}
 
 // CatalogWriteTestAppResult is a struct that contains the test app result.
 type CatalogWriteTestAppResult struct {
@@ -50,6 +53,14 @@ func NewCatalogWriteTestApp() *CatalogWriteTestApp {
 	return &CatalogWriteTestApp{}
 }
 
+// WithRabbitmqServer runs the bus of the test app on a rabbitmq container instead of the in-memory bus of the test
+// configuration.
+func (a *CatalogWriteTestApp) WithRabbitmqServer() *CatalogWriteTestApp {
+	a.rabbitmqServer = true
+
+	return a
+}
+
 // Run is a method that runs the test app.
 func (a *CatalogWriteTestApp) Run(t *testing.T) (result *CatalogWriteTestAppResult) {
 	t.Helper()
@@ -60,10 +71,14 @@ func (a *CatalogWriteTestApp) Run(t *testing.T) (result *CatalogWriteTestAppResu
 	appBuilder := NewCatalogsWriteTestApplicationBuilder(t)
 	appBuilder.ProvideModule(catalogs.NewCatalogsServiceModule())
 
-	appBuilder.Decorate(
-		rabbitmq.RabbitmqContainerOptionsDecorator(t, lifetimeCtx),
-	)
+	rabbitmqDecorator := rabbitmq.RabbitmqContainerOptionsDecorator
+	if a.rabbitmqServer {
+		rabbitmqDecorator = rabbitmq.RabbitmqServerContainerOptionsDecorator
+	}
+
+	appBuilder.Decorate(rabbitmqDecorator(t, lifetimeCtx))
 	appBuilder.Decorate(gorm.GormContainerOptionsDecorator(t, lifetimeCtx))
+	appBuilder.Decorate(redis.RedisContainerOptionsDecorator(t, lifetimeCtx))
 
 	testApp := appBuilder.Build()
 
@@ -105,6 +105,18 @@ func provideCatalogsMetrics(
 			name:        "search_product_grpc_requests_total",
 			description: "The total number of search product grpc requests",
 		},
+		{
+			name:        "import_products_grpc_requests_total",
+			description: "The total number of import products grpc requests",
+		},
+		{
+			name:        "get_products_grpc_requests_total",
+			description: "The total number of get products grpc requests",
+		},
+		{
+			name:        "watch_products_grpc_requests_total",
+			description: "The total number of watch products grpc requests",
+		},
 	}
 
 	rabbitMQMetrics := []metricDefinition{
@@ -148,6 +160,9 @@ func provideCatalogsMetrics(
 		DeleteProductGrpcRequests:     grpcCounters[2],
 		GetProductByIDGrpcRequests:    grpcCounters[3],
 		SearchProductGrpcRequests:     grpcCounters[4],
+		ImportProductsGrpcRequests:    grpcCounters[5],
+		GetProductsGrpcRequests:       grpcCounters[6],
+		WatchProductsGrpcRequests:     grpcCounters[7],
 		CreateProductRabbitMQMessages: rabbitMQCounters[0],
 		UpdateProductRabbitMQMessages: rabbitMQCounters[1],
 		DeleteProductRabbitMQMessages: rabbitMQCounters[2],
@@ -6,6 +6,7 @@ import (
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/metrics"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/postgresgorm/audit"
 	"gorm.io/gorm"
 
 	mediatr "github.com/mehdihadeli/go-mediatr"
@@ -34,7 +35,13 @@ func NewCatalogWriteInfraConfigurator(
 func (ic *CatalogWriteInfraConfigurator) CatalogWriteConfigInfra() {
 	ic.ResolveFunc(
 		func(l logger.Logger, tracer tracing.AppTracer, metrics metrics.AppMetrics, db *gorm.DB) error {
-			err := mediatr.RegisterRequestPipelineBehaviors(
+			// records the before and after snapshots of the product changes in the audit_logs table
+			err := db.Use(audit.NewPlugin(audit.WithTables("products")))
+			if err != nil {
+				return err
+			}
+
+			err = mediatr.RegisterRequestPipelineBehaviors(
 				loggingpipelines.NewMediatorLoggingPipeline(l),
 				validationpieline.NewMediatorValidationPipeline(l),
 				tracingpipelines.NewMediatorTracingPipeline(
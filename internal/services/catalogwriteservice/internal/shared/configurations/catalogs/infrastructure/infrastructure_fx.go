@@ -2,9 +2,11 @@ package infrastructure
 
 import (
 	"github.com/go-playground/validator"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/authentication"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/grpc"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/health"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/idempotency"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/migration/goose"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/metrics"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing"
@@ -12,6 +14,8 @@ import (
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/postgresmessaging"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/configurations"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/redis"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/schemaregistry"
 	"go.uber.org/fx"
 
 	customEcho "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/customecho"
@@ -27,6 +31,7 @@ func NewModule() fx.Option {
 		"infrastructurefx",
 		// Modules
 		core.Module,
+		schemaregistry.Module,
 		customEcho.Module,
 		grpc.Module,
 		postgresgorm.Module,
@@ -39,6 +44,9 @@ func NewModule() fx.Option {
 				}
 			},
 		),
+		redis.Module,
+		idempotency.Module,
+		authentication.Module,
 		health.Module,
 		tracing.Module,
 		metrics.Module,
@@ -12,6 +12,9 @@ type CatalogsMetrics struct {
 	DeleteProductGrpcRequests     metric.Float64Counter
 	GetProductByIDGrpcRequests    metric.Float64Counter
 	SearchProductGrpcRequests     metric.Float64Counter
+	ImportProductsGrpcRequests    metric.Float64Counter
+	GetProductsGrpcRequests       metric.Float64Counter
+	WatchProductsGrpcRequests     metric.Float64Counter
 	SuccessRabbitMQMessages       metric.Float64Counter
 	ErrorRabbitMQMessages         metric.Float64Counter
 	CreateProductRabbitMQMessages metric.Float64Counter
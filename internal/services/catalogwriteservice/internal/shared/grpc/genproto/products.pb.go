@@ -7,6 +7,7 @@
 package products_service
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -22,6 +23,67 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ProductChangeType int32
+
+const (
+	ProductChangeType_PRODUCT_CHANGE_TYPE_UNSPECIFIED   ProductChangeType = 0
+	ProductChangeType_PRODUCT_CHANGE_TYPE_CREATED       ProductChangeType = 1
+	ProductChangeType_PRODUCT_CHANGE_TYPE_UPDATED       ProductChangeType = 2
+	ProductChangeType_PRODUCT_CHANGE_TYPE_DELETED       ProductChangeType = 3
+	ProductChangeType_PRODUCT_CHANGE_TYPE_RESTORED      ProductChangeType = 4
+	ProductChangeType_PRODUCT_CHANGE_TYPE_PURGED        ProductChangeType = 5
+	ProductChangeType_PRODUCT_CHANGE_TYPE_PRICE_CHANGED ProductChangeType = 6
+)
+
+// Enum value maps for ProductChangeType.
+var (
+	ProductChangeType_name = map[int32]string{
+		0: "PRODUCT_CHANGE_TYPE_UNSPECIFIED",
+		1: "PRODUCT_CHANGE_TYPE_CREATED",
+		2: "PRODUCT_CHANGE_TYPE_UPDATED",
+		3: "PRODUCT_CHANGE_TYPE_DELETED",
+		4: "PRODUCT_CHANGE_TYPE_RESTORED",
+		5: "PRODUCT_CHANGE_TYPE_PURGED",
+		6: "PRODUCT_CHANGE_TYPE_PRICE_CHANGED",
+	}
+	ProductChangeType_value = map[string]int32{
+		"PRODUCT_CHANGE_TYPE_UNSPECIFIED":   0,
+		"PRODUCT_CHANGE_TYPE_CREATED":       1,
+		"PRODUCT_CHANGE_TYPE_UPDATED":       2,
+		"PRODUCT_CHANGE_TYPE_DELETED":       3,
+		"PRODUCT_CHANGE_TYPE_RESTORED":      4,
+		"PRODUCT_CHANGE_TYPE_PURGED":        5,
+		"PRODUCT_CHANGE_TYPE_PRICE_CHANGED": 6,
+	}
+)
+
+func (x ProductChangeType) Enum() *ProductChangeType {
+	p := new(ProductChangeType)
+	*p = x
+	return p
+}
+
+func (x ProductChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProductChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_products_proto_enumTypes[0].Descriptor()
+}
+
+func (ProductChangeType) Type() protoreflect.EnumType {
+	return &file_products_proto_enumTypes[0]
+}
+
+func (x ProductChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProductChangeType.Descriptor instead.
+func (ProductChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{0}
+}
+
 type Product struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductID     string                 `protobuf:"bytes,1,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
@@ -30,6 +92,7 @@ type Product struct {
 	Price         float64                `protobuf:"fixed64,4,opt,name=Price,proto3" json:"Price,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
+	Version       int64                  `protobuf:"varint,7,opt,name=Version,proto3" json:"Version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -106,6 +169,13 @@ func (x *Product) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Product) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type CreateProductReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
@@ -211,13 +281,15 @@ func (x *CreateProductRes) GetProductID() string {
 }
 
 type UpdateProductReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductID     string                 `protobuf:"bytes,1,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=Price,proto3" json:"Price,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ProductID   string                 `protobuf:"bytes,1,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
+	Price       float64                `protobuf:"fixed64,4,opt,name=Price,proto3" json:"Price,omitempty"`
+	// the version the client read, the update fails with FAILED_PRECONDITION when the product changed since, 0 skips the check
+	ExpectedVersion int64 `protobuf:"varint,5,opt,name=ExpectedVersion,proto3" json:"ExpectedVersion,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UpdateProductReq) Reset() {
@@ -278,6 +350,13 @@ func (x *UpdateProductReq) GetPrice() float64 {
 	return 0
 }
 
+func (x *UpdateProductReq) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 type UpdateProductRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -402,38 +481,809 @@ func (x *GetProductByIDRes) GetProduct() *Product {
 	return nil
 }
 
+type ImportProductReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// optional, the product with this id is updated when it exists
+	ProductID     string  `protobuf:"bytes,1,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+	Name          string  `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description   string  `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
+	Price         float64 `protobuf:"fixed64,4,opt,name=Price,proto3" json:"Price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportProductReq) Reset() {
+	*x = ImportProductReq{}
+	mi := &file_products_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportProductReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProductReq) ProtoMessage() {}
+
+func (x *ImportProductReq) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProductReq.ProtoReflect.Descriptor instead.
+func (*ImportProductReq) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ImportProductReq) GetProductID() string {
+	if x != nil {
+		return x.ProductID
+	}
+	return ""
+}
+
+func (x *ImportProductReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportProductReq) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ImportProductReq) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type ImportProductError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int64                  `protobuf:"varint,1,opt,name=Row,proto3" json:"Row,omitempty"`
+	ProductID     string                 `protobuf:"bytes,2,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=Message,proto3" json:"Message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportProductError) Reset() {
+	*x = ImportProductError{}
+	mi := &file_products_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportProductError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProductError) ProtoMessage() {}
+
+func (x *ImportProductError) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProductError.ProtoReflect.Descriptor instead.
+func (*ImportProductError) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ImportProductError) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportProductError) GetProductID() string {
+	if x != nil {
+		return x.ProductID
+	}
+	return ""
+}
+
+func (x *ImportProductError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportProductsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalRows     int64                  `protobuf:"varint,1,opt,name=TotalRows,proto3" json:"TotalRows,omitempty"`
+	Created       int64                  `protobuf:"varint,2,opt,name=Created,proto3" json:"Created,omitempty"`
+	Updated       int64                  `protobuf:"varint,3,opt,name=Updated,proto3" json:"Updated,omitempty"`
+	Failed        int64                  `protobuf:"varint,4,opt,name=Failed,proto3" json:"Failed,omitempty"`
+	Errors        []*ImportProductError  `protobuf:"bytes,5,rep,name=Errors,proto3" json:"Errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportProductsRes) Reset() {
+	*x = ImportProductsRes{}
+	mi := &file_products_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportProductsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProductsRes) ProtoMessage() {}
+
+func (x *ImportProductsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProductsRes.ProtoReflect.Descriptor instead.
+func (*ImportProductsRes) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ImportProductsRes) GetTotalRows() int64 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportProductsRes) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportProductsRes) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportProductsRes) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportProductsRes) GetErrors() []*ImportProductError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type DeleteProductReq struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductID string                 `protobuf:"bytes,1,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+	// the version the client read, the delete fails with FAILED_PRECONDITION when the product changed since, 0 skips the check
+	ExpectedVersion int64 `protobuf:"varint,2,opt,name=ExpectedVersion,proto3" json:"ExpectedVersion,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteProductReq) Reset() {
+	*x = DeleteProductReq{}
+	mi := &file_products_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductReq) ProtoMessage() {}
+
+func (x *DeleteProductReq) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductReq.ProtoReflect.Descriptor instead.
+func (*DeleteProductReq) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteProductReq) GetProductID() string {
+	if x != nil {
+		return x.ProductID
+	}
+	return ""
+}
+
+func (x *DeleteProductReq) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type DeleteProductRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductRes) Reset() {
+	*x = DeleteProductRes{}
+	mi := &file_products_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRes) ProtoMessage() {}
+
+func (x *DeleteProductRes) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRes.ProtoReflect.Descriptor instead.
+func (*DeleteProductRes) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{11}
+}
+
+type GetProductsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=Page,proto3" json:"Page,omitempty"`
+	Size          int32                  `protobuf:"varint,2,opt,name=Size,proto3" json:"Size,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,3,opt,name=OrderBy,proto3" json:"OrderBy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductsReq) Reset() {
+	*x = GetProductsReq{}
+	mi := &file_products_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductsReq) ProtoMessage() {}
+
+func (x *GetProductsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductsReq.ProtoReflect.Descriptor instead.
+func (*GetProductsReq) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetProductsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetProductsReq) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetProductsReq) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type GetProductsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=Products,proto3" json:"Products,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=Page,proto3" json:"Page,omitempty"`
+	Size          int32                  `protobuf:"varint,3,opt,name=Size,proto3" json:"Size,omitempty"`
+	TotalItems    int64                  `protobuf:"varint,4,opt,name=TotalItems,proto3" json:"TotalItems,omitempty"`
+	TotalPage     int32                  `protobuf:"varint,5,opt,name=TotalPage,proto3" json:"TotalPage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductsRes) Reset() {
+	*x = GetProductsRes{}
+	mi := &file_products_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductsRes) ProtoMessage() {}
+
+func (x *GetProductsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductsRes.ProtoReflect.Descriptor instead.
+func (*GetProductsRes) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetProductsRes) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *GetProductsRes) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetProductsRes) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetProductsRes) GetTotalItems() int64 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+func (x *GetProductsRes) GetTotalPage() int32 {
+	if x != nil {
+		return x.TotalPage
+	}
+	return 0
+}
+
+type SearchProductsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SearchText    string                 `protobuf:"bytes,1,opt,name=SearchText,proto3" json:"SearchText,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=Page,proto3" json:"Page,omitempty"`
+	Size          int32                  `protobuf:"varint,3,opt,name=Size,proto3" json:"Size,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,4,opt,name=OrderBy,proto3" json:"OrderBy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchProductsReq) Reset() {
+	*x = SearchProductsReq{}
+	mi := &file_products_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsReq) ProtoMessage() {}
+
+func (x *SearchProductsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsReq.ProtoReflect.Descriptor instead.
+func (*SearchProductsReq) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SearchProductsReq) GetSearchText() string {
+	if x != nil {
+		return x.SearchText
+	}
+	return ""
+}
+
+func (x *SearchProductsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchProductsReq) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *SearchProductsReq) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type SearchProductsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=Products,proto3" json:"Products,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=Page,proto3" json:"Page,omitempty"`
+	Size          int32                  `protobuf:"varint,3,opt,name=Size,proto3" json:"Size,omitempty"`
+	TotalItems    int64                  `protobuf:"varint,4,opt,name=TotalItems,proto3" json:"TotalItems,omitempty"`
+	TotalPage     int32                  `protobuf:"varint,5,opt,name=TotalPage,proto3" json:"TotalPage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchProductsRes) Reset() {
+	*x = SearchProductsRes{}
+	mi := &file_products_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsRes) ProtoMessage() {}
+
+func (x *SearchProductsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsRes.ProtoReflect.Descriptor instead.
+func (*SearchProductsRes) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SearchProductsRes) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *SearchProductsRes) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchProductsRes) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *SearchProductsRes) GetTotalItems() int64 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+func (x *SearchProductsRes) GetTotalPage() int32 {
+	if x != nil {
+		return x.TotalPage
+	}
+	return 0
+}
+
+type WatchProductsReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// optional, only the changes of these products are streamed when it is not empty
+	ProductIDs    []string `protobuf:"bytes,1,rep,name=ProductIDs,proto3" json:"ProductIDs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchProductsReq) Reset() {
+	*x = WatchProductsReq{}
+	mi := &file_products_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchProductsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchProductsReq) ProtoMessage() {}
+
+func (x *WatchProductsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchProductsReq.ProtoReflect.Descriptor instead.
+func (*WatchProductsReq) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WatchProductsReq) GetProductIDs() []string {
+	if x != nil {
+		return x.ProductIDs
+	}
+	return nil
+}
+
+type ProductChangeEvent struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	MessageID  string                 `protobuf:"bytes,1,opt,name=MessageID,proto3" json:"MessageID,omitempty"`
+	ChangeType ProductChangeType      `protobuf:"varint,2,opt,name=ChangeType,proto3,enum=products_service.ProductChangeType" json:"ChangeType,omitempty"`
+	ProductID  string                 `protobuf:"bytes,3,opt,name=ProductID,proto3" json:"ProductID,omitempty"`
+	// only set for the created, updated and restored changes
+	Product       *Product               `protobuf:"bytes,4,opt,name=Product,proto3" json:"Product,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=OccurredAt,proto3" json:"OccurredAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductChangeEvent) Reset() {
+	*x = ProductChangeEvent{}
+	mi := &file_products_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductChangeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductChangeEvent) ProtoMessage() {}
+
+func (x *ProductChangeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductChangeEvent.ProtoReflect.Descriptor instead.
+func (*ProductChangeEvent) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ProductChangeEvent) GetMessageID() string {
+	if x != nil {
+		return x.MessageID
+	}
+	return ""
+}
+
+func (x *ProductChangeEvent) GetChangeType() ProductChangeType {
+	if x != nil {
+		return x.ChangeType
+	}
+	return ProductChangeType_PRODUCT_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *ProductChangeEvent) GetProductID() string {
+	if x != nil {
+		return x.ProductID
+	}
+	return ""
+}
+
+func (x *ProductChangeEvent) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *ProductChangeEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
 var File_products_proto protoreflect.FileDescriptor
 
 const file_products_proto_rawDesc = "" +
 	"\n" +
-	"\x0eproducts.proto\x12\x10products_service\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe7\x01\n" +
+	"\x0eproducts.proto\x12\x10products_service\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x81\x02\n" +
 	"\aProduct\x12\x1c\n" +
 	"\tProductID\x18\x01 \x01(\tR\tProductID\x12\x12\n" +
 	"\x04Name\x18\x02 \x01(\tR\x04Name\x12 \n" +
 	"\vDescription\x18\x03 \x01(\tR\vDescription\x12\x14\n" +
 	"\x05Price\x18\x04 \x01(\x01R\x05Price\x128\n" +
 	"\tCreatedAt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tCreatedAt\x128\n" +
-	"\tUpdatedAt\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tUpdatedAt\"^\n" +
+	"\tUpdatedAt\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tUpdatedAt\x12\x18\n" +
+	"\aVersion\x18\a \x01(\x03R\aVersion\"^\n" +
 	"\x10CreateProductReq\x12\x12\n" +
 	"\x04Name\x18\x01 \x01(\tR\x04Name\x12 \n" +
 	"\vDescription\x18\x02 \x01(\tR\vDescription\x12\x14\n" +
 	"\x05Price\x18\x03 \x01(\x01R\x05Price\"0\n" +
 	"\x10CreateProductRes\x12\x1c\n" +
-	"\tProductID\x18\x01 \x01(\tR\tProductID\"|\n" +
+	"\tProductID\x18\x01 \x01(\tR\tProductID\"\xa6\x01\n" +
 	"\x10UpdateProductReq\x12\x1c\n" +
 	"\tProductID\x18\x01 \x01(\tR\tProductID\x12\x12\n" +
 	"\x04Name\x18\x02 \x01(\tR\x04Name\x12 \n" +
 	"\vDescription\x18\x03 \x01(\tR\vDescription\x12\x14\n" +
-	"\x05Price\x18\x04 \x01(\x01R\x05Price\"\x12\n" +
+	"\x05Price\x18\x04 \x01(\x01R\x05Price\x12(\n" +
+	"\x0fExpectedVersion\x18\x05 \x01(\x03R\x0fExpectedVersion\"\x12\n" +
 	"\x10UpdateProductRes\"1\n" +
 	"\x11GetProductByIDReq\x12\x1c\n" +
 	"\tProductID\x18\x01 \x01(\tR\tProductID\"H\n" +
 	"\x11GetProductByIDRes\x123\n" +
-	"\aProduct\x18\x01 \x01(\v2\x19.products_service.ProductR\aProduct2\x9f\x02\n" +
-	"\x0fProductsService\x12W\n" +
-	"\rCreateProduct\x12\".products_service.CreateProductReq\x1a\".products_service.CreateProductRes\x12W\n" +
-	"\rUpdateProduct\x12\".products_service.UpdateProductReq\x1a\".products_service.UpdateProductRes\x12Z\n" +
-	"\x0eGetProductByID\x12#.products_service.GetProductByIDReq\x1a#.products_service.GetProductByIDResB\x15Z\x13./;products_serviceb\x06proto3"
+	"\aProduct\x18\x01 \x01(\v2\x19.products_service.ProductR\aProduct\"|\n" +
+	"\x10ImportProductReq\x12\x1c\n" +
+	"\tProductID\x18\x01 \x01(\tR\tProductID\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12 \n" +
+	"\vDescription\x18\x03 \x01(\tR\vDescription\x12\x14\n" +
+	"\x05Price\x18\x04 \x01(\x01R\x05Price\"^\n" +
+	"\x12ImportProductError\x12\x10\n" +
+	"\x03Row\x18\x01 \x01(\x03R\x03Row\x12\x1c\n" +
+	"\tProductID\x18\x02 \x01(\tR\tProductID\x12\x18\n" +
+	"\aMessage\x18\x03 \x01(\tR\aMessage\"\xbb\x01\n" +
+	"\x11ImportProductsRes\x12\x1c\n" +
+	"\tTotalRows\x18\x01 \x01(\x03R\tTotalRows\x12\x18\n" +
+	"\aCreated\x18\x02 \x01(\x03R\aCreated\x12\x18\n" +
+	"\aUpdated\x18\x03 \x01(\x03R\aUpdated\x12\x16\n" +
+	"\x06Failed\x18\x04 \x01(\x03R\x06Failed\x12<\n" +
+	"\x06Errors\x18\x05 \x03(\v2$.products_service.ImportProductErrorR\x06Errors\"Z\n" +
+	"\x10DeleteProductReq\x12\x1c\n" +
+	"\tProductID\x18\x01 \x01(\tR\tProductID\x12(\n" +
+	"\x0fExpectedVersion\x18\x02 \x01(\x03R\x0fExpectedVersion\"\x12\n" +
+	"\x10DeleteProductRes\"R\n" +
+	"\x0eGetProductsReq\x12\x12\n" +
+	"\x04Page\x18\x01 \x01(\x05R\x04Page\x12\x12\n" +
+	"\x04Size\x18\x02 \x01(\x05R\x04Size\x12\x18\n" +
+	"\aOrderBy\x18\x03 \x01(\tR\aOrderBy\"\xad\x01\n" +
+	"\x0eGetProductsRes\x125\n" +
+	"\bProducts\x18\x01 \x03(\v2\x19.products_service.ProductR\bProducts\x12\x12\n" +
+	"\x04Page\x18\x02 \x01(\x05R\x04Page\x12\x12\n" +
+	"\x04Size\x18\x03 \x01(\x05R\x04Size\x12\x1e\n" +
+	"\n" +
+	"TotalItems\x18\x04 \x01(\x03R\n" +
+	"TotalItems\x12\x1c\n" +
+	"\tTotalPage\x18\x05 \x01(\x05R\tTotalPage\"u\n" +
+	"\x11SearchProductsReq\x12\x1e\n" +
+	"\n" +
+	"SearchText\x18\x01 \x01(\tR\n" +
+	"SearchText\x12\x12\n" +
+	"\x04Page\x18\x02 \x01(\x05R\x04Page\x12\x12\n" +
+	"\x04Size\x18\x03 \x01(\x05R\x04Size\x12\x18\n" +
+	"\aOrderBy\x18\x04 \x01(\tR\aOrderBy\"\xb0\x01\n" +
+	"\x11SearchProductsRes\x125\n" +
+	"\bProducts\x18\x01 \x03(\v2\x19.products_service.ProductR\bProducts\x12\x12\n" +
+	"\x04Page\x18\x02 \x01(\x05R\x04Page\x12\x12\n" +
+	"\x04Size\x18\x03 \x01(\x05R\x04Size\x12\x1e\n" +
+	"\n" +
+	"TotalItems\x18\x04 \x01(\x03R\n" +
+	"TotalItems\x12\x1c\n" +
+	"\tTotalPage\x18\x05 \x01(\x05R\tTotalPage\"2\n" +
+	"\x10WatchProductsReq\x12\x1e\n" +
+	"\n" +
+	"ProductIDs\x18\x01 \x03(\tR\n" +
+	"ProductIDs\"\x86\x02\n" +
+	"\x12ProductChangeEvent\x12\x1c\n" +
+	"\tMessageID\x18\x01 \x01(\tR\tMessageID\x12C\n" +
+	"\n" +
+	"ChangeType\x18\x02 \x01(\x0e2#.products_service.ProductChangeTypeR\n" +
+	"ChangeType\x12\x1c\n" +
+	"\tProductID\x18\x03 \x01(\tR\tProductID\x123\n" +
+	"\aProduct\x18\x04 \x01(\v2\x19.products_service.ProductR\aProduct\x12:\n" +
+	"\n" +
+	"OccurredAt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"OccurredAt*\x84\x02\n" +
+	"\x11ProductChangeType\x12#\n" +
+	"\x1fPRODUCT_CHANGE_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bPRODUCT_CHANGE_TYPE_CREATED\x10\x01\x12\x1f\n" +
+	"\x1bPRODUCT_CHANGE_TYPE_UPDATED\x10\x02\x12\x1f\n" +
+	"\x1bPRODUCT_CHANGE_TYPE_DELETED\x10\x03\x12 \n" +
+	"\x1cPRODUCT_CHANGE_TYPE_RESTORED\x10\x04\x12\x1e\n" +
+	"\x1aPRODUCT_CHANGE_TYPE_PURGED\x10\x05\x12%\n" +
+	"!PRODUCT_CHANGE_TYPE_PRICE_CHANGED\x10\x062\xb0\a\n" +
+	"\x0fProductsService\x12t\n" +
+	"\rCreateProduct\x12\".products_service.CreateProductReq\x1a\".products_service.CreateProductRes\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/v1/products\x12\x80\x01\n" +
+	"\rUpdateProduct\x12\".products_service.UpdateProductReq\x1a\".products_service.UpdateProductRes\"'\x82\xd3\xe4\x93\x02!:\x01*\x1a\x1c/api/v1/products/{ProductID}\x12\x80\x01\n" +
+	"\x0eGetProductByID\x12#.products_service.GetProductByIDReq\x1a#.products_service.GetProductByIDRes\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/products/{ProductID}\x12}\n" +
+	"\rDeleteProduct\x12\".products_service.DeleteProductReq\x1a\".products_service.DeleteProductRes\"$\x82\xd3\xe4\x93\x02\x1e*\x1c/api/v1/products/{ProductID}\x12k\n" +
+	"\vGetProducts\x12 .products_service.GetProductsReq\x1a .products_service.GetProductsRes\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/products\x12{\n" +
+	"\x0eSearchProducts\x12#.products_service.SearchProductsReq\x1a#.products_service.SearchProductsRes\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/products/search\x12[\n" +
+	"\x0eImportProducts\x12\".products_service.ImportProductReq\x1a#.products_service.ImportProductsRes(\x01\x12[\n" +
+	"\rWatchProducts\x12\".products_service.WatchProductsReq\x1a$.products_service.ProductChangeEvent0\x01B\x15Z\x13./;products_serviceb\x06proto3"
 
 var (
 	file_products_proto_rawDescOnce sync.Once
@@ -447,32 +1297,61 @@ func file_products_proto_rawDescGZIP() []byte {
 	return file_products_proto_rawDescData
 }
 
-var file_products_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_products_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_products_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_products_proto_goTypes = []any{
-	(*Product)(nil),               // 0: products_service.Product
-	(*CreateProductReq)(nil),      // 1: products_service.CreateProductReq
-	(*CreateProductRes)(nil),      // 2: products_service.CreateProductRes
-	(*UpdateProductReq)(nil),      // 3: products_service.UpdateProductReq
-	(*UpdateProductRes)(nil),      // 4: products_service.UpdateProductRes
-	(*GetProductByIDReq)(nil),     // 5: products_service.GetProductByIDReq
-	(*GetProductByIDRes)(nil),     // 6: products_service.GetProductByIDRes
-	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(ProductChangeType)(0),        // 0: products_service.ProductChangeType
+	(*Product)(nil),               // 1: products_service.Product
+	(*CreateProductReq)(nil),      // 2: products_service.CreateProductReq
+	(*CreateProductRes)(nil),      // 3: products_service.CreateProductRes
+	(*UpdateProductReq)(nil),      // 4: products_service.UpdateProductReq
+	(*UpdateProductRes)(nil),      // 5: products_service.UpdateProductRes
+	(*GetProductByIDReq)(nil),     // 6: products_service.GetProductByIDReq
+	(*GetProductByIDRes)(nil),     // 7: products_service.GetProductByIDRes
+	(*ImportProductReq)(nil),      // 8: products_service.ImportProductReq
+	(*ImportProductError)(nil),    // 9: products_service.ImportProductError
+	(*ImportProductsRes)(nil),     // 10: products_service.ImportProductsRes
+	(*DeleteProductReq)(nil),      // 11: products_service.DeleteProductReq
+	(*DeleteProductRes)(nil),      // 12: products_service.DeleteProductRes
+	(*GetProductsReq)(nil),        // 13: products_service.GetProductsReq
+	(*GetProductsRes)(nil),        // 14: products_service.GetProductsRes
+	(*SearchProductsReq)(nil),     // 15: products_service.SearchProductsReq
+	(*SearchProductsRes)(nil),     // 16: products_service.SearchProductsRes
+	(*WatchProductsReq)(nil),      // 17: products_service.WatchProductsReq
+	(*ProductChangeEvent)(nil),    // 18: products_service.ProductChangeEvent
+	(*timestamppb.Timestamp)(nil), // 19: google.protobuf.Timestamp
 }
 var file_products_proto_depIdxs = []int32{
-	7, // 0: products_service.Product.CreatedAt:type_name -> google.protobuf.Timestamp
-	7, // 1: products_service.Product.UpdatedAt:type_name -> google.protobuf.Timestamp
-	0, // 2: products_service.GetProductByIDRes.Product:type_name -> products_service.Product
-	1, // 3: products_service.ProductsService.CreateProduct:input_type -> products_service.CreateProductReq
-	3, // 4: products_service.ProductsService.UpdateProduct:input_type -> products_service.UpdateProductReq
-	5, // 5: products_service.ProductsService.GetProductByID:input_type -> products_service.GetProductByIDReq
-	2, // 6: products_service.ProductsService.CreateProduct:output_type -> products_service.CreateProductRes
-	4, // 7: products_service.ProductsService.UpdateProduct:output_type -> products_service.UpdateProductRes
-	6, // 8: products_service.ProductsService.GetProductByID:output_type -> products_service.GetProductByIDRes
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	19, // 0: products_service.Product.CreatedAt:type_name -> google.protobuf.Timestamp
+	19, // 1: products_service.Product.UpdatedAt:type_name -> google.protobuf.Timestamp
+	1,  // 2: products_service.GetProductByIDRes.Product:type_name -> products_service.Product
+	9,  // 3: products_service.ImportProductsRes.Errors:type_name -> products_service.ImportProductError
+	1,  // 4: products_service.GetProductsRes.Products:type_name -> products_service.Product
+	1,  // 5: products_service.SearchProductsRes.Products:type_name -> products_service.Product
+	0,  // 6: products_service.ProductChangeEvent.ChangeType:type_name -> products_service.ProductChangeType
+	1,  // 7: products_service.ProductChangeEvent.Product:type_name -> products_service.Product
+	19, // 8: products_service.ProductChangeEvent.OccurredAt:type_name -> google.protobuf.Timestamp
+	2,  // 9: products_service.ProductsService.CreateProduct:input_type -> products_service.CreateProductReq
+	4,  // 10: products_service.ProductsService.UpdateProduct:input_type -> products_service.UpdateProductReq
+	6,  // 11: products_service.ProductsService.GetProductByID:input_type -> products_service.GetProductByIDReq
+	11, // 12: products_service.ProductsService.DeleteProduct:input_type -> products_service.DeleteProductReq
+	13, // 13: products_service.ProductsService.GetProducts:input_type -> products_service.GetProductsReq
+	15, // 14: products_service.ProductsService.SearchProducts:input_type -> products_service.SearchProductsReq
+	8,  // 15: products_service.ProductsService.ImportProducts:input_type -> products_service.ImportProductReq
+	17, // 16: products_service.ProductsService.WatchProducts:input_type -> products_service.WatchProductsReq
+	3,  // 17: products_service.ProductsService.CreateProduct:output_type -> products_service.CreateProductRes
+	5,  // 18: products_service.ProductsService.UpdateProduct:output_type -> products_service.UpdateProductRes
+	7,  // 19: products_service.ProductsService.GetProductByID:output_type -> products_service.GetProductByIDRes
+	12, // 20: products_service.ProductsService.DeleteProduct:output_type -> products_service.DeleteProductRes
+	14, // 21: products_service.ProductsService.GetProducts:output_type -> products_service.GetProductsRes
+	16, // 22: products_service.ProductsService.SearchProducts:output_type -> products_service.SearchProductsRes
+	10, // 23: products_service.ProductsService.ImportProducts:output_type -> products_service.ImportProductsRes
+	18, // 24: products_service.ProductsService.WatchProducts:output_type -> products_service.ProductChangeEvent
+	17, // [17:25] is the sub-list for method output_type
+	9,  // [9:17] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_products_proto_init() }
@@ -485,13 +1364,14 @@ func file_products_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_products_proto_rawDesc), len(file_products_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      1,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_products_proto_goTypes,
 		DependencyIndexes: file_products_proto_depIdxs,
+		EnumInfos:         file_products_proto_enumTypes,
 		MessageInfos:      file_products_proto_msgTypes,
 	}.Build()
 	File_products_proto = out.File
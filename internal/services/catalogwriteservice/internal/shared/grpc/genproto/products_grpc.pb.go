@@ -22,15 +22,27 @@ const (
 	ProductsService_CreateProduct_FullMethodName  = "/products_service.ProductsService/CreateProduct"
 	ProductsService_UpdateProduct_FullMethodName  = "/products_service.ProductsService/UpdateProduct"
 	ProductsService_GetProductByID_FullMethodName = "/products_service.ProductsService/GetProductByID"
+	ProductsService_DeleteProduct_FullMethodName  = "/products_service.ProductsService/DeleteProduct"
+	ProductsService_GetProducts_FullMethodName    = "/products_service.ProductsService/GetProducts"
+	ProductsService_SearchProducts_FullMethodName = "/products_service.ProductsService/SearchProducts"
+	ProductsService_ImportProducts_FullMethodName = "/products_service.ProductsService/ImportProducts"
+	ProductsService_WatchProducts_FullMethodName  = "/products_service.ProductsService/WatchProducts"
 )
 
 // ProductsServiceClient is the client API for ProductsService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// the http annotations are served by the grpc-gateway, the streaming rpcs have no http mapping
 type ProductsServiceClient interface {
 	CreateProduct(ctx context.Context, in *CreateProductReq, opts ...grpc.CallOption) (*CreateProductRes, error)
 	UpdateProduct(ctx context.Context, in *UpdateProductReq, opts ...grpc.CallOption) (*UpdateProductRes, error)
 	GetProductByID(ctx context.Context, in *GetProductByIDReq, opts ...grpc.CallOption) (*GetProductByIDRes, error)
+	DeleteProduct(ctx context.Context, in *DeleteProductReq, opts ...grpc.CallOption) (*DeleteProductRes, error)
+	GetProducts(ctx context.Context, in *GetProductsReq, opts ...grpc.CallOption) (*GetProductsRes, error)
+	SearchProducts(ctx context.Context, in *SearchProductsReq, opts ...grpc.CallOption) (*SearchProductsRes, error)
+	ImportProducts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportProductReq, ImportProductsRes], error)
+	WatchProducts(ctx context.Context, in *WatchProductsReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProductChangeEvent], error)
 }
 
 type productsServiceClient struct {
@@ -71,13 +83,82 @@ func (c *productsServiceClient) GetProductByID(ctx context.Context, in *GetProdu
 	return out, nil
 }
 
+func (c *productsServiceClient) DeleteProduct(ctx context.Context, in *DeleteProductReq, opts ...grpc.CallOption) (*DeleteProductRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProductRes)
+	err := c.cc.Invoke(ctx, ProductsService_DeleteProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsServiceClient) GetProducts(ctx context.Context, in *GetProductsReq, opts ...grpc.CallOption) (*GetProductsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductsRes)
+	err := c.cc.Invoke(ctx, ProductsService_GetProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsServiceClient) SearchProducts(ctx context.Context, in *SearchProductsReq, opts ...grpc.CallOption) (*SearchProductsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchProductsRes)
+	err := c.cc.Invoke(ctx, ProductsService_SearchProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsServiceClient) ImportProducts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportProductReq, ImportProductsRes], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProductsService_ServiceDesc.Streams[0], ProductsService_ImportProducts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportProductReq, ImportProductsRes]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductsService_ImportProductsClient = grpc.ClientStreamingClient[ImportProductReq, ImportProductsRes]
+
+func (c *productsServiceClient) WatchProducts(ctx context.Context, in *WatchProductsReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProductChangeEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProductsService_ServiceDesc.Streams[1], ProductsService_WatchProducts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchProductsReq, ProductChangeEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductsService_WatchProductsClient = grpc.ServerStreamingClient[ProductChangeEvent]
+
 // ProductsServiceServer is the server API for ProductsService service.
 // All implementations should embed UnimplementedProductsServiceServer
 // for forward compatibility.
+//
+// the http annotations are served by the grpc-gateway, the streaming rpcs have no http mapping
 type ProductsServiceServer interface {
 	CreateProduct(context.Context, *CreateProductReq) (*CreateProductRes, error)
 	UpdateProduct(context.Context, *UpdateProductReq) (*UpdateProductRes, error)
 	GetProductByID(context.Context, *GetProductByIDReq) (*GetProductByIDRes, error)
+	DeleteProduct(context.Context, *DeleteProductReq) (*DeleteProductRes, error)
+	GetProducts(context.Context, *GetProductsReq) (*GetProductsRes, error)
+	SearchProducts(context.Context, *SearchProductsReq) (*SearchProductsRes, error)
+	ImportProducts(grpc.ClientStreamingServer[ImportProductReq, ImportProductsRes]) error
+	WatchProducts(*WatchProductsReq, grpc.ServerStreamingServer[ProductChangeEvent]) error
 }
 
 // UnimplementedProductsServiceServer should be embedded to have
@@ -96,6 +177,21 @@ func (UnimplementedProductsServiceServer) UpdateProduct(context.Context, *Update
 func (UnimplementedProductsServiceServer) GetProductByID(context.Context, *GetProductByIDReq) (*GetProductByIDRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProductByID not implemented")
 }
+func (UnimplementedProductsServiceServer) DeleteProduct(context.Context, *DeleteProductReq) (*DeleteProductRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
+}
+func (UnimplementedProductsServiceServer) GetProducts(context.Context, *GetProductsReq) (*GetProductsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProducts not implemented")
+}
+func (UnimplementedProductsServiceServer) SearchProducts(context.Context, *SearchProductsReq) (*SearchProductsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchProducts not implemented")
+}
+func (UnimplementedProductsServiceServer) ImportProducts(grpc.ClientStreamingServer[ImportProductReq, ImportProductsRes]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportProducts not implemented")
+}
+func (UnimplementedProductsServiceServer) WatchProducts(*WatchProductsReq, grpc.ServerStreamingServer[ProductChangeEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchProducts not implemented")
+}
 func (UnimplementedProductsServiceServer) testEmbeddedByValue() {}
 
 // UnsafeProductsServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -170,6 +266,78 @@ func _ProductsService_GetProductByID_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductsService_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServiceServer).DeleteProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductsService_DeleteProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServiceServer).DeleteProduct(ctx, req.(*DeleteProductReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductsService_GetProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServiceServer).GetProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductsService_GetProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServiceServer).GetProducts(ctx, req.(*GetProductsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductsService_SearchProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProductsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServiceServer).SearchProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductsService_SearchProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServiceServer).SearchProducts(ctx, req.(*SearchProductsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductsService_ImportProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ProductsServiceServer).ImportProducts(&grpc.GenericServerStream[ImportProductReq, ImportProductsRes]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductsService_ImportProductsServer = grpc.ClientStreamingServer[ImportProductReq, ImportProductsRes]
+
+func _ProductsService_WatchProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchProductsReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProductsServiceServer).WatchProducts(m, &grpc.GenericServerStream[WatchProductsReq, ProductChangeEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductsService_WatchProductsServer = grpc.ServerStreamingServer[ProductChangeEvent]
+
 // ProductsService_ServiceDesc is the grpc.ServiceDesc for ProductsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -189,7 +357,30 @@ var ProductsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProductByID",
 			Handler:    _ProductsService_GetProductByID_Handler,
 		},
+		{
+			MethodName: "DeleteProduct",
+			Handler:    _ProductsService_DeleteProduct_Handler,
+		},
+		{
+			MethodName: "GetProducts",
+			Handler:    _ProductsService_GetProducts_Handler,
+		},
+		{
+			MethodName: "SearchProducts",
+			Handler:    _ProductsService_SearchProducts_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportProducts",
+			Handler:       _ProductsService_ImportProducts_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchProducts",
+			Handler:       _ProductsService_WatchProducts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "products.proto",
 }
@@ -4,12 +4,20 @@ package grpc
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/http"
 
 	"emperror.dev/errors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/grpc/gateway"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/mapper"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing/attribute"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/utils"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	mediatr "github.com/mehdihadeli/go-mediatr"
 	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
@@ -17,15 +25,29 @@ import (
 	attribute2 "go.opentelemetry.io/otel/attribute"
 	api "go.opentelemetry.io/otel/metric"
 
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/changefeed"
 	createProductCommandV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/creatingproduct/v1"
 	createProductDtosV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/creatingproduct/v1/dtos"
+	deleteProductCommandV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/deletingproduct/v1"
 	getProductByIdQueryV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/gettingproductbyid/v1"
 	getProductByIdDtosV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/gettingproductbyid/v1/dtos"
+	getProductsQueryV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/gettingproducts/v1"
+	getProductsDtosV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/gettingproducts/v1/dtos"
+	importProductsCommandV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/importingproducts/v1"
+	importProductsDtosV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/importingproducts/v1/dtos"
+	searchProductsQueryV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/searchingproduct/v1"
+	searchProductsDtosV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/searchingproduct/v1/dtos"
 	updateProductCommandV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/updatingproduct/v1"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/shared/contracts"
 	productsService "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/shared/grpc/genproto"
 )
 
+// default paging of the list rpcs when the request doesn't set it.
+const (
+	defaultPageSize = 10
+	defaultPage     = 1
+)
+
 // grpcMetricsAttr returns the metric attributes for gRPC metrics.
 func grpcMetricsAttr() api.MeasurementOption {
 	return api.WithAttributes(
@@ -35,8 +57,9 @@ func grpcMetricsAttr() api.MeasurementOption {
 
 // ProductGrpcServiceServer is a struct that contains the ProductGrpcServiceServer.
 type ProductGrpcServiceServer struct {
-	catalogsMetrics *contracts.CatalogsMetrics
-	logger          logger.Logger
+	catalogsMetrics   *contracts.CatalogsMetrics
+	logger            logger.Logger
+	productChangeFeed *changefeed.ProductChangeFeed
 	// Ref:https://github.com/grpc/grpc-go/issues/3794#issuecomment-720599532
 	// product_service_client.UnimplementedProductsServiceServer
 }
@@ -45,10 +68,12 @@ type ProductGrpcServiceServer struct {
 func NewProductGrpcService(
 	catalogsMetrics *contracts.CatalogsMetrics,
 	log logger.Logger,
+	productChangeFeed *changefeed.ProductChangeFeed,
 ) *ProductGrpcServiceServer {
 	return &ProductGrpcServiceServer{
-		catalogsMetrics: catalogsMetrics,
-		logger:          log,
+		catalogsMetrics:   catalogsMetrics,
+		logger:            log,
+		productChangeFeed: productChangeFeed,
 	}
 }
 
@@ -102,6 +127,8 @@ func (s *ProductGrpcServiceServer) CreateProduct(
 		return nil, err
 	}
 
+	gateway.SetStatusCode(ctx, http.StatusCreated)
+
 	return &productsService.CreateProductRes{
 		ProductID: result.ProductID.String(),
 	}, nil
@@ -152,6 +179,10 @@ func (s *ProductGrpcServiceServer) UpdateProduct(
 
 		return nil, validationErr
 	}
+	command.ExpectedVersion, err = expectedVersion(ctx, req.GetExpectedVersion())
+	if err != nil {
+		return nil, err
+	}
 
 	if _, err = mediatr.Send[*updateProductCommandV1.UpdateProduct, *mediatr.Unit](ctx, command); err != nil {
 		err = errors.WithMessage(
@@ -170,6 +201,8 @@ func (s *ProductGrpcServiceServer) UpdateProduct(
 		return nil, err
 	}
 
+	gateway.SetStatusCode(ctx, http.StatusNoContent)
+
 	return &productsService.UpdateProductRes{}, nil
 }
 
@@ -249,5 +282,377 @@ func (s *ProductGrpcServiceServer) GetProductByID(
 		return nil, err
 	}
 
+	if queryResult.Product != nil {
+		gateway.SetETag(ctx, queryResult.Product.Version)
+	}
+
 	return &productsService.GetProductByIDRes{Product: product}, nil
 }
+
+// DeleteProduct is a method that deletes a product.
+func (s *ProductGrpcServiceServer) DeleteProduct(
+	ctx context.Context,
+	req *productsService.DeleteProductReq,
+) (*productsService.DeleteProductRes, error) {
+	s.catalogsMetrics.DeleteProductGrpcRequests.Add(ctx, 1, grpcMetricsAttr())
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.Object("Request", req))
+
+	productUUID, err := uuid.FromString(req.GetProductID())
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(
+			err,
+			"[ProductGrpcServiceServer_DeleteProduct.uuid.FromString] error in converting uuid",
+		)
+		s.logger.Errorf(
+			fmt.Sprintf(
+				"[ProductGrpcServiceServer_DeleteProduct.uuid.FromString] err: %v",
+				badRequestErr,
+			),
+		)
+
+		return nil, badRequestErr
+	}
+
+	command, err := deleteProductCommandV1.NewDeleteProductWithValidation(productUUID)
+	if err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(
+			err,
+			"[ProductGrpcServiceServer_DeleteProduct.StructCtx] command validation failed",
+		)
+		s.logger.Errorf(
+			fmt.Sprintf(
+				"[ProductGrpcServiceServer_DeleteProduct.StructCtx] err: %v",
+				validationErr,
+			),
+		)
+
+		return nil, validationErr
+	}
+	command.ExpectedVersion, err = expectedVersion(ctx, req.GetExpectedVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = mediatr.Send[*deleteProductCommandV1.DeleteProduct, *mediatr.Unit](ctx, command); err != nil {
+		err = errors.WithMessage(
+			err,
+			"[ProductGrpcServiceServer_DeleteProduct.Send] error in sending DeleteProduct",
+		)
+		s.logger.Errorw(
+			fmt.Sprintf(
+				"[ProductGrpcServiceServer_DeleteProduct.Send] id: {%s}, err: %v",
+				command.ProductID,
+				err,
+			),
+			logger.Fields{"ID": command.ProductID},
+		)
+
+		return nil, err
+	}
+
+	gateway.SetStatusCode(ctx, http.StatusNoContent)
+
+	return &productsService.DeleteProductRes{}, nil
+}
+
+// GetProducts is a method that gets a page of the products.
+func (s *ProductGrpcServiceServer) GetProducts(
+	ctx context.Context,
+	req *productsService.GetProductsReq,
+) (*productsService.GetProductsRes, error) {
+	s.catalogsMetrics.GetProductsGrpcRequests.Add(ctx, 1, grpcMetricsAttr())
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.Object("Request", req))
+
+	query, err := getProductsQueryV1.NewGetProducts(
+		newListQuery(req.GetPage(), req.GetSize(), req.GetOrderBy()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	queryResult, err := mediatr.Send[*getProductsQueryV1.GetProducts, *getProductsDtosV1.GetProductsResponseDto](
+		ctx,
+		query,
+	)
+	if err != nil {
+		err = errors.WithMessage(
+			err,
+			"[ProductGrpcServiceServer_GetProducts.Send] error in sending GetProducts",
+		)
+		s.logger.Errorf(
+			fmt.Sprintf("[ProductGrpcServiceServer_GetProducts.Send] err: %v", err),
+		)
+
+		return nil, err
+	}
+
+	products, err := mapper.Map[[]*productsService.Product](queryResult.Products.Items)
+	if err != nil {
+		return nil, errors.WithMessage(
+			err,
+			"[ProductGrpcServiceServer_GetProducts.Map] error in mapping products",
+		)
+	}
+
+	return &productsService.GetProductsRes{
+		Products:   products,
+		Page:       int32(queryResult.Products.Page),
+		Size:       int32(queryResult.Products.Size),
+		TotalItems: queryResult.Products.TotalItems,
+		TotalPage:  int32(queryResult.Products.TotalPage),
+	}, nil
+}
+
+// SearchProducts is a method that searches the products by name and description.
+func (s *ProductGrpcServiceServer) SearchProducts(
+	ctx context.Context,
+	req *productsService.SearchProductsReq,
+) (*productsService.SearchProductsRes, error) {
+	s.catalogsMetrics.SearchProductGrpcRequests.Add(ctx, 1, grpcMetricsAttr())
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.Object("Request", req))
+
+	query, err := searchProductsQueryV1.NewSearchProductsWithValidation(
+		req.GetSearchText(),
+		newListQuery(req.GetPage(), req.GetSize(), req.GetOrderBy()),
+	)
+	if err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(
+			err,
+			"[ProductGrpcServiceServer_SearchProducts.StructCtx] query validation failed",
+		)
+		s.logger.Errorf(
+			fmt.Sprintf(
+				"[ProductGrpcServiceServer_SearchProducts.StructCtx] err: %v",
+				validationErr,
+			),
+		)
+
+		return nil, validationErr
+	}
+
+	queryResult, err := mediatr.Send[*searchProductsQueryV1.SearchProducts, *searchProductsDtosV1.SearchProductsResponseDto](
+		ctx,
+		query,
+	)
+	if err != nil {
+		err = errors.WithMessage(
+			err,
+			"[ProductGrpcServiceServer_SearchProducts.Send] error in sending SearchProducts",
+		)
+		s.logger.Errorf(
+			fmt.Sprintf("[ProductGrpcServiceServer_SearchProducts.Send] err: %v", err),
+		)
+
+		return nil, err
+	}
+
+	products, err := mapper.Map[[]*productsService.Product](queryResult.Products.Items)
+	if err != nil {
+		return nil, errors.WithMessage(
+			err,
+			"[ProductGrpcServiceServer_SearchProducts.Map] error in mapping products",
+		)
+	}
+
+	return &productsService.SearchProductsRes{
+		Products:   products,
+		Page:       int32(queryResult.Products.Page),
+		Size:       int32(queryResult.Products.Size),
+		TotalItems: queryResult.Products.TotalItems,
+		TotalPage:  int32(queryResult.Products.TotalPage),
+	}, nil
+}
+
+// WatchProducts is a method that streams the product changes of this instance until the client cancels the call,
+// a subscriber that falls behind gets a `ResourceExhausted` error and should call it again.
+func (s *ProductGrpcServiceServer) WatchProducts(
+	req *productsService.WatchProductsReq,
+	stream grpc.ServerStreamingServer[productsService.ProductChangeEvent],
+) error {
+	ctx := stream.Context()
+	s.catalogsMetrics.WatchProductsGrpcRequests.Add(ctx, 1, grpcMetricsAttr())
+	trace.SpanFromContext(ctx).SetAttributes(attribute.Object("Request", req))
+
+	productIDs := make(map[string]struct{}, len(req.GetProductIDs()))
+	for _, id := range req.GetProductIDs() {
+		productUUID, err := uuid.FromString(id)
+		if err != nil {
+			return customErrors.NewBadRequestErrorWrap(
+				err,
+				"[ProductGrpcServiceServer_WatchProducts.uuid.FromString] error in converting uuid",
+			)
+		}
+		productIDs[productUUID.String()] = struct{}{}
+	}
+
+	subscription, cancel := s.productChangeFeed.Subscribe()
+	defer cancel()
+
+	// the headers tell the client the subscription is in place, so it doesn't miss the changes made after it
+	if err := stream.SendHeader(nil); err != nil {
+		return errors.WrapIf(err, "error in sending the watch stream headers")
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case change, ok := <-subscription.Changes():
+			if !ok {
+				if subscription.Lagged() {
+					return status.Error(
+						codes.ResourceExhausted,
+						"the watcher fell behind the product changes, watch again",
+					)
+				}
+
+				return nil
+			}
+
+			if _, watched := productIDs[change.ProductID]; len(productIDs) > 0 && !watched {
+				continue
+			}
+
+			event, err := toProductChangeEvent(change)
+			if err != nil {
+				return err
+			}
+
+			if err := stream.Send(event); err != nil {
+				return errors.WrapIf(err, "error in sending the product change")
+			}
+		}
+	}
+}
+
+// ImportProducts is a method that creates or updates the products of a client stream, the rows are saved in
+// batches and the rejected rows are reported in the response.
+func (s *ProductGrpcServiceServer) ImportProducts(
+	stream productsService.ProductsService_ImportProductsServer,
+) error {
+	ctx := stream.Context()
+	s.catalogsMetrics.ImportProductsGrpcRequests.Add(ctx, 1, grpcMetricsAttr())
+
+	result, err := importProductsCommandV1.ImportProductRows(
+		ctx,
+		&importProductsStreamReader{stream: stream},
+	)
+	if err != nil {
+		err = errors.WithMessage(
+			err,
+			"[ProductGrpcServiceServer_ImportProducts.ImportProductRows] error in importing products",
+		)
+		s.logger.Errorf(
+			fmt.Sprintf(
+				"[ProductGrpcServiceServer_ImportProducts.ImportProductRows] err: %v",
+				err,
+			),
+		)
+
+		return err
+	}
+
+	trace.SpanFromContext(ctx).SetAttributes(attribute.Object("Result", result))
+
+	res := &productsService.ImportProductsRes{
+		TotalRows: int64(result.TotalRows),
+		Created:   int64(result.Created),
+		Updated:   int64(result.Updated),
+		Failed:    int64(result.Failed),
+		Errors:    make([]*productsService.ImportProductError, 0, len(result.Errors)),
+	}
+	for _, rowErr := range result.Errors {
+		res.Errors = append(res.Errors, &productsService.ImportProductError{
+			Row:       int64(rowErr.Row),
+			ProductID: rowErr.ProductID,
+			Message:   rowErr.Message,
+		})
+	}
+
+	return stream.SendAndClose(res)
+}
+
+// importProductsStreamReader reads the import rows from a client stream, one message is one row.
+type importProductsStreamReader struct {
+	stream productsService.ProductsService_ImportProductsServer
+	row    int
+}
+
+// Read returns the next message of the stream.
+func (r *importProductsStreamReader) Read() (*importProductsDtosV1.ImportProductRowDto, error) {
+	req, err := r.stream.Recv()
+	if err == io.EOF {
+		return nil, io.EOF
+	}
+
+	if err != nil {
+		return nil, errors.WrapIf(err, "error in receiving the import stream")
+	}
+
+	r.row++
+
+	return &importProductsDtosV1.ImportProductRowDto{
+		Row:         r.row,
+		ID:          req.GetProductID(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Price:       req.GetPrice(),
+	}, nil
+}
+
+// expectedVersion returns the expected version of the request, or the `If-Match` version forwarded by the gateway
+// when the request has none.
+func expectedVersion(ctx context.Context, requested int64) (int64, error) {
+	if requested != 0 {
+		return requested, nil
+	}
+
+	return gateway.IfMatchVersion(ctx)
+}
+
+// newListQuery creates the list query of the list rpcs, the zero paging values fall back to the defaults.
+func newListQuery(page int32, size int32, orderBy string) *utils.ListQuery {
+	query := utils.NewListQuery(int(size), int(page))
+	if query.Size <= 0 {
+		query.Size = defaultPageSize
+	}
+	if query.Page <= 0 {
+		query.Page = defaultPage
+	}
+	query.OrderBy = orderBy
+
+	return query
+}
+
+// changeTypes maps the change types of the feed to the proto enum.
+var changeTypes = map[changefeed.ChangeType]productsService.ProductChangeType{
+	changefeed.ChangeTypeCreated:      productsService.ProductChangeType_PRODUCT_CHANGE_TYPE_CREATED,
+	changefeed.ChangeTypeUpdated:      productsService.ProductChangeType_PRODUCT_CHANGE_TYPE_UPDATED,
+	changefeed.ChangeTypeDeleted:      productsService.ProductChangeType_PRODUCT_CHANGE_TYPE_DELETED,
+	changefeed.ChangeTypeRestored:     productsService.ProductChangeType_PRODUCT_CHANGE_TYPE_RESTORED,
+	changefeed.ChangeTypePurged:       productsService.ProductChangeType_PRODUCT_CHANGE_TYPE_PURGED,
+	changefeed.ChangeTypePriceChanged: productsService.ProductChangeType_PRODUCT_CHANGE_TYPE_PRICE_CHANGED,
+}
+
+// toProductChangeEvent maps a product change to its grpc message.
+func toProductChangeEvent(change *changefeed.ProductChange) (*productsService.ProductChangeEvent, error) {
+	event := &productsService.ProductChangeEvent{
+		MessageID:  change.MessageID,
+		ChangeType: changeTypes[change.ChangeType],
+		ProductID:  change.ProductID,
+		OccurredAt: timestamppb.New(change.OccurredAt),
+	}
+
+	if change.Product != nil {
+		product, err := mapper.Map[*productsService.Product](change.Product)
+		if err != nil {
+			return nil, errors.WithMessage(err, "error in mapping the changed product")
+		}
+		event.Product = product
+	}
+
+	return event, nil
+}
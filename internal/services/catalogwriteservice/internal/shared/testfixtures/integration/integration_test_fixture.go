@@ -177,7 +177,27 @@ func NewCatalogWriteIntegrationTestSharedFixture(
 	t *testing.T,
 ) *CatalogWriteIntegrationTestSharedFixture {
 	t.Helper()
-	result := apptest.NewCatalogWriteTestApp().Run(t)
+
+	return newCatalogWriteIntegrationTestSharedFixture(t, apptest.NewCatalogWriteTestApp())
+}
+
+// NewCatalogWriteRabbitmqIntegrationTestSharedFixture is a constructor for the CatalogWriteIntegrationTestSharedFixture
+// that runs the bus on a rabbitmq container instead of the in-memory bus.
+func NewCatalogWriteRabbitmqIntegrationTestSharedFixture(
+	t *testing.T,
+) *CatalogWriteIntegrationTestSharedFixture {
+	t.Helper()
+
+	return newCatalogWriteIntegrationTestSharedFixture(t, apptest.NewCatalogWriteTestApp().WithRabbitmqServer())
+}
+
+// newCatalogWriteIntegrationTestSharedFixture runs the test app and creates the fixture of its dependencies.
+func newCatalogWriteIntegrationTestSharedFixture(
+	t *testing.T,
+	testApp *apptest.CatalogWriteTestApp,
+) *CatalogWriteIntegrationTestSharedFixture {
+	t.Helper()
+	result := testApp.Run(t)
 
 	// https://github.com/michaelklishin/rabbit-hole
 	rmqc, err := rabbithole.NewClient(
@@ -253,6 +273,11 @@ func (i *CatalogWriteIntegrationTestSharedFixture) TearDownTest() {
 }
 
 func (i *CatalogWriteIntegrationTestSharedFixture) cleanupRabbitmqData() error {
+	// the in-memory bus has no rabbitmq server to clean up
+	if i.rabbitmqOptions.InMemory {
+		return nil
+	}
+
 	// https://github.com/michaelklishin/rabbit-hole
 	// Get all queues
 	queues, err := i.RabbitmqCleaner.ListQueuesIn(
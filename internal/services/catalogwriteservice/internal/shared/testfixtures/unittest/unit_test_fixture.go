@@ -79,12 +79,28 @@ func (c *CatalogWriteUnitTestSharedFixture) BeginTx() {
 	c.Ctx = gormContext
 }
 
-// CommitTx is a method that commits the transaction.
-func (c *CatalogWriteUnitTestSharedFixture) CommitTx() {
+// CommitTx is a method that commits the transaction and runs its after commit actions, like the publishing of the
+// events, it returns the error of the after commit actions.
+func (c *CatalogWriteUnitTestSharedFixture) CommitTx() error {
+	tx := gormextensions.GetTxFromContextIfExists(c.Ctx)
+	if tx == nil {
+		return nil
+	}
+
+	c.Log.Info("committing transaction")
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+
+	return gormextensions.RunAfterCommitActions(c.Ctx)
+}
+
+// RollbackTx is a method that rolls back the transaction, its after commit actions never run.
+func (c *CatalogWriteUnitTestSharedFixture) RollbackTx() {
 	tx := gormextensions.GetTxFromContextIfExists(c.Ctx)
 	if tx != nil {
-		c.Log.Info("committing transaction")
-		tx.Commit()
+		c.Log.Info("rolling back transaction")
+		tx.Rollback()
 	}
 }
 
@@ -197,7 +213,11 @@ func (c *CatalogWriteUnitTestSharedFixture) cleanupDB() error {
 
 // migrateGorm is a method that migrates the Gorm database.
 func migrateGorm(dbContext *dbcontext.CatalogsGormDBContext) error {
-	err := dbContext.DB().AutoMigrate(&datamodel.ProductDataModel{})
+	err := dbContext.DB().AutoMigrate(
+		&datamodel.ProductDataModel{},
+		&datamodel.ProductPriceHistoryDataModel{},
+		&datamodel.ScheduledPriceChangeDataModel{},
+	)
 	if err != nil {
 		return err
 	}
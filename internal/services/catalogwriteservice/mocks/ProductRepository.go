@@ -251,6 +251,124 @@ func (_c *ProductRepository_GetProductByID_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// GetDeletedProductIDs provides a mock function with given fields: ctx, ids
+func (_m *ProductRepository) GetDeletedProductIDs(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedProductIDs")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]uuid.UUID, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []uuid.UUID); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_GetDeletedProductIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedProductIDs'
+type ProductRepository_GetDeletedProductIDs_Call struct {
+	*mock.Call
+}
+
+// GetDeletedProductIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []uuid.UUID
+func (_e *ProductRepository_Expecter) GetDeletedProductIDs(ctx interface{}, ids interface{}) *ProductRepository_GetDeletedProductIDs_Call {
+	return &ProductRepository_GetDeletedProductIDs_Call{Call: _e.mock.On("GetDeletedProductIDs", ctx, ids)}
+}
+
+func (_c *ProductRepository_GetDeletedProductIDs_Call) Run(run func(ctx context.Context, ids []uuid.UUID)) *ProductRepository_GetDeletedProductIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_GetDeletedProductIDs_Call) Return(_a0 []uuid.UUID, _a1 error) *ProductRepository_GetDeletedProductIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_GetDeletedProductIDs_Call) RunAndReturn(run func(context.Context, []uuid.UUID) ([]uuid.UUID, error)) *ProductRepository_GetDeletedProductIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductsByIDs provides a mock function with given fields: ctx, ids
+func (_m *ProductRepository) GetProductsByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.Product, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductsByIDs")
+	}
+
+	var r0 []*models.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]*models.Product, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []*models.Product); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_GetProductsByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductsByIDs'
+type ProductRepository_GetProductsByIDs_Call struct {
+	*mock.Call
+}
+
+// GetProductsByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []uuid.UUID
+func (_e *ProductRepository_Expecter) GetProductsByIDs(ctx interface{}, ids interface{}) *ProductRepository_GetProductsByIDs_Call {
+	return &ProductRepository_GetProductsByIDs_Call{Call: _e.mock.On("GetProductsByIDs", ctx, ids)}
+}
+
+func (_c *ProductRepository_GetProductsByIDs_Call) Run(run func(ctx context.Context, ids []uuid.UUID)) *ProductRepository_GetProductsByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_GetProductsByIDs_Call) Return(_a0 []*models.Product, _a1 error) *ProductRepository_GetProductsByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_GetProductsByIDs_Call) RunAndReturn(run func(context.Context, []uuid.UUID) ([]*models.Product, error)) *ProductRepository_GetProductsByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SearchProducts provides a mock function with given fields: ctx, searchText, listQuery
 func (_m *ProductRepository) SearchProducts(ctx context.Context, searchText string, listQuery *utils.ListQuery) (*utils.ListResult[*models.Product], error) {
 	ret := _m.Called(ctx, searchText, listQuery)
@@ -370,6 +488,53 @@ func (_c *ProductRepository_UpdateProduct_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// UpsertProducts provides a mock function with given fields: ctx, products
+func (_m *ProductRepository) UpsertProducts(ctx context.Context, products []*models.Product) error {
+	ret := _m.Called(ctx, products)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertProducts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*models.Product) error); ok {
+		r0 = rf(ctx, products)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProductRepository_UpsertProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertProducts'
+type ProductRepository_UpsertProducts_Call struct {
+	*mock.Call
+}
+
+// UpsertProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - products []*models.Product
+func (_e *ProductRepository_Expecter) UpsertProducts(ctx interface{}, products interface{}) *ProductRepository_UpsertProducts_Call {
+	return &ProductRepository_UpsertProducts_Call{Call: _e.mock.On("UpsertProducts", ctx, products)}
+}
+
+func (_c *ProductRepository_UpsertProducts_Call) Run(run func(ctx context.Context, products []*models.Product)) *ProductRepository_UpsertProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*models.Product))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_UpsertProducts_Call) Return(_a0 error) *ProductRepository_UpsertProducts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProductRepository_UpsertProducts_Call) RunAndReturn(run func(context.Context, []*models.Product) error) *ProductRepository_UpsertProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewProductRepository creates a new instance of ProductRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewProductRepository(t interface {
@@ -14,7 +14,6 @@ import (
 	gofakeit "github.com/brianvoe/gofakeit/v6"
 	httpexpect "github.com/gavv/httpexpect/v2"
 
-	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/creatingproduct/v1/dtos"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/shared/testfixtures/integration"
 )
 
@@ -29,7 +28,7 @@ func TestCreateProductEndpoint(t *testing.T) {
 var _ = Describe("CreateProduct Endpoint", func() {
 	var (
 		ctx     context.Context
-		request *dtos.CreateProductRequestDto
+		request map[string]interface{}
 	)
 
 	BeforeEach(func() {
@@ -48,10 +47,10 @@ var _ = Describe("CreateProduct Endpoint", func() {
 		BeforeEach(func() {
 			// Generate a valid request with explicit float64 price
 			price := float64(gofakeit.Price(100, 1000))
-			request = &dtos.CreateProductRequestDto{
-				Description: gofakeit.AdjectiveDescriptive(),
-				Price:       price,
-				Name:        gofakeit.Name(),
+			request = map[string]interface{}{
+				"Name":        gofakeit.Name(),
+				"Description": gofakeit.AdjectiveDescriptive(),
+				"Price":       price,
 			}
 		})
 
@@ -61,19 +60,15 @@ var _ = Describe("CreateProduct Endpoint", func() {
 				expect := httpexpect.New(GinkgoT(), integrationFixture.BaseAddress)
 				obj := expect.POST("products").
 					WithContext(ctx).
-					WithJSON(map[string]interface{}{
-						"name":        request.Name,
-						"description": request.Description,
-						"price":       request.Price,
-					}).
+					WithJSON(request).
 					Expect().
 					Status(http.StatusCreated).
 					JSON().
 					Object()
 
 				// Verify response structure
-				obj.ContainsKey("productID")
-				Expect(obj.Value("productID").Raw()).NotTo(BeEmpty())
+				obj.ContainsKey("ProductID")
+				Expect(obj.Value("ProductID").Raw()).NotTo(BeEmpty())
 			})
 		})
 	})
@@ -81,10 +76,10 @@ var _ = Describe("CreateProduct Endpoint", func() {
 	Describe("Create product returns a BadRequest status with invalid price input", func() {
 		BeforeEach(func() {
 			// Generate an invalid request with zero price
-			request = &dtos.CreateProductRequestDto{
-				Description: gofakeit.AdjectiveDescriptive(),
-				Price:       0.0,
-				Name:        gofakeit.Name(),
+			request = map[string]interface{}{
+				"Name":        gofakeit.Name(),
+				"Description": gofakeit.AdjectiveDescriptive(),
+				"Price":       0.0,
 			}
 		})
 
@@ -94,11 +89,7 @@ var _ = Describe("CreateProduct Endpoint", func() {
 				expect := httpexpect.New(GinkgoT(), integrationFixture.BaseAddress)
 				expect.POST("products").
 					WithContext(ctx).
-					WithJSON(map[string]interface{}{
-						"name":        request.Name,
-						"description": request.Description,
-						"price":       0.0,
-					}).
+					WithJSON(request).
 					Expect().
 					Status(http.StatusBadRequest)
 			})
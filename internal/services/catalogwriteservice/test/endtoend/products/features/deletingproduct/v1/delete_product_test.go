@@ -70,16 +70,16 @@ var _ = Describe("DeleteProduct Endpoint", func() {
 				createResponse := expect.POST("products").
 					WithContext(ctx).
 					WithJSON(map[string]interface{}{
-						"name":        "Test Product",
-						"description": "Test Description",
-						"price":       100.0,
+						"Name":        "Test Product",
+						"Description": "Test Description",
+						"Price":       100.0,
 					}).
 					Expect().
 					Status(http.StatusCreated).
 					JSON().
 					Object()
 
-				productID := createResponse.Value("productID").String().Raw()
+				productID := createResponse.Value("ProductID").String().Raw()
 
 				// Then delete it
 				expect.DELETE("products/" + productID).
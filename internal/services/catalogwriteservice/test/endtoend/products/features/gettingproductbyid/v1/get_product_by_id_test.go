@@ -37,9 +37,9 @@ var _ = Describe("GetProductById Endpoint", func() {
 
 		// Create a product for testing
 		createRequest := map[string]interface{}{
-			"name":        gofakeit.Name(),
-			"description": gofakeit.AdjectiveDescriptive(),
-			"price":       float64(gofakeit.Price(100, 1000)),
+			"Name":        gofakeit.Name(),
+			"Description": gofakeit.AdjectiveDescriptive(),
+			"Price":       float64(gofakeit.Price(100, 1000)),
 		}
 
 		// Create the product and verify the response
@@ -51,8 +51,8 @@ var _ = Describe("GetProductById Endpoint", func() {
 			Object()
 
 		// Ensure we got a valid productID
-		createRes.ContainsKey("productID")
-		productID = createRes.Value("productID").String().Raw()
+		createRes.ContainsKey("ProductID")
+		productID = createRes.Value("ProductID").String().Raw()
 		Expect(productID).NotTo(BeEmpty(), "Product ID should not be empty")
 
 		// Verify it's a valid UUID by attempting to parse it
@@ -67,10 +67,10 @@ var _ = Describe("GetProductById Endpoint", func() {
 			Object()
 
 		// Verify the response structure with nested product object
-		res.ContainsKey("product")
-		product := res.Value("product").Object()
-		product.ContainsKey("id")
-		product.Value("id").String().Equal(productID)
+		res.ContainsKey("Product")
+		product := res.Value("Product").Object()
+		product.ContainsKey("ProductID")
+		product.Value("ProductID").String().Equal(productID)
 	})
 
 	AfterEach(func() {
@@ -89,17 +89,17 @@ var _ = Describe("GetProductById Endpoint", func() {
 					Object()
 
 				By("Verifying the response structure")
-				res.ContainsKey("product")
-				product := res.Value("product").Object()
-				product.ContainsKey("id")
-				product.ContainsKey("name")
-				product.ContainsKey("description")
-				product.ContainsKey("price")
-				product.ContainsKey("createdAt")
-				product.ContainsKey("updatedAt")
+				res.ContainsKey("Product")
+				product := res.Value("Product").Object()
+				product.ContainsKey("ProductID")
+				product.ContainsKey("Name")
+				product.ContainsKey("Description")
+				product.ContainsKey("Price")
+				product.ContainsKey("CreatedAt")
+				product.ContainsKey("UpdatedAt")
 
 				By("Verifying the product ID matches")
-				product.Value("id").String().Equal(productID)
+				product.Value("ProductID").String().Equal(productID)
 			})
 		})
 
@@ -44,9 +44,9 @@ var _ = Describe("GetProducts Endpoint", func() {
 			It("Should return an OK status with products data", func() {
 				// Create a product first to ensure we have data
 				createRequest := map[string]interface{}{
-					"name":        gofakeit.Name(),
-					"description": gofakeit.AdjectiveDescriptive(),
-					"price":       float64(gofakeit.Price(100, 1000)),
+					"Name":        gofakeit.Name(),
+					"Description": gofakeit.AdjectiveDescriptive(),
+					"Price":       float64(gofakeit.Price(100, 1000)),
 				}
 
 				createRes := expect.POST("/products").
@@ -56,7 +56,7 @@ var _ = Describe("GetProducts Endpoint", func() {
 					JSON().
 					Object()
 
-				createRes.ContainsKey("productID")
+				createRes.ContainsKey("ProductID")
 
 				// Get all products
 				res := expect.GET("/products").
@@ -67,23 +67,21 @@ var _ = Describe("GetProducts Endpoint", func() {
 
 				// Verify the response structure
 				res.ContainsKey("Products")
-				products := res.Value("Products").Object()
-				products.ContainsKey("items")
-				products.ContainsKey("page")
-				products.ContainsKey("size")
+				res.ContainsKey("Page")
+				res.ContainsKey("Size")
 
 				// Verify the items array
-				items := products.Value("items").Array()
+				items := res.Value("Products").Array()
 				items.Length().Gt(0)
 
 				// Verify the first item structure
 				firstItem := items.First().Object()
-				firstItem.ContainsKey("id")
-				firstItem.ContainsKey("name")
-				firstItem.ContainsKey("description")
-				firstItem.ContainsKey("price")
-				firstItem.ContainsKey("createdAt")
-				firstItem.ContainsKey("updatedAt")
+				firstItem.ContainsKey("ProductID")
+				firstItem.ContainsKey("Name")
+				firstItem.ContainsKey("Description")
+				firstItem.ContainsKey("Price")
+				firstItem.ContainsKey("CreatedAt")
+				firstItem.ContainsKey("UpdatedAt")
 			})
 		})
 	})
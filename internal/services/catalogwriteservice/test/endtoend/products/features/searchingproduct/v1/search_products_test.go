@@ -49,7 +49,7 @@ var _ = Describe("Search Products Feature", func() {
 				expect := httpexpect.New(GinkgoT(), integrationFixture.BaseAddress)
 				expect.GET("products/search").
 					WithContext(ctx).
-					WithQuery("search", integrationFixture.Items[0].Name).
+					WithQuery("SearchText", integrationFixture.Items[0].Name).
 					Expect().
 					Status(http.StatusOK)
 			})
@@ -52,9 +52,9 @@ var _ = Describe("UpdateProduct Endpoint", func() {
 			It("Should return a 204 No Content status", func() {
 				By("Making update request")
 				updateRequest := map[string]interface{}{
-					"name":        gofakeit.Name(),
-					"description": gofakeit.AdjectiveDescriptive(),
-					"price":       gofakeit.Price(100, 1000),
+					"Name":        gofakeit.Name(),
+					"Description": gofakeit.AdjectiveDescriptive(),
+					"Price":       gofakeit.Price(100, 1000),
 				}
 
 				// First verify the product exists
@@ -79,20 +79,20 @@ var _ = Describe("UpdateProduct Endpoint", func() {
 					Object()
 
 				By("Verifying updated values")
-				product := response.Value("product").Object()
-				product.Value("id").String().Equal(productID.String())
-				product.Value("name").String().Equal(updateRequest["name"].(string))
-				product.Value("description").String().Equal(updateRequest["description"].(string))
-				product.Value("price").Number().Equal(updateRequest["price"].(float64))
+				product := response.Value("Product").Object()
+				product.Value("ProductID").String().Equal(productID.String())
+				product.Value("Name").String().Equal(updateRequest["Name"].(string))
+				product.Value("Description").String().Equal(updateRequest["Description"].(string))
+				product.Value("Price").Number().Equal(updateRequest["Price"].(float64))
 			})
 
 			It("Should return a 400 Bad Request for invalid UUID", func() {
 				By("Making request with invalid UUID")
 				invalidUUID := "not-a-uuid"
 				updateRequest := map[string]interface{}{
-					"name":        gofakeit.Name(),
-					"description": gofakeit.AdjectiveDescriptive(),
-					"price":       gofakeit.Price(100, 1000),
+					"Name":        gofakeit.Name(),
+					"Description": gofakeit.AdjectiveDescriptive(),
+					"Price":       gofakeit.Price(100, 1000),
 				}
 
 				expect.PUT("/products/{id}", invalidUUID).
@@ -106,9 +106,9 @@ var _ = Describe("UpdateProduct Endpoint", func() {
 				By("Making request with non-existent UUID")
 				nonExistentID := uuid.NewV4()
 				updateRequest := map[string]interface{}{
-					"name":        gofakeit.Name(),
-					"description": gofakeit.AdjectiveDescriptive(),
-					"price":       gofakeit.Price(100, 1000),
+					"Name":        gofakeit.Name(),
+					"Description": gofakeit.AdjectiveDescriptive(),
+					"Price":       gofakeit.Price(100, 1000),
 				}
 
 				expect.PUT("/products/{id}", nonExistentID).
@@ -121,9 +121,9 @@ var _ = Describe("UpdateProduct Endpoint", func() {
 			It("Should return a 400 Bad Request for invalid data", func() {
 				By("Making request with invalid data")
 				invalidRequest := map[string]interface{}{
-					"name":        "", // Empty name should fail validation
-					"description": gofakeit.AdjectiveDescriptive(),
-					"price":       0, // Zero price should fail validation
+					"Name":        "", // Empty name should fail validation
+					"Description": gofakeit.AdjectiveDescriptive(),
+					"Price":       0, // Zero price should fail validation
 				}
 
 				expect.PUT("/products/{id}", productID).
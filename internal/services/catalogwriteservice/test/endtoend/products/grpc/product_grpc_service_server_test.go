@@ -6,6 +6,7 @@ package grpc
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -257,4 +258,191 @@ var _ = Describe("Product gRPC Service", func() {
 			})
 		})
 	})
+
+	Describe("Delete product", func() {
+		When("A valid request is made", func() {
+			It("Should delete the product successfully", func() {
+				By("Making a request to delete the product")
+				req := &productsservice.DeleteProductReq{
+					ProductID: productID,
+				}
+
+				res, err := integrationFixture.ProductServiceClient.DeleteProduct(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res).NotTo(BeNil())
+
+				By("Verifying the product is not found anymore")
+				getRes, err := integrationFixture.ProductServiceClient.GetProductByID(
+					ctx,
+					&productsservice.GetProductByIDReq{ProductID: productID},
+				)
+				Expect(err).To(HaveOccurred())
+				Expect(getRes).To(BeNil())
+			})
+		})
+
+		When("An invalid request is made", func() {
+			It("Should return an error for malformed UUID", func() {
+				By("Making a request with malformed UUID")
+				req := &productsservice.DeleteProductReq{
+					ProductID: "invalid-uuid",
+				}
+
+				res, err := integrationFixture.ProductServiceClient.DeleteProduct(ctx, req)
+				Expect(err).To(HaveOccurred())
+				Expect(res).To(BeNil())
+			})
+
+			It("Should return an error for non-existent UUID", func() {
+				By("Making a request with non-existent UUID")
+				req := &productsservice.DeleteProductReq{
+					ProductID: uuid.New().String(),
+				}
+
+				res, err := integrationFixture.ProductServiceClient.DeleteProduct(ctx, req)
+				Expect(err).To(HaveOccurred())
+				Expect(res).To(BeNil())
+			})
+		})
+	})
+
+	Describe("Get products", func() {
+		When("A valid request is made", func() {
+			It("Should return a page of the products", func() {
+				By("Making a request to get the products")
+				req := &productsservice.GetProductsReq{
+					Page: 1,
+					Size: 10,
+				}
+
+				res, err := integrationFixture.ProductServiceClient.GetProducts(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res).NotTo(BeNil())
+
+				By("Verifying the response")
+				Expect(res.Products).NotTo(BeEmpty())
+				Expect(res.Page).To(Equal(int32(1)))
+				Expect(res.Size).To(Equal(int32(10)))
+				Expect(res.TotalItems).To(BeNumerically(">=", len(res.Products)))
+			})
+
+			It("Should use the default paging when it is not set", func() {
+				By("Making a request without paging")
+				res, err := integrationFixture.ProductServiceClient.GetProducts(
+					ctx,
+					&productsservice.GetProductsReq{},
+				)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res).NotTo(BeNil())
+				Expect(res.Page).To(Equal(int32(1)))
+				Expect(res.Size).To(Equal(int32(10)))
+			})
+		})
+	})
+
+	Describe("Search products", func() {
+		When("A valid request is made", func() {
+			It("Should return the matching products", func() {
+				By("Making a request to search the products")
+				req := &productsservice.SearchProductsReq{
+					SearchText: "Test Product",
+					Page:       1,
+					Size:       10,
+				}
+
+				res, err := integrationFixture.ProductServiceClient.SearchProducts(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res).NotTo(BeNil())
+
+				By("Verifying the response")
+				Expect(res.Products).NotTo(BeEmpty())
+				ids := make([]string, 0, len(res.Products))
+				for _, product := range res.Products {
+					ids = append(ids, product.ProductID)
+				}
+				Expect(ids).To(ContainElement(productID))
+			})
+		})
+
+		When("An invalid request is made", func() {
+			It("Should return an error for empty search text", func() {
+				By("Making a request with empty search text")
+				res, err := integrationFixture.ProductServiceClient.SearchProducts(
+					ctx,
+					&productsservice.SearchProductsReq{},
+				)
+				Expect(err).To(HaveOccurred())
+				Expect(res).To(BeNil())
+			})
+		})
+	})
+
+	Describe("Watch products", func() {
+		When("A product is changed after watching", func() {
+			It("Should stream the product changes", func() {
+				watchCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+				defer cancel()
+
+				By("Watching the changes of the product")
+				stream, err := integrationFixture.ProductServiceClient.WatchProducts(
+					watchCtx,
+					&productsservice.WatchProductsReq{ProductIDs: []string{productID}},
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				// the headers are sent once the server subscribed to the changes
+				_, err = stream.Header()
+				Expect(err).NotTo(HaveOccurred())
+
+				By("Updating the product")
+				_, err = integrationFixture.ProductServiceClient.UpdateProduct(
+					ctx,
+					&productsservice.UpdateProductReq{
+						ProductID:   productID,
+						Name:        "Watched Test Product",
+						Description: "Watched Test Description",
+						Price:       40.99,
+					},
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				By("Deleting the product")
+				_, err = integrationFixture.ProductServiceClient.DeleteProduct(
+					ctx,
+					&productsservice.DeleteProductReq{ProductID: productID},
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				By("Verifying the streamed changes")
+				updated, err := stream.Recv()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updated.ChangeType).
+					To(Equal(productsservice.ProductChangeType_PRODUCT_CHANGE_TYPE_UPDATED))
+				Expect(updated.ProductID).To(Equal(productID))
+				Expect(updated.Product).NotTo(BeNil())
+				Expect(updated.Product.Name).To(Equal("Watched Test Product"))
+
+				deleted, err := stream.Recv()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deleted.ChangeType).
+					To(Equal(productsservice.ProductChangeType_PRODUCT_CHANGE_TYPE_DELETED))
+				Expect(deleted.ProductID).To(Equal(productID))
+				Expect(deleted.Product).To(BeNil())
+			})
+		})
+
+		When("An invalid request is made", func() {
+			It("Should return an error for malformed UUID", func() {
+				By("Watching with a malformed UUID")
+				stream, err := integrationFixture.ProductServiceClient.WatchProducts(
+					ctx,
+					&productsservice.WatchProductsReq{ProductIDs: []string{"invalid-uuid"}},
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = stream.Recv()
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
 })
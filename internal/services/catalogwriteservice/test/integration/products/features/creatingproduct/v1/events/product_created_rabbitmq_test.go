@@ -0,0 +1,122 @@
+//go:build integration
+// +build integration
+
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/test/hypothesis"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/test/messaging"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	gofakeit "github.com/brianvoe/gofakeit/v6"
+	mediatr "github.com/mehdihadeli/go-mediatr"
+
+	createProductCommand "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/creatingproduct/v1"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/creatingproduct/v1/dtos"
+	integrationEvents "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/creatingproduct/v1/events/integrationevents"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/shared/testfixtures/integration"
+)
+
+var integrationFixture *integration.CatalogWriteIntegrationTestSharedFixture
+
+// the test configuration runs the bus in memory, this suite keeps the publishing and the consuming covered on the
+// rabbitmq transport with a rabbitmq container.
+func TestProductCreatedOnRabbitmq(t *testing.T) {
+	RegisterFailHandler(Fail)
+	integrationFixture = integration.NewCatalogWriteRabbitmqIntegrationTestSharedFixture(t)
+	RunSpecs(t, "ProductCreated On Rabbitmq Integration Tests")
+}
+
+var _ = Describe("ProductCreated On Rabbitmq Feature", func() {
+	var (
+		ctx           context.Context
+		err           error
+		command       *createProductCommand.CreateProduct
+		result        *dtos.CreateProductResponseDto
+		shouldPublish hypothesis.Hypothesis[*integrationEvents.ProductCreatedV1]
+		shouldConsume hypothesis.Hypothesis[*integrationEvents.ProductCreatedV1]
+	)
+
+	_ = BeforeEach(func() {
+		By("Seeding the required data")
+		integrationFixture.SetupTest()
+	})
+
+	_ = AfterEach(func() {
+		By("Cleanup test data")
+		integrationFixture.TearDownTest()
+	})
+
+	_ = BeforeSuite(func() {
+		ctx = context.Background()
+
+		// the test consumer should be connected before starting the bus, so its queue is declared on the broker
+		shouldConsume, err = messaging.ShouldConsumeNewConsumer[*integrationEvents.ProductCreatedV1](
+			integrationFixture.Bus,
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		err = integrationFixture.Bus.Start(ctx)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// wait for consumers ready to consume before publishing messages, preparation background workers takes a bit time (for preventing messages lost)
+		time.Sleep(1 * time.Second)
+	})
+
+	_ = AfterSuite(func() {
+		integrationFixture.Log.Info("TearDownSuite started")
+		err := integrationFixture.Bus.Stop()
+		Expect(err).ShouldNot(HaveOccurred())
+		time.Sleep(1 * time.Second)
+	})
+
+	// "Scenario" step for testing the ProductCreated event goes through the rabbitmq broker
+	Describe("Publishing and consuming ProductCreated through the rabbitmq broker", func() {
+		Context("Given new product doesn't exists in the system", func() {
+			BeforeEach(func() {
+				command, err = createProductCommand.NewCreateProductWithValidation(
+					gofakeit.Name(),
+					gofakeit.AdjectiveDescriptive(),
+					gofakeit.Price(150, 6000),
+				)
+				Expect(err).ToNot(HaveOccurred())
+
+				shouldPublish = messaging.ShouldProduced(
+					ctx,
+					integrationFixture.Bus,
+					func(event *integrationEvents.ProductCreatedV1) bool {
+						return event.ProductDto.ID == command.ProductID
+					},
+				)
+			})
+
+			When("CreateProduct command is executed for non-existing product", func() {
+				BeforeEach(func() {
+					result, err = mediatr.Send[*createProductCommand.CreateProduct, *dtos.CreateProductResponseDto](
+						ctx,
+						command,
+					)
+				})
+
+				It("Should create the product successfully", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result).ToNot(BeNil())
+				})
+
+				It("Should publish ProductCreated event to the rabbitmq broker", func() {
+					shouldPublish.Validate(ctx, "there is no published message", time.Second*30)
+				})
+
+				It("Should consume ProductCreated event from the rabbitmq broker", func() {
+					shouldConsume.Validate(ctx, "there is no consumed message", time.Second*30)
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,88 @@
+//go:build unit
+// +build unit
+
+package changefeed
+
+import (
+	"testing"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/mocks"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/changefeed"
+	dtoV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1"
+	creatingProductEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/creatingproduct/v1/events/integrationevents"
+	deletingProductEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/deletingproduct/v1/events/integrationevents"
+)
+
+type productChangeFeedUnitTests struct {
+	suite.Suite
+	feed     *changefeed.ProductChangeFeed
+	produced func(message types.IMessage)
+}
+
+func TestProductChangeFeedUnit(t *testing.T) {
+	suite.Run(t, &productChangeFeedUnitTests{})
+}
+
+func (c *productChangeFeedUnitTests) SetupTest() {
+	bus := &mocks.Bus{}
+	bus.On("IsProduced", mock.Anything).Run(func(args mock.Arguments) {
+		c.produced = args.Get(0).(func(message types.IMessage))
+	})
+
+	c.feed = changefeed.NewProductChangeFeed(bus)
+	c.Require().NotNil(c.produced)
+}
+
+func (c *productChangeFeedUnitTests) Test_Should_Publish_Product_Events_To_Subscribers() {
+	sub, cancel := c.feed.Subscribe()
+	defer cancel()
+
+	product := &dtoV1.ProductDto{ID: uuid.NewV4(), Name: "product"}
+	c.produced(creatingProductEventsV1.NewProductCreatedV1(product))
+	c.produced(deletingProductEventsV1.NewProductDeletedV1(product.ID.String()))
+
+	created := <-sub.Changes()
+	c.Equal(changefeed.ChangeTypeCreated, created.ChangeType)
+	c.Equal(product.ID.String(), created.ProductID)
+	c.Equal(product, created.Product)
+
+	deleted := <-sub.Changes()
+	c.Equal(changefeed.ChangeTypeDeleted, deleted.ChangeType)
+	c.Equal(product.ID.String(), deleted.ProductID)
+	c.Nil(deleted.Product)
+}
+
+func (c *productChangeFeedUnitTests) Test_Should_Ignore_Other_Messages() {
+	sub, cancel := c.feed.Subscribe()
+	defer cancel()
+
+	c.produced(types.NewMessage(uuid.NewV4().String()))
+	cancel()
+
+	_, ok := <-sub.Changes()
+	c.False(ok)
+	c.False(sub.Lagged())
+}
+
+func (c *productChangeFeedUnitTests) Test_Should_Drop_Lagging_Subscriber() {
+	sub, cancel := c.feed.Subscribe()
+	defer cancel()
+
+	for i := 0; i <= changefeed.DefaultBufferSize; i++ {
+		c.produced(deletingProductEventsV1.NewProductDeletedV1(uuid.NewV4().String()))
+	}
+
+	received := 0
+	for range sub.Changes() {
+		received++
+	}
+
+	c.Equal(changefeed.DefaultBufferSize, received)
+	c.True(sub.Lagged())
+}
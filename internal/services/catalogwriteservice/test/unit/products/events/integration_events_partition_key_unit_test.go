@@ -0,0 +1,56 @@
+//go:build unit
+// +build unit
+
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/json"
+	"github.com/stretchr/testify/suite"
+
+	messageHeader "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/messageheader"
+	messageProducer "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/producer"
+	uuid "github.com/satori/go.uuid"
+
+	dtoV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1"
+	changeProductPriceEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/changingproductprice/v1/events/integrationevents"
+	createProductEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/creatingproduct/v1/events/integrationevents"
+	deleteProductEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/deletingproduct/v1/events/integrationevents"
+	purgeProductEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/purgingproduct/v1/events/integrationevents"
+	restoreProductEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/restoringproduct/v1/events/integrationevents"
+	updateProductEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/updatingproduct/v1/events/integrationevents"
+)
+
+type integrationEventsPartitionKeyUnitTests struct {
+	suite.Suite
+}
+
+func TestIntegrationEventsPartitionKeyUnit(t *testing.T) {
+	suite.Run(t, &integrationEventsPartitionKeyUnitTests{})
+}
+
+// TestShouldPublishTheProductIDAsThePartitionKey tests the product events are published with the id of their product
+// as the partition key header, so the consumers order them without deserializing them.
+func (s *integrationEventsPartitionKeyUnitTests) TestShouldPublishTheProductIDAsThePartitionKey() {
+	productID := uuid.NewV4()
+	productDto := &dtoV1.ProductDto{ID: productID, Name: "coffee", Price: 10, CreatedAt: time.Now()}
+
+	messages := []types.IMessage{
+		createProductEventsV1.NewProductCreatedV1(productDto),
+		updateProductEventsV1.NewProductUpdatedV1(productDto),
+		restoreProductEventsV1.NewProductRestoredV1(productDto),
+		deleteProductEventsV1.NewProductDeletedV1(productID.String()),
+		purgeProductEventsV1.NewProductPurgedV1(productID.String()),
+		changeProductPriceEventsV1.NewProductPriceChangedV1(productID, 10, 12, "updated", nil, time.Now()),
+	}
+
+	serializer := json.NewDefaultMessageJsonSerializer(json.NewDefaultJsonSerializer())
+	for _, message := range messages {
+		meta := messageProducer.PublishingMetadata(message, nil, serializer)
+
+		s.Assert().Equal(productID.String(), messageHeader.GetPartitionKey(meta), message.GetMessageTypeName())
+	}
+}
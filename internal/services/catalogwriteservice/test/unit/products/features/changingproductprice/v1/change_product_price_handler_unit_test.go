@@ -0,0 +1,165 @@
+//go:build unit
+// +build unit
+
+package v1
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/cqrs"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/postgresgorm/gormdbcontext"
+	"github.com/stretchr/testify/suite"
+
+	mediatr "github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/data/datamodels"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1/fxparams"
+	changingproductpricev1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/changingproductprice/v1"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/models"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/shared/testfixtures/unittest"
+)
+
+type changeProductPriceHandlerUnitTests struct {
+	*unittest.CatalogWriteUnitTestSharedFixture
+	handler cqrs.RequestHandlerWithRegisterer[*changingproductpricev1.ChangeProductPrice, *mediatr.Unit]
+}
+
+func TestChangeProductPriceHandlerUnit(t *testing.T) {
+	suite.Run(
+		t,
+		&changeProductPriceHandlerUnitTests{
+			CatalogWriteUnitTestSharedFixture: unittest.NewCatalogWriteUnitTestSharedFixture(t),
+		},
+	)
+}
+
+func (c *changeProductPriceHandlerUnitTests) SetupTest() {
+	// call base `SetupTest hook` before running child hook
+	c.CatalogWriteUnitTestSharedFixture.SetupTest()
+	c.handler = changingproductpricev1.NewChangeProductPriceHandler(
+		fxparams.ProductHandlerParams{
+			CatalogsDBContext: c.CatalogDBContext,
+			Tracer:            c.Tracer,
+			RabbitmqProducer:  c.Bus,
+			Log:               c.Log,
+		},
+	)
+}
+
+func (c *changeProductPriceHandlerUnitTests) TearDownTest() {
+	// call base `TearDownTest hook` before running child hook
+	c.CatalogWriteUnitTestSharedFixture.TearDownTest()
+}
+
+// TestHandleShouldChangePriceAndRecordHistory tests the handle should change the price and record it in the price history.
+func (c *changeProductPriceHandlerUnitTests) TestHandleShouldChangePriceAndRecordHistory() {
+	existing := c.Products[0]
+	scheduledPriceChangeID := uuid.NewV4()
+
+	command, err := changingproductpricev1.NewChangeProductPriceWithValidation(
+		existing.ID,
+		existing.Price+10,
+		&scheduledPriceChangeID,
+	)
+	c.Require().NoError(err)
+
+	c.BeginTx()
+	_, err = c.handler.Handle(c.Ctx, command)
+	c.CommitTx()
+
+	c.Require().NoError(err)
+
+	product, err := gormdbcontext.FindDataModelByID[*datamodels.ProductDataModel](
+		c.Ctx,
+		c.CatalogDBContext,
+		existing.ID,
+	)
+	c.Require().NoError(err)
+	c.Assert().Equal(command.NewPrice, product.Price)
+
+	var history []*datamodels.ProductPriceHistoryDataModel
+	err = c.CatalogDBContext.DB().Where("product_id = ?", existing.ID).Find(&history).Error
+	c.Require().NoError(err)
+	c.Require().Len(history, 1)
+	c.Assert().Equal(existing.Price, history[0].OldPrice)
+	c.Assert().Equal(command.NewPrice, history[0].NewPrice)
+	c.Assert().Equal(models.PriceChangeReasonScheduled, history[0].Reason)
+	c.Assert().Equal(scheduledPriceChangeID, *history[0].ScheduledPriceChangeID)
+
+	// ProductPriceChanged and ProductUpdated
+	c.Bus.AssertNumberOfCalls(c.T(), "PublishMessage", 2)
+}
+
+// TestHandleShouldNotPublishRolledBackChange tests the events of a rolled back price change are not published.
+func (c *changeProductPriceHandlerUnitTests) TestHandleShouldNotPublishRolledBackChange() {
+	existing := c.Products[0]
+
+	command, err := changingproductpricev1.NewChangeProductPriceWithValidation(
+		existing.ID,
+		existing.Price+10,
+		nil,
+	)
+	c.Require().NoError(err)
+
+	c.BeginTx()
+	_, err = c.handler.Handle(c.Ctx, command)
+	c.RollbackTx()
+
+	c.Require().NoError(err)
+
+	product, err := gormdbcontext.FindDataModelByID[*datamodels.ProductDataModel](
+		c.Ctx,
+		c.CatalogDBContext,
+		existing.ID,
+	)
+	c.Require().NoError(err)
+	c.Assert().Equal(existing.Price, product.Price)
+	c.Bus.AssertNumberOfCalls(c.T(), "PublishMessage", 0)
+}
+
+// TestHandleShouldSkipUnchangedPrice tests the handle should not record anything when the price is unchanged.
+func (c *changeProductPriceHandlerUnitTests) TestHandleShouldSkipUnchangedPrice() {
+	existing := c.Products[0]
+
+	command, err := changingproductpricev1.NewChangeProductPriceWithValidation(
+		existing.ID,
+		existing.Price,
+		nil,
+	)
+	c.Require().NoError(err)
+
+	c.BeginTx()
+	_, err = c.handler.Handle(c.Ctx, command)
+	c.CommitTx()
+
+	c.Require().NoError(err)
+
+	var count int64
+	err = c.CatalogDBContext.DB().
+		Model(&datamodels.ProductPriceHistoryDataModel{}).
+		Where("product_id = ?", existing.ID).
+		Count(&count).Error
+	c.Require().NoError(err)
+	c.Assert().Zero(count)
+	c.Bus.AssertNumberOfCalls(c.T(), "PublishMessage", 0)
+}
+
+// TestHandleShouldReturnErrorForNotFoundItem tests the handle should return error for not found item.
+func (c *changeProductPriceHandlerUnitTests) TestHandleShouldReturnErrorForNotFoundItem() {
+	command, err := changingproductpricev1.NewChangeProductPriceWithValidation(
+		uuid.NewV4(),
+		100,
+		nil,
+	)
+	c.Require().NoError(err)
+
+	c.BeginTx()
+	_, err = c.handler.Handle(c.Ctx, command)
+	c.CommitTx()
+
+	c.Bus.AssertNumberOfCalls(c.T(), "PublishMessage", 0)
+	c.True(customErrors.IsApplicationError(err, http.StatusNotFound))
+}
@@ -126,3 +126,56 @@ func (c *deleteProductHandlerUnitTests) TestHandleShouldReturnErrorForErrorInBus
 	c.True(customErrors.IsApplicationError(err, http.StatusInternalServerError))
 	c.ErrorContains(err, "error in publishing 'ProductDeleted' message")
 }
+
+// TestHandleShouldReturnPreconditionFailedForStaleExpectedVersion tests the handle should return precondition failed for stale expected version.
+func (c *deleteProductHandlerUnitTests) TestHandleShouldReturnPreconditionFailedForStaleExpectedVersion() {
+	existing, err := gormdbcontext.FindDataModelByID[*datamodels.ProductDataModel](
+		c.Ctx,
+		c.CatalogDBContext,
+		c.Products[0].ID,
+	)
+	c.Require().NoError(err)
+
+	deleteProduct := &deletingproductv1.DeleteProduct{
+		ProductID:       existing.ID,
+		ExpectedVersion: existing.Version + 1,
+	}
+
+	c.BeginTx()
+	res, err := c.handler.Handle(c.Ctx, deleteProduct)
+	c.CommitTx()
+
+	c.Nil(res)
+	c.True(customErrors.IsPreconditionFailedError(err))
+	c.Bus.AssertNumberOfCalls(c.T(), "PublishMessage", 0)
+
+	p, err := gormdbcontext.FindDataModelByID[*datamodels.ProductDataModel](
+		c.Ctx,
+		c.CatalogDBContext,
+		existing.ID,
+	)
+	c.Require().NoError(err)
+	c.NotNil(p)
+}
+
+// TestHandleShouldDeleteProductWithMatchingExpectedVersion tests the handle should delete product with matching expected version.
+func (c *deleteProductHandlerUnitTests) TestHandleShouldDeleteProductWithMatchingExpectedVersion() {
+	existing, err := gormdbcontext.FindDataModelByID[*datamodels.ProductDataModel](
+		c.Ctx,
+		c.CatalogDBContext,
+		c.Products[0].ID,
+	)
+	c.Require().NoError(err)
+
+	deleteProduct := &deletingproductv1.DeleteProduct{
+		ProductID:       existing.ID,
+		ExpectedVersion: existing.Version,
+	}
+
+	c.BeginTx()
+	_, err = c.handler.Handle(c.Ctx, deleteProduct)
+	c.CommitTx()
+
+	c.Require().NoError(err)
+	c.Bus.AssertNumberOfCalls(c.T(), "PublishMessage", 1)
+}
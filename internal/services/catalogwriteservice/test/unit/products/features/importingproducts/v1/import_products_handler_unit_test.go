@@ -0,0 +1,191 @@
+//go:build unit
+// +build unit
+
+package v1
+
+import (
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/cqrs"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/postgresgorm/gormdbcontext"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/data/datamodels"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1/fxparams"
+	importingproductsv1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/importingproducts/v1"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/importingproducts/v1/dtos"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/models"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/shared/testfixtures/unittest"
+)
+
+type importProductsHandlerUnitTests struct {
+	*unittest.CatalogWriteUnitTestSharedFixture
+	handler cqrs.RequestHandlerWithRegisterer[*importingproductsv1.ImportProducts, *dtos.ImportProductsResponseDto]
+}
+
+func TestImportProductsHandlerUnit(t *testing.T) {
+	suite.Run(
+		t,
+		&importProductsHandlerUnitTests{
+			CatalogWriteUnitTestSharedFixture: unittest.NewCatalogWriteUnitTestSharedFixture(t),
+		},
+	)
+}
+
+func (c *importProductsHandlerUnitTests) SetupTest() {
+	// call base `SetupTest hook` before running child hook
+	c.CatalogWriteUnitTestSharedFixture.SetupTest()
+	c.handler = importingproductsv1.NewImportProductsHandler(
+		fxparams.ProductHandlerParams{
+			CatalogsDBContext: c.CatalogDBContext,
+			Tracer:            c.Tracer,
+			RabbitmqProducer:  c.Bus,
+			Log:               c.Log,
+			ProductRepository: c.ProductRepository,
+		},
+	)
+}
+
+func (c *importProductsHandlerUnitTests) TearDownTest() {
+	// call base `TearDownTest hook` before running child hook
+	c.CatalogWriteUnitTestSharedFixture.TearDownTest()
+}
+
+// TestHandleShouldCreateNewAndUpdateExistingProducts tests the handle should upsert the batch and record the changed prices.
+func (c *importProductsHandlerUnitTests) TestHandleShouldCreateNewAndUpdateExistingProducts() {
+	existing := c.Products[0]
+	newProductID := uuid.NewV4()
+
+	command, err := importingproductsv1.NewImportProductsWithValidation(
+		[]*importingproductsv1.ImportProductItem{
+			{
+				Row:         1,
+				ProductID:   existing.ID,
+				Name:        "imported name",
+				Description: existing.Description,
+				Price:       existing.Price + 10,
+			},
+			{
+				Row:         2,
+				ProductID:   newProductID,
+				Name:        "new product",
+				Description: "new product description",
+				Price:       120,
+			},
+		},
+	)
+	c.Require().NoError(err)
+
+	result, err := c.handler.Handle(c.Ctx, command)
+	c.Require().NoError(err)
+
+	c.Assert().Equal(2, result.TotalRows)
+	c.Assert().Equal(1, result.Created)
+	c.Assert().Equal(1, result.Updated)
+	c.Assert().Zero(result.Failed)
+
+	updated, err := gormdbcontext.FindDataModelByID[*datamodels.ProductDataModel](
+		c.Ctx,
+		c.CatalogDBContext,
+		existing.ID,
+	)
+	c.Require().NoError(err)
+	c.Assert().Equal("imported name", updated.Name)
+	c.Assert().Equal(existing.Price+10, updated.Price)
+
+	c.Assert().True(
+		gormdbcontext.Exists[*datamodels.ProductDataModel](c.Ctx, c.CatalogDBContext, newProductID),
+	)
+
+	var history []*datamodels.ProductPriceHistoryDataModel
+	err = c.CatalogDBContext.DB().Where("product_id = ?", existing.ID).Find(&history).Error
+	c.Require().NoError(err)
+	c.Require().Len(history, 1)
+	c.Assert().Equal(models.PriceChangeReasonImported, history[0].Reason)
+
+	// ProductUpdated, ProductPriceChanged and ProductCreated
+	c.Bus.AssertNumberOfCalls(c.T(), "PublishMessage", 3)
+}
+
+// TestHandleShouldRejectDeletedProducts tests a soft deleted product should be a row error and stay deleted.
+func (c *importProductsHandlerUnitTests) TestHandleShouldRejectDeletedProducts() {
+	deleted := c.Products[1]
+	c.Require().NoError(c.CatalogDBContext.DB().Delete(deleted).Error)
+
+	command, err := importingproductsv1.NewImportProductsWithValidation(
+		[]*importingproductsv1.ImportProductItem{
+			{
+				Row:         1,
+				ProductID:   deleted.ID,
+				Name:        "imported name",
+				Description: deleted.Description,
+				Price:       deleted.Price + 10,
+			},
+		},
+	)
+	c.Require().NoError(err)
+
+	result, err := c.handler.Handle(c.Ctx, command)
+	c.Require().NoError(err)
+
+	c.Assert().Zero(result.Created)
+	c.Assert().Zero(result.Updated)
+	c.Assert().Equal(1, result.Failed)
+	c.Require().Len(result.Errors, 1)
+	c.Assert().Equal(1, result.Errors[0].Row)
+	c.Assert().Equal(deleted.ID.String(), result.Errors[0].ProductID)
+
+	var stored datamodels.ProductDataModel
+	err = c.CatalogDBContext.DB().Unscoped().Where("id = ?", deleted.ID).First(&stored).Error
+	c.Require().NoError(err)
+	c.Assert().True(stored.DeletedAt.Valid)
+	c.Assert().Equal(deleted.Name, stored.Name)
+
+	c.Bus.AssertNotCalled(c.T(), "PublishMessage", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestHandleShouldReportUnpublishedMessagesAsWarnings tests a failed publish after the commit should keep the saved
+// product and report a warning for its row.
+func (c *importProductsHandlerUnitTests) TestHandleShouldReportUnpublishedMessagesAsWarnings() {
+	c.Bus.ExpectedCalls = nil
+	c.Bus.On("PublishMessage", mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("error in the publish message"))
+
+	newProductID := uuid.NewV4()
+	command, err := importingproductsv1.NewImportProductsWithValidation(
+		[]*importingproductsv1.ImportProductItem{
+			{
+				Row:         3,
+				ProductID:   newProductID,
+				Name:        "new product",
+				Description: "new product description",
+				Price:       120,
+			},
+		},
+	)
+	c.Require().NoError(err)
+
+	result, err := c.handler.Handle(c.Ctx, command)
+	c.Require().NoError(err)
+
+	c.Assert().Equal(1, result.Created)
+	c.Assert().Zero(result.Failed)
+	c.Require().Len(result.Warnings, 1)
+	c.Assert().Equal(3, result.Warnings[0].Row)
+	c.Assert().Equal(newProductID.String(), result.Warnings[0].ProductID)
+	c.Assert().Contains(result.Warnings[0].Message, "ProductCreatedV1")
+
+	c.Assert().True(
+		gormdbcontext.Exists[*datamodels.ProductDataModel](c.Ctx, c.CatalogDBContext, newProductID),
+	)
+}
+
+// TestNewImportProductsShouldRejectEmptyBatch tests an empty batch should fail the validation.
+func (c *importProductsHandlerUnitTests) TestNewImportProductsShouldRejectEmptyBatch() {
+	_, err := importingproductsv1.NewImportProductsWithValidation(nil)
+	c.Require().Error(err)
+}
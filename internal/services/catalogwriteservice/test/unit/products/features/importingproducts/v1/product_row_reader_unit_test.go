@@ -0,0 +1,108 @@
+//go:build unit
+// +build unit
+
+package v1
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/suite"
+
+	importingproductsv1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/importingproducts/v1"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/importingproducts/v1/dtos"
+)
+
+type productRowReaderUnitTests struct {
+	suite.Suite
+}
+
+func TestProductRowReaderUnit(t *testing.T) {
+	suite.Run(t, &productRowReaderUnitTests{})
+}
+
+// TestCSVReaderShouldReadRowsAndReportInvalidOnes tests the csv reader should continue after an invalid row.
+func (c *productRowReaderUnitTests) TestCSVReaderShouldReadRowsAndReportInvalidOnes() {
+	input := "Name,Description,Price,createdAt\n" +
+		"first,first description,10.5,2024-01-01T00:00:00Z\n" +
+		"second,second description,abc,\n" +
+		"third,third description,30,\n"
+
+	rows, rowErrs, err := readAll(importingproductsv1.NewCSVProductRowReader(strings.NewReader(input)))
+	c.Require().NoError(err)
+
+	c.Require().Len(rows, 2)
+	c.Assert().Equal("first", rows[0].Name)
+	c.Assert().Equal(10.5, rows[0].Price)
+	c.Assert().Equal(1, rows[0].Row)
+	c.Assert().Equal(3, rows[1].Row)
+
+	c.Require().Len(rowErrs, 1)
+	c.Assert().Equal(2, rowErrs[0].Row)
+}
+
+// TestCSVReaderShouldRejectMissingColumns tests the csv reader should stop when a required column is missing.
+func (c *productRowReaderUnitTests) TestCSVReaderShouldRejectMissingColumns() {
+	reader := importingproductsv1.NewCSVProductRowReader(strings.NewReader("name,price\nfirst,10\n"))
+
+	_, _, err := readAll(reader)
+	c.Require().Error(err)
+}
+
+// TestJSONReaderShouldReadRowsAndReportInvalidOnes tests the json reader should continue after a row with a wrong type.
+func (c *productRowReaderUnitTests) TestJSONReaderShouldReadRowsAndReportInvalidOnes() {
+	input := `[
+		{"id": "5f1b2d4c-8f0e-4a57-9a9e-8b1c2c3d4e5f", "name": "first", "description": "first description", "price": 10},
+		{"name": "second", "description": "second description", "price": "abc"},
+		{"name": "third", "description": "third description", "price": 30}
+	]`
+
+	rows, rowErrs, err := readAll(importingproductsv1.NewJSONProductRowReader(strings.NewReader(input)))
+	c.Require().NoError(err)
+
+	c.Require().Len(rows, 2)
+	c.Assert().Equal("5f1b2d4c-8f0e-4a57-9a9e-8b1c2c3d4e5f", rows[0].ID)
+	c.Assert().Equal(3, rows[1].Row)
+
+	c.Require().Len(rowErrs, 1)
+	c.Assert().Equal(2, rowErrs[0].Row)
+}
+
+// TestJSONReaderShouldRejectNonArray tests the json reader should stop when the body is not an array.
+func (c *productRowReaderUnitTests) TestJSONReaderShouldRejectNonArray() {
+	reader := importingproductsv1.NewJSONProductRowReader(strings.NewReader(`{"name": "first"}`))
+
+	_, _, err := readAll(reader)
+	c.Require().Error(err)
+}
+
+func readAll(
+	reader importingproductsv1.ProductRowReader,
+) ([]*dtos.ImportProductRowDto, []*importingproductsv1.RowError, error) {
+	var (
+		rows    []*dtos.ImportProductRowDto
+		rowErrs []*importingproductsv1.RowError
+	)
+
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			return rows, rowErrs, nil
+		}
+
+		var rowErr *importingproductsv1.RowError
+		if errors.As(err, &rowErr) {
+			rowErrs = append(rowErrs, rowErr)
+
+			continue
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		rows = append(rows, row)
+	}
+}
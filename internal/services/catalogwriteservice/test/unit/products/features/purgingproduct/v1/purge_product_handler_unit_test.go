@@ -0,0 +1,134 @@
+//go:build unit
+// +build unit
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/cqrs"
+	"github.com/stretchr/testify/suite"
+
+	mediatr "github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/data/datamodels"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1/fxparams"
+	purgingproductv1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/purgingproduct/v1"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/models"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/shared/testfixtures/unittest"
+)
+
+type purgeProductHandlerUnitTests struct {
+	*unittest.CatalogWriteUnitTestSharedFixture
+	handler cqrs.RequestHandlerWithRegisterer[*purgingproductv1.PurgeProduct, *mediatr.Unit]
+}
+
+func TestPurgeProductHandlerUnit(t *testing.T) {
+	suite.Run(
+		t,
+		&purgeProductHandlerUnitTests{
+			CatalogWriteUnitTestSharedFixture: unittest.NewCatalogWriteUnitTestSharedFixture(t),
+		},
+	)
+}
+
+func (c *purgeProductHandlerUnitTests) SetupTest() {
+	// call base `SetupTest hook` before running child hook
+	c.CatalogWriteUnitTestSharedFixture.SetupTest()
+	c.handler = purgingproductv1.NewPurgeProductHandler(
+		fxparams.ProductHandlerParams{
+			CatalogsDBContext: c.CatalogDBContext,
+			Tracer:            c.Tracer,
+			RabbitmqProducer:  c.Bus,
+			Log:               c.Log,
+		},
+	)
+}
+
+func (c *purgeProductHandlerUnitTests) TearDownTest() {
+	// call base `TearDownTest hook` before running child hook
+	c.CatalogWriteUnitTestSharedFixture.TearDownTest()
+}
+
+// TestHandleShouldPurgeProductWithItsPriceHistory tests the handle should permanently delete the product and its price history.
+func (c *purgeProductHandlerUnitTests) TestHandleShouldPurgeProductWithItsPriceHistory() {
+	existing := c.Products[0]
+	err := c.CatalogDBContext.DB().Create(&datamodels.ProductPriceHistoryDataModel{
+		ID:        uuid.NewV4(),
+		ProductID: existing.ID,
+		OldPrice:  existing.Price,
+		NewPrice:  existing.Price + 1,
+		Reason:    models.PriceChangeReasonUpdated,
+	}).Error
+	c.Require().NoError(err)
+	err = c.CatalogDBContext.DB().Delete(&datamodels.ProductDataModel{}, existing.ID).Error
+	c.Require().NoError(err)
+
+	command, err := purgingproductv1.NewPurgeProductWithValidation(existing.ID)
+	c.Require().NoError(err)
+
+	c.BeginTx()
+	_, err = c.handler.Handle(c.Ctx, command)
+	c.CommitTx()
+
+	c.Require().NoError(err)
+
+	var count int64
+	err = c.CatalogDBContext.DB().
+		Unscoped().
+		Model(&datamodels.ProductDataModel{}).
+		Where("id = ?", existing.ID).
+		Count(&count).Error
+	c.Require().NoError(err)
+	c.Assert().Zero(count)
+
+	err = c.CatalogDBContext.DB().
+		Model(&datamodels.ProductPriceHistoryDataModel{}).
+		Where("product_id = ?", existing.ID).
+		Count(&count).Error
+	c.Require().NoError(err)
+	c.Assert().Zero(count)
+
+	c.Bus.AssertNumberOfCalls(c.T(), "PublishMessage", 1)
+}
+
+// TestHandleShouldReturnNotFoundErrorForNotFoundItem tests the handle should return a not found error for a non-existing product.
+func (c *purgeProductHandlerUnitTests) TestHandleShouldReturnNotFoundErrorForNotFoundItem() {
+	command, err := purgingproductv1.NewPurgeProductWithValidation(uuid.NewV4())
+	c.Require().NoError(err)
+
+	c.BeginTx()
+	_, err = c.handler.Handle(c.Ctx, command)
+	c.CommitTx()
+
+	c.Require().Error(err)
+	c.Assert().True(customErrors.IsNotFoundError(err))
+	c.Bus.AssertNumberOfCalls(c.T(), "PublishMessage", 0)
+}
+
+// TestHandleShouldReturnConflictErrorForNotDeletedItem tests the handle should not purge a product that isn't deleted.
+func (c *purgeProductHandlerUnitTests) TestHandleShouldReturnConflictErrorForNotDeletedItem() {
+	existing := c.Products[0]
+
+	command, err := purgingproductv1.NewPurgeProductWithValidation(existing.ID)
+	c.Require().NoError(err)
+
+	c.BeginTx()
+	_, err = c.handler.Handle(c.Ctx, command)
+	c.CommitTx()
+
+	c.Require().Error(err)
+	c.Assert().True(customErrors.IsConflictError(err))
+
+	var count int64
+	err = c.CatalogDBContext.DB().
+		Model(&datamodels.ProductDataModel{}).
+		Where("id = ?", existing.ID).
+		Count(&count).Error
+	c.Require().NoError(err)
+	c.Assert().Equal(int64(1), count)
+
+	c.Bus.AssertNumberOfCalls(c.T(), "PublishMessage", 0)
+}
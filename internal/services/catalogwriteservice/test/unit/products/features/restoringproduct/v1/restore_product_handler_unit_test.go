@@ -0,0 +1,103 @@
+//go:build unit
+// +build unit
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/cqrs"
+	"github.com/stretchr/testify/suite"
+
+	mediatr "github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/data/datamodels"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/dtos/v1/fxparams"
+	restoringproductv1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/restoringproduct/v1"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/shared/testfixtures/unittest"
+)
+
+type restoreProductHandlerUnitTests struct {
+	*unittest.CatalogWriteUnitTestSharedFixture
+	handler cqrs.RequestHandlerWithRegisterer[*restoringproductv1.RestoreProduct, *mediatr.Unit]
+}
+
+func TestRestoreProductHandlerUnit(t *testing.T) {
+	suite.Run(
+		t,
+		&restoreProductHandlerUnitTests{
+			CatalogWriteUnitTestSharedFixture: unittest.NewCatalogWriteUnitTestSharedFixture(t),
+		},
+	)
+}
+
+func (c *restoreProductHandlerUnitTests) SetupTest() {
+	// call base `SetupTest hook` before running child hook
+	c.CatalogWriteUnitTestSharedFixture.SetupTest()
+	c.handler = restoringproductv1.NewRestoreProductHandler(
+		fxparams.ProductHandlerParams{
+			CatalogsDBContext: c.CatalogDBContext,
+			Tracer:            c.Tracer,
+			RabbitmqProducer:  c.Bus,
+			Log:               c.Log,
+		},
+	)
+}
+
+func (c *restoreProductHandlerUnitTests) TearDownTest() {
+	// call base `TearDownTest hook` before running child hook
+	c.CatalogWriteUnitTestSharedFixture.TearDownTest()
+}
+
+// TestHandleShouldRestoreDeletedProduct tests the handle should clear the deletion of a soft-deleted product.
+func (c *restoreProductHandlerUnitTests) TestHandleShouldRestoreDeletedProduct() {
+	existing := c.Products[0]
+	err := c.CatalogDBContext.DB().Delete(&datamodels.ProductDataModel{}, existing.ID).Error
+	c.Require().NoError(err)
+
+	command, err := restoringproductv1.NewRestoreProductWithValidation(existing.ID)
+	c.Require().NoError(err)
+
+	c.BeginTx()
+	_, err = c.handler.Handle(c.Ctx, command)
+	c.CommitTx()
+
+	c.Require().NoError(err)
+
+	var product datamodels.ProductDataModel
+	err = c.CatalogDBContext.DB().First(&product, existing.ID).Error
+	c.Require().NoError(err)
+	c.Assert().False(product.DeletedAt.Valid)
+
+	c.Bus.AssertNumberOfCalls(c.T(), "PublishMessage", 1)
+}
+
+// TestHandleShouldReturnConflictErrorForNotDeletedProduct tests the handle should return a conflict error for a product that is not deleted.
+func (c *restoreProductHandlerUnitTests) TestHandleShouldReturnConflictErrorForNotDeletedProduct() {
+	command, err := restoringproductv1.NewRestoreProductWithValidation(c.Products[0].ID)
+	c.Require().NoError(err)
+
+	c.BeginTx()
+	_, err = c.handler.Handle(c.Ctx, command)
+	c.CommitTx()
+
+	c.Require().Error(err)
+	c.Assert().True(customErrors.IsConflictError(err))
+	c.Bus.AssertNumberOfCalls(c.T(), "PublishMessage", 0)
+}
+
+// TestHandleShouldReturnNotFoundErrorForNotFoundItem tests the handle should return a not found error for a non-existing product.
+func (c *restoreProductHandlerUnitTests) TestHandleShouldReturnNotFoundErrorForNotFoundItem() {
+	command, err := restoringproductv1.NewRestoreProductWithValidation(uuid.NewV4())
+	c.Require().NoError(err)
+
+	c.BeginTx()
+	_, err = c.handler.Handle(c.Ctx, command)
+	c.CommitTx()
+
+	c.Require().Error(err)
+	c.Assert().True(customErrors.IsNotFoundError(err))
+	c.Bus.AssertNumberOfCalls(c.T(), "PublishMessage", 0)
+}
@@ -0,0 +1,66 @@
+//go:build unit
+// +build unit
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	uuid "github.com/satori/go.uuid"
+
+	v1 "github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/features/schedulingproductpricechange/v1"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/shared/testfixtures/unittest"
+)
+
+type scheduleProductPriceChangeUnitTests struct {
+	*unittest.CatalogWriteUnitTestSharedFixture
+}
+
+func TestScheduleProductPriceChangeUnit(t *testing.T) {
+	suite.Run(
+		t,
+		&scheduleProductPriceChangeUnitTests{
+			CatalogWriteUnitTestSharedFixture: unittest.NewCatalogWriteUnitTestSharedFixture(t),
+		},
+	)
+}
+
+// TestNewScheduleProductPriceChangeShouldReturnNoErrorForValidInput tests the new schedule product price change should return no error for valid input.
+func (c *scheduleProductPriceChangeUnitTests) TestNewScheduleProductPriceChangeShouldReturnNoErrorForValidInput() {
+	productID := uuid.NewV4()
+	effectiveAt := time.Now().Add(time.Hour)
+
+	command, err := v1.NewScheduleProductPriceChangeWithValidation(productID, 120, effectiveAt)
+
+	c.Require().NoError(err)
+	c.Assert().Equal(productID, command.ProductID)
+	c.Assert().Equal(120.0, command.NewPrice)
+	c.Assert().Equal(effectiveAt, command.EffectiveAt)
+	c.Assert().NotEqual(uuid.Nil, command.ScheduledPriceChangeID)
+}
+
+// TestNewScheduleProductPriceChangeShouldReturnErrorForPastEffectiveTime tests the new schedule product price change should return error for past effective time.
+func (c *scheduleProductPriceChangeUnitTests) TestNewScheduleProductPriceChangeShouldReturnErrorForPastEffectiveTime() {
+	_, err := v1.NewScheduleProductPriceChangeWithValidation(
+		uuid.NewV4(),
+		120,
+		time.Now().Add(-time.Minute),
+	)
+
+	c.Require().Error(err)
+	c.ErrorContains(err, "must be in the future")
+}
+
+// TestNewScheduleProductPriceChangeShouldReturnErrorForInvalidPrice tests the new schedule product price change should return error for invalid price.
+func (c *scheduleProductPriceChangeUnitTests) TestNewScheduleProductPriceChangeShouldReturnErrorForInvalidPrice() {
+	_, err := v1.NewScheduleProductPriceChangeWithValidation(
+		uuid.NewV4(),
+		0,
+		time.Now().Add(time.Hour),
+	)
+
+	c.Require().Error(err)
+}
@@ -87,6 +87,43 @@ func (c *updateProductHandlerUnitTests) TestHandleShouldUpdateProductWithValidDa
 	c.Bus.AssertNumberOfCalls(c.T(), "PublishMessage", 1)
 }
 
+// TestHandleShouldRollBackThePriceHistoryWithTheUpdate tests the price history is written in the transaction of the
+// product update, so a rolled back update leaves no history and publishes nothing.
+func (c *updateProductHandlerUnitTests) TestHandleShouldRollBackThePriceHistoryWithTheUpdate() {
+	existing := c.Products[0]
+
+	updateProductCommand, err := updatingoroductsv1.NewUpdateProductWithValidation(
+		existing.ID,
+		gofakeit.Name(),
+		gofakeit.EmojiDescription(),
+		existing.Price+10,
+	)
+	c.Require().NoError(err)
+
+	c.BeginTx()
+	_, err = c.handler.Handle(c.Ctx, updateProductCommand)
+	c.RollbackTx()
+
+	c.Require().NoError(err)
+
+	var count int64
+	err = c.CatalogDBContext.DB().
+		Model(&datamodels.ProductPriceHistoryDataModel{}).
+		Where("product_id = ?", existing.ID).
+		Count(&count).Error
+	c.Require().NoError(err)
+	c.Assert().Zero(count)
+	c.Bus.AssertNumberOfCalls(c.T(), "PublishMessage", 0)
+}
+
+// TestUpdateProductShouldRunInATransaction tests the update product command is a tx request, so the mediator
+// transaction pipeline runs the product update and its price history in one transaction.
+func (c *updateProductHandlerUnitTests) TestUpdateProductShouldRunInATransaction() {
+	command := updatingoroductsv1.NewUpdateProduct(uuid.NewV4(), gofakeit.Name(), gofakeit.EmojiDescription(), 10)
+
+	c.True(cqrs.IsTxCommand(command))
+}
+
 // TestHandleShouldReturnErrorForNotFoundItem tests the handle should return error for not found item.
 func (c *updateProductHandlerUnitTests) TestHandleShouldReturnErrorForNotFoundItem() {
 	id := uuid.NewV4()
@@ -132,9 +169,84 @@ func (c *updateProductHandlerUnitTests) TestHandleShouldReturnErrorForErrorInBus
 
 	c.BeginTx()
 	_, err = c.handler.Handle(c.Ctx, updateProductCommand)
-	c.CommitTx()
+	c.Require().NoError(err)
+
+	// the event is published once the update is committed
+	c.Bus.AssertNumberOfCalls(c.T(), "PublishMessage", 0)
+	err = c.CommitTx()
 
 	c.Bus.AssertNumberOfCalls(c.T(), "PublishMessage", 1)
 	c.ErrorContains(err, "error in the publish message")
 	c.ErrorContains(err, "error in publishing 'ProductUpdated' message")
 }
+
+// TestHandleShouldIncrementVersionForMatchingExpectedVersion tests the handle should increment version for matching expected version.
+func (c *updateProductHandlerUnitTests) TestHandleShouldIncrementVersionForMatchingExpectedVersion() {
+	existing, err := gormdbcontext.FindDataModelByID[*datamodels.ProductDataModel](
+		c.Ctx,
+		c.CatalogDBContext,
+		c.Products[0].ID,
+	)
+	c.Require().NoError(err)
+
+	command, err := updatingoroductsv1.NewUpdateProductWithValidation(
+		existing.ID,
+		gofakeit.Name(),
+		gofakeit.EmojiDescription(),
+		existing.Price,
+	)
+	c.Require().NoError(err)
+	command.ExpectedVersion = existing.Version
+
+	c.BeginTx()
+	_, err = c.handler.Handle(c.Ctx, command)
+	c.CommitTx()
+
+	c.Require().NoError(err)
+
+	updatedProduct, err := gormdbcontext.FindDataModelByID[*datamodels.ProductDataModel](
+		c.Ctx,
+		c.CatalogDBContext,
+		existing.ID,
+	)
+	c.Require().NoError(err)
+
+	c.Assert().Equal(existing.Version+1, updatedProduct.Version)
+	c.Assert().Equal(command.Name, updatedProduct.Name)
+}
+
+// TestHandleShouldReturnPreconditionFailedForStaleExpectedVersion tests the handle should return precondition failed for stale expected version.
+func (c *updateProductHandlerUnitTests) TestHandleShouldReturnPreconditionFailedForStaleExpectedVersion() {
+	existing, err := gormdbcontext.FindDataModelByID[*datamodels.ProductDataModel](
+		c.Ctx,
+		c.CatalogDBContext,
+		c.Products[0].ID,
+	)
+	c.Require().NoError(err)
+
+	command, err := updatingoroductsv1.NewUpdateProductWithValidation(
+		existing.ID,
+		gofakeit.Name(),
+		gofakeit.EmojiDescription(),
+		existing.Price,
+	)
+	c.Require().NoError(err)
+	command.ExpectedVersion = existing.Version + 1
+
+	c.BeginTx()
+	_, err = c.handler.Handle(c.Ctx, command)
+	c.CommitTx()
+
+	c.True(customErrors.IsPreconditionFailedError(err))
+	c.Bus.AssertNumberOfCalls(c.T(), "PublishMessage", 0)
+
+	unchangedProduct, err := gormdbcontext.FindDataModelByID[*datamodels.ProductDataModel](
+		c.Ctx,
+		c.CatalogDBContext,
+		existing.ID,
+	)
+	c.Require().NoError(err)
+
+	c.Assert().Equal(existing.Version, unchangedProduct.Version)
+	c.Assert().Equal(existing.Name, unchangedProduct.Name)
+}
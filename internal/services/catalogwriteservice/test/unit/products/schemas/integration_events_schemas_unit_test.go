@@ -0,0 +1,45 @@
+//go:build unit
+// +build unit
+
+package schemas
+
+import (
+	"os"
+	"testing"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/constants"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/schemaregistry"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/catalogwriteservice/internal/products/configurations/schemas"
+)
+
+// updateSchemasEnv is the environment variable that stores the changed schemas instead of failing the check.
+const updateSchemasEnv = "UPDATE_SCHEMAS"
+
+type integrationEventsSchemasUnitTests struct {
+	suite.Suite
+	registry *schemaregistry.Registry
+}
+
+func TestIntegrationEventsSchemasUnit(t *testing.T) {
+	suite.Run(t, &integrationEventsSchemasUnitTests{})
+}
+
+func (s *integrationEventsSchemasUnitTests) SetupSuite() {
+	options, err := schemaregistry.ProvideConfig(constants.Test)
+	s.Require().NoError(err)
+
+	s.registry = schemaregistry.NewRegistryFromOptions(options)
+	schemas.ConfigProductsSchemas(s.registry)
+}
+
+// TestSchemasShouldBeCompatibleAndStored tests the integration events schemas are compatible with their stored
+// versions and stored, run with UPDATE_SCHEMAS=true to store the changed schemas.
+func (s *integrationEventsSchemasUnitTests) TestSchemasShouldBeCompatibleAndStored() {
+	if os.Getenv(updateSchemasEnv) == "true" {
+		s.Require().NoError(s.registry.Update())
+	}
+
+	s.Require().NoError(s.registry.Check())
+}
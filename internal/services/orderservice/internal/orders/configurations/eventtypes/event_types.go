@@ -0,0 +1,31 @@
+// Package eventtypes configures the stable type aliases and the schema versions of the orders events.
+package eventtypes
+
+import (
+	"emperror.dev/errors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/versioning"
+
+	createOrderDomainEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/creatingorder/v1/events/domainevents"
+	createOrderIntegrationEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/creatingorder/v1/events/integrationevents"
+	submitOrderDomainEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/submittingorder/v1/events/domainevents"
+	updateShoppingCartEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/updatingshoppingcard/v1/events"
+)
+
+// ConfigureOrdersEventTypes registers the orders events with their aliases, the events stored and published
+// before the aliases are resolved by their go type names. A breaking change of an event shape bumps its schema
+// version and registers an upcaster from the previous version with versioning.RegisterUpcaster.
+func ConfigureOrdersEventTypes() error {
+	return errors.Combine(
+		versioning.RegisterType[*createOrderDomainEventsV1.OrderCreatedV1]("orders.order-created", 1),
+		versioning.RegisterType[*updateShoppingCartEventsV1.ShoppingCartUpdatedV1](
+			"orders.shopping-cart-updated",
+			1,
+		),
+		versioning.RegisterType[*submitOrderDomainEventsV1.OrderSubmittedV1]("orders.order-submitted", 1),
+		versioning.RegisterType[*createOrderIntegrationEventsV1.OrderCreatedV1](
+			"orders.integration.order-created",
+			1,
+		),
+	)
+}
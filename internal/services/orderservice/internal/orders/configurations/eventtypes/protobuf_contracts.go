@@ -0,0 +1,44 @@
+// Package eventtypes configures the protobuf contracts of the orders integration events.
+package eventtypes
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/types"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/serializer/protobuf"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/mapper"
+
+	dtosV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/dtos/v1"
+	createOrderIntegrationEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/creatingorder/v1/events/integrationevents"
+	grpcOrderService "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/shared/grpc/genproto"
+)
+
+// ConfigureOrdersProtobufContracts maps the orders integration events to their protobuf contracts in
+// api/protobuf/orderservice, it should be called after the orders mappings.
+func ConfigureOrdersProtobufContracts() {
+	protobuf.RegisterMapping(
+		func(event *createOrderIntegrationEventsV1.OrderCreatedV1) (*grpcOrderService.OrderCreatedV1, error) {
+			order, err := mapper.Map[*grpcOrderService.OrderReadModel](event.OrderReadDto)
+			if err != nil {
+				return nil, err
+			}
+
+			return &grpcOrderService.OrderCreatedV1{
+				MessageID: event.MessageId,
+				Created:   timestamppb.New(event.Created),
+				Order:     order,
+			}, nil
+		},
+		func(contract *grpcOrderService.OrderCreatedV1) (*createOrderIntegrationEventsV1.OrderCreatedV1, error) {
+			order, err := mapper.Map[*dtosV1.OrderReadDto](contract.Order)
+			if err != nil {
+				return nil, err
+			}
+
+			return &createOrderIntegrationEventsV1.OrderCreatedV1{
+				Message:      &types.Message{MessageId: contract.MessageID, Created: contract.Created.AsTime()},
+				OrderReadDto: order,
+			}, nil
+		},
+	)
+}
@@ -88,6 +88,41 @@ func configureGrpcMappings() error {
 				ShopItems:       items,
 				CreatedAt:       timestamppb.New(orderReadDto.CreatedAt),
 				UpdatedAt:       timestamppb.New(orderReadDto.UpdatedAt),
+				Version:         orderReadDto.Version,
+			}, nil
+		},
+	); err != nil {
+		return err
+	}
+
+	// grpcOrderService.OrderReadModel -> dtos.OrderReadDto
+	if err := mapper.CreateCustomMap[*grpcOrderService.OrderReadModel, *dtosV1.OrderReadDto](
+		func(orderReadModel *grpcOrderService.OrderReadModel) (*dtosV1.OrderReadDto, error) {
+			if orderReadModel == nil {
+				return nil, nil
+			}
+			items, err := mapper.Map[[]*dtosV1.ShopItemReadDto](orderReadModel.ShopItems)
+			if err != nil {
+				return nil, err
+			}
+
+			return &dtosV1.OrderReadDto{
+				ID:              orderReadModel.ID,
+				OrderID:         orderReadModel.OrderID,
+				PaymentID:       orderReadModel.PaymentID,
+				DeliveredTime:   orderReadModel.DeliveredTime.AsTime(),
+				TotalPrice:      orderReadModel.TotalPrice,
+				DeliveryAddress: orderReadModel.DeliveryAddress,
+				AccountEmail:    orderReadModel.AccountEmail,
+				Canceled:        orderReadModel.Canceled,
+				Completed:       orderReadModel.Completed,
+				Paid:            orderReadModel.Paid,
+				Submitted:       orderReadModel.Submitted,
+				CancelReason:    orderReadModel.CancelReason,
+				ShopItems:       items,
+				CreatedAt:       orderReadModel.CreatedAt.AsTime(),
+				UpdatedAt:       orderReadModel.UpdatedAt.AsTime(),
+				Version:         orderReadModel.Version,
 			}, nil
 		},
 	); err != nil {
@@ -117,6 +152,7 @@ func configureGrpcMappings() error {
 				UpdatedAt:       timestamppb.New(order.UpdatedAt()),
 				ShopItems:       items,
 				PaymentID:       order.PaymentID().String(),
+				Version:         order.Version(),
 			}, nil
 		},
 	); err != nil {
@@ -179,6 +215,11 @@ func configureShopItemMappings() error {
 		return err
 	}
 
+	// grpcOrderService.ShopItemReadModel -> dtos.ShopItemReadDto
+	if err := mapper.CreateMap[*grpcOrderService.ShopItemReadModel, *dtosV1.ShopItemReadDto](); err != nil {
+		return err
+	}
+
 	// valueobject.ShopItem -> grpcOrderService.ShopItem
 	if err := mapper.CreateCustomMap[*valueobject.ShopItem, *grpcOrderService.ShopItem](
 		func(src *valueobject.ShopItem) (*grpcOrderService.ShopItem, error) {
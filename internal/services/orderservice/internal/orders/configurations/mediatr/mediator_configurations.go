@@ -2,6 +2,7 @@
 package mediatr
 
 import (
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/encryption"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/es/contracts/store"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing"
@@ -11,10 +12,16 @@ import (
 	repositories2 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/contracts/repositories"
 	createOrderCommandV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/creatingorder/v1/commands"
 	createOrderDtosV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/creatingorder/v1/dtos"
+	forgetCustomerCommandV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/forgettingcustomer/v1/commands"
+	forgetCustomerDtosV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/forgettingcustomer/v1/dtos"
 	GetOrderByIDDtosV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/gettingorderbyid/v1/dtos"
 	GetOrderByIDQueryV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/gettingorderbyid/v1/queries"
 	getOrdersDtosV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/gettingorders/v1/dtos"
 	getOrdersQueryV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/gettingorders/v1/queries"
+	submitOrderCommandV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/submittingorder/v1/commands"
+	submitOrderDtosV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/submittingorder/v1/dtos"
+	updateShoppingCartCommandV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/updatingshoppingcard/v1/commands"
+	updateShoppingCartDtosV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/updatingshoppingcard/v1/dtos"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/models/orders/aggregate"
 )
 
@@ -22,7 +29,9 @@ import (
 func ConfigOrdersMediator(
 	log logger.Logger,
 	mongoOrderReadRepository repositories2.OrderMongoRepository,
+	elasticOrderReadRepository repositories2.OrderElasticRepository,
 	orderAggregateStore store.AggregateStore[*aggregate.Order],
+	subjectKeyStore encryption.SubjectKeyStore,
 	tracer tracing.AppTracer,
 ) error {
 	// https://stackoverflow.com/questions/72034479/how-to-implement-generic-interfaces
@@ -33,6 +42,33 @@ func ConfigOrdersMediator(
 		return err
 	}
 
+	err = mediatr.RegisterRequestHandler[*updateShoppingCartCommandV1.UpdateShoppingCart, *updateShoppingCartDtosV1.UpdateShoppingCartResponseDto](
+		updateShoppingCartCommandV1.NewUpdateShoppingCartHandler(log, orderAggregateStore, tracer),
+	)
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*submitOrderCommandV1.SubmitOrder, *submitOrderDtosV1.SubmitOrderResponseDto](
+		submitOrderCommandV1.NewSubmitOrderHandler(log, orderAggregateStore, tracer),
+	)
+	if err != nil {
+		return err
+	}
+
+	err = mediatr.RegisterRequestHandler[*forgetCustomerCommandV1.ForgetCustomer, *forgetCustomerDtosV1.ForgetCustomerResponseDto](
+		forgetCustomerCommandV1.NewForgetCustomerHandler(
+			log,
+			subjectKeyStore,
+			mongoOrderReadRepository,
+			elasticOrderReadRepository,
+			tracer,
+		),
+	)
+	if err != nil {
+		return err
+	}
+
 	err = mediatr.RegisterRequestHandler[*GetOrderByIDQueryV1.GetOrderByID, *GetOrderByIDDtosV1.GetOrderByIDResponseDto](
 		GetOrderByIDQueryV1.NewGetOrderByIDHandler(log, mongoOrderReadRepository, tracer),
 	)
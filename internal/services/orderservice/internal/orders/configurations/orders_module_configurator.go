@@ -2,17 +2,24 @@
 package configurations
 
 import (
+	"context"
+
 	"github.com/go-playground/validator"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/web/route"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/encryption"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/es/contracts/store"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/mongodb"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	contracts2 "github.com/raphaeldiscky/go-food-micro/internal/pkg/fxapp/contracts"
 	grpcServer "github.com/raphaeldiscky/go-food-micro/internal/pkg/grpc"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/grpc/gateway"
 	echocontracts "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/customecho/contracts"
 	googleGrpc "google.golang.org/grpc"
 
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/configurations/eventtypes"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/configurations/mappings"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/configurations/mediatr"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/contracts/repositories"
@@ -42,17 +49,43 @@ func (c *OrdersModuleConfigurator) ConfigureOrdersModule() {
 		func(logger logger.Logger,
 			_ echocontracts.EchoHTTPServer,
 			orderRepository repositories.OrderMongoRepository,
+			elasticOrderRepository repositories.OrderElasticRepository,
 			orderAggregateStore store.AggregateStore[*aggregate.Order],
+			subjectKeyStore encryption.SubjectKeyStore,
+			mongoClient *mongo.Client,
+			mongoOptions *mongodb.MongoDbOptions,
 			tracer tracing.AppTracer,
 		) error {
+			// creating indexes is idempotent, so they can be ensured on every startup
+			err := encryption.EnsureMongoSubjectKeyIndexes(context.Background(), mongoClient, mongoOptions)
+			if err != nil {
+				return err
+			}
+
 			// config Orders Mappings
-			err := mappings.ConfigureOrdersMappings()
+			err = mappings.ConfigureOrdersMappings()
+			if err != nil {
+				return err
+			}
+
+			// config Orders Event Types
+			err = eventtypes.ConfigureOrdersEventTypes()
 			if err != nil {
 				return err
 			}
 
+			// config Orders Protobuf Contracts
+			eventtypes.ConfigureOrdersProtobufContracts()
+
 			// config Orders Mediators
-			err = mediatr.ConfigOrdersMediator(logger, orderRepository, orderAggregateStore, tracer)
+			err = mediatr.ConfigOrdersMediator(
+				logger,
+				orderRepository,
+				elasticOrderRepository,
+				orderAggregateStore,
+				subjectKeyStore,
+				tracer,
+			)
 			if err != nil {
 				return err
 			}
@@ -83,4 +116,19 @@ func (c *OrdersModuleConfigurator) MapOrdersEndpoints() {
 			return nil
 		},
 	)
+
+	// config Orders Grpc Gateway Endpoints, the crud rest endpoints are the http annotations of the proto
+	c.ResolveFunc(
+		func(echoServer echocontracts.EchoHTTPServer, grpcClient grpcServer.GrpcClient) error {
+			return gateway.MapGateway(
+				context.Background(),
+				echoServer.RouteBuilder(),
+				grpcClient.GetGrpcConnection(),
+				gateway.Service{
+					Descriptor: ordersservice.File_orders_proto.Services().ByName("OrdersService"),
+					Register:   ordersservice.RegisterOrdersServiceHandler,
+				},
+			)
+		},
+	)
 }
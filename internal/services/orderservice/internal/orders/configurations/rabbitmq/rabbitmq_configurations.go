@@ -13,5 +13,7 @@ func ConfigOrdersRabbitMQ(builder rabbitmqConfigurations.RabbitMQConfigurationBu
 	builder.AddProducer(
 		createOrderIntegrationEventsV1.OrderCreatedV1{},
 		func(_ producerConfigurations.RabbitMQProducerConfigurationBuilder) {
+			// the order created is published as json for the existing consumers, its protobuf contract
+			// orders.OrderCreatedV1 in api/protobuf/orderservice is registered for the consumers that negotiate it
 		})
 }
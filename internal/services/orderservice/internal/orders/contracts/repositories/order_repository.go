@@ -33,6 +33,8 @@ type orderReadRepository interface {
 		order *readmodels.OrderReadModel,
 	) (*readmodels.OrderReadModel, error)
 	DeleteOrderByID(ctx context.Context, uuid uuid.UUID) error
+	// RedactCustomerOrders redacts the personal data of the orders of a customer and returns the redacted orders.
+	RedactCustomerOrders(ctx context.Context, accountEmail string) (int64, error)
 }
 
 // OrderElasticRepository is the elastic repository for the order.
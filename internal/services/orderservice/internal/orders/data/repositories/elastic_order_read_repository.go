@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"emperror.dev/errors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/encryption"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing/attribute"
@@ -445,3 +446,76 @@ func (e elasticOrderReadRepository) DeleteOrderByID(ctx context.Context, id uuid
 
 	return nil
 }
+
+// RedactCustomerOrders redacts the personal data of the orders of a customer with an update by query.
+func (e elasticOrderReadRepository) RedactCustomerOrders(
+	ctx context.Context,
+	accountEmail string,
+) (int64, error) {
+	ctx, span := e.tracer.Start(ctx, "elasticOrderReadRepository.RedactCustomerOrders")
+	defer span.End()
+
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"term": map[string]interface{}{
+				"accountEmail.keyword": map[string]interface{}{
+					"value":            strings.TrimSpace(accountEmail),
+					"case_insensitive": true,
+				},
+			},
+		},
+		"script": map[string]interface{}{
+			"source": "ctx._source.accountEmail = params.redacted; ctx._source.deliveryAddress = params.redacted",
+			"lang":   "painless",
+			"params": map[string]interface{}{"redacted": encryption.RedactedValue},
+		},
+	}
+
+	queryJSON, err := json.Marshal(query)
+	if err != nil {
+		return 0, errors.WrapIf(err, "failed to marshal update by query")
+	}
+
+	res, err := e.elasticClient.UpdateByQuery(
+		[]string{orderIndex},
+		e.elasticClient.UpdateByQuery.WithContext(ctx),
+		e.elasticClient.UpdateByQuery.WithBody(strings.NewReader(string(queryJSON))),
+		e.elasticClient.UpdateByQuery.WithConflicts("proceed"),
+		e.elasticClient.UpdateByQuery.WithRefresh(true),
+	)
+	if err != nil {
+		return 0, errors.WrapIf(err, "failed to redact the customer orders")
+	}
+	defer func() {
+		if closeErr := closeResponseBody(res.Body); closeErr != nil {
+			e.log.Error(closeErr)
+		}
+	}()
+
+	if res.StatusCode == 404 {
+		return 0, nil
+	}
+
+	if res.IsError() {
+		return 0, fmt.Errorf("update by query error: %s", res.String())
+	}
+
+	var result struct {
+		Updated int64 `json:"updated"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return 0, errors.WrapIf(err, "failed to decode update by query response")
+	}
+
+	span.SetAttributes(attribute2.Int64("RedactedOrders", result.Updated))
+
+	e.log.Infow(
+		fmt.Sprintf(
+			"[elasticOrderReadRepository.RedactCustomerOrders] %d orders of a customer redacted",
+			result.Updated,
+		),
+		logger.Fields{"RedactedOrders": result.Updated},
+	)
+
+	return result.Updated, nil
+}
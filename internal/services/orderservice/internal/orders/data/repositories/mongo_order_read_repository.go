@@ -4,8 +4,11 @@ package repositories
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"emperror.dev/errors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/encryption"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/mongodb"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing"
@@ -301,3 +304,43 @@ func (m mongoOrderReadRepository) DeleteOrderByID(ctx context.Context, uuid goUu
 
 	return nil
 }
+
+// RedactCustomerOrders redacts the personal data of the orders of a customer in the database.
+func (m mongoOrderReadRepository) RedactCustomerOrders(
+	ctx context.Context,
+	accountEmail string,
+) (int64, error) {
+	ctx, span := m.tracer.Start(ctx, "mongoOrderReadRepository.RedactCustomerOrders")
+	defer span.End()
+
+	collection := m.mongoClient.Database(m.mongoOptions.Database).Collection(orderCollection)
+
+	filter := bson.M{"accountEmail": primitive.Regex{
+		Pattern: "^" + regexp.QuoteMeta(strings.TrimSpace(accountEmail)) + "$",
+		Options: "i",
+	}}
+	update := bson.M{"$set": bson.M{
+		"accountEmail":    encryption.RedactedValue,
+		"deliveryAddress": encryption.RedactedValue,
+	}}
+
+	result, err := collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, utils2.TraceStatusFromContext(ctx, errors.WrapIf(
+			err,
+			"[mongoOrderReadRepository_RedactCustomerOrders.UpdateMany] error in redacting the customer orders",
+		))
+	}
+
+	span.SetAttributes(attribute2.Int64("RedactedOrders", result.ModifiedCount))
+
+	m.log.Infow(
+		fmt.Sprintf(
+			"[mongoOrderReadRepository.RedactCustomerOrders] %d orders of a customer redacted",
+			result.ModifiedCount,
+		),
+		logger.Fields{"RedactedOrders": result.ModifiedCount},
+	)
+
+	return result.ModifiedCount, nil
+}
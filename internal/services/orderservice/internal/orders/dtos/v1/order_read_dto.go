@@ -8,8 +8,8 @@ type OrderReadDto struct {
 	ID              string             `json:"id"`
 	OrderID         string             `json:"orderId"`
 	ShopItems       []*ShopItemReadDto `json:"shopItems"`
-	AccountEmail    string             `json:"accountEmail"`
-	DeliveryAddress string             `json:"deliveryAddress"`
+	AccountEmail    string             `json:"accountEmail"    pii:"true"`
+	DeliveryAddress string             `json:"deliveryAddress" pii:"true"`
 	CancelReason    string             `json:"cancelReason"`
 	TotalPrice      float64            `json:"totalPrice"`
 	DeliveredTime   time.Time          `json:"deliveredTime"`
@@ -20,4 +20,5 @@ type OrderReadDto struct {
 	PaymentID       string             `json:"paymentID"`
 	CreatedAt       time.Time          `json:"createdAt"`
 	UpdatedAt       time.Time          `json:"updatedAt"`
+	Version         int64              `json:"version"`
 }
@@ -0,0 +1,48 @@
+// Package domainexceptions contains the domain exceptions for the orderservice.
+package domainexceptions
+
+import (
+	"fmt"
+
+	"emperror.dev/errors"
+
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+	uuid "github.com/satori/go.uuid"
+)
+
+// orderAlreadySubmittedError is the order already submitted error.
+type orderAlreadySubmittedError struct {
+	customErrors.ConflictError
+}
+
+// NewOrderAlreadySubmittedError creates a new order already submitted error.
+func NewOrderAlreadySubmittedError(id uuid.UUID) error {
+	conflict := customErrors.NewConflictError(
+		fmt.Sprintf("order with id %s is already submitted", id),
+	)
+	customErr, ok := customErrors.GetCustomError(conflict).(customErrors.ConflictError)
+	if !ok {
+		return conflict // Return original error if type assertion fails
+	}
+
+	br := &orderAlreadySubmittedError{
+		ConflictError: customErr,
+	}
+
+	return errors.WithStackIf(br)
+}
+
+// isOrderAlreadySubmittedError checks if the error is an order already submitted error.
+func (i *orderAlreadySubmittedError) isOrderAlreadySubmittedError() bool {
+	return true
+}
+
+// IsOrderAlreadySubmittedError checks if the error is an order already submitted error.
+func IsOrderAlreadySubmittedError(err error) bool {
+	var os *orderAlreadySubmittedError
+	if errors.As(err, &os) {
+		return os.isOrderAlreadySubmittedError()
+	}
+
+	return false
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+	uuid "github.com/satori/go.uuid"
 )
 
 // TestOrderShopItemsRequiredError tests the order shop items required error.
@@ -24,6 +25,15 @@ func TestOrderNotFoundError(t *testing.T) {
 	assert.True(t, IsOrderNotFoundError(err))
 }
 
+// TestOrderAlreadySubmittedError tests the order already submitted error.
+func TestOrderAlreadySubmittedError(t *testing.T) {
+	t.Parallel()
+
+	err := NewOrderAlreadySubmittedError(uuid.NewV4())
+	assert.True(t, IsOrderAlreadySubmittedError(err))
+	assert.True(t, customErrors.IsConflictError(err))
+}
+
 // TestInvalidDeliveryAddressError tests the invalid delivery address error.
 func TestInvalidDeliveryAddressError(t *testing.T) {
 	t.Parallel()
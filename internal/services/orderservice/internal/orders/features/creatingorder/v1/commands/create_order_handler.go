@@ -71,7 +71,7 @@ func (c *CreateOrderHandler) Handle(
 		)
 	}
 
-	response := &dtos.CreateOrderResponseDto{OrderID: order.ID()}
+	response := &dtos.CreateOrderResponseDto{OrderID: order.ID(), Version: order.Version()}
 
 	c.log.Infow(
 		fmt.Sprintf("[CreateOrderHandler.Handle] order with id: {%s} created", command.OrderID),
@@ -8,4 +8,5 @@ import uuid "github.com/satori/go.uuid"
 // CreateOrderResponseDto is the response dto for the create order command.
 type CreateOrderResponseDto struct {
 	OrderID uuid.UUID `json:"ID"`
+	Version int64     `json:"version"`
 }
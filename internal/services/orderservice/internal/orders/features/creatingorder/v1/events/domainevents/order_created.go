@@ -19,8 +19,8 @@ type OrderCreatedV1 struct {
 	*domain.DomainEvent
 	OrderID         uuid.UUID             `json:"order_id"`
 	ShopItems       []*dtosV1.ShopItemDto `json:"shopItems"       bson:"shopItems,omitempty"`
-	AccountEmail    string                `json:"accountEmail"    bson:"accountEmail,omitempty"`
-	DeliveryAddress string                `json:"deliveryAddress" bson:"deliveryAddress,omitempty"`
+	AccountEmail    string                `json:"accountEmail"    bson:"accountEmail,omitempty"    pii:"subject"`
+	DeliveryAddress string                `json:"deliveryAddress" bson:"deliveryAddress,omitempty" pii:"true"`
 	CreatedAt       time.Time             `json:"createdAt"       bson:"createdAt,omitempty"`
 	DeliveredTime   time.Time             `json:"deliveredTime"   bson:"deliveredTime,omitempty"`
 }
@@ -0,0 +1,33 @@
+// Package commands contains the commands for the forget customer.
+package commands
+
+import (
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/go-ozzo/ozzo-validation/is"
+)
+
+// ForgetCustomer is the command for the forget customer, it erases the personal data of a customer.
+type ForgetCustomer struct {
+	AccountEmail string
+}
+
+// NewForgetCustomer creates a new forget customer command.
+func NewForgetCustomer(accountEmail string) (*ForgetCustomer, error) {
+	command := &ForgetCustomer{AccountEmail: strings.TrimSpace(accountEmail)}
+
+	err := command.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return command, nil
+}
+
+// Validate validates the forget customer command.
+func (c *ForgetCustomer) Validate() error {
+	return validation.ValidateStruct(c,
+		validation.Field(&c.AccountEmail, validation.Required, is.Email),
+	)
+}
@@ -0,0 +1,86 @@
+// Package commands contains the forget customer command handler.
+package commands
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/encryption"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing"
+
+	attribute2 "go.opentelemetry.io/otel/attribute"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/contracts/repositories"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/forgettingcustomer/v1/dtos"
+)
+
+// ForgetCustomerHandler is the forget customer handler.
+type ForgetCustomerHandler struct {
+	log                      logger.Logger
+	subjectKeyStore          encryption.SubjectKeyStore
+	mongoOrderReadRepository repositories.OrderMongoRepository
+	elasticOrderRepository   repositories.OrderElasticRepository
+	tracer                   tracing.AppTracer
+}
+
+// NewForgetCustomerHandler creates a new forget customer handler.
+func NewForgetCustomerHandler(
+	log logger.Logger,
+	subjectKeyStore encryption.SubjectKeyStore,
+	mongoOrderReadRepository repositories.OrderMongoRepository,
+	elasticOrderRepository repositories.OrderElasticRepository,
+	tracer tracing.AppTracer,
+) *ForgetCustomerHandler {
+	return &ForgetCustomerHandler{
+		log:                      log,
+		subjectKeyStore:          subjectKeyStore,
+		mongoOrderReadRepository: mongoOrderReadRepository,
+		elasticOrderRepository:   elasticOrderRepository,
+		tracer:                   tracer,
+	}
+}
+
+// Handle handles the forget customer command, destroying the key of the customer shreds the personal data in the
+// order events and the plain copies in the read models are redacted. A failed command can be retried.
+func (c *ForgetCustomerHandler) Handle(
+	ctx context.Context,
+	command *ForgetCustomer,
+) (*dtos.ForgetCustomerResponseDto, error) {
+	ctx, span := c.tracer.Start(ctx, "ForgetCustomerHandler.Handle")
+	defer span.End()
+
+	subjectID := encryption.SubjectID(command.AccountEmail)
+	span.SetAttributes(attribute2.String("SubjectID", subjectID))
+
+	err := c.subjectKeyStore.DestroyKeys(ctx, subjectID)
+	if err != nil {
+		return nil, errors.WithMessage(
+			err,
+			"[ForgetCustomerHandler_Handle.DestroyKeys] error in destroying the customer keys",
+		)
+	}
+
+	redactedOrders, err := c.mongoOrderReadRepository.RedactCustomerOrders(ctx, command.AccountEmail)
+	if err != nil {
+		return nil, errors.WithMessage(
+			err,
+			"[ForgetCustomerHandler_Handle.RedactCustomerOrders] error in redacting the mongo orders",
+		)
+	}
+
+	_, err = c.elasticOrderRepository.RedactCustomerOrders(ctx, command.AccountEmail)
+	if err != nil {
+		return nil, errors.WithMessage(
+			err,
+			"[ForgetCustomerHandler_Handle.RedactCustomerOrders] error in redacting the elastic orders",
+		)
+	}
+
+	c.log.Infow(
+		"[ForgetCustomerHandler.Handle] customer forgotten",
+		logger.Fields{"SubjectID": subjectID, "RedactedOrders": redactedOrders},
+	)
+
+	return &dtos.ForgetCustomerResponseDto{SubjectID: subjectID, RedactedOrders: redactedOrders}, nil
+}
@@ -0,0 +1,7 @@
+// Package dtos contains the forget customer request dto.
+package dtos
+
+// ForgetCustomerRequestDto validation will handle in command level.
+type ForgetCustomerRequestDto struct {
+	AccountEmail string `json:"accountEmail"`
+}
@@ -0,0 +1,8 @@
+// Package dtos contains the forget customer response dto.
+package dtos
+
+// ForgetCustomerResponseDto is the response dto for the forget customer command.
+type ForgetCustomerResponseDto struct {
+	SubjectID      string `json:"subjectId"`
+	RedactedOrders int64  `json:"redactedOrders"`
+}
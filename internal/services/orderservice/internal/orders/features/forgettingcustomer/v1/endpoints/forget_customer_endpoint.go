@@ -0,0 +1,116 @@
+// Package endpoints contains the forget customer endpoint.
+package endpoints
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/authentication"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/web/route"
+
+	echo "github.com/labstack/echo/v4"
+	mediatr "github.com/mehdihadeli/go-mediatr"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/contracts/params"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/forgettingcustomer/v1/commands"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/forgettingcustomer/v1/dtos"
+)
+
+// forgetCustomerEndpoint is the forget customer endpoint.
+type forgetCustomerEndpoint struct {
+	params.OrderRouteParams
+}
+
+// NewForgetCustomerEndpoint creates a new forget customer endpoint.
+func NewForgetCustomerEndpoint(p params.OrderRouteParams) route.Endpoint {
+	return &forgetCustomerEndpoint{OrderRouteParams: p}
+}
+
+// MapEndpoint maps the forget customer endpoint.
+func (ep *forgetCustomerEndpoint) MapEndpoint() {
+	ep.OrdersGroup.POST("/customers/forget", ep.handler())
+}
+
+// Forget Customer
+// @Tags Orders
+// @Summary Forget customer
+// @Description Erase the personal data of a customer, the customer key is destroyed and the orders are redacted. Only the customer itself or an admin can forget a customer.
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token of the customer or an admin"
+// @Param ForgetCustomerRequestDto body dtos.ForgetCustomerRequestDto true "Customer data"
+// @Success 200 {object} dtos.ForgetCustomerResponseDto
+// @Failure 401 "The caller is not authenticated"
+// @Failure 403 "The caller is neither the customer nor an admin"
+// @Router /api/v1/orders/customers/forget [post].
+func (ep *forgetCustomerEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		ep.OrdersMetrics.HTTPMetrics.ForgetCustomerHTTPRequests.Add(ctx, 1)
+
+		request := &dtos.ForgetCustomerRequestDto{}
+		if err := c.Bind(request); err != nil {
+			badRequestErr := customErrors.NewBadRequestErrorWrap(
+				err,
+				"[forgetCustomerEndpoint_handler.Bind] error in the binding request",
+			)
+			ep.Logger.Errorf(
+				fmt.Sprintf("[forgetCustomerEndpoint_handler.Bind] err: %v", badRequestErr),
+			)
+
+			return badRequestErr
+		}
+
+		if err := authorizeForgetCustomer(c, request.AccountEmail); err != nil {
+			ep.Logger.Errorf(fmt.Sprintf("[forgetCustomerEndpoint_handler.Authorize] err: %v", err))
+
+			return err
+		}
+
+		command, err := commands.NewForgetCustomer(request.AccountEmail)
+		if err != nil {
+			validationErr := customErrors.NewValidationErrorWrap(
+				err,
+				"[forgetCustomerEndpoint_handler.StructCtx] command validation failed",
+			)
+			ep.Logger.Errorf(
+				fmt.Sprintf("[forgetCustomerEndpoint_handler.StructCtx] err: %v", validationErr),
+			)
+
+			return validationErr
+		}
+
+		result, err := mediatr.Send[*commands.ForgetCustomer, *dtos.ForgetCustomerResponseDto](
+			ctx,
+			command,
+		)
+		if err != nil {
+			err = errors.WithMessage(
+				err,
+				"[forgetCustomerEndpoint_handler.Send] error in sending ForgetCustomer",
+			)
+			ep.Logger.Errorf(fmt.Sprintf("[forgetCustomerEndpoint_handler.Send] err: %v", err))
+
+			return err
+		}
+
+		return c.JSON(http.StatusOK, result)
+	}
+}
+
+// authorizeForgetCustomer allows forgetting a customer only to the authenticated customer itself or to an admin.
+func authorizeForgetCustomer(c echo.Context, accountEmail string) error {
+	principal, authenticated := authentication.PrincipalFromContext(c.Request().Context())
+	if !authenticated {
+		return customErrors.NewUnAuthorizedError("a customer is only forgotten by an authenticated caller")
+	}
+
+	if !principal.IsAdmin() && !principal.IsAccount(strings.TrimSpace(accountEmail)) {
+		return customErrors.NewForbiddenError("another customer can't be forgotten")
+	}
+
+	return nil
+}
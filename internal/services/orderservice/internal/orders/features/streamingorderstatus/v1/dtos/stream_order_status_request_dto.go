@@ -0,0 +1,8 @@
+// Package dtos contains the stream order status request dto.
+package dtos
+
+// StreamOrderStatusRequestDto selects the orders of the status stream, at least one of the fields is required.
+type StreamOrderStatusRequestDto struct {
+	OrderID      string `query:"orderId"      json:"orderId"`
+	AccountEmail string `query:"accountEmail" json:"accountEmail"`
+}
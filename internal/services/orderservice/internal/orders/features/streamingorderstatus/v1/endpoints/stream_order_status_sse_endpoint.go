@@ -0,0 +1,119 @@
+// Package endpoints contains the order status server-sent events endpoint.
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/web/route"
+
+	echo "github.com/labstack/echo/v4"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/contracts/params"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/statusstream"
+)
+
+// laggedEvent is the last event of a stream dropped for falling behind, the client should reload the order and reconnect.
+const laggedEvent = "lagged"
+
+// StreamOrderStatusSSEEndpoint is the order status server-sent events endpoint.
+type StreamOrderStatusSSEEndpoint struct {
+	params.OrderRouteParams
+	hub *statusstream.OrderStatusHub
+}
+
+// NewStreamOrderStatusSSEEndpoint creates a new order status server-sent events endpoint.
+func NewStreamOrderStatusSSEEndpoint(
+	p params.OrderRouteParams,
+	hub *statusstream.OrderStatusHub,
+) route.Endpoint {
+	return &StreamOrderStatusSSEEndpoint{OrderRouteParams: p, hub: hub}
+}
+
+// MapEndpoint maps the order status server-sent events endpoint.
+func (ep *StreamOrderStatusSSEEndpoint) MapEndpoint() {
+	ep.OrdersGroup.GET("/status-stream", ep.handler())
+}
+
+// Stream Order Status
+// @Tags Orders
+// @Summary Stream the order status updates
+// @Description Stream the status updates of an order or an account as server-sent events
+// @Produce text/event-stream
+// @Param orderId query string false "Order ID, a customer only receives it when the order is of its account"
+// @Param accountEmail query string false "Account Email, of the caller unless it is an admin"
+// @Param Authorization header string true "Bearer token of the account or an admin"
+// @Success 200 {object} statusstream.OrderStatusUpdate
+// @Router /api/v1/orders/status-stream [get].
+func (ep *StreamOrderStatusSSEEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		filter, err := subscriptionFilter(c)
+		if err != nil {
+			return err
+		}
+
+		subscription, cancel := ep.hub.Subscribe(filter)
+		defer cancel()
+
+		// the stream outlives the write timeout of the server
+		_ = http.NewResponseController(c.Response()).SetWriteDeadline(time.Time{})
+
+		res := c.Response()
+		res.Header().Set(echo.HeaderContentType, "text/event-stream")
+		res.Header().Set("Cache-Control", "no-cache")
+		res.Header().Set("Connection", "keep-alive")
+		res.Header().Set("X-Accel-Buffering", "no")
+		res.WriteHeader(http.StatusOK)
+		res.Flush()
+
+		heartbeat := time.NewTicker(heartbeatInterval)
+		defer heartbeat.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-heartbeat.C:
+				if _, err := fmt.Fprint(res, ": heartbeat\n\n"); err != nil {
+					return nil
+				}
+				res.Flush()
+			case update, ok := <-subscription.Updates():
+				if !ok {
+					if subscription.Lagged() {
+						_, _ = fmt.Fprintf(res, "event: %s\ndata: {}\n\n", laggedEvent)
+						res.Flush()
+					}
+
+					return nil
+				}
+
+				if err := writeEvent(res, update); err != nil {
+					ep.Logger.Errorf("[StreamOrderStatusSSEEndpoint_handler.writeEvent] err: %v", err)
+
+					return nil
+				}
+			}
+		}
+	}
+}
+
+// writeEvent writes an update as a server-sent event named by its type.
+func writeEvent(res *echo.Response, update *statusstream.OrderStatusUpdate) error {
+	data, err := json.Marshal(update)
+	if err != nil {
+		return errors.WrapIf(err, "error in marshaling the order status update")
+	}
+
+	if _, err := fmt.Fprintf(res, "id: %s\nevent: %s\ndata: %s\n\n", update.EventID, update.Type, data); err != nil {
+		return errors.WrapIf(err, "error in writing the order status update")
+	}
+	res.Flush()
+
+	return nil
+}
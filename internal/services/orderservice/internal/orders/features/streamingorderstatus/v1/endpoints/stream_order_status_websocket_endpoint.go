@@ -0,0 +1,119 @@
+// Package endpoints contains the order status websocket endpoint.
+package endpoints
+
+import (
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/web/route"
+
+	echo "github.com/labstack/echo/v4"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/contracts/params"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/statusstream"
+)
+
+const (
+	// writeWait is the time allowed to write a message to the websocket.
+	writeWait = 10 * time.Second
+	// pongWait is the time allowed to read the pong of a heartbeat ping, the connection is closed after it.
+	pongWait = 2 * heartbeatInterval
+)
+
+// StreamOrderStatusWebsocketEndpoint is the order status websocket endpoint.
+type StreamOrderStatusWebsocketEndpoint struct {
+	params.OrderRouteParams
+	hub      *statusstream.OrderStatusHub
+	upgrader websocket.Upgrader
+}
+
+// NewStreamOrderStatusWebsocketEndpoint creates a new order status websocket endpoint.
+func NewStreamOrderStatusWebsocketEndpoint(
+	p params.OrderRouteParams,
+	hub *statusstream.OrderStatusHub,
+) route.Endpoint {
+	return &StreamOrderStatusWebsocketEndpoint{OrderRouteParams: p, hub: hub}
+}
+
+// MapEndpoint maps the order status websocket endpoint.
+func (ep *StreamOrderStatusWebsocketEndpoint) MapEndpoint() {
+	ep.OrdersGroup.GET("/status-stream/ws", ep.handler())
+}
+
+// Stream Order Status Websocket
+// @Tags Orders
+// @Summary Stream the order status updates over a websocket
+// @Description Stream the status updates of an order or an account as websocket json messages
+// @Param orderId query string false "Order ID, a customer only receives it when the order is of its account"
+// @Param accountEmail query string false "Account Email, of the caller unless it is an admin"
+// @Param Authorization header string true "Bearer token of the account or an admin"
+// @Success 101 {object} statusstream.OrderStatusUpdate
+// @Router /api/v1/orders/status-stream/ws [get].
+func (ep *StreamOrderStatusWebsocketEndpoint) handler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		filter, err := subscriptionFilter(c)
+		if err != nil {
+			return err
+		}
+
+		// subscribed before the upgrade, so the updates after the client is connected are never missed
+		subscription, cancel := ep.hub.Subscribe(filter)
+		defer cancel()
+
+		conn, err := ep.upgrader.Upgrade(c.Response(), c.Request(), nil)
+		if err != nil {
+			// the upgrader already wrote the error response
+			ep.Logger.Errorf("[StreamOrderStatusWebsocketEndpoint_handler.Upgrade] err: %v", err)
+
+			return nil
+		}
+		defer conn.Close()
+
+		// the client only sends the control messages, reading them detects the closed and dead connections
+		_ = conn.SetReadDeadline(time.Now().Add(pongWait))
+		conn.SetPongHandler(func(string) error {
+			return conn.SetReadDeadline(time.Now().Add(pongWait))
+		})
+
+		closed := make(chan struct{})
+		go func() {
+			defer close(closed)
+			for {
+				if _, _, err := conn.NextReader(); err != nil {
+					return
+				}
+			}
+		}()
+
+		heartbeat := time.NewTicker(heartbeatInterval)
+		defer heartbeat.Stop()
+
+		for {
+			select {
+			case <-closed:
+				return nil
+			case <-heartbeat.C:
+				if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeWait)); err != nil {
+					return nil
+				}
+			case update, ok := <-subscription.Updates():
+				if !ok {
+					if subscription.Lagged() {
+						_ = conn.WriteControl(
+							websocket.CloseMessage,
+							websocket.FormatCloseMessage(websocket.CloseTryAgainLater, laggedEvent),
+							time.Now().Add(writeWait),
+						)
+					}
+
+					return nil
+				}
+
+				_ = conn.SetWriteDeadline(time.Now().Add(writeWait))
+				if err := conn.WriteJSON(update); err != nil {
+					return nil
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// Package endpoints contains the order status stream endpoints.
+package endpoints
+
+import (
+	"strings"
+	"time"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/authentication"
+
+	echo "github.com/labstack/echo/v4"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/streamingorderstatus/v1/dtos"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/statusstream"
+)
+
+// heartbeatInterval is the interval of the keep alive messages, so the idle streams are not closed by the proxies.
+const heartbeatInterval = 15 * time.Second
+
+// subscriptionFilter binds the filter of the stream, the updates carry the account of the orders, so they are only
+// streamed to an authenticated caller, a customer only receives the updates of its own account and an admin any of them.
+func subscriptionFilter(c echo.Context) (statusstream.Filter, error) {
+	request := &dtos.StreamOrderStatusRequestDto{}
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, request); err != nil {
+		return statusstream.Filter{}, customErrors.NewBadRequestErrorWrap(
+			err,
+			"error in the binding request",
+		)
+	}
+
+	filter := statusstream.Filter{
+		OrderID:      strings.TrimSpace(request.OrderID),
+		AccountEmail: strings.TrimSpace(request.AccountEmail),
+	}
+
+	if filter.OrderID != "" {
+		orderID, err := uuid.FromString(filter.OrderID)
+		if err != nil {
+			return statusstream.Filter{}, customErrors.NewBadRequestErrorWrap(
+				err,
+				"orderId is not a valid uuid",
+			)
+		}
+		filter.OrderID = orderID.String()
+	}
+
+	// without a signing key there is no principal, so the streams are closed instead of open to anyone
+	principal, authenticated := authentication.PrincipalFromContext(c.Request().Context())
+	if !authenticated {
+		return statusstream.Filter{}, customErrors.NewUnAuthorizedError(
+			"the order status updates are only streamed to an authenticated caller",
+		)
+	}
+
+	if !principal.IsAdmin() {
+		switch {
+		case principal.Email == "":
+			return statusstream.Filter{}, customErrors.NewForbiddenError(
+				"the token has no account email to stream the orders of",
+			)
+		case filter.AccountEmail != "" && !principal.IsAccount(filter.AccountEmail):
+			return statusstream.Filter{}, customErrors.NewForbiddenError(
+				"the orders of another account can't be streamed",
+			)
+		}
+
+		// an order filter is narrowed to the own account, so the orders of the other accounts are never streamed
+		filter.AccountEmail = principal.Email
+	}
+
+	if filter.OrderID == "" && filter.AccountEmail == "" {
+		return statusstream.Filter{}, customErrors.NewBadRequestError(
+			"orderId or accountEmail is required",
+		)
+	}
+
+	return filter, nil
+}
@@ -2,15 +2,33 @@
 package commands
 
 import (
+	validation "github.com/go-ozzo/ozzo-validation"
 	uuid "github.com/satori/go.uuid"
 )
 
 // SubmitOrder is the command for the submit order.
 type SubmitOrder struct {
-	OrderID uuid.UUID `validate:"required"`
+	OrderID uuid.UUID
+	// ExpectedVersion is the order version the client has seen, 0 submits the latest version.
+	ExpectedVersion int64
 }
 
 // NewSubmitOrder creates a new submit order command.
-func NewSubmitOrder(orderID uuid.UUID) *SubmitOrder {
-	return &SubmitOrder{OrderID: orderID}
+func NewSubmitOrder(orderID uuid.UUID, expectedVersion int64) (*SubmitOrder, error) {
+	command := &SubmitOrder{OrderID: orderID, ExpectedVersion: expectedVersion}
+
+	err := command.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return command, nil
+}
+
+// Validate validates the submit order command.
+func (c *SubmitOrder) Validate() error {
+	return validation.ValidateStruct(c,
+		validation.Field(&c.OrderID, validation.Required),
+		validation.Field(&c.ExpectedVersion, validation.Min(int64(0))),
+	)
 }
@@ -0,0 +1,83 @@
+// Package commands contains the submit order command handler.
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/es/contracts/store"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing"
+
+	streamName "github.com/raphaeldiscky/go-food-micro/internal/pkg/es/models/streamname"
+	esErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/eventstoredb/errors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/submittingorder/v1/dtos"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/models/orders/aggregate"
+)
+
+// SubmitOrderHandler is the submit order handler.
+type SubmitOrderHandler struct {
+	log            logger.Logger
+	aggregateStore store.AggregateStore[*aggregate.Order]
+	tracer         tracing.AppTracer
+}
+
+// NewSubmitOrderHandler creates a new submit order handler.
+func NewSubmitOrderHandler(
+	log logger.Logger,
+	aggregateStore store.AggregateStore[*aggregate.Order],
+	tracer tracing.AppTracer,
+) *SubmitOrderHandler {
+	return &SubmitOrderHandler{log: log, aggregateStore: aggregateStore, tracer: tracer}
+}
+
+// Handle handles the submit order command, it isn't retried on a concurrent change because the client has to
+// submit the cart it has seen.
+func (c *SubmitOrderHandler) Handle(
+	ctx context.Context,
+	command *SubmitOrder,
+) (*dtos.SubmitOrderResponseDto, error) {
+	order, err := c.aggregateStore.Load(ctx, command.OrderID)
+	if err != nil {
+		return nil, errors.WithMessage(
+			err,
+			"[SubmitOrderHandler_Handle.Load] error in loading order aggregate",
+		)
+	}
+
+	if command.ExpectedVersion != 0 && order.Version() != command.ExpectedVersion {
+		return nil, esErrors.NewWrongExpectedVersionError(
+			fmt.Errorf(
+				"expected order version %d, current version is %d",
+				command.ExpectedVersion,
+				order.Version(),
+			),
+			streamName.For[*aggregate.Order](order).String(),
+		)
+	}
+
+	err = order.Submit()
+	if err != nil {
+		return nil, errors.WithMessage(
+			err,
+			"[SubmitOrderHandler_Handle.Submit] error in submitting order",
+		)
+	}
+
+	_, err = c.aggregateStore.Store(order, nil, ctx)
+	if err != nil {
+		return nil, errors.WithMessage(
+			err,
+			"[SubmitOrderHandler_Handle.Store] error in storing order aggregate",
+		)
+	}
+
+	c.log.Infow(
+		fmt.Sprintf("[SubmitOrderHandler.Handle] order with id: {%s} submitted", command.OrderID),
+		logger.Fields{"ID": command.OrderID, "Version": order.Version()},
+	)
+
+	return &dtos.SubmitOrderResponseDto{OrderID: order.ID(), Version: order.Version()}, nil
+}
@@ -0,0 +1,10 @@
+// Package dtos contains the submit order response dto.
+package dtos
+
+import uuid "github.com/satori/go.uuid"
+
+// SubmitOrderResponseDto is the response dto for the submit order command.
+type SubmitOrderResponseDto struct {
+	OrderID uuid.UUID `json:"orderId"`
+	Version int64     `json:"version"`
+}
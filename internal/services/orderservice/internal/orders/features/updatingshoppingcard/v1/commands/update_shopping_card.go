@@ -2,6 +2,7 @@
 package commands
 
 import (
+	validation "github.com/go-ozzo/ozzo-validation"
 	uuid "github.com/satori/go.uuid"
 
 	dtosV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/dtos/v1"
@@ -9,11 +10,37 @@ import (
 
 // UpdateShoppingCart is the command for the update shopping cart.
 type UpdateShoppingCart struct {
-	OrderID   uuid.UUID             `validate:"required"`
-	ShopItems []*dtosV1.ShopItemDto `validate:"required"`
+	OrderID   uuid.UUID
+	ShopItems []*dtosV1.ShopItemDto
+	// ExpectedVersion is the order version the client has seen, 0 updates the latest version.
+	ExpectedVersion int64
 }
 
 // NewUpdateShoppingCart creates a new update shopping cart command.
-func NewUpdateShoppingCart(orderID uuid.UUID, shopItems []*dtosV1.ShopItemDto) *UpdateShoppingCart {
-	return &UpdateShoppingCart{OrderID: orderID, ShopItems: shopItems}
+func NewUpdateShoppingCart(
+	orderID uuid.UUID,
+	shopItems []*dtosV1.ShopItemDto,
+	expectedVersion int64,
+) (*UpdateShoppingCart, error) {
+	command := &UpdateShoppingCart{
+		OrderID:         orderID,
+		ShopItems:       shopItems,
+		ExpectedVersion: expectedVersion,
+	}
+
+	err := command.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return command, nil
+}
+
+// Validate validates the update shopping cart command.
+func (c *UpdateShoppingCart) Validate() error {
+	return validation.ValidateStruct(c,
+		validation.Field(&c.OrderID, validation.Required),
+		validation.Field(&c.ShopItems, validation.Required),
+		validation.Field(&c.ExpectedVersion, validation.Min(int64(0))),
+	)
 }
@@ -0,0 +1,102 @@
+// Package commands contains the update shopping cart command handler.
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/es/contracts/store"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/mapper"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing"
+
+	streamName "github.com/raphaeldiscky/go-food-micro/internal/pkg/es/models/streamname"
+	esErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/eventstoredb/errors"
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/updatingshoppingcard/v1/dtos"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/models/orders/aggregate"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/models/orders/valueobject"
+)
+
+// UpdateShoppingCartHandler is the update shopping cart handler.
+type UpdateShoppingCartHandler struct {
+	log            logger.Logger
+	aggregateStore store.AggregateStore[*aggregate.Order]
+	tracer         tracing.AppTracer
+}
+
+// NewUpdateShoppingCartHandler creates a new update shopping cart handler.
+func NewUpdateShoppingCartHandler(
+	log logger.Logger,
+	aggregateStore store.AggregateStore[*aggregate.Order],
+	tracer tracing.AppTracer,
+) *UpdateShoppingCartHandler {
+	return &UpdateShoppingCartHandler{
+		log:            log,
+		aggregateStore: aggregateStore,
+		tracer:         tracer,
+	}
+}
+
+// Handle handles the update shopping cart command.
+func (c *UpdateShoppingCartHandler) Handle(
+	ctx context.Context,
+	command *UpdateShoppingCart,
+) (*dtos.UpdateShoppingCartResponseDto, error) {
+	shopItems, err := mapper.Map[[]*valueobject.ShopItem](command.ShopItems)
+	if err != nil {
+		return nil, customErrors.NewApplicationErrorWrap(
+			err,
+			"[UpdateShoppingCartHandler_Handle.Map] error in the mapping shopItems",
+		)
+	}
+
+	order, err := c.aggregateStore.Load(ctx, command.OrderID)
+	if err != nil {
+		return nil, errors.WithMessage(
+			err,
+			"[UpdateShoppingCartHandler_Handle.Load] error in loading order aggregate",
+		)
+	}
+
+	if command.ExpectedVersion != 0 && order.Version() != command.ExpectedVersion {
+		return nil, esErrors.NewWrongExpectedVersionError(
+			fmt.Errorf(
+				"expected order version %d, current version is %d",
+				command.ExpectedVersion,
+				order.Version(),
+			),
+			streamName.For[*aggregate.Order](order).String(),
+		)
+	}
+
+	err = order.UpdateShoppingCard(shopItems)
+	if err != nil {
+		return nil, errors.WithMessage(
+			err,
+			"[UpdateShoppingCartHandler_Handle.UpdateShoppingCard] error in updating shopping cart",
+		)
+	}
+
+	// the loaded version is the expected stream version, so a concurrent change fails with a wrong expected version.
+	// the cart is replaced as a whole, so the update is never retried on a newer version the client hasn't seen.
+	_, err = c.aggregateStore.Store(order, nil, ctx)
+	if err != nil {
+		return nil, errors.WithMessage(
+			err,
+			"[UpdateShoppingCartHandler_Handle.Store] error in storing order aggregate",
+		)
+	}
+
+	c.log.Infow(
+		fmt.Sprintf(
+			"[UpdateShoppingCartHandler.Handle] shopping cart of order with id: {%s} updated",
+			command.OrderID,
+		),
+		logger.Fields{"ID": command.OrderID, "Version": order.Version()},
+	)
+
+	return &dtos.UpdateShoppingCartResponseDto{OrderID: order.ID(), Version: order.Version()}, nil
+}
@@ -0,0 +1,10 @@
+// Package dtos contains the update shopping cart response dto.
+package dtos
+
+import uuid "github.com/satori/go.uuid"
+
+// UpdateShoppingCartResponseDto is the response dto for the update shopping cart command.
+type UpdateShoppingCartResponseDto struct {
+	OrderID uuid.UUID `json:"orderId"`
+	Version int64     `json:"version"`
+}
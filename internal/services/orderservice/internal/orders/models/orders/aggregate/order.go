@@ -20,6 +20,7 @@ import (
 	dtosV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/dtos/v1"
 	domainExceptions "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/exceptions/domainexceptions"
 	createOrderDomainEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/creatingorder/v1/events/domainevents"
+	submitOrderDomainEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/submittingorder/v1/events/domainevents"
 	updateOrderDomainEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/updatingshoppingcard/v1/events"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/models/orders/valueobject"
 )
@@ -101,6 +102,16 @@ func NewOrder(
 
 // UpdateShoppingCard updates the shopping card.
 func (o *Order) UpdateShoppingCard(shopItems []*valueobject.ShopItem) error {
+	if o.submitted {
+		return domainExceptions.NewOrderAlreadySubmittedError(o.ID())
+	}
+
+	if len(shopItems) == 0 {
+		return domainExceptions.NewOrderShopItemsRequiredError(
+			"[Order_UpdateShoppingCard] order items is required",
+		)
+	}
+
 	event, err := updateOrderDomainEventsV1.NewShoppingCartUpdatedV1(o.ID(), shopItems)
 	if err != nil {
 		return err
@@ -114,6 +125,20 @@ func (o *Order) UpdateShoppingCard(shopItems []*valueobject.ShopItem) error {
 	return nil
 }
 
+// Submit submits the order.
+func (o *Order) Submit() error {
+	if o.submitted {
+		return domainExceptions.NewOrderAlreadySubmittedError(o.ID())
+	}
+
+	event, err := submitOrderDomainEventsV1.NewSubmitOrderV1(o.ID())
+	if err != nil {
+		return err
+	}
+
+	return o.Apply(event, true)
+}
+
 // When handles the event.
 func (o *Order) When(event domain.IDomainEvent) error {
 	switch evt := event.(type) {
@@ -121,6 +146,8 @@ func (o *Order) When(event domain.IDomainEvent) error {
 		return o.onOrderCreated(evt)
 	case *updateOrderDomainEventsV1.ShoppingCartUpdatedV1:
 		return o.onShoppingCartUpdated(evt)
+	case *submitOrderDomainEventsV1.OrderSubmittedV1:
+		return o.onOrderSubmitted(evt)
 	default:
 		return errors.InvalidEventTypeError
 	}
@@ -150,6 +177,13 @@ func (o *Order) onShoppingCartUpdated(evt *updateOrderDomainEventsV1.ShoppingCar
 	return nil
 }
 
+// onOrderSubmitted handles the order submitted event.
+func (o *Order) onOrderSubmitted(_ *submitOrderDomainEventsV1.OrderSubmittedV1) error {
+	o.submitted = true
+
+	return nil
+}
+
 // ShopItems returns the shop items.
 func (o *Order) ShopItems() []*valueobject.ShopItem {
 	return o.shopItems
@@ -210,6 +244,12 @@ func (o *Order) CancelReason() string {
 	return o.cancelReason
 }
 
+// Version returns the version of the order exposed to the clients, the number of the events in the order stream.
+// The expected stream version of a client version is `version - 1`.
+func (o *Order) Version() int64 {
+	return o.CurrentVersion() + 1
+}
+
 // String returns the string representation of the order.
 func (o *Order) String() string {
 	j, err := json.Marshal(o)
@@ -0,0 +1,84 @@
+// Package aggregate_test contains the order aggregate tests.
+package aggregate_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/configurations/mappings"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/exceptions/domainexceptions"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/models/orders/aggregate"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/models/orders/valueobject"
+)
+
+func TestMain(m *testing.M) {
+	if err := mappings.ConfigureOrdersMappings(); err != nil {
+		panic(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+func newOrder(t *testing.T) *aggregate.Order {
+	t.Helper()
+
+	order, err := aggregate.NewOrder(
+		uuid.NewV4(),
+		[]*valueobject.ShopItem{valueobject.CreateNewShopItem("pizza", "margherita", 1, 10)},
+		"john@example.com",
+		"somewhere",
+		time.Now().Add(time.Hour),
+		time.Now(),
+	)
+	require.NoError(t, err)
+
+	return order
+}
+
+// TestOrderVersion tests the version counts the events of the order.
+func TestOrderVersion(t *testing.T) {
+	order := newOrder(t)
+	assert.Equal(t, int64(1), order.Version())
+
+	err := order.UpdateShoppingCard(
+		[]*valueobject.ShopItem{valueobject.CreateNewShopItem("pasta", "carbonara", 2, 8)},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), order.Version())
+
+	require.NoError(t, order.Submit())
+	assert.Equal(t, int64(3), order.Version())
+	assert.True(t, order.Submitted())
+	assert.Len(t, order.UncommittedEvents(), 3)
+}
+
+// TestSubmittedOrderRejectsChanges tests a submitted order can't be submitted or updated again.
+func TestSubmittedOrderRejectsChanges(t *testing.T) {
+	order := newOrder(t)
+	require.NoError(t, order.Submit())
+
+	err := order.Submit()
+	assert.True(t, domainexceptions.IsOrderAlreadySubmittedError(err))
+
+	err = order.UpdateShoppingCard(
+		[]*valueobject.ShopItem{valueobject.CreateNewShopItem("pasta", "carbonara", 2, 8)},
+	)
+	assert.True(t, domainexceptions.IsOrderAlreadySubmittedError(err))
+	assert.True(t, customErrors.IsConflictError(err))
+	assert.Equal(t, int64(2), order.Version())
+}
+
+// TestUpdateShoppingCardRequiresItems tests the shopping cart can't be emptied.
+func TestUpdateShoppingCardRequiresItems(t *testing.T) {
+	order := newOrder(t)
+
+	err := order.UpdateShoppingCard(nil)
+	assert.True(t, domainexceptions.IsOrderShopItemsRequiredError(err))
+}
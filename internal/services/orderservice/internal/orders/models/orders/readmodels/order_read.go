@@ -25,6 +25,7 @@ type OrderReadModel struct {
 	PaymentID       string               `json:"paymentID"                 bson:"paymentID,omitempty"`
 	CreatedAt       time.Time            `json:"createdAt,omitempty"       bson:"createdAt,omitempty"`
 	UpdatedAt       time.Time            `json:"updatedAt,omitempty"       bson:"updatedAt,omitempty"`
+	Version         int64                `json:"version"                   bson:"version"`
 }
 
 // NewOrderReadModel creates a new order read model.
@@ -2,20 +2,25 @@
 package orders
 
 import (
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/authentication"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/web/route"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/encryption"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/es"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/eventstoredb"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/idempotency"
 	"go.uber.org/fx"
 
 	echo "github.com/labstack/echo/v4"
 	echocontracts "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/customecho/contracts"
+	authenticationMiddleware "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/customecho/middlewares/authentication"
+	idempotencyMiddleware "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/customecho/middlewares/idempotency"
 
 	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/data/repositories"
-	createOrderV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/creatingorder/v1/endpoints"
-	GetOrderByIDV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/gettingorderbyid/v1/endpoints"
-	getOrdersV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/gettingorders/v1/endpoints"
+	forgetCustomerV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/forgettingcustomer/v1/endpoints"
+	streamOrderStatusV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/streamingorderstatus/v1/endpoints"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/models/orders/aggregate"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/projections"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/statusstream"
 )
 
 // Module is the orders module.
@@ -27,26 +32,46 @@ func Module() fx.Option {
 		fx.Provide(fx.Annotate(repositories.NewMongoOrderReadRepository)),
 		fx.Provide(repositories.NewElasticOrderReadRepository),
 
-		fx.Provide(eventstoredb.NewEventStoreAggregateStore[*aggregate.Order]),
-		fx.Provide(fx.Annotate(func(catalogsServer echocontracts.EchoHTTPServer) *echo.Group {
+		// the order events are encrypted with the keys of their customers, forgetting a customer destroys its key
+		fx.Provide(
+			fx.Annotate(encryption.NewMongoSubjectKeyStore, fx.As(new(encryption.SubjectKeyStore))),
+		),
+		fx.Provide(fx.Annotate(
+			eventstoredb.NewEventStoreAggregateStore[*aggregate.Order],
+			fx.ParamTags(``, ``, ``, ``, `optional:"true"`),
+		)),
+		fx.Provide(statusstream.NewOrderStatusHub),
+		fx.Provide(fx.Annotate(func(
+			catalogsServer echocontracts.EchoHTTPServer,
+			idempotencyManager *idempotency.Manager,
+			tokenValidator *authentication.TokenValidator,
+		) *echo.Group {
 			var g *echo.Group
 			catalogsServer.RouteBuilder().RegisterGroupFunc("/api/v1", func(v1 *echo.Group) {
-				group := v1.Group("/orders")
+				// the bearer token sets the principal of the endpoints that act on an account,
+				// retried commands with the same `Idempotency-Key` get the first response
+				group := v1.Group(
+					"/orders",
+					authenticationMiddleware.Authentication(tokenValidator),
+					idempotencyMiddleware.Idempotency(idempotencyManager),
+				)
 				g = group
 			})
 
 			return g
 		}, fx.ResultTags(`name:"order-echo-group"`))),
 
+		// the crud endpoints are served by the grpc gateway
 		fx.Provide(
-			route.AsRoute(createOrderV1.NewCreateOrderEndpoint, "order-routes"),
-			route.AsRoute(GetOrderByIDV1.NewGetOrderByIDEndpoint, "order-routes"),
-			route.AsRoute(getOrdersV1.NewGetOrdersEndpoint, "order-routes"),
+			route.AsRoute(streamOrderStatusV1.NewStreamOrderStatusSSEEndpoint, "order-routes"),
+			route.AsRoute(streamOrderStatusV1.NewStreamOrderStatusWebsocketEndpoint, "order-routes"),
+			route.AsRoute(forgetCustomerV1.NewForgetCustomerEndpoint, "order-routes"),
 		),
 
 		fx.Provide(
 			es.AsProjection(projections.NewElasticOrderProjection),
 			es.AsProjection(projections.NewMongoOrderProjection),
+			es.AsProjection(statusstream.NewOrderStatusProjection),
 		),
 	)
 }
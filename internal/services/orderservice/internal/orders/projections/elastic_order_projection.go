@@ -15,6 +15,8 @@ import (
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing/attribute"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing/utils"
 
+	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+	uuid "github.com/satori/go.uuid"
 	attribute2 "go.opentelemetry.io/otel/attribute"
 
 	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/contracts/repositories"
@@ -52,11 +54,11 @@ func (e *elasticOrderProjection) ProcessEvent(
 	// Handling and projecting event to elastic read model
 	switch evt := streamEvent.Event.(type) {
 	case *createOrderDomainEventsV1.OrderCreatedV1:
-		return e.onOrderCreated(ctx, evt)
+		return e.onOrderCreated(ctx, evt, readModelVersion(streamEvent))
 	case *updateOrderDomainEventsV1.ShoppingCartUpdatedV1:
-		return e.onShoppingCartUpdated(ctx, evt)
+		return e.onShoppingCartUpdated(ctx, evt, readModelVersion(streamEvent))
 	case *submitOrderDomainEventsV1.OrderSubmittedV1:
-		return e.onOrderSubmitted(ctx, evt)
+		return e.onOrderSubmitted(ctx, evt, readModelVersion(streamEvent))
 	default:
 		return nil
 	}
@@ -66,6 +68,7 @@ func (e *elasticOrderProjection) ProcessEvent(
 func (e *elasticOrderProjection) onOrderCreated(
 	ctx context.Context,
 	evt *createOrderDomainEventsV1.OrderCreatedV1,
+	version int64,
 ) error {
 	ctx, span := e.tracer.Start(ctx, "elasticOrderProjection.onOrderCreated")
 	span.SetAttributes(attribute.Object("Event", evt))
@@ -87,6 +90,7 @@ func (e *elasticOrderProjection) onOrderCreated(
 		evt.DeliveryAddress,
 		evt.DeliveredTime,
 	)
+	orderRead.Version = version
 
 	_, err = e.elasticOrderReadRepository.CreateOrder(ctx, orderRead)
 	if err != nil {
@@ -114,6 +118,7 @@ func (e *elasticOrderProjection) onOrderCreated(
 func (e *elasticOrderProjection) onShoppingCartUpdated(
 	ctx context.Context,
 	evt *updateOrderDomainEventsV1.ShoppingCartUpdatedV1,
+	version int64,
 ) error {
 	ctx, span := e.tracer.Start(ctx, "elasticOrderProjection.onShoppingCartUpdated")
 	span.SetAttributes(attribute.Object("Event", evt))
@@ -128,21 +133,16 @@ func (e *elasticOrderProjection) onShoppingCartUpdated(
 	}
 
 	// Get existing order
-	order, err := e.elasticOrderReadRepository.GetOrderByID(ctx, evt.GetAggregateID())
-	if err != nil {
-		return utils.TraceStatusFromSpan(
-			span,
-			errors.WrapIf(
-				err,
-				"[elasticOrderProjection_onShoppingCartUpdated.GetOrderByID] error in getting order",
-			),
-		)
+	order, err := e.getOrderForVersion(ctx, evt.GetAggregateID(), version)
+	if err != nil || order == nil {
+		return utils.TraceStatusFromSpan(span, err)
 	}
 
 	// Update order with new items
 	order.ShopItems = items
 	order.TotalPrice = getShopItemsTotalPrice(items)
 	order.UpdatedAt = time.Now()
+	order.Version = version
 
 	_, err = e.elasticOrderReadRepository.UpdateOrder(ctx, order)
 	if err != nil {
@@ -170,6 +170,7 @@ func (e *elasticOrderProjection) onShoppingCartUpdated(
 func (e *elasticOrderProjection) onOrderSubmitted(
 	ctx context.Context,
 	evt *submitOrderDomainEventsV1.OrderSubmittedV1,
+	version int64,
 ) error {
 	ctx, span := e.tracer.Start(ctx, "elasticOrderProjection.onOrderSubmitted")
 	span.SetAttributes(attribute.Object("Event", evt))
@@ -177,20 +178,15 @@ func (e *elasticOrderProjection) onOrderSubmitted(
 	defer span.End()
 
 	// Get existing order
-	order, err := e.elasticOrderReadRepository.GetOrderByID(ctx, evt.OrderID)
-	if err != nil {
-		return utils.TraceStatusFromSpan(
-			span,
-			errors.WrapIf(
-				err,
-				"[elasticOrderProjection_onOrderSubmitted.GetOrderByID] error in getting order",
-			),
-		)
+	order, err := e.getOrderForVersion(ctx, evt.OrderID, version)
+	if err != nil || order == nil {
+		return utils.TraceStatusFromSpan(span, err)
 	}
 
 	// Update order status
 	order.Submitted = true
 	order.UpdatedAt = time.Now()
+	order.Version = version
 
 	_, err = e.elasticOrderReadRepository.UpdateOrder(ctx, order)
 	if err != nil {
@@ -214,6 +210,39 @@ func (e *elasticOrderProjection) onOrderSubmitted(
 	return nil
 }
 
+// getOrderForVersion gets the read model of the order, it returns nil when the read model already has the version
+// of the event, so a redelivered event is not applied twice.
+func (e *elasticOrderProjection) getOrderForVersion(
+	ctx context.Context,
+	orderID uuid.UUID,
+	version int64,
+) (*readmodels.OrderReadModel, error) {
+	order, err := e.elasticOrderReadRepository.GetOrderByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, errors.WrapIf(
+			err,
+			"[elasticOrderProjection_getOrderForVersion.GetOrderByOrderID] error in getting order",
+		)
+	}
+
+	if order == nil {
+		return nil, customErrors.NewNotFoundError(
+			fmt.Sprintf("[elasticOrderProjection_getOrderForVersion] order with orderId '%s' not found", orderID),
+		)
+	}
+
+	if order.Version >= version {
+		return nil, nil
+	}
+
+	return order, nil
+}
+
+// readModelVersion returns the order version of the event, the number of the events in the stream up to the event.
+func readModelVersion(streamEvent *models.StreamEvent) int64 {
+	return streamEvent.Version + 1
+}
+
 // getShopItemsTotalPrice gets the total price of the shop items.
 func getShopItemsTotalPrice(shopItems []*readmodels.ShopItemReadModel) float64 {
 	var totalPrice float64
@@ -3,6 +3,7 @@ package projections
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"emperror.dev/errors"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core/messaging/producer"
@@ -15,12 +16,15 @@ import (
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing/utils"
 
 	customErrors "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/httperrors/customerrors"
+	uuid "github.com/satori/go.uuid"
 	attribute2 "go.opentelemetry.io/otel/attribute"
 
 	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/contracts/repositories"
 	dtosV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/dtos/v1"
 	createOrderDomainEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/creatingorder/v1/events/domainevents"
 	createOrderIntegrationEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/creatingorder/v1/events/integrationevents"
+	submitOrderDomainEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/submittingorder/v1/events/domainevents"
+	updateOrderDomainEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/updatingshoppingcard/v1/events"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/models/orders/readmodels"
 )
 
@@ -52,18 +56,24 @@ func (m *mongoOrderProjection) ProcessEvent(
 	ctx context.Context,
 	streamEvent *models.StreamEvent,
 ) error {
-	// Handling and projecting event to elastic read model
-	if evt, ok := streamEvent.Event.(*createOrderDomainEventsV1.OrderCreatedV1); ok {
-		return m.onOrderCreated(ctx, evt)
+	// Handling and projecting event to mongo read model
+	switch evt := streamEvent.Event.(type) {
+	case *createOrderDomainEventsV1.OrderCreatedV1:
+		return m.onOrderCreated(ctx, evt, readModelVersion(streamEvent))
+	case *updateOrderDomainEventsV1.ShoppingCartUpdatedV1:
+		return m.onShoppingCartUpdated(ctx, evt, readModelVersion(streamEvent))
+	case *submitOrderDomainEventsV1.OrderSubmittedV1:
+		return m.onOrderSubmitted(ctx, evt, readModelVersion(streamEvent))
+	default:
+		return nil
 	}
-
-	return nil
 }
 
 // onOrderCreated handles the order created event.
 func (m *mongoOrderProjection) onOrderCreated(
 	ctx context.Context,
 	evt *createOrderDomainEventsV1.OrderCreatedV1,
+	version int64,
 ) error {
 	ctx, span := m.tracer.Start(ctx, "mongoOrderProjection.onOrderCreated")
 	span.SetAttributes(attribute.Object("Event", evt))
@@ -85,6 +95,8 @@ func (m *mongoOrderProjection) onOrderCreated(
 		evt.DeliveryAddress,
 		evt.DeliveredTime,
 	)
+	orderRead.Version = version
+
 	_, err = m.mongoOrderRepository.CreateOrder(ctx, orderRead)
 	if err != nil {
 		return utils.TraceStatusFromSpan(
@@ -138,3 +150,124 @@ func (m *mongoOrderProjection) onOrderCreated(
 
 	return nil
 }
+
+// onShoppingCartUpdated handles the shopping cart updated event.
+func (m *mongoOrderProjection) onShoppingCartUpdated(
+	ctx context.Context,
+	evt *updateOrderDomainEventsV1.ShoppingCartUpdatedV1,
+	version int64,
+) error {
+	ctx, span := m.tracer.Start(ctx, "mongoOrderProjection.onShoppingCartUpdated")
+	span.SetAttributes(attribute.Object("Event", evt))
+	span.SetAttributes(attribute2.String("OrderID", evt.OrderID.String()))
+	defer span.End()
+
+	items, err := mapper.Map[[]*readmodels.ShopItemReadModel](evt.ShopItems)
+	if err != nil {
+		return errors.WrapIf(
+			err,
+			"[mongoOrderProjection_onShoppingCartUpdated.Map] error in mapping shopItems",
+		)
+	}
+
+	order, err := m.getOrderForVersion(ctx, evt.OrderID, version)
+	if err != nil || order == nil {
+		return utils.TraceStatusFromSpan(span, err)
+	}
+
+	order.ShopItems = items
+	order.TotalPrice = getShopItemsTotalPrice(items)
+	order.UpdatedAt = time.Now()
+	order.Version = version
+
+	_, err = m.mongoOrderRepository.UpdateOrder(ctx, order)
+	if err != nil {
+		return utils.TraceStatusFromSpan(
+			span,
+			errors.WrapIf(
+				err,
+				"[mongoOrderProjection_onShoppingCartUpdated.UpdateOrder] error in updating order with mongoOrderRepository",
+			),
+		)
+	}
+
+	m.logger.Infow(
+		fmt.Sprintf(
+			"[mongoOrderProjection.onShoppingCartUpdated] order with id '%s' updated",
+			order.OrderID,
+		),
+		logger.Fields{"ID": order.OrderID, "Version": order.Version},
+	)
+
+	return nil
+}
+
+// onOrderSubmitted handles the order submitted event.
+func (m *mongoOrderProjection) onOrderSubmitted(
+	ctx context.Context,
+	evt *submitOrderDomainEventsV1.OrderSubmittedV1,
+	version int64,
+) error {
+	ctx, span := m.tracer.Start(ctx, "mongoOrderProjection.onOrderSubmitted")
+	span.SetAttributes(attribute.Object("Event", evt))
+	span.SetAttributes(attribute2.String("OrderID", evt.OrderID.String()))
+	defer span.End()
+
+	order, err := m.getOrderForVersion(ctx, evt.OrderID, version)
+	if err != nil || order == nil {
+		return utils.TraceStatusFromSpan(span, err)
+	}
+
+	order.Submitted = true
+	order.UpdatedAt = time.Now()
+	order.Version = version
+
+	_, err = m.mongoOrderRepository.UpdateOrder(ctx, order)
+	if err != nil {
+		return utils.TraceStatusFromSpan(
+			span,
+			errors.WrapIf(
+				err,
+				"[mongoOrderProjection_onOrderSubmitted.UpdateOrder] error in updating order with mongoOrderRepository",
+			),
+		)
+	}
+
+	m.logger.Infow(
+		fmt.Sprintf(
+			"[mongoOrderProjection.onOrderSubmitted] order with id '%s' submitted",
+			order.OrderID,
+		),
+		logger.Fields{"ID": order.OrderID, "Version": order.Version},
+	)
+
+	return nil
+}
+
+// getOrderForVersion gets the read model of the order, it returns nil when the read model already has the version
+// of the event, so a redelivered event is not applied twice.
+func (m *mongoOrderProjection) getOrderForVersion(
+	ctx context.Context,
+	orderID uuid.UUID,
+	version int64,
+) (*readmodels.OrderReadModel, error) {
+	order, err := m.mongoOrderRepository.GetOrderByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, errors.WrapIf(
+			err,
+			"[mongoOrderProjection_getOrderForVersion.GetOrderByOrderID] error in getting order with mongoOrderRepository",
+		)
+	}
+
+	if order == nil {
+		return nil, customErrors.NewNotFoundError(
+			fmt.Sprintf("[mongoOrderProjection_getOrderForVersion] order with orderId '%s' not found", orderID),
+		)
+	}
+
+	if order.Version >= version {
+		return nil, nil
+	}
+
+	return order, nil
+}
@@ -0,0 +1,91 @@
+// Package statusstream contains the order status hub.
+package statusstream
+
+import (
+	"sync"
+)
+
+// DefaultBufferSize is the number of updates a subscriber can fall behind before it is dropped.
+const DefaultBufferSize = 32
+
+// Subscription receives the order status updates that match its filter.
+type Subscription struct {
+	filter  Filter
+	updates chan *OrderStatusUpdate
+	lagged  bool
+}
+
+// Updates returns the channel of the updates, it is closed when the subscription is canceled or dropped.
+func (s *Subscription) Updates() <-chan *OrderStatusUpdate {
+	return s.updates
+}
+
+// Lagged reports whether the subscription was dropped because it didn't keep up with the updates,
+// it is only meaningful after the updates channel is closed.
+func (s *Subscription) Lagged() bool {
+	return s.lagged
+}
+
+// OrderStatusHub fans the order status updates out to the subscribers of this instance.
+type OrderStatusHub struct {
+	mu          sync.Mutex
+	subscribers map[*Subscription]struct{}
+	bufferSize  int
+}
+
+// NewOrderStatusHub creates a new order status hub.
+func NewOrderStatusHub() *OrderStatusHub {
+	return &OrderStatusHub{
+		subscribers: make(map[*Subscription]struct{}),
+		bufferSize:  DefaultBufferSize,
+	}
+}
+
+// Subscribe creates a subscription, the returned cancel func must be called when the subscriber is done.
+func (h *OrderStatusHub) Subscribe(filter Filter) (*Subscription, func()) {
+	sub := &Subscription{
+		filter:  filter,
+		updates: make(chan *OrderStatusUpdate, h.bufferSize),
+	}
+
+	h.mu.Lock()
+	h.subscribers[sub] = struct{}{}
+	h.mu.Unlock()
+
+	return sub, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+
+		h.remove(sub)
+	}
+}
+
+// Publish sends the update to the matching subscribers without blocking the projection, a subscriber
+// with a full buffer is dropped, so a slow client never holds back the others.
+func (h *OrderStatusHub) Publish(update *OrderStatusUpdate) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subscribers {
+		if !sub.filter.Matches(update) {
+			continue
+		}
+
+		select {
+		case sub.updates <- update:
+		default:
+			sub.lagged = true
+			h.remove(sub)
+		}
+	}
+}
+
+// remove closes and removes a subscription, the lock must be held.
+func (h *OrderStatusHub) remove(sub *Subscription) {
+	if _, ok := h.subscribers[sub]; !ok {
+		return
+	}
+
+	delete(h.subscribers, sub)
+	close(sub.updates)
+}
@@ -0,0 +1,69 @@
+//go:build unit
+// +build unit
+
+// Package statusstream contains the order status hub tests.
+package statusstream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// TestOrderStatusHubFiltersUpdates tests the subscribers only receive the matching updates.
+func TestOrderStatusHubFiltersUpdates(t *testing.T) {
+	t.Parallel()
+
+	hub := NewOrderStatusHub()
+	orderID := uuid.NewV4().String()
+
+	byOrder, cancelByOrder := hub.Subscribe(Filter{OrderID: orderID})
+	defer cancelByOrder()
+	byAccount, cancelByAccount := hub.Subscribe(Filter{AccountEmail: "john@example.com"})
+	defer cancelByAccount()
+
+	hub.Publish(&OrderStatusUpdate{OrderID: orderID, AccountEmail: "John@Example.com"})
+	hub.Publish(&OrderStatusUpdate{OrderID: uuid.NewV4().String(), AccountEmail: "jane@example.com"})
+
+	require.Len(t, byOrder.Updates(), 1)
+	assert.Equal(t, orderID, (<-byOrder.Updates()).OrderID)
+
+	require.Len(t, byAccount.Updates(), 1)
+	assert.Equal(t, orderID, (<-byAccount.Updates()).OrderID)
+}
+
+// TestOrderStatusHubDropsLaggingSubscriber tests a subscriber with a full buffer is dropped without blocking the others.
+func TestOrderStatusHubDropsLaggingSubscriber(t *testing.T) {
+	t.Parallel()
+
+	hub := NewOrderStatusHub()
+	orderID := uuid.NewV4().String()
+
+	slow, cancelSlow := hub.Subscribe(Filter{OrderID: orderID})
+	defer cancelSlow()
+
+	for i := 0; i <= DefaultBufferSize; i++ {
+		hub.Publish(&OrderStatusUpdate{OrderID: orderID})
+	}
+
+	received := 0
+	for range slow.Updates() {
+		received++
+	}
+
+	assert.Equal(t, DefaultBufferSize, received)
+	assert.True(t, slow.Lagged())
+
+	fresh, cancelFresh := hub.Subscribe(Filter{OrderID: orderID})
+	hub.Publish(&OrderStatusUpdate{OrderID: orderID})
+	cancelFresh()
+
+	_, ok := <-fresh.Updates()
+	assert.True(t, ok)
+	_, ok = <-fresh.Updates()
+	assert.False(t, ok)
+	assert.False(t, fresh.Lagged())
+}
@@ -0,0 +1,109 @@
+// Package statusstream contains the order status projection.
+package statusstream
+
+import (
+	"context"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/es/contracts/projection"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/es/contracts/store"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/es/models"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
+
+	uuid "github.com/satori/go.uuid"
+
+	createOrderDomainEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/creatingorder/v1/events/domainevents"
+	submitOrderDomainEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/submittingorder/v1/events/domainevents"
+	updateOrderDomainEventsV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/updatingshoppingcard/v1/events"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/models/orders/aggregate"
+)
+
+// orderStatusProjection publishes the projected order events to the order status hub.
+type orderStatusProjection struct {
+	hub            *OrderStatusHub
+	aggregateStore store.AggregateStore[*aggregate.Order]
+	logger         logger.Logger
+}
+
+// NewOrderStatusProjection creates a new order status projection.
+func NewOrderStatusProjection(
+	hub *OrderStatusHub,
+	aggregateStore store.AggregateStore[*aggregate.Order],
+	log logger.Logger,
+) projection.IProjection {
+	return &orderStatusProjection{
+		hub:            hub,
+		aggregateStore: aggregateStore,
+		logger:         log,
+	}
+}
+
+// ProcessEvent processes the event, the status is the one of the event, so the updates don't depend on the other
+// projections. The updates are best effort so an error never fails the projection pipeline.
+func (p *orderStatusProjection) ProcessEvent(
+	ctx context.Context,
+	streamEvent *models.StreamEvent,
+) error {
+	update := &OrderStatusUpdate{
+		EventID:    streamEvent.EventID.String(),
+		Version:    streamEvent.Version,
+		OccurredAt: streamEvent.Event.GetOccurredOn(),
+	}
+
+	switch evt := streamEvent.Event.(type) {
+	case *createOrderDomainEventsV1.OrderCreatedV1:
+		update.Type = UpdateTypeOrderCreated
+		update.OrderID = evt.OrderID.String()
+		update.AccountEmail = evt.AccountEmail
+		update.Status = OrderStatusCreated
+		for _, item := range evt.ShopItems {
+			update.TotalPrice += item.Price * float64(item.Quantity)
+		}
+	case *updateOrderDomainEventsV1.ShoppingCartUpdatedV1:
+		// the cart of a submitted order can't be changed, so the order is still created
+		update.Type = UpdateTypeShoppingCartUpdated
+		update.OrderID = evt.OrderID.String()
+		update.Status = OrderStatusCreated
+		for _, item := range evt.ShopItems {
+			update.TotalPrice += item.Price() * float64(item.Quantity())
+		}
+		order := p.loadOrder(ctx, evt.OrderID)
+		if order == nil {
+			return nil
+		}
+		update.AccountEmail = order.AccountEmail()
+	case *submitOrderDomainEventsV1.OrderSubmittedV1:
+		update.Type = UpdateTypeOrderSubmitted
+		update.OrderID = evt.OrderID.String()
+		update.Status = OrderStatusSubmitted
+		order := p.loadOrder(ctx, evt.OrderID)
+		if order == nil {
+			return nil
+		}
+		update.AccountEmail = order.AccountEmail()
+		update.TotalPrice = order.TotalPrice()
+	default:
+		return nil
+	}
+
+	p.hub.Publish(update)
+
+	return nil
+}
+
+// loadOrder returns the order aggregate, the events after the creation don't carry the account email which the
+// subscribers are filtered by. The aggregate is read from the event store, which already has the event, so it
+// doesn't depend on the order the projections run in. Nil is returned when the order can't be loaded.
+func (p *orderStatusProjection) loadOrder(ctx context.Context, orderID uuid.UUID) *aggregate.Order {
+	order, err := p.aggregateStore.Load(ctx, orderID)
+	if err != nil || order == nil {
+		p.logger.Warnf(
+			"[orderStatusProjection.loadOrder] skipping the status update of order '%s', err: %v",
+			orderID,
+			err,
+		)
+
+		return nil
+	}
+
+	return order
+}
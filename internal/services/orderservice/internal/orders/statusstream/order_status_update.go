@@ -0,0 +1,60 @@
+// Package statusstream contains the live order status updates streamed to the clients.
+package statusstream
+
+import (
+	"strings"
+	"time"
+)
+
+// UpdateType is the kind of change of an order status update.
+type UpdateType string
+
+// the update types of the order events.
+const (
+	UpdateTypeOrderCreated        UpdateType = "order_created"
+	UpdateTypeShoppingCartUpdated UpdateType = "shopping_cart_updated"
+	UpdateTypeOrderSubmitted      UpdateType = "order_submitted"
+)
+
+// OrderStatus is the status of an order.
+type OrderStatus string
+
+// the statuses of an order.
+const (
+	OrderStatusCreated   OrderStatus = "created"
+	OrderStatusSubmitted OrderStatus = "submitted"
+	OrderStatusPaid      OrderStatus = "paid"
+	OrderStatusCompleted OrderStatus = "completed"
+	OrderStatusCanceled  OrderStatus = "canceled"
+)
+
+// OrderStatusUpdate is the update sent to the subscribers when an order event is projected.
+type OrderStatusUpdate struct {
+	EventID      string      `json:"eventId"`
+	Type         UpdateType  `json:"type"`
+	OrderID      string      `json:"orderId"`
+	AccountEmail string      `json:"accountEmail"`
+	Status       OrderStatus `json:"status"`
+	TotalPrice   float64     `json:"totalPrice"`
+	Version      int64       `json:"version"`
+	OccurredAt   time.Time   `json:"occurredAt"`
+}
+
+// Filter selects the updates of a subscriber, the empty fields match all the orders.
+type Filter struct {
+	OrderID      string
+	AccountEmail string
+}
+
+// Matches reports whether the update passes the filter.
+func (f Filter) Matches(update *OrderStatusUpdate) bool {
+	if f.OrderID != "" && !strings.EqualFold(f.OrderID, update.OrderID) {
+		return false
+	}
+
+	if f.AccountEmail != "" && !strings.EqualFold(f.AccountEmail, update.AccountEmail) {
+		return false
+	}
+
+	return true
+}
@@ -3,16 +3,20 @@ package infrastructure
 
 import (
 	"github.com/go-playground/validator"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/authentication"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/core"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/elasticsearch"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/encryption"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/eventstoredb"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/grpc"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/health"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/idempotency"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/mongodb"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/metrics"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/rabbitmq/configurations"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/redis"
 	"go.uber.org/fx"
 
 	customEcho "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/customecho"
@@ -29,6 +33,7 @@ func Module() fx.Option {
 		"infrastructurefx",
 		// Modules
 		core.Module,
+		encryption.Module,
 		customEcho.Module,
 		grpc.Module,
 		mongodb.Module,
@@ -47,6 +52,9 @@ func Module() fx.Option {
 				}
 			},
 		),
+		redis.Module,
+		idempotency.Module,
+		authentication.Module,
 		health.Module,
 		tracing.Module,
 		metrics.Module,
@@ -144,14 +144,23 @@ func configHTTPMetrics(meter metric.Meter, serviceName string) (*contracts.HTTPM
 		return nil, err
 	}
 
+	forgetCustomerHTTPRequests, err := meter.Float64Counter(
+		fmt.Sprintf("%s_forget_customer_http_requests_total", serviceName),
+		metric.WithDescription("The total number of forget customer http requests"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &contracts.HTTPMetrics{
-		GetOrdersHTTPRequests:    getOrdersHTTPRequests,
-		CreateOrderHTTPRequests:  createOrderHTTPRequests,
-		UpdateOrderHTTPRequests:  updateOrderHTTPRequests,
-		PayOrderHTTPRequests:     payOrderHTTPRequests,
-		SubmitOrderHTTPRequests:  submitOrderHTTPRequests,
-		GetOrderByIDHTTPRequests: getOrderByIDHTTPRequests,
-		SearchOrderHTTPRequests:  searchOrderHTTPRequests,
+		GetOrdersHTTPRequests:      getOrdersHTTPRequests,
+		CreateOrderHTTPRequests:    createOrderHTTPRequests,
+		UpdateOrderHTTPRequests:    updateOrderHTTPRequests,
+		PayOrderHTTPRequests:       payOrderHTTPRequests,
+		SubmitOrderHTTPRequests:    submitOrderHTTPRequests,
+		GetOrderByIDHTTPRequests:   getOrderByIDHTTPRequests,
+		SearchOrderHTTPRequests:    searchOrderHTTPRequests,
+		ForgetCustomerHTTPRequests: forgetCustomerHTTPRequests,
 	}, nil
 }
 
@@ -20,13 +20,14 @@ type GrpcMetrics struct {
 
 // HTTPMetrics contains the HTTP metrics.
 type HTTPMetrics struct {
-	GetOrdersHTTPRequests    metric.Float64Counter
-	CreateOrderHTTPRequests  metric.Float64Counter
-	UpdateOrderHTTPRequests  metric.Float64Counter
-	PayOrderHTTPRequests     metric.Float64Counter
-	SubmitOrderHTTPRequests  metric.Float64Counter
-	GetOrderByIDHTTPRequests metric.Float64Counter
-	SearchOrderHTTPRequests  metric.Float64Counter
+	GetOrdersHTTPRequests      metric.Float64Counter
+	CreateOrderHTTPRequests    metric.Float64Counter
+	UpdateOrderHTTPRequests    metric.Float64Counter
+	PayOrderHTTPRequests       metric.Float64Counter
+	SubmitOrderHTTPRequests    metric.Float64Counter
+	GetOrderByIDHTTPRequests   metric.Float64Counter
+	SearchOrderHTTPRequests    metric.Float64Counter
+	ForgetCustomerHTTPRequests metric.Float64Counter
 }
 
 // RabbitMQMetrics contains the RabbitMQ metrics.
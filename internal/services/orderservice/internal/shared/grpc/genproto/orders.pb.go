@@ -7,6 +7,7 @@
 package orders_service
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -106,8 +107,10 @@ type Order struct {
 	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
 	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
 	PaymentID       string                 `protobuf:"bytes,14,opt,name=PaymentID,proto3" json:"PaymentID,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// the number of the events in the order stream, sent back as the expected version of the commands
+	Version       int64 `protobuf:"varint,15,opt,name=Version,proto3" json:"Version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Order) Reset() {
@@ -238,6 +241,13 @@ func (x *Order) GetPaymentID() string {
 	return ""
 }
 
+func (x *Order) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type OrderReadModel struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ID              string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
@@ -255,6 +265,7 @@ type OrderReadModel struct {
 	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
 	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
 	PaymentID       string                 `protobuf:"bytes,15,opt,name=PaymentID,proto3" json:"PaymentID,omitempty"`
+	Version         int64                  `protobuf:"varint,16,opt,name=Version,proto3" json:"Version,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -394,6 +405,13 @@ func (x *OrderReadModel) GetPaymentID() string {
 	return ""
 }
 
+func (x *OrderReadModel) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type ShopItemReadModel struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=Title,proto3" json:"Title,omitempty"`
@@ -533,6 +551,7 @@ func (x *CreateOrderReq) GetDeliveryTime() *timestamppb.Timestamp {
 type CreateOrderRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderID       string                 `protobuf:"bytes,1,opt,name=OrderID,proto3" json:"OrderID,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -574,11 +593,20 @@ func (x *CreateOrderRes) GetOrderID() string {
 	return ""
 }
 
+func (x *CreateOrderRes) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type SubmitOrderReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderID       string                 `protobuf:"bytes,1,opt,name=OrderID,proto3" json:"OrderID,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderID string                 `protobuf:"bytes,1,opt,name=OrderID,proto3" json:"OrderID,omitempty"`
+	// 0 submits the latest version, otherwise a changed order is rejected with ABORTED
+	ExpectedVersion int64 `protobuf:"varint,2,opt,name=ExpectedVersion,proto3" json:"ExpectedVersion,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *SubmitOrderReq) Reset() {
@@ -618,9 +646,17 @@ func (x *SubmitOrderReq) GetOrderID() string {
 	return ""
 }
 
+func (x *SubmitOrderReq) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 type SubmitOrderRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderID       string                 `protobuf:"bytes,1,opt,name=OrderID,proto3" json:"OrderID,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -662,6 +698,13 @@ func (x *SubmitOrderRes) GetOrderID() string {
 	return ""
 }
 
+func (x *SubmitOrderRes) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type GetOrderByIDReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ID            string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
@@ -751,11 +794,13 @@ func (x *GetOrderByIDRes) GetOrder() *OrderReadModel {
 }
 
 type UpdateShoppingCartReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderID       string                 `protobuf:"bytes,1,opt,name=OrderID,proto3" json:"OrderID,omitempty"`
-	ShopItems     []*ShopItem            `protobuf:"bytes,2,rep,name=ShopItems,proto3" json:"ShopItems,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	OrderID   string                 `protobuf:"bytes,1,opt,name=OrderID,proto3" json:"OrderID,omitempty"`
+	ShopItems []*ShopItem            `protobuf:"bytes,2,rep,name=ShopItems,proto3" json:"ShopItems,omitempty"`
+	// 0 updates the latest version, otherwise a changed order is rejected with ABORTED
+	ExpectedVersion int64 `protobuf:"varint,3,opt,name=ExpectedVersion,proto3" json:"ExpectedVersion,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UpdateShoppingCartReq) Reset() {
@@ -802,8 +847,17 @@ func (x *UpdateShoppingCartReq) GetShopItems() []*ShopItem {
 	return nil
 }
 
+func (x *UpdateShoppingCartReq) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 type UpdateShoppingCartRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderID       string                 `protobuf:"bytes,1,opt,name=OrderID,proto3" json:"OrderID,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -838,6 +892,20 @@ func (*UpdateShoppingCartRes) Descriptor() ([]byte, []int) {
 	return file_orders_proto_rawDescGZIP(), []int{11}
 }
 
+func (x *UpdateShoppingCartRes) GetOrderID() string {
+	if x != nil {
+		return x.OrderID
+	}
+	return ""
+}
+
+func (x *UpdateShoppingCartRes) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type GetOrdersReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SearchText    string                 `protobuf:"bytes,1,opt,name=SearchText,proto3" json:"SearchText,omitempty"`
@@ -1026,16 +1094,77 @@ func (x *Pagination) GetHasMore() bool {
 	return false
 }
 
+// OrderCreatedV1 is the contract of the order created integration event.
+type OrderCreatedV1 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageID     string                 `protobuf:"bytes,1,opt,name=MessageID,proto3" json:"MessageID,omitempty"`
+	Created       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=Created,proto3" json:"Created,omitempty"`
+	Order         *OrderReadModel        `protobuf:"bytes,3,opt,name=Order,proto3" json:"Order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderCreatedV1) Reset() {
+	*x = OrderCreatedV1{}
+	mi := &file_orders_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderCreatedV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderCreatedV1) ProtoMessage() {}
+
+func (x *OrderCreatedV1) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderCreatedV1.ProtoReflect.Descriptor instead.
+func (*OrderCreatedV1) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *OrderCreatedV1) GetMessageID() string {
+	if x != nil {
+		return x.MessageID
+	}
+	return ""
+}
+
+func (x *OrderCreatedV1) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *OrderCreatedV1) GetOrder() *OrderReadModel {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
 var File_orders_proto protoreflect.FileDescriptor
 
 const file_orders_proto_rawDesc = "" +
 	"\n" +
-	"\forders.proto\x12\x0eorders_service\x1a\x1fgoogle/protobuf/timestamp.proto\"t\n" +
+	"\forders.proto\x12\x0eorders_service\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"t\n" +
 	"\bShopItem\x12\x14\n" +
 	"\x05Title\x18\x01 \x01(\tR\x05Title\x12 \n" +
 	"\vDescription\x18\x02 \x01(\tR\vDescription\x12\x1a\n" +
 	"\bQuantity\x18\x03 \x01(\x04R\bQuantity\x12\x14\n" +
-	"\x05Price\x18\x04 \x01(\x01R\x05Price\"\xab\x04\n" +
+	"\x05Price\x18\x04 \x01(\x01R\x05Price\"\xc5\x04\n" +
 	"\x05Order\x12\x18\n" +
 	"\aOrderID\x18\x01 \x01(\tR\aOrderID\x126\n" +
 	"\tShopItems\x18\x02 \x03(\v2\x18.orders_service.ShopItemR\tShopItems\x12\x12\n" +
@@ -1053,7 +1182,8 @@ const file_orders_proto_rawDesc = "" +
 	"\rDeliveredTime\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\rDeliveredTime\x128\n" +
 	"\tCreatedAt\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tCreatedAt\x128\n" +
 	"\tUpdatedAt\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tUpdatedAt\x12\x1c\n" +
-	"\tPaymentID\x18\x0e \x01(\tR\tPaymentID\"\xcd\x04\n" +
+	"\tPaymentID\x18\x0e \x01(\tR\tPaymentID\x12\x18\n" +
+	"\aVersion\x18\x0f \x01(\x03R\aVersion\"\xe7\x04\n" +
 	"\x0eOrderReadModel\x12\x0e\n" +
 	"\x02ID\x18\x01 \x01(\tR\x02ID\x12\x18\n" +
 	"\aOrderID\x18\x02 \x01(\tR\aOrderID\x12?\n" +
@@ -1072,7 +1202,8 @@ const file_orders_proto_rawDesc = "" +
 	"\rDeliveredTime\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\rDeliveredTime\x128\n" +
 	"\tCreatedAt\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tCreatedAt\x128\n" +
 	"\tUpdatedAt\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tUpdatedAt\x12\x1c\n" +
-	"\tPaymentID\x18\x0f \x01(\tR\tPaymentID\"}\n" +
+	"\tPaymentID\x18\x0f \x01(\tR\tPaymentID\x12\x18\n" +
+	"\aVersion\x18\x10 \x01(\x03R\aVersion\"}\n" +
 	"\x11ShopItemReadModel\x12\x14\n" +
 	"\x05Title\x18\x01 \x01(\tR\x05Title\x12 \n" +
 	"\vDescription\x18\x02 \x01(\tR\vDescription\x12\x1a\n" +
@@ -1082,21 +1213,27 @@ const file_orders_proto_rawDesc = "" +
 	"\fAccountEmail\x18\x01 \x01(\tR\fAccountEmail\x126\n" +
 	"\tShopItems\x18\x02 \x03(\v2\x18.orders_service.ShopItemR\tShopItems\x12(\n" +
 	"\x0fDeliveryAddress\x18\x03 \x01(\tR\x0fDeliveryAddress\x12>\n" +
-	"\fDeliveryTime\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fDeliveryTime\"*\n" +
+	"\fDeliveryTime\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fDeliveryTime\"D\n" +
 	"\x0eCreateOrderRes\x12\x18\n" +
-	"\aOrderID\x18\x01 \x01(\tR\aOrderID\"*\n" +
+	"\aOrderID\x18\x01 \x01(\tR\aOrderID\x12\x18\n" +
+	"\aVersion\x18\x02 \x01(\x03R\aVersion\"T\n" +
 	"\x0eSubmitOrderReq\x12\x18\n" +
-	"\aOrderID\x18\x01 \x01(\tR\aOrderID\"*\n" +
+	"\aOrderID\x18\x01 \x01(\tR\aOrderID\x12(\n" +
+	"\x0fExpectedVersion\x18\x02 \x01(\x03R\x0fExpectedVersion\"D\n" +
 	"\x0eSubmitOrderRes\x12\x18\n" +
-	"\aOrderID\x18\x01 \x01(\tR\aOrderID\"!\n" +
+	"\aOrderID\x18\x01 \x01(\tR\aOrderID\x12\x18\n" +
+	"\aVersion\x18\x02 \x01(\x03R\aVersion\"!\n" +
 	"\x0fGetOrderByIDReq\x12\x0e\n" +
 	"\x02ID\x18\x01 \x01(\tR\x02ID\"G\n" +
 	"\x0fGetOrderByIDRes\x124\n" +
-	"\x05Order\x18\x01 \x01(\v2\x1e.orders_service.OrderReadModelR\x05Order\"i\n" +
+	"\x05Order\x18\x01 \x01(\v2\x1e.orders_service.OrderReadModelR\x05Order\"\x93\x01\n" +
 	"\x15UpdateShoppingCartReq\x12\x18\n" +
 	"\aOrderID\x18\x01 \x01(\tR\aOrderID\x126\n" +
-	"\tShopItems\x18\x02 \x03(\v2\x18.orders_service.ShopItemR\tShopItems\"\x17\n" +
-	"\x15UpdateShoppingCartRes\"V\n" +
+	"\tShopItems\x18\x02 \x03(\v2\x18.orders_service.ShopItemR\tShopItems\x12(\n" +
+	"\x0fExpectedVersion\x18\x03 \x01(\x03R\x0fExpectedVersion\"K\n" +
+	"\x15UpdateShoppingCartRes\x12\x18\n" +
+	"\aOrderID\x18\x01 \x01(\tR\aOrderID\x12\x18\n" +
+	"\aVersion\x18\x02 \x01(\x03R\aVersion\"V\n" +
 	"\fGetOrdersReq\x12\x1e\n" +
 	"\n" +
 	"SearchText\x18\x01 \x01(\tR\n" +
@@ -1118,13 +1255,17 @@ const file_orders_proto_rawDesc = "" +
 	"TotalPages\x12\x12\n" +
 	"\x04Page\x18\x03 \x01(\x05R\x04Page\x12\x12\n" +
 	"\x04Size\x18\x04 \x01(\x05R\x04Size\x12\x18\n" +
-	"\aHasMore\x18\x05 \x01(\bR\aHasMore2\xac\x03\n" +
-	"\rOrdersService\x12M\n" +
-	"\vCreateOrder\x12\x1e.orders_service.CreateOrderReq\x1a\x1e.orders_service.CreateOrderRes\x12M\n" +
-	"\vSubmitOrder\x12\x1e.orders_service.SubmitOrderReq\x1a\x1e.orders_service.SubmitOrderRes\x12b\n" +
-	"\x12UpdateShoppingCart\x12%.orders_service.UpdateShoppingCartReq\x1a%.orders_service.UpdateShoppingCartRes\x12P\n" +
-	"\fGetOrderByID\x12\x1f.orders_service.GetOrderByIDReq\x1a\x1f.orders_service.GetOrderByIDRes\x12G\n" +
-	"\tGetOrders\x12\x1c.orders_service.GetOrdersReq\x1a\x1c.orders_service.GetOrdersResB\x13Z\x11./;orders_serviceb\x06proto3"
+	"\aHasMore\x18\x05 \x01(\bR\aHasMore\"\x9a\x01\n" +
+	"\x0eOrderCreatedV1\x12\x1c\n" +
+	"\tMessageID\x18\x01 \x01(\tR\tMessageID\x124\n" +
+	"\aCreated\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aCreated\x124\n" +
+	"\x05Order\x18\x03 \x01(\v2\x1e.orders_service.OrderReadModelR\x05Order2\xdc\x04\n" +
+	"\rOrdersService\x12h\n" +
+	"\vCreateOrder\x12\x1e.orders_service.CreateOrderReq\x1a\x1e.orders_service.CreateOrderRes\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/api/v1/orders\x12y\n" +
+	"\vSubmitOrder\x12\x1e.orders_service.SubmitOrderReq\x1a\x1e.orders_service.SubmitOrderRes\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/orders/{OrderID}/submit\x12\x95\x01\n" +
+	"\x12UpdateShoppingCart\x12%.orders_service.UpdateShoppingCartReq\x1a%.orders_service.UpdateShoppingCartRes\"1\x82\xd3\xe4\x93\x02+:\x01*\x1a&/api/v1/orders/{OrderID}/shopping-cart\x12m\n" +
+	"\fGetOrderByID\x12\x1f.orders_service.GetOrderByIDReq\x1a\x1f.orders_service.GetOrderByIDRes\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/orders/{ID}\x12_\n" +
+	"\tGetOrders\x12\x1c.orders_service.GetOrdersReq\x1a\x1c.orders_service.GetOrdersRes\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/ordersB\x13Z\x11./;orders_serviceb\x06proto3"
 
 var (
 	file_orders_proto_rawDescOnce sync.Once
@@ -1138,7 +1279,7 @@ func file_orders_proto_rawDescGZIP() []byte {
 	return file_orders_proto_rawDescData
 }
 
-var file_orders_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_orders_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_orders_proto_goTypes = []any{
 	(*ShopItem)(nil),              // 0: orders_service.ShopItem
 	(*Order)(nil),                 // 1: orders_service.Order
@@ -1155,38 +1296,41 @@ var file_orders_proto_goTypes = []any{
 	(*GetOrdersReq)(nil),          // 12: orders_service.GetOrdersReq
 	(*GetOrdersRes)(nil),          // 13: orders_service.GetOrdersRes
 	(*Pagination)(nil),            // 14: orders_service.Pagination
-	(*timestamppb.Timestamp)(nil), // 15: google.protobuf.Timestamp
+	(*OrderCreatedV1)(nil),        // 15: orders_service.OrderCreatedV1
+	(*timestamppb.Timestamp)(nil), // 16: google.protobuf.Timestamp
 }
 var file_orders_proto_depIdxs = []int32{
 	0,  // 0: orders_service.Order.ShopItems:type_name -> orders_service.ShopItem
-	15, // 1: orders_service.Order.DeliveredTime:type_name -> google.protobuf.Timestamp
-	15, // 2: orders_service.Order.CreatedAt:type_name -> google.protobuf.Timestamp
-	15, // 3: orders_service.Order.UpdatedAt:type_name -> google.protobuf.Timestamp
+	16, // 1: orders_service.Order.DeliveredTime:type_name -> google.protobuf.Timestamp
+	16, // 2: orders_service.Order.CreatedAt:type_name -> google.protobuf.Timestamp
+	16, // 3: orders_service.Order.UpdatedAt:type_name -> google.protobuf.Timestamp
 	3,  // 4: orders_service.OrderReadModel.ShopItems:type_name -> orders_service.ShopItemReadModel
-	15, // 5: orders_service.OrderReadModel.DeliveredTime:type_name -> google.protobuf.Timestamp
-	15, // 6: orders_service.OrderReadModel.CreatedAt:type_name -> google.protobuf.Timestamp
-	15, // 7: orders_service.OrderReadModel.UpdatedAt:type_name -> google.protobuf.Timestamp
+	16, // 5: orders_service.OrderReadModel.DeliveredTime:type_name -> google.protobuf.Timestamp
+	16, // 6: orders_service.OrderReadModel.CreatedAt:type_name -> google.protobuf.Timestamp
+	16, // 7: orders_service.OrderReadModel.UpdatedAt:type_name -> google.protobuf.Timestamp
 	0,  // 8: orders_service.CreateOrderReq.ShopItems:type_name -> orders_service.ShopItem
-	15, // 9: orders_service.CreateOrderReq.DeliveryTime:type_name -> google.protobuf.Timestamp
+	16, // 9: orders_service.CreateOrderReq.DeliveryTime:type_name -> google.protobuf.Timestamp
 	2,  // 10: orders_service.GetOrderByIDRes.Order:type_name -> orders_service.OrderReadModel
 	0,  // 11: orders_service.UpdateShoppingCartReq.ShopItems:type_name -> orders_service.ShopItem
 	14, // 12: orders_service.GetOrdersRes.Pagination:type_name -> orders_service.Pagination
 	2,  // 13: orders_service.GetOrdersRes.Orders:type_name -> orders_service.OrderReadModel
-	4,  // 14: orders_service.OrdersService.CreateOrder:input_type -> orders_service.CreateOrderReq
-	6,  // 15: orders_service.OrdersService.SubmitOrder:input_type -> orders_service.SubmitOrderReq
-	10, // 16: orders_service.OrdersService.UpdateShoppingCart:input_type -> orders_service.UpdateShoppingCartReq
-	8,  // 17: orders_service.OrdersService.GetOrderByID:input_type -> orders_service.GetOrderByIDReq
-	12, // 18: orders_service.OrdersService.GetOrders:input_type -> orders_service.GetOrdersReq
-	5,  // 19: orders_service.OrdersService.CreateOrder:output_type -> orders_service.CreateOrderRes
-	7,  // 20: orders_service.OrdersService.SubmitOrder:output_type -> orders_service.SubmitOrderRes
-	11, // 21: orders_service.OrdersService.UpdateShoppingCart:output_type -> orders_service.UpdateShoppingCartRes
-	9,  // 22: orders_service.OrdersService.GetOrderByID:output_type -> orders_service.GetOrderByIDRes
-	13, // 23: orders_service.OrdersService.GetOrders:output_type -> orders_service.GetOrdersRes
-	19, // [19:24] is the sub-list for method output_type
-	14, // [14:19] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	16, // 14: orders_service.OrderCreatedV1.Created:type_name -> google.protobuf.Timestamp
+	2,  // 15: orders_service.OrderCreatedV1.Order:type_name -> orders_service.OrderReadModel
+	4,  // 16: orders_service.OrdersService.CreateOrder:input_type -> orders_service.CreateOrderReq
+	6,  // 17: orders_service.OrdersService.SubmitOrder:input_type -> orders_service.SubmitOrderReq
+	10, // 18: orders_service.OrdersService.UpdateShoppingCart:input_type -> orders_service.UpdateShoppingCartReq
+	8,  // 19: orders_service.OrdersService.GetOrderByID:input_type -> orders_service.GetOrderByIDReq
+	12, // 20: orders_service.OrdersService.GetOrders:input_type -> orders_service.GetOrdersReq
+	5,  // 21: orders_service.OrdersService.CreateOrder:output_type -> orders_service.CreateOrderRes
+	7,  // 22: orders_service.OrdersService.SubmitOrder:output_type -> orders_service.SubmitOrderRes
+	11, // 23: orders_service.OrdersService.UpdateShoppingCart:output_type -> orders_service.UpdateShoppingCartRes
+	9,  // 24: orders_service.OrdersService.GetOrderByID:output_type -> orders_service.GetOrderByIDRes
+	13, // 25: orders_service.OrdersService.GetOrders:output_type -> orders_service.GetOrdersRes
+	21, // [21:26] is the sub-list for method output_type
+	16, // [16:21] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_orders_proto_init() }
@@ -1200,7 +1344,7 @@ func file_orders_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_orders_proto_rawDesc), len(file_orders_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -29,6 +29,8 @@ const (
 // OrdersServiceClient is the client API for OrdersService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// the http annotations are served by the grpc-gateway
 type OrdersServiceClient interface {
 	CreateOrder(ctx context.Context, in *CreateOrderReq, opts ...grpc.CallOption) (*CreateOrderRes, error)
 	SubmitOrder(ctx context.Context, in *SubmitOrderReq, opts ...grpc.CallOption) (*SubmitOrderRes, error)
@@ -98,6 +100,8 @@ func (c *ordersServiceClient) GetOrders(ctx context.Context, in *GetOrdersReq, o
 // OrdersServiceServer is the server API for OrdersService service.
 // All implementations should embed UnimplementedOrdersServiceServer
 // for forward compatibility.
+//
+// the http annotations are served by the grpc-gateway
 type OrdersServiceServer interface {
 	CreateOrder(context.Context, *CreateOrderReq) (*CreateOrderRes, error)
 	SubmitOrder(context.Context, *SubmitOrderReq) (*SubmitOrderRes, error)
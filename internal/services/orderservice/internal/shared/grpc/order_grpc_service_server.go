@@ -4,9 +4,11 @@ package grpc
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"emperror.dev/errors"
 	"github.com/go-playground/validator"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/grpc/gateway"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/mapper"
 	"github.com/raphaeldiscky/go-food-micro/internal/pkg/utils"
@@ -27,10 +29,20 @@ import (
 	GetOrderByIDQueryV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/gettingorderbyid/v1/queries"
 	getOrdersDtosV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/gettingorders/v1/dtos"
 	getOrdersQueryV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/gettingorders/v1/queries"
+	submitOrderCommandV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/submittingorder/v1/commands"
+	submitOrderDtosV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/submittingorder/v1/dtos"
+	updateShoppingCartCommandV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/updatingshoppingcard/v1/commands"
+	updateShoppingCartDtosV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/updatingshoppingcard/v1/dtos"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/shared/contracts"
 	grpcOrderService "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/shared/grpc/genproto"
 )
 
+// default paging of the list rpcs when the request doesn't set it.
+const (
+	defaultPageSize = 10
+	defaultPage     = 1
+)
+
 // OrderGrpcServiceServer is the order grpc service server.
 type OrderGrpcServiceServer struct {
 	ordersMetrics *contracts.OrdersMetrics
@@ -113,7 +125,13 @@ func (o OrderGrpcServiceServer) CreateOrder(
 		return nil, err
 	}
 
-	return &grpcOrderService.CreateOrderRes{OrderID: result.OrderID.String()}, nil
+	gateway.SetStatusCode(ctx, http.StatusCreated)
+	gateway.SetETag(ctx, result.Version)
+
+	return &grpcOrderService.CreateOrderRes{
+		OrderID: result.OrderID.String(),
+		Version: result.Version,
+	}, nil
 }
 
 // GetOrderByID gets the order by id.
@@ -190,23 +208,175 @@ func (o OrderGrpcServiceServer) GetOrderByID(
 		return nil, utils2.TraceStatusFromContext(ctx, err)
 	}
 
+	if q != nil {
+		gateway.SetETag(ctx, q.Version)
+	}
+
 	return &grpcOrderService.GetOrderByIDRes{Order: order}, nil
 }
 
 // SubmitOrder submits an order.
 func (o OrderGrpcServiceServer) SubmitOrder(
-	_ context.Context,
-	_ *grpcOrderService.SubmitOrderReq,
+	ctx context.Context,
+	req *grpcOrderService.SubmitOrderReq,
 ) (*grpcOrderService.SubmitOrderRes, error) {
-	return nil, nil
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute2.Object("Request", req))
+	o.ordersMetrics.GrpcMetrics.SubmitOrderGrpcRequests.Add(
+		ctx,
+		1,
+		api.WithAttributes(getGrpcMetricsAttributes()),
+	)
+
+	orderIDUUID, err := uuid.FromString(req.GetOrderID())
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(
+			err,
+			"[OrderGrpcServiceServer_SubmitOrder.uuid.FromString] error in converting uuid",
+		)
+		o.logger.Errorf(
+			fmt.Sprintf(
+				"[OrderGrpcServiceServer_SubmitOrder.uuid.FromString] err: %v",
+				badRequestErr,
+			),
+		)
+
+		return nil, badRequestErr
+	}
+
+	expectedVersion, err := expectedVersion(ctx, req.GetExpectedVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	command, err := submitOrderCommandV1.NewSubmitOrder(orderIDUUID, expectedVersion)
+	if err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(
+			err,
+			"[OrderGrpcServiceServer_SubmitOrder.StructCtx] command validation failed",
+		)
+		o.logger.Errorf(
+			fmt.Sprintf("[OrderGrpcServiceServer_SubmitOrder.StructCtx] err: %v", validationErr),
+		)
+
+		return nil, validationErr
+	}
+
+	result, err := mediatr.Send[*submitOrderCommandV1.SubmitOrder, *submitOrderDtosV1.SubmitOrderResponseDto](
+		ctx,
+		command,
+	)
+	if err != nil {
+		err = errors.WithMessage(
+			err,
+			"[OrderGrpcServiceServer_SubmitOrder.Send] error in sending SubmitOrder",
+		)
+		o.logger.Errorw(
+			fmt.Sprintf(
+				"[OrderGrpcServiceServer_SubmitOrder.Send] id: {%s}, err: %v",
+				command.OrderID,
+				err,
+			),
+			logger.Fields{"ID": command.OrderID},
+		)
+
+		return nil, err
+	}
+
+	gateway.SetETag(ctx, result.Version)
+
+	return &grpcOrderService.SubmitOrderRes{
+		OrderID: result.OrderID.String(),
+		Version: result.Version,
+	}, nil
 }
 
 // UpdateShoppingCart updates the shopping cart.
 func (o OrderGrpcServiceServer) UpdateShoppingCart(
-	_ context.Context,
-	_ *grpcOrderService.UpdateShoppingCartReq,
+	ctx context.Context,
+	req *grpcOrderService.UpdateShoppingCartReq,
 ) (*grpcOrderService.UpdateShoppingCartRes, error) {
-	return nil, nil
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute2.Object("Request", req))
+	o.ordersMetrics.GrpcMetrics.UpdateOrderGrpcRequests.Add(
+		ctx,
+		1,
+		api.WithAttributes(getGrpcMetricsAttributes()),
+	)
+
+	orderIDUUID, err := uuid.FromString(req.GetOrderID())
+	if err != nil {
+		badRequestErr := customErrors.NewBadRequestErrorWrap(
+			err,
+			"[OrderGrpcServiceServer_UpdateShoppingCart.uuid.FromString] error in converting uuid",
+		)
+		o.logger.Errorf(
+			fmt.Sprintf(
+				"[OrderGrpcServiceServer_UpdateShoppingCart.uuid.FromString] err: %v",
+				badRequestErr,
+			),
+		)
+
+		return nil, badRequestErr
+	}
+
+	shopItemsDtos, err := mapper.Map[[]*dtosV1.ShopItemDto](req.GetShopItems())
+	if err != nil {
+		return nil, err
+	}
+
+	expectedVersion, err := expectedVersion(ctx, req.GetExpectedVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	command, err := updateShoppingCartCommandV1.NewUpdateShoppingCart(
+		orderIDUUID,
+		shopItemsDtos,
+		expectedVersion,
+	)
+	if err != nil {
+		validationErr := customErrors.NewValidationErrorWrap(
+			err,
+			"[OrderGrpcServiceServer_UpdateShoppingCart.StructCtx] command validation failed",
+		)
+		o.logger.Errorf(
+			fmt.Sprintf(
+				"[OrderGrpcServiceServer_UpdateShoppingCart.StructCtx] err: %v",
+				validationErr,
+			),
+		)
+
+		return nil, validationErr
+	}
+
+	result, err := mediatr.Send[*updateShoppingCartCommandV1.UpdateShoppingCart, *updateShoppingCartDtosV1.UpdateShoppingCartResponseDto](
+		ctx,
+		command,
+	)
+	if err != nil {
+		err = errors.WithMessage(
+			err,
+			"[OrderGrpcServiceServer_UpdateShoppingCart.Send] error in sending UpdateShoppingCart",
+		)
+		o.logger.Errorw(
+			fmt.Sprintf(
+				"[OrderGrpcServiceServer_UpdateShoppingCart.Send] id: {%s}, err: %v",
+				command.OrderID,
+				err,
+			),
+			logger.Fields{"ID": command.OrderID},
+		)
+
+		return nil, err
+	}
+
+	gateway.SetETag(ctx, result.Version)
+
+	return &grpcOrderService.UpdateShoppingCartRes{
+		OrderID: result.OrderID.String(),
+		Version: result.Version,
+	}, nil
 }
 
 // GetOrders gets the orders.
@@ -222,9 +392,7 @@ func (o OrderGrpcServiceServer) GetOrders(
 	span := trace.SpanFromContext(ctx)
 	span.SetAttributes(attribute2.Object("Request", req))
 
-	query := getOrdersQueryV1.NewGetOrders(
-		&utils.ListQuery{Page: int(req.Page), Size: int(req.Size)},
-	)
+	query := getOrdersQueryV1.NewGetOrders(newListQuery(req.GetPage(), req.GetSize()))
 
 	queryResult, err := mediatr.Send[*getOrdersQueryV1.GetOrders, *getOrdersDtosV1.GetOrdersResponseDto](
 		ctx,
@@ -252,3 +420,26 @@ func (o OrderGrpcServiceServer) GetOrders(
 
 	return ordersResponse, nil
 }
+
+// expectedVersion returns the expected version of the request, or the `If-Match` version forwarded by the gateway
+// when the request has none.
+func expectedVersion(ctx context.Context, requested int64) (int64, error) {
+	if requested != 0 {
+		return requested, nil
+	}
+
+	return gateway.IfMatchVersion(ctx)
+}
+
+// newListQuery creates the list query of the list rpcs, the zero paging values fall back to the defaults.
+func newListQuery(page int32, size int32) *utils.ListQuery {
+	query := utils.NewListQuery(int(size), int(page))
+	if query.Size <= 0 {
+		query.Size = defaultPageSize
+	}
+	if query.Page <= 0 {
+		query.Page = defaultPage
+	}
+
+	return query
+}
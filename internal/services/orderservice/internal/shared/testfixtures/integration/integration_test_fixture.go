@@ -116,6 +116,11 @@ func (i *OrderIntegrationTestSharedFixture) TearDownTest() {
 
 // cleanupRabbitmqData cleans up the rabbitmq data.
 func (i *OrderIntegrationTestSharedFixture) cleanupRabbitmqData() error {
+	// the in-memory bus has no rabbitmq server to clean up
+	if i.rabbitmqOptions.InMemory {
+		return nil
+	}
+
 	// https://github.com/michaelklishin/rabbit-hole
 	// Get all queues
 	queues, err := i.RabbitmqCleaner.ListQueuesIn(
@@ -197,6 +202,7 @@ func seedReadModelData(
 			PaymentID:       gofakeit.UUID(),
 			CreatedAt:       gofakeit.Date(),
 			UpdatedAt:       gofakeit.Date(),
+			Version:         1,
 		},
 		{
 			ID:              gofakeit.UUID(),
@@ -214,6 +220,7 @@ func seedReadModelData(
 			PaymentID:       gofakeit.UUID(),
 			CreatedAt:       gofakeit.Date(),
 			UpdatedAt:       gofakeit.Date(),
+			Version:         1,
 		},
 	}
 
@@ -309,6 +309,63 @@ func (_c *OrderMongoRepository_GetOrderByOrderID_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// RedactCustomerOrders provides a mock function with given fields: ctx, accountEmail
+func (_m *OrderMongoRepository) RedactCustomerOrders(ctx context.Context, accountEmail string) (int64, error) {
+	ret := _m.Called(ctx, accountEmail)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RedactCustomerOrders")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, accountEmail)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, accountEmail)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, accountEmail)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderMongoRepository_RedactCustomerOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RedactCustomerOrders'
+type OrderMongoRepository_RedactCustomerOrders_Call struct {
+	*mock.Call
+}
+
+// RedactCustomerOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - accountEmail string
+func (_e *OrderMongoRepository_Expecter) RedactCustomerOrders(ctx interface{}, accountEmail interface{}) *OrderMongoRepository_RedactCustomerOrders_Call {
+	return &OrderMongoRepository_RedactCustomerOrders_Call{Call: _e.mock.On("RedactCustomerOrders", ctx, accountEmail)}
+}
+
+func (_c *OrderMongoRepository_RedactCustomerOrders_Call) Run(run func(ctx context.Context, accountEmail string)) *OrderMongoRepository_RedactCustomerOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OrderMongoRepository_RedactCustomerOrders_Call) Return(_a0 int64, _a1 error) *OrderMongoRepository_RedactCustomerOrders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderMongoRepository_RedactCustomerOrders_Call) RunAndReturn(run func(context.Context, string) (int64, error)) *OrderMongoRepository_RedactCustomerOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SearchOrders provides a mock function with given fields: ctx, searchText, listQuery
 func (_m *OrderMongoRepository) SearchOrders(ctx context.Context, searchText string, listQuery *utils.ListQuery) (*utils.ListResult[*readmodels.OrderReadModel], error) {
 	ret := _m.Called(ctx, searchText, listQuery)
@@ -14,10 +14,7 @@ import (
 
 	gofakeit "github.com/brianvoe/gofakeit/v6"
 	httpexpect "github.com/gavv/httpexpect/v2"
-	customTypes "github.com/raphaeldiscky/go-food-micro/internal/pkg/core/customtypes"
 
-	dtosV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/dtos/v1"
-	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/creatingorder/v1/dtos"
 	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/shared/testfixtures/integration"
 )
 
@@ -32,7 +29,7 @@ func TestCreateOrder(t *testing.T) {
 var _ = Describe("CreateOrder Feature", func() {
 	var (
 		ctx     context.Context
-		request *dtos.CreateOrderRequestDto
+		request map[string]interface{}
 	)
 
 	_ = BeforeEach(func() {
@@ -50,16 +47,17 @@ var _ = Describe("CreateOrder Feature", func() {
 	// "Scenario" for testing the creation of an order with valid input
 	Describe("Create new order return created status with valid input", func() {
 		BeforeEach(func() {
-			request = &dtos.CreateOrderRequestDto{
-				AccountEmail:    gofakeit.Email(),
-				DeliveryAddress: gofakeit.Address().Address,
-				DeliveryTime:    customTypes.CustomTime(time.Now()),
-				ShopItems: []*dtosV1.ShopItemDto{
+			// the gateway binds the body to the proto request, so the fields have its names
+			request = map[string]interface{}{
+				"AccountEmail":    gofakeit.Email(),
+				"DeliveryAddress": gofakeit.Address().Address,
+				"DeliveryTime":    time.Now().UTC().Format(time.RFC3339),
+				"ShopItems": []map[string]interface{}{
 					{
-						Quantity:    uint64(gofakeit.Number(1, 10)),
-						Description: gofakeit.AdjectiveDescriptive(),
-						Price:       gofakeit.Price(100, 10000),
-						Title:       gofakeit.Name(),
+						"Quantity":    gofakeit.Number(1, 10),
+						"Description": gofakeit.AdjectiveDescriptive(),
+						"Price":       gofakeit.Price(100, 10000),
+						"Title":       gofakeit.Name(),
 					},
 				},
 			}
@@ -73,7 +71,8 @@ var _ = Describe("CreateOrder Feature", func() {
 					WithContext(ctx).
 					WithJSON(request).
 					Expect().
-					Status(http.StatusCreated)
+					Status(http.StatusCreated).
+					Header("ETag").NotEmpty()
 			})
 		})
 	})
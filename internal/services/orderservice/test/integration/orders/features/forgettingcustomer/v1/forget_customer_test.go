@@ -0,0 +1,147 @@
+//go:build integration
+// +build integration
+
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/encryption"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	gofakeit "github.com/brianvoe/gofakeit/v6"
+	mediatr "github.com/mehdihadeli/go-mediatr"
+	testUtils "github.com/raphaeldiscky/go-food-micro/internal/pkg/test/utils"
+
+	dtosV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/dtos/v1"
+	createOrderCommandV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/creatingorder/v1/commands"
+	createOrderDtosV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/creatingorder/v1/dtos"
+	forgetCustomerCommandV1 "github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/forgettingcustomer/v1/commands"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/forgettingcustomer/v1/dtos"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/models/orders/readmodels"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/shared/testfixtures/integration"
+)
+
+var integrationFixture *integration.OrderIntegrationTestSharedFixture
+
+func TestForgetCustomer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	integrationFixture = integration.NewOrderIntegrationTestSharedFixture(t)
+	RunSpecs(t, "Forget Customer Integration Tests")
+}
+
+var _ = Describe("Forget Customer Feature", func() {
+	var (
+		ctx          context.Context
+		err          error
+		accountEmail string
+		createResult *createOrderDtosV1.CreateOrderResponseDto
+		result       *dtos.ForgetCustomerResponseDto
+	)
+
+	_ = BeforeEach(func() {
+		By("Seeding the required data")
+		integrationFixture.SetupTest()
+	})
+
+	_ = AfterEach(func() {
+		By("Cleanup test data")
+		integrationFixture.TearDownTest()
+	})
+
+	_ = BeforeSuite(func() {
+		ctx = context.Background()
+
+		// in test mode we set rabbitmq `AutoStart=false` in configuration in rabbitmqOptions, so we should run rabbitmq bus manually
+		err = integrationFixture.Bus.Start(context.Background())
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// wait for consumers ready to consume before publishing messages, preparation background workers takes a bit time (for preventing messages lost)
+		time.Sleep(1 * time.Second)
+	})
+
+	_ = AfterSuite(func() {
+		integrationFixture.Log.Info("TearDownSuite started")
+		err := integrationFixture.Bus.Stop()
+		Expect(err).ShouldNot(HaveOccurred())
+		time.Sleep(1 * time.Second)
+	})
+
+	// "Scenario" for forgetting a customer with an existing order
+	Describe("Forgetting a customer with an order in EventStoreDB", func() {
+		BeforeEach(func() {
+			accountEmail = gofakeit.Email()
+
+			createCommand, err := createOrderCommandV1.NewCreateOrder(
+				[]*dtosV1.ShopItemDto{
+					{
+						Quantity:    uint64(gofakeit.Number(1, 10)),
+						Description: gofakeit.AdjectiveDescriptive(),
+						Price:       gofakeit.Price(100, 10000),
+						Title:       gofakeit.Name(),
+					},
+				},
+				accountEmail,
+				gofakeit.Address().Address,
+				time.Now(),
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			createResult, err = mediatr.Send[*createOrderCommandV1.CreateOrder, *createOrderDtosV1.CreateOrderResponseDto](
+				ctx,
+				createCommand,
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			// the read model is projected before the customer is forgotten, so its redaction is tested too
+			err = testUtils.WaitUntilConditionMet(func() bool {
+				order, err := integrationFixture.OrderMongoRepository.GetOrderByOrderID(ctx, createResult.OrderID)
+				Expect(err).ToNot(HaveOccurred())
+
+				return order != nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		When("the ForgetCustomer command is executed for the customer", func() {
+			BeforeEach(func() {
+				command, err := forgetCustomerCommandV1.NewForgetCustomer(accountEmail)
+				Expect(err).ToNot(HaveOccurred())
+
+				result, err = mediatr.Send[*forgetCustomerCommandV1.ForgetCustomer, *dtos.ForgetCustomerResponseDto](
+					ctx,
+					command,
+				)
+			})
+
+			It("Should forget the customer successfully", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).ToNot(BeNil())
+				Expect(result.SubjectID).To(Equal(encryption.SubjectID(accountEmail)))
+				Expect(result.RedactedOrders).To(BeNumerically(">=", 1))
+			})
+
+			It("Should load the order aggregate with the personal data redacted", func() {
+				order, err := integrationFixture.OrderAggregateStore.Load(ctx, createResult.OrderID)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(order.AccountEmail()).To(Equal(encryption.RedactedValue))
+				Expect(order.DeliveryAddress()).To(Equal(encryption.RedactedValue))
+				Expect(order.ShopItems()).To(HaveLen(1))
+			})
+
+			It("Should redact the order in MongoDB Read database", func() {
+				var order *readmodels.OrderReadModel
+				order, err = integrationFixture.OrderMongoRepository.GetOrderByOrderID(ctx, createResult.OrderID)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(order.AccountEmail).To(Equal(encryption.RedactedValue))
+				Expect(order.DeliveryAddress).To(Equal(encryption.RedactedValue))
+			})
+		})
+	})
+})
@@ -0,0 +1,146 @@
+//go:build unit
+// +build unit
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/authentication"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/http/customecho/handlers"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger/defaultlogger"
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/metric/noop"
+
+	echo "github.com/labstack/echo/v4"
+	mediatr "github.com/mehdihadeli/go-mediatr"
+	authenticationMiddleware "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/customecho/middlewares/authentication"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/contracts/params"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/forgettingcustomer/v1/commands"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/forgettingcustomer/v1/dtos"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/forgettingcustomer/v1/endpoints"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/shared/contracts"
+)
+
+const (
+	customerEmail = "john@example.com"
+	otherEmail    = "jane@example.com"
+)
+
+// forgetCustomerRecorder records the forgotten customers instead of forgetting them.
+type forgetCustomerRecorder struct {
+	forgotten []string
+}
+
+func (r *forgetCustomerRecorder) Handle(
+	_ context.Context,
+	command *commands.ForgetCustomer,
+) (*dtos.ForgetCustomerResponseDto, error) {
+	r.forgotten = append(r.forgotten, command.AccountEmail)
+
+	return &dtos.ForgetCustomerResponseDto{RedactedOrders: 1}, nil
+}
+
+type forgetCustomerEndpointUnitTests struct {
+	suite.Suite
+	recorder       *forgetCustomerRecorder
+	tokenValidator *authentication.TokenValidator
+	echo           *echo.Echo
+}
+
+func TestForgetCustomerEndpointUnit(t *testing.T) {
+	suite.Run(t, &forgetCustomerEndpointUnitTests{})
+}
+
+func (s *forgetCustomerEndpointUnitTests) SetupTest() {
+	s.recorder = &forgetCustomerRecorder{}
+	s.Require().NoError(
+		mediatr.RegisterRequestHandler[*commands.ForgetCustomer, *dtos.ForgetCustomerResponseDto](s.recorder),
+	)
+
+	s.tokenValidator = authentication.NewTokenValidator(
+		&authentication.AuthenticationOptions{SigningKey: "test-signing-key"},
+	)
+
+	forgetCustomerRequests, err := noop.NewMeterProvider().Meter("test").Float64Counter("forget_customer")
+	s.Require().NoError(err)
+
+	log := defaultlogger.GetLogger()
+	s.echo = echo.New()
+	s.echo.HTTPErrorHandler = func(err error, c echo.Context) {
+		handlers.ProblemDetailErrorHandlerFunc(err, c, log)
+	}
+
+	endpoints.NewForgetCustomerEndpoint(params.OrderRouteParams{
+		Logger:      log,
+		OrdersGroup: s.echo.Group("/api/v1/orders", authenticationMiddleware.Authentication(s.tokenValidator)),
+		OrdersMetrics: &contracts.OrdersMetrics{
+			HTTPMetrics: &contracts.HTTPMetrics{ForgetCustomerHTTPRequests: forgetCustomerRequests},
+		},
+	}).MapEndpoint()
+}
+
+func (s *forgetCustomerEndpointUnitTests) TearDownTest() {
+	mediatr.ClearRequestRegistrations()
+}
+
+func (s *forgetCustomerEndpointUnitTests) token(email string, roles ...string) string {
+	token, err := s.tokenValidator.Issue(
+		&authentication.Principal{Subject: email, Email: email, Roles: roles},
+		time.Minute,
+	)
+	s.Require().NoError(err)
+
+	return token
+}
+
+func (s *forgetCustomerEndpointUnitTests) forget(accountEmail string, token string) *httptest.ResponseRecorder {
+	body, err := json.Marshal(&dtos.ForgetCustomerRequestDto{AccountEmail: accountEmail})
+	s.Require().NoError(err)
+
+	request := httptest.NewRequest(http.MethodPost, "/api/v1/orders/customers/forget", strings.NewReader(string(body)))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	if token != "" {
+		request.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	}
+
+	response := httptest.NewRecorder()
+	s.echo.ServeHTTP(response, request)
+
+	return response
+}
+
+func (s *forgetCustomerEndpointUnitTests) Test_Should_Reject_An_Anonymous_Caller() {
+	response := s.forget(customerEmail, "")
+
+	s.Assert().Equal(http.StatusUnauthorized, response.Code)
+	s.Assert().Empty(s.recorder.forgotten)
+}
+
+func (s *forgetCustomerEndpointUnitTests) Test_Should_Reject_Another_Customer() {
+	response := s.forget(customerEmail, s.token(otherEmail))
+
+	s.Assert().Equal(http.StatusForbidden, response.Code)
+	s.Assert().Empty(s.recorder.forgotten)
+}
+
+func (s *forgetCustomerEndpointUnitTests) Test_Should_Forget_The_Customer_Itself() {
+	response := s.forget(customerEmail, s.token(customerEmail))
+
+	s.Require().Equal(http.StatusOK, response.Code)
+	s.Assert().Equal([]string{customerEmail}, s.recorder.forgotten)
+}
+
+func (s *forgetCustomerEndpointUnitTests) Test_Should_Forget_Any_Customer_For_An_Admin() {
+	response := s.forget(customerEmail, s.token("admin@example.com", authentication.RoleAdmin))
+
+	s.Require().Equal(http.StatusOK, response.Code)
+	s.Assert().Equal([]string{customerEmail}, s.recorder.forgotten)
+}
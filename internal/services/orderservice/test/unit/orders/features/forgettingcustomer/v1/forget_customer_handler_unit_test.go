@@ -0,0 +1,100 @@
+//go:build unit
+// +build unit
+
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/encryption"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger/defaultlogger"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/otel/tracing"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/forgettingcustomer/v1/commands"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/mocks"
+)
+
+type forgetCustomerHandlerUnitTests struct {
+	suite.Suite
+	ctx                    context.Context
+	subjectKeyStore        *encryption.InMemorySubjectKeyStore
+	mongoOrderRepository   *mocks.OrderMongoRepository
+	elasticOrderRepository *mocks.OrderElasticRepository
+	handler                *commands.ForgetCustomerHandler
+}
+
+func TestForgetCustomerHandlerUnit(t *testing.T) {
+	suite.Run(t, &forgetCustomerHandlerUnitTests{})
+}
+
+func (s *forgetCustomerHandlerUnitTests) SetupTest() {
+	s.ctx = context.Background()
+	s.subjectKeyStore = encryption.NewInMemorySubjectKeyStore()
+	s.mongoOrderRepository = mocks.NewOrderMongoRepository(s.T())
+	s.elasticOrderRepository = mocks.NewOrderElasticRepository(s.T())
+	s.handler = commands.NewForgetCustomerHandler(
+		defaultlogger.GetLogger(),
+		s.subjectKeyStore,
+		s.mongoOrderRepository,
+		s.elasticOrderRepository,
+		tracing.NewAppTracer("test"),
+	)
+}
+
+func (s *forgetCustomerHandlerUnitTests) Test_Handle_Should_Destroy_The_Customer_Key_And_Redact_The_Read_Models() {
+	subjectID := encryption.SubjectID(customerEmail)
+	key, err := s.subjectKeyStore.GetOrCreateKey(s.ctx, subjectID)
+	s.Require().NoError(err)
+
+	s.mongoOrderRepository.On("RedactCustomerOrders", mock.Anything, customerEmail).Return(int64(2), nil)
+	s.elasticOrderRepository.On("RedactCustomerOrders", mock.Anything, customerEmail).Return(int64(2), nil)
+
+	command, err := commands.NewForgetCustomer(customerEmail)
+	s.Require().NoError(err)
+
+	result, err := s.handler.Handle(s.ctx, command)
+	s.Require().NoError(err)
+
+	s.Assert().Equal(subjectID, result.SubjectID)
+	s.Assert().Equal(int64(2), result.RedactedOrders)
+
+	_, err = s.subjectKeyStore.Key(s.ctx, key.ID)
+	s.Assert().ErrorIs(err, encryption.ErrKeyShredded)
+}
+
+func (s *forgetCustomerHandlerUnitTests) Test_Handle_Should_Return_The_Error_Of_The_Mongo_Redaction() {
+	s.mongoOrderRepository.On("RedactCustomerOrders", mock.Anything, customerEmail).
+		Return(int64(0), errors.New("mongo is down"))
+
+	command, err := commands.NewForgetCustomer(customerEmail)
+	s.Require().NoError(err)
+
+	result, err := s.handler.Handle(s.ctx, command)
+
+	s.Require().Error(err)
+	s.Assert().Contains(err.Error(), "mongo is down")
+	s.Assert().Nil(result)
+	s.elasticOrderRepository.AssertNotCalled(s.T(), "RedactCustomerOrders", mock.Anything, mock.Anything)
+}
+
+func (s *forgetCustomerHandlerUnitTests) Test_Handle_Should_Keep_The_Other_Customers_Keys() {
+	otherKey, err := s.subjectKeyStore.GetOrCreateKey(s.ctx, encryption.SubjectID(otherEmail))
+	s.Require().NoError(err)
+
+	s.mongoOrderRepository.On("RedactCustomerOrders", mock.Anything, customerEmail).Return(int64(0), nil)
+	s.elasticOrderRepository.On("RedactCustomerOrders", mock.Anything, customerEmail).Return(int64(0), nil)
+
+	command, err := commands.NewForgetCustomer(customerEmail)
+	s.Require().NoError(err)
+
+	_, err = s.handler.Handle(s.ctx, command)
+	s.Require().NoError(err)
+
+	key, err := s.subjectKeyStore.Key(s.ctx, otherKey.ID)
+	s.Require().NoError(err)
+	s.Assert().Equal(otherKey.Material, key.Material)
+}
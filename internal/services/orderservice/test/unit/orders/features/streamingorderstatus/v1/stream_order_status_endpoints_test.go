@@ -0,0 +1,241 @@
+//go:build unit
+// +build unit
+
+package v1
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/authentication"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/http/customecho/handlers"
+	"github.com/raphaeldiscky/go-food-micro/internal/pkg/logger/defaultlogger"
+	"github.com/stretchr/testify/suite"
+
+	echo "github.com/labstack/echo/v4"
+	authenticationMiddleware "github.com/raphaeldiscky/go-food-micro/internal/pkg/http/customecho/middlewares/authentication"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/contracts/params"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/features/streamingorderstatus/v1/endpoints"
+	"github.com/raphaeldiscky/go-food-micro/internal/services/orderservice/internal/orders/statusstream"
+)
+
+const (
+	customerEmail = "john@example.com"
+	otherEmail    = "jane@example.com"
+)
+
+type streamOrderStatusEndpointsUnitTests struct {
+	suite.Suite
+	hub            *statusstream.OrderStatusHub
+	tokenValidator *authentication.TokenValidator
+	server         *httptest.Server
+}
+
+func TestStreamOrderStatusEndpointsUnit(t *testing.T) {
+	suite.Run(t, &streamOrderStatusEndpointsUnitTests{})
+}
+
+func (s *streamOrderStatusEndpointsUnitTests) SetupTest() {
+	s.hub = statusstream.NewOrderStatusHub()
+	s.tokenValidator = authentication.NewTokenValidator(
+		&authentication.AuthenticationOptions{SigningKey: "test-signing-key"},
+	)
+
+	log := defaultlogger.GetLogger()
+	e := echo.New()
+	e.HTTPErrorHandler = func(err error, c echo.Context) {
+		handlers.ProblemDetailErrorHandlerFunc(err, c, log)
+	}
+
+	routeParams := params.OrderRouteParams{
+		Logger:      log,
+		OrdersGroup: e.Group("/api/v1/orders", authenticationMiddleware.Authentication(s.tokenValidator)),
+	}
+	endpoints.NewStreamOrderStatusSSEEndpoint(routeParams, s.hub).MapEndpoint()
+	endpoints.NewStreamOrderStatusWebsocketEndpoint(routeParams, s.hub).MapEndpoint()
+
+	// closed after the streams of the test, the server waits for the open connections
+	s.server = httptest.NewServer(e)
+	s.T().Cleanup(s.server.Close)
+}
+
+func (s *streamOrderStatusEndpointsUnitTests) token(email string, roles ...string) string {
+	token, err := s.tokenValidator.Issue(
+		&authentication.Principal{Subject: email, Email: email, Roles: roles},
+		time.Minute,
+	)
+	s.Require().NoError(err)
+
+	return token
+}
+
+func (s *streamOrderStatusEndpointsUnitTests) openSSE(query string, token string) *http.Response {
+	request, err := http.NewRequest(http.MethodGet, s.server.URL+"/api/v1/orders/status-stream?"+query, nil)
+	s.Require().NoError(err)
+	if token != "" {
+		request.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	s.Require().NoError(err)
+	s.T().Cleanup(func() { _ = response.Body.Close() })
+
+	return response
+}
+
+func (s *streamOrderStatusEndpointsUnitTests) dialWebsocket(query string, token string) (*websocket.Conn, *http.Response, error) {
+	header := http.Header{}
+	if token != "" {
+		header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	}
+
+	url := "ws" + strings.TrimPrefix(s.server.URL, "http") + "/api/v1/orders/status-stream/ws?" + query
+	conn, response, err := websocket.DefaultDialer.Dial(url, header)
+	if conn != nil {
+		s.T().Cleanup(func() { _ = conn.Close() })
+	}
+
+	return conn, response, err
+}
+
+// readEvent reads the next server-sent event and returns its name and data.
+func (s *streamOrderStatusEndpointsUnitTests) readEvent(reader *bufio.Reader) (string, *statusstream.OrderStatusUpdate) {
+	var name string
+	update := &statusstream.OrderStatusUpdate{}
+
+	for {
+		line, err := reader.ReadString('\n')
+		s.Require().NoError(err)
+
+		line = strings.TrimRight(line, "\n")
+		switch {
+		case strings.HasPrefix(line, "event: "):
+			name = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			s.Require().NoError(json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), update))
+		case line == "" && name != "":
+			return name, update
+		}
+	}
+}
+
+func (s *streamOrderStatusEndpointsUnitTests) Test_SSE_Should_Stream_The_Updates_Of_The_Order() {
+	orderID := uuid.NewV4().String()
+
+	response := s.openSSE("orderId="+orderID, s.token("admin@example.com", authentication.RoleAdmin))
+	s.Require().Equal(http.StatusOK, response.StatusCode)
+	s.Assert().Equal("text/event-stream", response.Header.Get(echo.HeaderContentType))
+
+	s.hub.Publish(&statusstream.OrderStatusUpdate{OrderID: uuid.NewV4().String(), Type: statusstream.UpdateTypeOrderCreated})
+	s.hub.Publish(&statusstream.OrderStatusUpdate{
+		EventID: "event-1",
+		OrderID: orderID,
+		Type:    statusstream.UpdateTypeOrderSubmitted,
+		Status:  statusstream.OrderStatusSubmitted,
+	})
+
+	name, update := s.readEvent(bufio.NewReader(response.Body))
+	s.Assert().Equal(string(statusstream.UpdateTypeOrderSubmitted), name)
+	s.Assert().Equal(orderID, update.OrderID)
+	s.Assert().Equal(statusstream.OrderStatusSubmitted, update.Status)
+}
+
+func (s *streamOrderStatusEndpointsUnitTests) Test_SSE_Should_Reject_An_Account_Filter_Without_A_Token() {
+	response := s.openSSE("accountEmail="+customerEmail, "")
+
+	s.Assert().Equal(http.StatusUnauthorized, response.StatusCode)
+}
+
+func (s *streamOrderStatusEndpointsUnitTests) Test_SSE_Should_Reject_An_Order_Filter_Without_A_Token() {
+	response := s.openSSE("orderId="+uuid.NewV4().String(), "")
+
+	s.Assert().Equal(http.StatusUnauthorized, response.StatusCode)
+}
+
+func (s *streamOrderStatusEndpointsUnitTests) Test_SSE_Should_Reject_A_Customer_Token_Without_An_Email() {
+	token, err := s.tokenValidator.Issue(&authentication.Principal{Subject: "customer"}, time.Minute)
+	s.Require().NoError(err)
+
+	response := s.openSSE("orderId="+uuid.NewV4().String(), token)
+
+	s.Assert().Equal(http.StatusForbidden, response.StatusCode)
+}
+
+func (s *streamOrderStatusEndpointsUnitTests) Test_SSE_Should_Reject_The_Account_Of_Another_Customer() {
+	response := s.openSSE("accountEmail="+customerEmail, s.token(otherEmail))
+
+	s.Assert().Equal(http.StatusForbidden, response.StatusCode)
+}
+
+func (s *streamOrderStatusEndpointsUnitTests) Test_SSE_Should_Stream_Only_The_Own_Account_Updates_Of_A_Customer() {
+	orderID := uuid.NewV4().String()
+
+	// the order filter of an authenticated customer is narrowed to its own account
+	response := s.openSSE("orderId="+orderID, s.token(customerEmail))
+	s.Require().Equal(http.StatusOK, response.StatusCode)
+
+	s.hub.Publish(&statusstream.OrderStatusUpdate{OrderID: orderID, AccountEmail: otherEmail, Type: statusstream.UpdateTypeOrderCreated})
+	s.hub.Publish(&statusstream.OrderStatusUpdate{OrderID: orderID, AccountEmail: customerEmail, Type: statusstream.UpdateTypeOrderSubmitted})
+
+	name, update := s.readEvent(bufio.NewReader(response.Body))
+	s.Assert().Equal(string(statusstream.UpdateTypeOrderSubmitted), name)
+	s.Assert().Equal(customerEmail, update.AccountEmail)
+}
+
+func (s *streamOrderStatusEndpointsUnitTests) Test_SSE_Should_Stream_Any_Account_To_An_Admin() {
+	response := s.openSSE("accountEmail="+customerEmail, s.token("admin@example.com", authentication.RoleAdmin))
+	s.Require().Equal(http.StatusOK, response.StatusCode)
+
+	s.hub.Publish(&statusstream.OrderStatusUpdate{OrderID: uuid.NewV4().String(), AccountEmail: customerEmail, Type: statusstream.UpdateTypeOrderCreated})
+
+	_, update := s.readEvent(bufio.NewReader(response.Body))
+	s.Assert().Equal(customerEmail, update.AccountEmail)
+}
+
+func (s *streamOrderStatusEndpointsUnitTests) Test_SSE_Should_Reject_A_Request_Without_A_Filter() {
+	response := s.openSSE("", s.token("admin@example.com", authentication.RoleAdmin))
+
+	s.Assert().Equal(http.StatusBadRequest, response.StatusCode)
+}
+
+func (s *streamOrderStatusEndpointsUnitTests) Test_Websocket_Should_Stream_The_Updates_Of_The_Account() {
+	conn, _, err := s.dialWebsocket("accountEmail="+customerEmail, s.token(customerEmail))
+	s.Require().NoError(err)
+
+	orderID := uuid.NewV4().String()
+	s.hub.Publish(&statusstream.OrderStatusUpdate{OrderID: uuid.NewV4().String(), AccountEmail: otherEmail})
+	s.hub.Publish(&statusstream.OrderStatusUpdate{
+		OrderID:      orderID,
+		AccountEmail: customerEmail,
+		Type:         statusstream.UpdateTypeOrderCreated,
+		Status:       statusstream.OrderStatusCreated,
+	})
+
+	s.Require().NoError(conn.SetReadDeadline(time.Now().Add(5 * time.Second)))
+	update := &statusstream.OrderStatusUpdate{}
+	s.Require().NoError(conn.ReadJSON(update))
+	s.Assert().Equal(orderID, update.OrderID)
+	s.Assert().Equal(statusstream.OrderStatusCreated, update.Status)
+}
+
+func (s *streamOrderStatusEndpointsUnitTests) Test_Websocket_Should_Reject_An_Account_Filter_Without_A_Token() {
+	_, response, err := s.dialWebsocket("accountEmail="+customerEmail, "")
+
+	s.Require().ErrorIs(err, websocket.ErrBadHandshake)
+	s.Assert().Equal(http.StatusUnauthorized, response.StatusCode)
+}
+
+func (s *streamOrderStatusEndpointsUnitTests) Test_Websocket_Should_Reject_An_Invalid_Token() {
+	_, response, err := s.dialWebsocket("orderId="+uuid.NewV4().String(), "invalid")
+
+	s.Require().ErrorIs(err, websocket.ErrBadHandshake)
+	s.Assert().Equal(http.StatusUnauthorized, response.StatusCode)
+}
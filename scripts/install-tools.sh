@@ -37,6 +37,10 @@ go install honnef.co/go/tools/cmd/staticcheck@2025.1.1
 # https://pkg.go.dev/google.golang.org/grpc/cmd/protoc-gen-go-grpc
 go install google.golang.org/grpc/cmd/protoc-gen-go-grpc@v1.5.1
 
+# https://github.com/grpc-ecosystem/grpc-gateway
+go install github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-grpc-gateway@v2.27.1
+go install github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2@v2.27.1
+
 # migration tools
 # https://github.com/pressly/goose
 go install github.com/pressly/goose/v3/cmd/goose@v3.24.3
@@ -5,12 +5,18 @@ set -e
 
 readonly service="$1"
 readonly outPath="./internal/services/$service/internal/shared/grpc/genproto"
+readonly openapiPath="./api/openapi/$service/grpc"
+
+mkdir -p "$openapiPath"
 
 # https://stackoverflow.com/questions/13616033/install-protocol-buffers-on-windows
 # https://dev.to/techschoolguru/how-to-define-a-protobuf-message-and-generate-go-code-4g4e
 protoc \
   --proto_path="api/protobuf/$service" \
+  --proto_path="api/protobuf/third_party" \
   --go_out="$outPath" \
   --go-grpc_out="$outPath" \
   --go-grpc_opt=require_unimplemented_servers=false \
+  --grpc-gateway_out="$outPath" \
+  --openapiv2_out="$openapiPath" \
     api/protobuf/$service/*.proto